@@ -0,0 +1,201 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiAxiModel"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemServer"
+	"github.com/ReconfigureIO/smi/go-template/src/smiProtocol"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//
+// Implements a repeatable command line flag for specifying error regions,
+// using the '<base>:<size>=slverr' or '<base>:<size>=decerr' format.
+//
+type errorRegionFlags struct {
+	regions []smiAxiModel.AxiErrorRegion
+}
+
+func (flags *errorRegionFlags) String() string {
+	return ""
+}
+
+func (flags *errorRegionFlags) Set(value string) error {
+	invalid := errors.New(fmt.Sprintf(
+		"Invalid error region specification (%s)", value))
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 {
+		return invalid
+	}
+	region := smiAxiModel.AxiErrorRegion{}
+	switch strings.ToLower(parts[1]) {
+	case "slverr":
+		region.Resp = smiProtocol.StatusSlvErr
+	case "decerr":
+		region.Resp = smiProtocol.StatusDecErr
+	default:
+		return invalid
+	}
+	location := strings.SplitN(parts[0], ":", 2)
+	if len(location) != 2 {
+		return invalid
+	}
+	var err error
+	region.Base, err = strconv.ParseUint(location[0], 0, 64)
+	if err == nil {
+		region.Size, err = strconv.ParseUint(location[1], 0, 64)
+	}
+	if err != nil || region.Size == 0 {
+		return invalid
+	}
+	flags.regions = append(flags.regions, region)
+	return nil
+}
+
+//
+// Implements a repeatable command line flag for specifying memory images to
+// load, using the '<addr>=<file>' format.
+//
+type loadFlags struct {
+	addrs     []uint64
+	fileNames []string
+}
+
+func (flags *loadFlags) String() string {
+	return ""
+}
+
+func (flags *loadFlags) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return errors.New(fmt.Sprintf(
+			"Invalid memory region specification (%s)", value))
+	}
+	addr, err := strconv.ParseUint(parts[0], 0, 64)
+	if err != nil {
+		return errors.New(fmt.Sprintf(
+			"Invalid memory region specification (%s)", value))
+	}
+	flags.addrs = append(flags.addrs, addr)
+	flags.fileNames = append(flags.fileNames, parts[1])
+	return nil
+}
+
+//
+// Combines the standard input and output streams for use as the bridge
+// connection when running over pipes.
+//
+type stdioConn struct {
+	io.Reader
+	io.Writer
+}
+
+func main() {
+	socketPathPtr := flag.String("socket", "",
+		"the Unix domain socket path to listen on (standard input and output if not set)")
+	dataWidthPtr := flag.Uint("axiBusWidth", 64,
+		"the AXI data bus width in bits (64, 128, 256 or 512)")
+	config := smiAxiModel.AxiSlaveConfig{}
+	flag.UintVar(&config.MinLatency, "minLatency", 10,
+		"the minimum response latency in clock cycles")
+	flag.UintVar(&config.MaxLatency, "maxLatency", 40,
+		"the maximum response latency in clock cycles")
+	flag.Float64Var(&config.ReadyRate, "readyRate", 1.0,
+		"the probability of asserting each ready signal on a given cycle")
+	flag.Float64Var(&config.ValidRate, "validRate", 1.0,
+		"the probability of presenting an available response on a given cycle")
+	flag.BoolVar(&config.Reorder, "reorder", false,
+		"allow responses for different AXI IDs to complete out of order")
+	flag.UintVar(&config.MaxOutstanding, "maxOutstanding", 0,
+		"the maximum number of outstanding bursts per direction (0 for no limit)")
+	flag.Float64Var(&config.SlvErrRate, "slvErrRate", 0,
+		"the probability of a burst completing with a SLVERR response")
+	flag.Float64Var(&config.DecErrRate, "decErrRate", 0,
+		"the probability of a burst completing with a DECERR response")
+	flag.Int64Var(&config.Seed, "seed", 1,
+		"the random number generator seed")
+	errorRegions := &errorRegionFlags{}
+	flag.Var(errorRegions, "errorRegion",
+		"always return an error response, as <base>:<size>=slverr|decerr (may be repeated)")
+	loads := &loadFlags{}
+	flag.Var(loads, "load",
+		"initialise memory from a file, as <addr>=<file> (may be repeated)")
+	flag.Parse()
+	config.DataWidth = *dataWidthPtr / 8
+	config.ErrorRegions = errorRegions.regions
+
+	// Initialise the memory contents from the host files.
+	memory := smiMemServer.NewSparseMemory()
+	for i, fileName := range loads.fileNames {
+		data, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		memory.Write(loads.addrs[i], data)
+	}
+	slave, err := smiAxiModel.NewAxiSlave(memory, config)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Run the bridge over the selected connection.
+	if *socketPathPtr == "" {
+		err = smiAxiModel.ServeAxiBridge(stdioConn{os.Stdin, os.Stdout}, slave)
+	} else {
+		var listener net.Listener
+		listener, err = net.Listen("unix", *socketPathPtr)
+		if err == nil {
+			fmt.Fprintf(os.Stderr, "Waiting for connection on %s\n", *socketPathPtr)
+			var conn net.Conn
+			conn, err = listener.Accept()
+			listener.Close()
+			if err == nil {
+				err = smiAxiModel.ServeAxiBridge(conn, slave)
+				conn.Close()
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Report the slave activity and any protocol violations.
+	stats := slave.Stats
+	fmt.Fprintf(os.Stderr, "Read bursts  : %d (%d errors)\n", stats.ReadBursts, stats.ReadErrors)
+	fmt.Fprintf(os.Stderr, "Write bursts : %d (%d errors)\n", stats.WriteBursts, stats.WriteErrors)
+	if !slave.Idle() {
+		fmt.Fprintln(os.Stderr, "Outstanding bursts not completed by testbench")
+	}
+	for _, violation := range stats.ProtocolErrors {
+		fmt.Fprintf(os.Stderr, "AXI protocol error at %s\n", violation)
+	}
+	if len(stats.ProtocolErrors) != 0 {
+		os.Exit(1)
+	}
+}
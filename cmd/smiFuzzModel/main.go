@@ -0,0 +1,97 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiFuzzModel"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemServer"
+	"io/ioutil"
+	"os"
+)
+
+func main() {
+	seedPtr := flag.Uint64("seed", smiFuzzModel.DefaultRandSeed,
+		"the random number generator seed")
+
+	// Raw random number sequence options.
+	randCountPtr := flag.Uint64("randCount", 0,
+		"print the specified number of raw random values instead of fuzz test parameters")
+	randWidthPtr := flag.Uint("randWidth", 64,
+		"the random source data width in bits when printing raw values")
+
+	// Fuzz test configuration options.
+	addrBasePtr := flag.Uint64("memAddrBase", 0,
+		"the base address of the fuzz test window")
+	blockSizePtr := flag.Uint("memBlockSize", 4096,
+		"the size of the fuzz test window in bytes")
+	numTestsPtr := flag.Uint("numTests", 16,
+		"the number of fuzz test bursts in each run")
+	numRunsPtr := flag.Uint("numRuns", 1,
+		"the number of fuzz test runs issued after reset")
+	dumpPtr := flag.String("dump", "",
+		"write the expected test window contents after the final run to a file")
+	flag.Parse()
+
+	// Print the raw random number sequence if required.
+	if *randCountPtr != 0 {
+		source, err := smiFuzzModel.NewRandSource(*randWidthPtr, *seedPtr)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		digits := (*randWidthPtr + 3) / 4
+		for i := uint64(0); i < *randCountPtr; i++ {
+			fmt.Printf("%0*X\n", digits, source.Next())
+		}
+		return
+	}
+
+	// Generate the burst parameters for each fuzz test run in turn.
+	gen, err := smiFuzzModel.NewFuzzParamGen(*seedPtr)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	config := smiFuzzModel.DefaultFuzzTestConfig(
+		*addrBasePtr, uint32(*blockSizePtr), uint32(*numTestsPtr))
+	memory := smiMemServer.NewSparseMemory()
+	for run := uint(0); run < *numRunsPtr; run++ {
+		params, err := gen.Generate(config)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		fmt.Printf("Run %d: data count %d\n", run, smiFuzzModel.ExpectedDataCount(params))
+		for i, param := range params {
+			fmt.Printf("  %6d addr 0x%016X len %8d init 0x%016X incr 0x%016X\n",
+				i, param.BaseAddr, param.ByteLength, param.DataInit, param.DataIncr)
+			param.Apply(memory)
+		}
+	}
+
+	// Save the expected test window contents.
+	if *dumpPtr != "" {
+		data := make([]byte, *blockSizePtr)
+		memory.Read(*addrBasePtr, data)
+		if err := ioutil.WriteFile(*dumpPtr, data, 0644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemServer"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//
+// Specifies a memory region which is associated with a host file.
+//
+type memoryRegion struct {
+	addr     uint64
+	length   uint64
+	fileName string
+}
+
+//
+// Implements a repeatable command line flag for specifying memory regions,
+// using the '<addr>=<file>' format if the region length is derived from the
+// file or the '<addr>:<length>=<file>' format otherwise.
+//
+type regionFlags struct {
+	regions    []memoryRegion
+	needLength bool
+}
+
+func (flags *regionFlags) String() string {
+	return ""
+}
+
+func (flags *regionFlags) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return errors.New(fmt.Sprintf(
+			"Invalid memory region specification (%s)", value))
+	}
+	region := memoryRegion{fileName: parts[1]}
+	location := strings.SplitN(parts[0], ":", 2)
+	if flags.needLength != (len(location) == 2) {
+		return errors.New(fmt.Sprintf(
+			"Invalid memory region specification (%s)", value))
+	}
+	var err error
+	region.addr, err = strconv.ParseUint(location[0], 0, 64)
+	if err == nil && flags.needLength {
+		region.length, err = strconv.ParseUint(location[1], 0, 64)
+	}
+	if err != nil {
+		return errors.New(fmt.Sprintf(
+			"Invalid memory region specification (%s)", value))
+	}
+	flags.regions = append(flags.regions, region)
+	return nil
+}
+
+//
+// Combines the standard input and output streams for use as the bridge
+// connection when running over pipes.
+//
+type stdioConn struct {
+	io.Reader
+	io.Writer
+}
+
+func main() {
+	socketPathPtr := flag.String("socket", "",
+		"the Unix domain socket path to listen on (standard input and output if not set)")
+	config := smiMemServer.ServerConfig{}
+	flag.Uint64Var(&config.ReadLatency, "readLatency", 20,
+		"the read response latency in clock cycles")
+	flag.Uint64Var(&config.WriteLatency, "writeLatency", 10,
+		"the write response latency in clock cycles")
+	flag.Uint64Var(&config.AddressLimit, "addressLimit", 0,
+		"the size of the valid address range in bytes (0 for no limit)")
+	loads := &regionFlags{needLength: false}
+	flag.Var(loads, "load",
+		"initialise memory from a file, as <addr>=<file> (may be repeated)")
+	dumps := &regionFlags{needLength: true}
+	flag.Var(dumps, "dump",
+		"save memory to a file on exit, as <addr>:<length>=<file> (may be repeated)")
+	flag.Parse()
+
+	// Initialise the memory contents from the host files.
+	memory := smiMemServer.NewSparseMemory()
+	for _, region := range loads.regions {
+		data, err := ioutil.ReadFile(region.fileName)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		memory.Write(region.addr, data)
+	}
+	server := smiMemServer.NewSmiMemServer(memory, config)
+
+	// Run the bridge over the selected connection.
+	var err error
+	if *socketPathPtr == "" {
+		err = smiMemServer.ServeBridge(stdioConn{os.Stdin, os.Stdout}, server)
+	} else {
+		var listener net.Listener
+		listener, err = net.Listen("unix", *socketPathPtr)
+		if err == nil {
+			fmt.Fprintf(os.Stderr, "Waiting for connection on %s\n", *socketPathPtr)
+			var conn net.Conn
+			conn, err = listener.Accept()
+			listener.Close()
+			if err == nil {
+				err = smiMemServer.ServeBridge(conn, server)
+				conn.Close()
+			}
+		}
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	if server.Pending() != 0 {
+		fmt.Fprintf(os.Stderr, "%d responses not collected by testbench\n", server.Pending())
+	}
+
+	// Save the specified memory regions to the host files.
+	for _, region := range dumps.regions {
+		data := make([]byte, region.length)
+		memory.Read(region.addr, data)
+		if err := ioutil.WriteFile(region.fileName, data, 0644); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//
+// Specifies the result of generating a single batch configuration.
+//
+type batchResult struct {
+	output bytes.Buffer // Dry run file listing.
+	err    error        // Generation error, if any.
+}
+
+//
+// Records the errors for all the invalid configurations in a batch file, so
+// that they can be reported together.
+//
+type batchConfigErrors struct {
+	errs       []error // Errors for the invalid configurations, in order.
+	numConfigs int     // Total number of configurations in the batch file.
+}
+
+func (e batchConfigErrors) Error() string {
+	messages := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+//
+// Reads and validates a JSON batch file, which contains an array of project
+// file objects. Each configuration must use a different output directory,
+// since the configurations are generated concurrently. Every configuration
+// is checked, and if any are invalid the errors for all of them are returned
+// as a batchConfigErrors value, so that no configurations are generated
+// from a partially valid batch file. All errors are prefixed with the batch
+// file name.
+//
+func loadBatchFile(fileName string) ([]generatorOptions, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	batchError := func(message string) error {
+		return errors.New(fmt.Sprintf("%s: %s", fileName, message))
+	}
+
+	// Split the array into the individual configurations, recording the
+	// first line of each so that errors are reported against the batch file.
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil {
+		return nil, batchError(projectDecodeError(data, 1, err).Error())
+	} else if token != json.Delim('[') {
+		return nil, batchError("Expected an array of configurations")
+	}
+	configs := make([]generatorOptions, 0)
+	configErrs := batchConfigErrors{}
+	outputDirs := make(map[string]int)
+	for decoder.More() {
+		// Skip the separating comma and white space to find the start of the
+		// next configuration.
+		start := decoder.InputOffset()
+		for (start < int64(len(data))) &&
+			((data[start] == ',') || unicode.IsSpace(rune(data[start]))) {
+			start++
+		}
+		firstLine := projectLineNumber(data, start, 1)
+		var config json.RawMessage
+		if err := decoder.Decode(&config); err != nil {
+			return nil, batchError(projectDecodeError(data, 1, err).Error())
+		}
+
+		configErrs.numConfigs++
+		index := configErrs.numConfigs
+		options, err := parseProjectFile(config, firstLine)
+		if err != nil {
+			configErrs.errs = append(configErrs.errs, batchError(
+				fmt.Sprintf("configuration %d: %s", index, err.Error())))
+			continue
+		}
+		options.outputDir = resolveProjectPath(fileName, options.outputDir)
+		if other, ok := outputDirs[options.outputDir]; ok {
+			configErrs.errs = append(configErrs.errs, batchError(fmt.Sprintf(
+				"configuration %d: Output directory %s is also used by configuration %d",
+				index, options.outputDir, other)))
+			continue
+		}
+		outputDirs[options.outputDir] = index
+		configs = append(configs, options)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, batchError(projectDecodeError(data, 1, err).Error())
+	}
+	if decoder.More() {
+		return nil, batchError(fmt.Sprintf("line %d: Unexpected data after configurations",
+			projectLineNumber(data, decoder.InputOffset(), 1)))
+	}
+	if len(configErrs.errs) != 0 {
+		return nil, configErrs
+	}
+	if len(configs) == 0 {
+		return nil, batchError("No configurations specified")
+	}
+	return configs, nil
+}
+
+//
+// Generates a set of configurations concurrently, using the specified number
+// of worker goroutines. The generation results are returned in configuration
+// order, with any errors recorded against the individual configurations.
+//
+func runBatchGenerator(configs []generatorOptions, settings outputSettings,
+	numJobs int) []batchResult {
+
+	results := make([]batchResult, len(configs))
+	indices := make(chan int)
+	workers := sync.WaitGroup{}
+	for job := 0; job < numJobs; job++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for index := range indices {
+				result := &results[index]
+				result.err = runGenerator(configs[index], settings,
+					generateAdaptor, &result.output)
+			}
+		}()
+	}
+	for index := range configs {
+		indices <- index
+	}
+	close(indices)
+	workers.Wait()
+	return results
+}
+
+//
+// Implements the 'batch' command. If any configurations are invalid, each
+// of them is reported and nothing is generated. Dry run listings are printed
+// in configuration order once all the configurations have been processed,
+// and each failed configuration is reported before returning an overall
+// error.
+//
+func runBatch(args []string) error {
+	settings := outputSettings{}
+	flags := newFlagSet("batch")
+	checkOnly := flags.Bool("check", false,
+		"check the batch file without generating any files")
+	numJobs := flags.Int("jobs", runtime.NumCPU(),
+		"the number of configurations to generate concurrently")
+	settings.addFlags(flags, nil)
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return usageError{errors.New("Expected a single batch file name")}
+	}
+	if *numJobs < 1 {
+		return usageError{errors.New(fmt.Sprintf(
+			"Invalid number of jobs (%d)", *numJobs))}
+	}
+	configs, err := loadBatchFile(flags.Arg(0))
+	if configErrs, ok := err.(batchConfigErrors); ok {
+		for _, configErr := range configErrs.errs {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", os.Args[0], configErr)
+		}
+		return errors.New(fmt.Sprintf("%s: %d of %d configurations are invalid",
+			flags.Arg(0), len(configErrs.errs), configErrs.numConfigs))
+	}
+	if err != nil || *checkOnly {
+		return err
+	}
+
+	failures := 0
+	for index, result := range runBatchGenerator(configs, settings, *numJobs) {
+		os.Stdout.Write(result.output.Bytes())
+		if result.err != nil {
+			fmt.Fprintf(os.Stderr, "%s: configuration %d (%s): %s\n", os.Args[0],
+				index+1, configs[index].outputDir, result.err)
+			failures++
+		}
+	}
+	if failures != 0 {
+		return errors.New(fmt.Sprintf("%d of %d configurations failed",
+			failures, len(configs)))
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//
+// Writes a batch file to a temporary directory and loads it, returning the
+// batch file name along with the results.
+//
+func loadTestBatchFile(t *testing.T, tempDir string, batch string) (
+	string, []generatorOptions, error) {
+
+	fileName := filepath.Join(tempDir, "batch.json")
+	if err := ioutil.WriteFile(fileName, []byte(batch), 0666); err != nil {
+		t.Fatal(err)
+	}
+	configs, err := loadBatchFile(fileName)
+	return fileName, configs, err
+}
+
+//
+// Tests that valid batch files are split into the individual configurations,
+// with the output directories resolved relative to the batch file.
+//
+func TestLoadBatchFile(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "smiMemWrapperGen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	_, configs, err := loadTestBatchFile(t, tempDir, `[
+  {"targetPlatform": "sdaccel", "numMemPorts": 2, "outputDir": "a"},
+  {"targetPlatform": "llvm", "numMemPorts": 8, "outputDir": "b"},
+  {"targetPlatform": "sdaccel", "numMemPorts": 4}
+]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	outputDirs := make([]string, len(configs))
+	numMemPorts := make([]uint, len(configs))
+	for i, config := range configs {
+		outputDirs[i] = config.outputDir
+		numMemPorts[i] = config.numMemPorts
+	}
+	expectedDirs := []string{filepath.Join(tempDir, "a"), filepath.Join(tempDir, "b"), tempDir}
+	if !reflect.DeepEqual(outputDirs, expectedDirs) {
+		t.Errorf("output directories %v, expected %v", outputDirs, expectedDirs)
+	}
+	if !reflect.DeepEqual(numMemPorts, []uint{2, 8, 4}) {
+		t.Errorf("memory port counts %v, expected [2 8 4]", numMemPorts)
+	}
+}
+
+//
+// Tests that invalid batch files are rejected. Errors in the individual
+// configurations are all reported together, in configuration order and
+// using line numbers in the batch file.
+//
+func TestLoadBatchFileErrors(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "smiMemWrapperGen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	outputDir := filepath.Join(tempDir, "a")
+	tests := []struct {
+		batch    string
+		messages []string
+	}{
+		{`{"targetPlatform": "sdaccel", "numMemPorts": 2}`,
+			[]string{"Expected an array of configurations"}},
+		{`[]`,
+			[]string{"No configurations specified"}},
+		{"[\n  {\"targetPlatform\": \"sdaccel\", \"numMemPorts\": 2},\n  {\"targetPlatform\": }\n]",
+			[]string{"line 3: "}},
+		{`[{"targetPlatform": "sdaccel", "numMemPorts": 2}] []`,
+			[]string{"line 1: Unexpected data after configurations"}},
+		{`[
+  {"targetPlatform": "sdaccel", "numMemPorts": 2, "outputDir": "a"},
+  {"targetPlatform": "sdaccel", "numMemPorts": 3, "outputDir": "a"},
+  {"targetPlatform": "sdaccel", "numMemPorts": 4, "outputDir": "a"}
+]`,
+			[]string{
+				"configuration 2: Output directory " + outputDir +
+					" is also used by configuration 1",
+				"configuration 3: Output directory " + outputDir +
+					" is also used by configuration 1"}},
+		{`[
+  {"targetPlatform": "sdaccel", "numMemPorts": 2, "outputDir": "a"},
+  {"targetPlatform": "sdaccel", "numMemPorts": 99, "outputDir": "b"},
+  {"targetPlatform": "sdaccel", "numMemPorts": 2, "outputDir": "c",
+   "kernelArgsWidth": 2, "fuzzTest": true},
+  {"targetPlatform": "sdaccel", "numMemPorts": 2, "outputDir": "d",
+   "numPorts": 2},
+  {"targetPlatform": "sdaccel",
+   "numMemPorts": "two", "outputDir": "e"}
+]`,
+			[]string{
+				"configuration 2: Invalid number of SMI memory ports (99)",
+				`configuration 3: Field "kernelArgsWidth" can not be used with "fuzzTest"`,
+				`configuration 4: Unknown field "numPorts"`,
+				"configuration 5: line 9: Invalid value for numMemPorts"}}}
+	for i, test := range tests {
+		fileName, configs, err := loadTestBatchFile(t, tempDir, test.batch)
+		if err == nil {
+			t.Errorf("batch %d: not rejected", i)
+			continue
+		}
+		if configs != nil {
+			t.Errorf("batch %d: configurations returned with error", i)
+		}
+		messages := []string{err.Error()}
+		if configErrs, ok := err.(batchConfigErrors); ok {
+			messages = make([]string, len(configErrs.errs))
+			for j, configErr := range configErrs.errs {
+				messages[j] = configErr.Error()
+			}
+		}
+		if len(messages) != len(test.messages) {
+			t.Errorf("batch %d: errors %q, expected %q", i, messages, test.messages)
+			continue
+		}
+		for j, message := range messages {
+			if !strings.HasPrefix(message, fileName+": "+test.messages[j]) {
+				t.Errorf("batch %d: error '%s', expected '%s: %s'",
+					i, message, fileName, test.messages[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,593 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemTemplates"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+//
+// Specifies the generated files and supported options for a single target
+// platform.
+//
+type platformConfig struct {
+	name             string                       // Platform name used on the command line.
+	description      string                       // Short description for the platform list.
+	kernelName       func(numClients uint) string // Name of the user kernel module.
+	adaptorName      string                       // Name of the generated kernel adaptor module.
+	busAdaptorName   string                       // Library memory bus adaptor module.
+	outputStyles     []string                     // Supported output styles.
+	vivadoPackage    bool                         // Generate Vivado IP packaging files.
+	controlSlave     bool                         // Include the AXI control slave when packaging.
+	useAxiBusIdWidth bool                         // Adaptor uses the AXI ID width option.
+	fuzzTest         bool                         // Supports the fuzz test kernel.
+	verilatorHarness bool                         // Supports the Verilator simulation harness.
+	clockCrossing    bool                         // Supports separate kernel and memory clocks.
+}
+
+//
+// Returns a kernel name function for platforms with a fixed kernel name.
+//
+func fixedKernelName(kernelName string) func(uint) string {
+	return func(uint) string { return kernelName }
+}
+
+//
+// Lists the supported target platforms, in the order they are reported.
+//
+var platformConfigs = []platformConfig{
+	{"sdaccel", "Xilinx SDAccel RTL kernel with AXI memory and control interfaces",
+		func(numClients uint) string {
+			return fmt.Sprintf("teak__action__top__smi__x%d", numClients)
+		},
+		"teak__action__top__gmem", "smiAxiMemBusAdaptor",
+		[]string{"verilog", "systemverilog", "vhdl"}, true, true, false, true, true, true},
+	{"llvm", "LLVM generated kernel with an AXI memory interface",
+		fixedKernelName("teak___x24_main_x2e_Top_x3a_public"),
+		"llvm_kernel_smi_adaptor", "smiAxiMemBusAdaptor",
+		[]string{"verilog", "systemverilog", "vhdl"}, true, false, true, false, true, true},
+	{"huawei-fp1", "Huawei FP1 kernel with an AXI memory interface",
+		fixedKernelName("teak__main_x2e_Top"),
+		"fp1_teak_action_top_gmem", "smiAxiMemBusAdaptor",
+		[]string{"verilog", "systemverilog"}, true, false, false, false, false, false},
+	{"intel-avalon", "Intel OpenCL BSP kernel with an Avalon memory interface",
+		fixedKernelName("teak___x24_main_x2e_Top_x3a_public"),
+		"avalon_kernel_smi_adaptor", "smiAvmMemBusAdaptor",
+		[]string{"verilog"}, false, false, false, false, false, false},
+}
+
+//
+// Looks up the platform configuration for the named target platform.
+//
+func findPlatform(name string) (platformConfig, error) {
+	names := make([]string, len(platformConfigs))
+	for i, platform := range platformConfigs {
+		if platform.name == name {
+			return platform, nil
+		}
+		names[i] = platform.name
+	}
+	return platformConfig{}, errors.New(fmt.Sprintf(
+		"Invalid target platform (%s), expected one of: %s",
+		name, strings.Join(names, ", ")))
+}
+
+//
+// Matches valid Verilog module names.
+//
+var moduleNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+//
+// Specifies the generator options which are common to all subcommands.
+//
+type generatorOptions struct {
+	numMemPorts      uint                                   // Number of SMI memory ports.
+	axiBusWidth      uint                                   // Width of the AXI data bus.
+	axiBusIdWidth    uint                                   // Width of the AXI ID bus.
+	kernelArgsWidth  uint                                   // Number of 32-bit kernel argument words.
+	kernelName       string                                 // User kernel module name override.
+	targetPlatform   string                                 // Name of the target platform.
+	outputStyle      string                                 // Generated HDL style.
+	outputDir        string                                 // Directory for the generated files.
+	treeOptions      smiMemTemplates.ArbitrationTreeOptions // Arbiter FIFO and pipeline settings.
+	fuzzTest         bool                                   // Generate a fuzz test kernel.
+	verilatorHarness bool                                   // Generate a Verilator simulation harness.
+	clockCrossing    string                                 // Location of the memory clock domain crossing.
+
+	// The following are derived from the options during validation.
+	scalingFactor uint           // Bus width scaling factor.
+	fileExt       string         // Output file extension.
+	platform      platformConfig // Target platform configuration.
+}
+
+//
+// Returns the default generator options.
+//
+func defaultGeneratorOptions() generatorOptions {
+	return generatorOptions{
+		numMemPorts:     1,
+		axiBusWidth:     64,
+		axiBusIdWidth:   1,
+		kernelArgsWidth: 1,
+		targetPlatform:  "sdaccel",
+		outputStyle:     "verilog",
+		treeOptions:     smiMemTemplates.DefaultArbitrationTreeOptions(),
+		clockCrossing:   smiMemTemplates.ClockCrossingNone}
+}
+
+//
+// Implements a command line flag for specifying a list of unsigned integers,
+// using a comma separated list. The flag may be repeated, in which case the
+// lists are concatenated.
+//
+type uintListFlag struct {
+	values *[]uint
+}
+
+func (flag *uintListFlag) String() string {
+	if (flag == nil) || (flag.values == nil) {
+		return ""
+	}
+	fields := make([]string, len(*flag.values))
+	for i, value := range *flag.values {
+		fields[i] = strconv.FormatUint(uint64(value), 10)
+	}
+	return strings.Join(fields, ",")
+}
+
+func (flag *uintListFlag) Set(value string) error {
+	for _, field := range strings.Split(value, ",") {
+		parsed, err := strconv.ParseUint(strings.TrimSpace(field), 10, 32)
+		if err != nil {
+			return errors.New(fmt.Sprintf(
+				"Invalid list entry (%s), expected an unsigned integer", field))
+		}
+		*flag.values = append(*flag.values, uint(parsed))
+	}
+	return nil
+}
+
+//
+// Registers the options which define the arbitration tree. The current option
+// settings are used as the defaults for each of the option registration
+// functions.
+//
+func (options *generatorOptions) addTreeFlags(flags *flag.FlagSet) {
+	flags.UintVar(&options.numMemPorts, "numMemPorts", options.numMemPorts,
+		"the number of SMI memory ports")
+	flags.UintVar(&options.axiBusWidth, "axiBusWidth", options.axiBusWidth,
+		"the width of the AXI data bus (64, 128, 256 or 512)")
+	flags.UintVar(&options.treeOptions.FifoFlitDepth, "fifoDepth",
+		options.treeOptions.FifoFlitDepth, "the depth of the arbiter flit FIFOs (4 to 1024)")
+	flags.UintVar(&options.treeOptions.FifoFrames, "fifoFrames",
+		options.treeOptions.FifoFrames, "the maximum number of frames per arbiter FIFO (1 to 63)")
+	flags.Var(&uintListFlag{&options.treeOptions.PipelineLayers}, "pipelineLayers",
+		"comma separated list of tree layers with pipelined server side links (layer 0 is the server port)")
+	flags.Var(&uintListFlag{&options.treeOptions.PipelineClients}, "pipelineClients",
+		"comma separated list of SMI memory ports with pipelined client links")
+	flags.UintVar(&options.treeOptions.PipelineFanIn, "pipelineFanIn",
+		options.treeOptions.PipelineFanIn,
+		"pipeline the client links of arbiters with a higher fan in (0 to disable)")
+}
+
+//
+// Registers the output style option.
+//
+func (options *generatorOptions) addStyleFlags(flags *flag.FlagSet) {
+	flags.StringVar(&options.outputStyle, "outputStyle", options.outputStyle,
+		"the generated HDL style ('verilog', 'systemverilog' or 'vhdl')")
+}
+
+//
+// Registers the options which select the target platform and kernel adaptor.
+//
+func (options *generatorOptions) addPlatformFlags(flags *flag.FlagSet) {
+	flags.StringVar(&options.targetPlatform, "targetPlatform", options.targetPlatform,
+		"the target platform (see the 'platforms' command)")
+	flags.BoolVar(&options.fuzzTest, "fuzzTest", options.fuzzTest,
+		"include a memory fuzz test kernel for the SMI memory ports (sdaccel only)")
+	flags.StringVar(&options.clockCrossing, "clockCrossing", options.clockCrossing,
+		"the separate memory clock domain crossing ('none', 'kernel' or 'root', sdaccel or llvm only)")
+}
+
+//
+// Registers the remaining kernel adaptor options.
+//
+func (options *generatorOptions) addAdaptorFlags(flags *flag.FlagSet) {
+	flags.UintVar(&options.axiBusIdWidth, "axiBusIdWidth", options.axiBusIdWidth,
+		"the width of the AXI ID bus")
+	flags.UintVar(&options.kernelArgsWidth, "kernelArgsWidth", options.kernelArgsWidth,
+		fmt.Sprintf("the number of 32-bit kernel argument words (1 to %d)",
+			smiMemTemplates.MaxKernelArgsWidth))
+	flags.StringVar(&options.kernelName, "kernelName", options.kernelName,
+		"the user kernel module name (defaults to the platform kernel name)")
+	flags.BoolVar(&options.verilatorHarness, "verilatorHarness", options.verilatorHarness,
+		"generate a Verilator simulation harness and Makefile fragment (sdaccel or llvm only)")
+}
+
+//
+// Checks the arbitration tree options, deriving the bus width scaling factor
+// and output file extension.
+//
+func (options *generatorOptions) validateTree() error {
+	if (options.numMemPorts < 1) || (options.numMemPorts > 64) {
+		return errors.New(fmt.Sprintf(
+			"Invalid number of SMI memory ports (%d), expected 1 to 64",
+			options.numMemPorts))
+	}
+
+	// Convert the AXI bus width the bus width scaling factor.
+	switch options.axiBusWidth {
+	case 64:
+		options.scalingFactor = 1
+	case 128:
+		options.scalingFactor = 2
+	case 256:
+		options.scalingFactor = 4
+	case 512:
+		options.scalingFactor = 8
+	default:
+		return errors.New(fmt.Sprintf(
+			"Invalid AXI bus width (%d), expected 64, 128, 256 or 512",
+			options.axiBusWidth))
+	}
+	if err := options.treeOptions.Validate(); err != nil {
+		return err
+	}
+
+	// Check that the selected pipeline stages exist in the arbitration tree.
+	_, err := smiMemTemplates.DescribeArbitrationTreeWithOptions(
+		"", options.numMemPorts, options.scalingFactor, options.treeOptions)
+	if err != nil {
+		return err
+	}
+
+	// Select the output file extension for the requested output style.
+	switch options.outputStyle {
+	case "verilog":
+		options.fileExt = "v"
+	case "systemverilog":
+		options.fileExt = "sv"
+	case "vhdl":
+		options.fileExt = "vhd"
+	default:
+		return errors.New(fmt.Sprintf(
+			"Invalid output style (%s), expected verilog, systemverilog or vhdl",
+			options.outputStyle))
+	}
+	return nil
+}
+
+//
+// Checks the full set of kernel adaptor options against the capabilities of
+// the selected target platform.
+//
+func (options *generatorOptions) validateAdaptor() error {
+	err := options.validateTree()
+	if err != nil {
+		return err
+	}
+	options.platform, err = findPlatform(options.targetPlatform)
+	if err != nil {
+		return err
+	}
+	platform := options.platform
+
+	supported := false
+	for _, outputStyle := range platform.outputStyles {
+		supported = supported || (outputStyle == options.outputStyle)
+	}
+	if !supported {
+		return errors.New(fmt.Sprintf(
+			"Output style (%s) not supported for %s platform, expected %s",
+			options.outputStyle, platform.name,
+			strings.Join(platform.outputStyles, " or ")))
+	}
+
+	// The fuzz test kernel uses a fixed set of kernel arguments.
+	if options.fuzzTest {
+		if !platform.fuzzTest || (options.outputStyle != "verilog") {
+			return errors.New(
+				"Fuzz test kernel only supported for sdaccel platform with verilog output style")
+		}
+		options.kernelArgsWidth = smiMemTemplates.FuzzTestKernelArgsWidth
+	}
+	if options.verilatorHarness {
+		if !platform.verilatorHarness || (options.outputStyle != "verilog") {
+			return errors.New(
+				"Verilator harness only supported for sdaccel or llvm platforms with verilog output style")
+		}
+	}
+	if err := options.validateClockCrossing(); err != nil {
+		return err
+	}
+	if (options.kernelArgsWidth < 1) ||
+		(options.kernelArgsWidth > smiMemTemplates.MaxKernelArgsWidth) {
+		return errors.New(fmt.Sprintf(
+			"Invalid number of kernel argument words (%d), expected 1 to %d",
+			options.kernelArgsWidth, smiMemTemplates.MaxKernelArgsWidth))
+	}
+	if options.axiBusIdWidth < 1 {
+		return errors.New("Invalid AXI ID bus width (0)")
+	}
+	if (options.kernelName != "") && !moduleNameRegexp.MatchString(options.kernelName) {
+		return errors.New(fmt.Sprintf(
+			"Invalid kernel module name (%s)", options.kernelName))
+	}
+	return nil
+}
+
+//
+// Checks the clock domain crossing option against the capabilities of the
+// selected target platform. The Verilator harness only drives a single clock.
+//
+func (options *generatorOptions) validateClockCrossing() error {
+	switch options.clockCrossing {
+	case smiMemTemplates.ClockCrossingNone:
+		return nil
+	case smiMemTemplates.ClockCrossingKernel, smiMemTemplates.ClockCrossingRoot:
+	default:
+		return errors.New(fmt.Sprintf(
+			"Invalid clock crossing (%s), expected none, kernel or root",
+			options.clockCrossing))
+	}
+	if !options.platform.clockCrossing || (options.outputStyle != "verilog") {
+		return errors.New(
+			"Clock crossing only supported for sdaccel or llvm platforms with verilog output style")
+	}
+	if options.verilatorHarness {
+		return errors.New("Verilator harness can not be used with clock crossing")
+	}
+	return nil
+}
+
+//
+// Derives the arbitration tree module name from the validated options.
+//
+func (options *generatorOptions) treeModuleName() string {
+	return fmt.Sprintf("smiMemArbitrationTreeX%dS%d",
+		options.numMemPorts, options.scalingFactor)
+}
+
+//
+// Derives the output path for a generated file. The generated file names
+// are always relative to the output directory, since they are also used to
+// refer to the source files from the generated packaging scripts.
+//
+func (options *generatorOptions) outputPath(fileName string) string {
+	return filepath.Join(options.outputDir, fileName)
+}
+
+//
+// Generates the arbitration tree for the validated options, returning the
+// list of generated files. The tree source file is always the last entry.
+//
+func generateTree(options *generatorOptions) ([]string, error) {
+	if options.outputDir != "" {
+		if err := os.MkdirAll(options.outputDir, 0755); err != nil {
+			return nil, err
+		}
+	}
+	moduleName := options.treeModuleName()
+	fileName := fmt.Sprintf("%s.%s", moduleName, options.fileExt)
+	fileNames := make([]string, 0, 2)
+	var err error
+	switch options.outputStyle {
+	case "systemverilog":
+		err = smiMemTemplates.CreateSmiSvInterfaces(
+			options.outputPath("smiInterfaces.sv"))
+		if err == nil {
+			fileNames = append(fileNames, "smiInterfaces.sv")
+			err = smiMemTemplates.CreateArbitrationTreeSvWithOptions(
+				options.outputPath(fileName), moduleName, options.numMemPorts,
+				options.scalingFactor, options.treeOptions)
+		}
+	case "vhdl":
+		err = smiMemTemplates.CreateArbitrationTreeVhdlWithOptions(
+			options.outputPath(fileName), moduleName, options.numMemPorts,
+			options.scalingFactor, options.treeOptions)
+	default:
+		err = smiMemTemplates.CreateArbitrationTreeWithOptions(
+			options.outputPath(fileName), moduleName, options.numMemPorts,
+			options.scalingFactor, options.treeOptions)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return append(fileNames, fileName), nil
+}
+
+//
+// Generates the arbitration tree, kernel adaptor and platform packaging files
+// for the validated options, returning the list of generated files.
+//
+func generateAdaptor(options *generatorOptions) ([]string, error) {
+	fileNames, err := generateTree(options)
+	if err != nil {
+		return nil, err
+	}
+	treeFileName := fileNames[len(fileNames)-1]
+
+	// Build the wrapper component with the specified number of ports.
+	platform := options.platform
+	numClients := options.numMemPorts
+	scalingFactor := options.scalingFactor
+	kernelName := options.kernelName
+	if kernelName == "" {
+		kernelName = platform.kernelName(numClients)
+	}
+	moduleName := platform.adaptorName
+	fileName := fmt.Sprintf("%s.%s", moduleName, options.fileExt)
+	filePath := options.outputPath(fileName)
+	sourceFiles := []string{treeFileName}
+	switch platform.name {
+	case "sdaccel":
+		switch options.outputStyle {
+		case "systemverilog":
+			err = smiMemTemplates.CreateSmiSdaKernelAdaptorSv(
+				filePath, moduleName, kernelName, numClients, scalingFactor)
+		case "vhdl":
+			err = smiMemTemplates.CreateSmiSdaKernelAdaptorVhdl(
+				filePath, moduleName, kernelName, numClients, scalingFactor)
+		default:
+			err = smiMemTemplates.CreateSmiSdaKernelAdaptorWithClockCrossing(
+				filePath, moduleName, kernelName, numClients, scalingFactor,
+				options.clockCrossing)
+			if err == nil {
+				err = smiMemTemplates.CreateSmiSdaKernelXml(
+					options.outputPath("kernel.xml"), moduleName, kernelName,
+					numClients, scalingFactor, options.kernelArgsWidth)
+			}
+			if err == nil {
+				err = smiMemTemplates.CreateSmiSdaComponentXmlWithClockCrossing(
+					options.outputPath("component.xml"), moduleName, kernelName,
+					numClients, scalingFactor, options.kernelArgsWidth,
+					options.clockCrossing)
+			}
+			if err == nil {
+				fileNames = append(fileNames, "kernel.xml", "component.xml")
+			}
+			if (err == nil) && options.fuzzTest {
+				kernelFileName := fmt.Sprintf("%s.%s", kernelName, options.fileExt)
+				err = smiMemTemplates.CreateSmiFuzzTestKernel(
+					options.outputPath(kernelFileName), kernelName, numClients)
+				sourceFiles = append(sourceFiles, kernelFileName)
+				fileNames = append(fileNames, kernelFileName)
+			}
+		}
+	case "llvm":
+		switch options.outputStyle {
+		case "systemverilog":
+			err = smiMemTemplates.CreateSmiLlvmKernelAdaptorSv(
+				filePath, moduleName, kernelName, numClients, scalingFactor,
+				options.axiBusIdWidth, options.kernelArgsWidth)
+		case "vhdl":
+			err = smiMemTemplates.CreateSmiLlvmKernelAdaptorVhdl(
+				filePath, moduleName, kernelName, numClients, scalingFactor,
+				options.axiBusIdWidth, options.kernelArgsWidth)
+		default:
+			err = smiMemTemplates.CreateSmiLlvmKernelAdaptorWithClockCrossing(
+				filePath, moduleName, kernelName, numClients, scalingFactor,
+				options.axiBusIdWidth, options.kernelArgsWidth, options.clockCrossing)
+		}
+	case "huawei-fp1":
+		// The FP1 kernel uses positional port associations, so there is no
+		// VHDL variant of the adaptor.
+		if options.outputStyle == "systemverilog" {
+			err = smiMemTemplates.CreateSmiFp1KernelAdaptorSv(
+				filePath, moduleName, kernelName, numClients, scalingFactor)
+		} else {
+			err = smiMemTemplates.CreateSmiFp1KernelAdaptor(
+				filePath, moduleName, kernelName, numClients, scalingFactor)
+		}
+	case "intel-avalon":
+		err = smiMemTemplates.CreateSmiAvalonKernelAdaptor(
+			filePath, moduleName, kernelName, numClients, scalingFactor,
+			options.kernelArgsWidth)
+	default:
+		err = errors.New(fmt.Sprintf(
+			"Invalid target platform (%s) for kernel adaptor", platform.name))
+	}
+	if err != nil {
+		return nil, err
+	}
+	fileNames = append(fileNames, fileName)
+	sourceFiles = append(sourceFiles, fileName)
+
+	// Build the Vivado IP packaging script for the generated files. The
+	// platform packaging files are only generated for the Verilog style.
+	if platform.vivadoPackage && (options.outputStyle == "verilog") {
+		axiBusIdWidth := uint(1)
+		if platform.useAxiBusIdWidth {
+			axiBusIdWidth = options.axiBusIdWidth
+		}
+		packageFileName := fmt.Sprintf("%s_package.tcl", moduleName)
+		err = smiMemTemplates.CreateVivadoPackageScriptWithOptions(
+			options.outputPath(packageFileName), moduleName, sourceFiles,
+			numClients, scalingFactor, axiBusIdWidth, platform.controlSlave,
+			options.fuzzTest, options.clockCrossing, options.treeOptions)
+		if err != nil {
+			return nil, err
+		}
+		fileNames = append(fileNames, packageFileName)
+	}
+
+	// Build the Verilator simulation harness for the generated files.
+	if options.verilatorHarness {
+		harnessName := fmt.Sprintf("%s_harness", moduleName)
+		err = smiMemTemplates.CreateVerilatorHarness(
+			options.outputPath(harnessName+".cpp"), moduleName, platform.name,
+			numClients, scalingFactor, options.kernelArgsWidth, options.fuzzTest)
+		if err == nil {
+			err = smiMemTemplates.CreateVerilatorHarnessMakefile(
+				options.outputPath(harnessName+".mk"), moduleName, platform.name,
+				sourceFiles, numClients, scalingFactor, options.kernelArgsWidth,
+				options.fuzzTest)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fileNames = append(fileNames, harnessName+".cpp", harnessName+".mk")
+	}
+	return fileNames, nil
+}
+
+//
+// Lists the library files required by the validated options. The first list
+// contains files from the verilog/ directory and the second list contains
+// files from the test/verilog/ directory. The bus adaptor, fuzz test and
+// clock domain bridge components are only included if a target platform has
+// been selected.
+//
+func requiredLibraryFiles(options *generatorOptions) ([]string, []string, error) {
+	busAdaptorName := ""
+	if options.platform.name != "" {
+		busAdaptorName = options.platform.busAdaptorName
+	}
+	libraryFiles, err := smiMemTemplates.RequiredLibraryFilesWithOptions(
+		options.numMemPorts, options.scalingFactor, busAdaptorName,
+		options.treeOptions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var testFiles []string
+	if options.fuzzTest {
+		fuzzLibraryFiles, fuzzTestFiles, err := smiMemTemplates.FuzzTestKernelFiles()
+		if err != nil {
+			return nil, nil, err
+		}
+		libraryFiles = smiMemTemplates.MergeLibraryFiles(libraryFiles, fuzzLibraryFiles)
+		testFiles = fuzzTestFiles
+	}
+	if options.clockCrossing != smiMemTemplates.ClockCrossingNone {
+		bridgeLibraryFiles, err := smiMemTemplates.ClockBridgeFiles()
+		if err != nil {
+			return nil, nil, err
+		}
+		libraryFiles = smiMemTemplates.MergeLibraryFiles(libraryFiles, bridgeLibraryFiles)
+	}
+	return libraryFiles, testFiles, nil
+}
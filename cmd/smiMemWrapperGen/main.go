@@ -21,75 +21,387 @@ import (
 	"flag"
 	"fmt"
 	"github.com/ReconfigureIO/smi/go-template/src/smiMemTemplates"
+	"os"
+	"path/filepath"
+	"strings"
 )
 
-func main() {
+//
+// Specifies a single smiMemWrapperGen subcommand.
+//
+type command struct {
+	name        string                    // Subcommand name.
+	arguments   string                    // Positional argument summary for usage text.
+	description string                    // Short description for usage text.
+	run         func(args []string) error // Runs the command with the remaining arguments.
+}
+
+//
+// Indicates that a command failed because of invalid options, in which case
+// the usage exit status is used.
+//
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string {
+	return e.err.Error()
+}
+
+//
+// Lists the supported subcommands. This is populated by init to avoid an
+// initialisation loop via the help command.
+//
+var commands []command
+
+func init() {
+	commands = []command{
+		{"tree", "", "generate the SMI memory arbitration tree only", runTree},
+		{"adaptor", "", "generate the arbitration tree, kernel adaptor and " +
+			"platform packaging files", runAdaptor},
+		{"platforms", "", "list the supported target platforms", runPlatforms},
+		{"inspect", "", "report the arbitration tree topology", runInspect},
+		{"libs", "", "list the required Verilog library files", runLibs},
+		{"estimate", "", "estimate the FPGA resource usage for a configuration",
+			runEstimate},
+		{"project", "<project.json>", "generate the files described by a JSON " +
+			"project file", runProject},
+		{"batch", "<batch.json>", "generate multiple project configurations " +
+			"concurrently", runBatch},
+		{"serve", "", "run a local HTTP service which generates archives of " +
+			"the generated files", runServe},
+		{"help", "[command]", "show usage information for a command", runHelp},
+	}
+}
+
+//
+// Prints the top level usage text.
+//
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [options]\n\nCommands:\n",
+		os.Args[0])
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintf(os.Stderr, "\nRun '%s help <command>' for the command options. "+
+		"If no command is given\nbefore the options, or no arguments are given, "+
+		"the 'adaptor' command is used.\n",
+		os.Args[0])
+}
+
+//
+// Creates the flag set for a subcommand, with usage text that includes the
+// command description.
+//
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ExitOnError)
+	flags.Usage = func() {
+		for _, cmd := range commands {
+			if cmd.name == name {
+				fmt.Fprintf(os.Stderr, "Usage: %s\n\n%s.\n", strings.TrimSpace(
+					strings.Join([]string{os.Args[0], cmd.name, "[options]", cmd.arguments}, " ")),
+					strings.ToUpper(cmd.description[:1])+cmd.description[1:])
+			}
+		}
+		fmt.Fprintf(os.Stderr, "\nOptions:\n")
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+//
+// Parses the subcommand options, rejecting unexpected positional arguments.
+//
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	flags.Parse(args)
+	if flags.NArg() != 0 {
+		return usageError{errors.New(fmt.Sprintf(
+			"Unexpected argument (%s)", flags.Arg(0)))}
+	}
+	return nil
+}
+
+//
+// Implements the 'tree' command.
+//
+func runTree(args []string) error {
+	options := defaultGeneratorOptions()
+	settings := outputSettings{}
+	flags := newFlagSet("tree")
+	options.addTreeFlags(flags)
+	options.addStyleFlags(flags)
+	settings.addFlags(flags, &options)
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := options.validateTree(); err != nil {
+		return usageError{err}
+	}
+	return runGenerator(options, settings, generateTree, os.Stdout)
+}
+
+//
+// Implements the 'adaptor' command.
+//
+func runAdaptor(args []string) error {
+	options := defaultGeneratorOptions()
+	settings := outputSettings{}
+	flags := newFlagSet("adaptor")
+	options.addTreeFlags(flags)
+	options.addStyleFlags(flags)
+	options.addPlatformFlags(flags)
+	options.addAdaptorFlags(flags)
+	settings.addFlags(flags, &options)
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := options.validateAdaptor(); err != nil {
+		return usageError{err}
+	}
+	return runGenerator(options, settings, generateAdaptor, os.Stdout)
+}
+
+//
+// Implements the 'platforms' command.
+//
+func runPlatforms(args []string) error {
+	flags := newFlagSet("platforms")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	for _, platform := range platformConfigs {
+		fmt.Printf("%-14s %s\n", platform.name, platform.description)
+		fmt.Printf("%-14s   output styles: %s\n", "",
+			strings.Join(platform.outputStyles, ", "))
+	}
+	return nil
+}
+
+//
+// Implements the 'inspect' command.
+//
+func runInspect(args []string) error {
+	options := defaultGeneratorOptions()
+	flags := newFlagSet("inspect")
+	options.addTreeFlags(flags)
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	if err := options.validateTree(); err != nil {
+		return usageError{err}
+	}
+	topology, err := smiMemTemplates.DescribeArbitrationTreeWithOptions(
+		options.treeModuleName(), options.numMemPorts, options.scalingFactor,
+		options.treeOptions)
+	if err != nil {
+		return err
+	}
+	return topology.Write(os.Stdout)
+}
+
+//
+// Implements the 'libs' command. The test component files required by the
+// fuzz test kernel are listed with their test/ directory prefix.
+//
+func runLibs(args []string) error {
+	options := defaultGeneratorOptions()
+	options.targetPlatform = ""
+	flags := newFlagSet("libs")
+	options.addTreeFlags(flags)
+	options.addPlatformFlags(flags)
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	var err error
+	if options.targetPlatform == "" {
+		if options.fuzzTest {
+			err = errors.New("Fuzz test kernel requires a target platform")
+		} else if options.clockCrossing != smiMemTemplates.ClockCrossingNone {
+			err = errors.New("Clock crossing requires a target platform")
+		} else {
+			err = options.validateTree()
+		}
+	} else {
+		err = options.validateAdaptor()
+	}
+	if err != nil {
+		return usageError{err}
+	}
+
+	libraryFiles, testFiles, err := requiredLibraryFiles(&options)
+	if err != nil {
+		return err
+	}
+	for _, fileName := range libraryFiles {
+		fmt.Println("verilog/" + fileName)
+	}
+	for _, fileName := range testFiles {
+		fmt.Println("test/verilog/" + fileName)
+	}
+	return nil
+}
+
+//
+// Locates the default resource calibration data file. This is in the data/
+// directory of the release directory, which is the parent of the bin/
+// directory containing the executable. When running from the repository
+// using 'go run', the data/ directory in the current working directory is
+// used instead.
+//
+func defaultCalibrationFile() (string, error) {
+	fileName := filepath.Join("data", "smiResourceCalibration.json")
+	searchPaths := make([]string, 0, 2)
+	if executable, err := os.Executable(); err == nil {
+		if executable, err = filepath.EvalSymlinks(executable); err == nil {
+			releaseDir := filepath.Dir(filepath.Dir(executable))
+			searchPaths = append(searchPaths, filepath.Join(releaseDir, fileName))
+		}
+	}
+	searchPaths = append(searchPaths, fileName)
+	for _, path := range searchPaths {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+	return "", errors.New(fmt.Sprintf(
+		"Resource calibration data file not found (%s), use the -calibration option",
+		strings.Join(searchPaths, ", ")))
+}
 
-	// We pass two parameters. One is the number of SMI endpoints to
-	// generate code for, the other is the output AXI data bus width.
-	numMemPortsPtr := flag.Uint("numMemPorts", 1,
-		"the number of SMI memory ports")
-	axiBusWidthPtr := flag.Uint("axiBusWidth", 64,
-		"the width of the AXI data bus (64, 128, 256 or 512)")
-	axiBusIdWidthPtr := flag.Uint("axiBusIdWidth", 1,
-		"the width of the AXI ID bus")
-	kernelArgsWidthPtr := flag.Uint("kernelArgsWidth", 1,
-		"the number of 32-bit kernel argument words")
-	targetPlatformPtr := flag.String("targetPlatform", "sdaccel",
-		"the target platform ('sdaccel', 'llvm' or 'huawei-fp1')")
-	flag.Parse()
-
-	// Convert the AXI bus width the bus width scaling factor.
-	scalingFactor := uint(0)
-	switch *axiBusWidthPtr {
-	case 64:
-		scalingFactor = 1
-	case 128:
-		scalingFactor = 2
-	case 256:
-		scalingFactor = 4
-	case 512:
-		scalingFactor = 8
-	default:
-		panic(errors.New(fmt.Sprintf(
-			"Invalid AXI bus width (%d) for kernel adaptor", *axiBusWidthPtr)))
-	}
-
-	// Build the arbitration component with the specified number of ports.
-	moduleName := fmt.Sprintf("smiMemArbitrationTreeX%dS%d", *numMemPortsPtr, scalingFactor)
-	fileName := fmt.Sprintf("%s.v", moduleName)
-	err := smiMemTemplates.CreateArbitrationTree(
-		fileName, moduleName, *numMemPortsPtr, scalingFactor)
+//
+// Implements the 'estimate' command. The bus adaptor is only included in the
+// estimate if a target platform has been selected.
+//
+func runEstimate(args []string) error {
+	options := defaultGeneratorOptions()
+	options.targetPlatform = ""
+	flags := newFlagSet("estimate")
+	options.addTreeFlags(flags)
+	options.addPlatformFlags(flags)
+	calibrationFile := flags.String("calibration", "",
+		"the resource calibration data file (default "+
+			"data/smiResourceCalibration.json in the release or repository directory)")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	var err error
+	if options.fuzzTest {
+		err = errors.New("Fuzz test kernel is not included in resource estimates")
+	} else if options.clockCrossing != smiMemTemplates.ClockCrossingNone {
+		err = errors.New("Clock crossing is not included in resource estimates")
+	} else if options.targetPlatform == "" {
+		err = options.validateTree()
+	} else {
+		err = options.validateAdaptor()
+	}
 	if err != nil {
-		panic(err)
-	}
-
-	// Build the wrapper component with the specified number of ports.
-	switch *targetPlatformPtr {
-	case "sdaccel":
-		kernelName := fmt.Sprintf("teak__action__top__smi__x%d", *numMemPortsPtr)
-		moduleName = "teak__action__top__gmem"
-		fileName = fmt.Sprintf("%s.v", moduleName)
-		err = smiMemTemplates.CreateSmiSdaKernelAdaptor(
-			fileName, moduleName, kernelName, *numMemPortsPtr, scalingFactor)
-	case "llvm":
-		kernelName := "teak___x24_main_x2e_Top_x3a_public"
-		moduleName := "llvm_kernel_smi_adaptor"
-		fileName = fmt.Sprintf("%s.v", moduleName)
-		err = smiMemTemplates.CreateSmiLlvmKernelAdaptor(
-			fileName, moduleName, kernelName, *numMemPortsPtr,
-			scalingFactor, *axiBusIdWidthPtr, *kernelArgsWidthPtr)
-	case "huawei-fp1":
-		kernelName := "teak__main_x2e_Top"
-		moduleName = "fp1_teak_action_top_gmem"
-		fileName = fmt.Sprintf("%s.v", moduleName)
-		err = smiMemTemplates.CreateSmiFp1KernelAdaptor(
-			fileName, moduleName, kernelName, *numMemPortsPtr, scalingFactor)
-	default:
-		err = errors.New(fmt.Sprintf(
-			"Invalid target platform (%s) for kernel adaptor", *targetPlatformPtr))
+		return usageError{err}
 	}
+
+	if *calibrationFile == "" {
+		*calibrationFile, err = defaultCalibrationFile()
+		if err != nil {
+			return err
+		}
+	}
+	file, err := os.Open(*calibrationFile)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	calibration, err := smiMemTemplates.ReadResourceCalibration(file)
+	if err != nil {
+		return errors.New(fmt.Sprintf("%s: %s", *calibrationFile, err.Error()))
+	}
+	topology, err := smiMemTemplates.DescribeArbitrationTreeWithOptions(
+		options.treeModuleName(), options.numMemPorts, options.scalingFactor,
+		options.treeOptions)
+	if err != nil {
+		return err
+	}
+	estimate, err := smiMemTemplates.EstimateResources(
+		topology, options.platform.busAdaptorName, calibration)
+	if err != nil {
+		return err
+	}
+	return estimate.Write(os.Stdout)
+}
+
+//
+// Implements the 'project' command.
+//
+func runProject(args []string) error {
+	settings := outputSettings{}
+	flags := newFlagSet("project")
+	checkOnly := flags.Bool("check", false,
+		"check the project file without generating any files")
+	settings.addFlags(flags, nil)
+	flags.Parse(args)
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return usageError{errors.New("Expected a single project file name")}
+	}
+	options, err := loadProjectFile(flags.Arg(0))
+	if err != nil || *checkOnly {
+		return err
+	}
+	return runGenerator(options, settings, generateAdaptor, os.Stdout)
+}
+
+//
+// Implements the 'help' command.
+//
+func runHelp(args []string) error {
+	if len(args) == 0 {
+		usage()
+		return nil
+	}
+	for _, cmd := range commands {
+		if (cmd.name == args[0]) && (cmd.name != "help") {
+			return cmd.run([]string{"-h"})
+		}
+	}
+	if args[0] == "help" {
+		usage()
+		return nil
+	}
+	return usageError{errors.New(fmt.Sprintf("Unknown command (%s)", args[0]))}
+}
+
+func main() {
+	args := os.Args[1:]
+	if len(args) != 0 {
+		switch args[0] {
+		case "-h", "-help", "--help":
+			usage()
+			return
+		}
+	}
+
+	// Existing build scripts pass the adaptor options without a command, or
+	// run without any options to generate the default adaptor.
+	run := runAdaptor
+	if (len(args) != 0) && !strings.HasPrefix(args[0], "-") {
+		run = nil
+		for _, cmd := range commands {
+			if cmd.name == args[0] {
+				run = cmd.run
+			}
+		}
+		if run == nil {
+			fmt.Fprintf(os.Stderr, "%s: Unknown command (%s)\n\n", os.Args[0], args[0])
+			usage()
+			os.Exit(2)
+		}
+		args = args[1:]
+	}
+
+	err := run(args)
 	if err != nil {
-		panic(err)
+		fmt.Fprintf(os.Stderr, "%s: %s\n", os.Args[0], err)
+		if _, ok := err.(usageError); ok {
+			os.Exit(2)
+		}
+		os.Exit(1)
 	}
 }
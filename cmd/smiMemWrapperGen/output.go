@@ -0,0 +1,134 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemTemplates"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+//
+// Specifies the ways in which generated files may be written.
+//
+type outputSettings struct {
+	dryRun      bool // List the files which would be generated without writing them.
+	changedOnly bool // Only write files whose contents have changed.
+}
+
+//
+// Registers the output directory and output mode options.
+//
+func (settings *outputSettings) addFlags(flags *flag.FlagSet, options *generatorOptions) {
+	if options != nil {
+		flags.StringVar(&options.outputDir, "outputDir", options.outputDir,
+			"the directory for the generated files (created if required)")
+	}
+	flags.BoolVar(&settings.dryRun, "dryRun", false,
+		"list the files which would be generated, without writing them")
+	flags.BoolVar(&settings.changedOnly, "changedOnly", false,
+		"only write the generated files whose contents have changed")
+}
+
+//
+// Specifies the states which are reported for each generated file.
+//
+const (
+	fileStatusNew       = "new"
+	fileStatusChanged   = "changed"
+	fileStatusUnchanged = "unchanged"
+)
+
+//
+// Determines the status of a generated file by comparing it with the existing
+// file at the output location, ignoring the file creation timestamps.
+//
+func generatedFileStatus(data []byte, outputPath string) (string, error) {
+	existing, err := ioutil.ReadFile(outputPath)
+	if os.IsNotExist(err) {
+		return fileStatusNew, nil
+	} else if err != nil {
+		return "", err
+	}
+	if smiMemTemplates.SameGeneratedContent(data, existing) {
+		return fileStatusUnchanged, nil
+	}
+	return fileStatusChanged, nil
+}
+
+//
+// Runs a generator function using the specified output settings. By default
+// the files are written directly to the output directory. For dry runs and
+// change only writes the files are first generated in a temporary directory
+// and then compared with the existing files in the output directory. Dry runs
+// list each file and its status to the specified output writer, while change
+// only writes copy the new and changed files to the output directory.
+//
+func runGenerator(options generatorOptions, settings outputSettings,
+	generate func(*generatorOptions) ([]string, error), output io.Writer) error {
+
+	if !settings.dryRun && !settings.changedOnly {
+		_, err := generate(&options)
+		return err
+	}
+
+	stagingDir, err := ioutil.TempDir("", "smiMemWrapperGen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(stagingDir)
+	outputDir := options.outputDir
+	options.outputDir = stagingDir
+	fileNames, err := generate(&options)
+	if err != nil {
+		return err
+	}
+
+	for _, fileName := range fileNames {
+		data, err := ioutil.ReadFile(filepath.Join(stagingDir, fileName))
+		if err != nil {
+			return err
+		}
+		outputPath := filepath.Join(outputDir, fileName)
+		status, err := generatedFileStatus(data, outputPath)
+		if err != nil {
+			return err
+		}
+		if settings.dryRun {
+			if _, err := fmt.Fprintf(output, "%-9s %s\n", status, outputPath); err != nil {
+				return err
+			}
+			continue
+		}
+		if status == fileStatusUnchanged {
+			continue
+		}
+		if outputDir != "" {
+			if err := os.MkdirAll(outputDir, 0755); err != nil {
+				return err
+			}
+		}
+		if err := ioutil.WriteFile(outputPath, data, 0666); err != nil {
+			return err
+		}
+	}
+	return nil
+}
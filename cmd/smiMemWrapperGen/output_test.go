@@ -0,0 +1,160 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//
+// Implements a generator function which writes a fixed set of files with
+// a file creation timestamp that changes on every call, in the same format
+// as the generated file headers.
+//
+type testGenerator struct {
+	files map[string]string // File contents, indexed by file name.
+	calls int               // Number of generator calls.
+}
+
+func (gen *testGenerator) generate(options *generatorOptions) ([]string, error) {
+	gen.calls++
+	fileNames := make([]string, 0, len(gen.files))
+	for _, fileName := range []string{"a.v", "b.v"} {
+		data := fmt.Sprintf("// Created Mon, 02 Jan 2006 15:04:%02d UTC\n%s",
+			gen.calls, gen.files[fileName])
+		path := filepath.Join(options.outputDir, fileName)
+		if err := ioutil.WriteFile(path, []byte(data), 0666); err != nil {
+			return nil, err
+		}
+		fileNames = append(fileNames, fileName)
+	}
+	return fileNames, nil
+}
+
+//
+// Tests the output modes of runGenerator by running a sequence of steps
+// against the same output directory. Each step specifies the output
+// settings, the generated file contents, the expected dry run listing and
+// which of the output files are expected to have been written.
+//
+func TestRunGenerator(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "smiMemWrapperGen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	outputDir := filepath.Join(tempDir, "out")
+	pathA, pathB := filepath.Join(outputDir, "a.v"), filepath.Join(outputDir, "b.v")
+
+	steps := []struct {
+		name     string
+		settings outputSettings
+		files    map[string]string
+		listing  []string
+		written  []string
+	}{
+		{"dry run to missing directory", outputSettings{dryRun: true},
+			map[string]string{"a.v": "A1", "b.v": "B1"},
+			[]string{"new       " + pathA, "new       " + pathB}, nil},
+		{"changed only to missing directory", outputSettings{changedOnly: true},
+			map[string]string{"a.v": "A1", "b.v": "B1"},
+			nil, []string{"a.v", "b.v"}},
+		{"dry run with new timestamps", outputSettings{dryRun: true},
+			map[string]string{"a.v": "A1", "b.v": "B1"},
+			[]string{"unchanged " + pathA, "unchanged " + pathB}, nil},
+		{"dry run with changed file", outputSettings{dryRun: true},
+			map[string]string{"a.v": "A1", "b.v": "B2"},
+			[]string{"unchanged " + pathA, "changed   " + pathB}, nil},
+		{"changed only with changed file", outputSettings{changedOnly: true},
+			map[string]string{"a.v": "A1", "b.v": "B2"},
+			nil, []string{"b.v"}},
+		{"default output", outputSettings{},
+			map[string]string{"a.v": "A1", "b.v": "B2"},
+			nil, []string{"a.v", "b.v"}}}
+
+	gen := &testGenerator{}
+	options := defaultGeneratorOptions()
+	options.outputDir = outputDir
+	for _, step := range steps {
+		before := make(map[string][]byte)
+		for _, fileName := range []string{"a.v", "b.v"} {
+			before[fileName], _ = ioutil.ReadFile(filepath.Join(outputDir, fileName))
+		}
+		gen.files = step.files
+		output := bytes.Buffer{}
+		if err := runGenerator(options, step.settings, gen.generate, &output); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+
+		var listing []string
+		if output.Len() != 0 {
+			listing = strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n")
+		}
+		if !reflect.DeepEqual(listing, step.listing) {
+			t.Errorf("%s: listing %q, expected %q", step.name, listing, step.listing)
+		}
+		var written []string
+		for _, fileName := range []string{"a.v", "b.v"} {
+			after, _ := ioutil.ReadFile(filepath.Join(outputDir, fileName))
+			if !bytes.Equal(after, before[fileName]) {
+				written = append(written, fileName)
+				if !bytes.HasSuffix(after, []byte(step.files[fileName])) {
+					t.Errorf("%s: %s contains %q", step.name, fileName, after)
+				}
+			}
+		}
+		if !reflect.DeepEqual(written, step.written) {
+			t.Errorf("%s: wrote %v, expected %v", step.name, written, step.written)
+		}
+	}
+}
+
+//
+// Tests that generator errors are returned for all the output modes, without
+// writing any files.
+//
+func TestRunGeneratorError(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "smiMemWrapperGen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	options := defaultGeneratorOptions()
+	options.outputDir = filepath.Join(tempDir, "out")
+	generateError := errors.New("generator failed")
+	generate := func(*generatorOptions) ([]string, error) {
+		return nil, generateError
+	}
+	for _, settings := range []outputSettings{
+		{}, {dryRun: true}, {changedOnly: true}} {
+		output := bytes.Buffer{}
+		if err := runGenerator(options, settings, generate, &output); err != generateError {
+			t.Errorf("settings %+v: got error %v, expected %v", settings, err, generateError)
+		}
+		if _, err := os.Stat(options.outputDir); !os.IsNotExist(err) {
+			t.Errorf("settings %+v: output directory created", settings)
+		}
+	}
+}
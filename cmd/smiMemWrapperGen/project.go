@@ -0,0 +1,189 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+//
+// Specifies the contents of a JSON project file. Each field has the same
+// meaning as the adaptor command option with the same name, with the target
+// platform and number of memory ports being mandatory. Relative output
+// directories are resolved against the directory containing the project
+// file, which is also used if no output directory is given.
+//
+type projectFile struct {
+	TargetPlatform   *string `json:"targetPlatform"`
+	NumMemPorts      *uint   `json:"numMemPorts"`
+	AxiBusWidth      *uint   `json:"axiBusWidth"`
+	AxiBusIdWidth    *uint   `json:"axiBusIdWidth"`
+	KernelName       *string `json:"kernelName"`
+	KernelArgsWidth  *uint   `json:"kernelArgsWidth"`
+	OutputStyle      *string `json:"outputStyle"`
+	OutputDir        *string `json:"outputDir"`
+	FifoDepth        *uint   `json:"fifoDepth"`
+	FifoFrames       *uint   `json:"fifoFrames"`
+	PipelineLayers   []uint  `json:"pipelineLayers"`
+	PipelineClients  []uint  `json:"pipelineClients"`
+	PipelineFanIn    *uint   `json:"pipelineFanIn"`
+	FuzzTest         *bool   `json:"fuzzTest"`
+	VerilatorHarness *bool   `json:"verilatorHarness"`
+	ClockCrossing    *string `json:"clockCrossing"`
+}
+
+//
+// Converts a byte offset in the project file to a line number, given the
+// line number for the start of the data.
+//
+func projectLineNumber(data []byte, offset int64, firstLine int) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + firstLine
+}
+
+//
+// Converts the JSON decoder errors to project file errors which identify the
+// location of the problem.
+//
+func projectDecodeError(data []byte, firstLine int, err error) error {
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		return errors.New(fmt.Sprintf("line %d: %s",
+			projectLineNumber(data, e.Offset, firstLine), e.Error()))
+	case *json.UnmarshalTypeError:
+		return errors.New(fmt.Sprintf("line %d: Invalid value for %s (%s), expected %s",
+			projectLineNumber(data, e.Offset, firstLine), e.Field, e.Value,
+			e.Type.String()))
+	}
+
+	// Unknown fields are reported using a plain error message.
+	message := err.Error()
+	if strings.HasPrefix(message, "json: unknown field ") {
+		return errors.New(fmt.Sprintf("Unknown field %s",
+			strings.TrimPrefix(message, "json: unknown field ")))
+	}
+	return err
+}
+
+//
+// Parses the JSON project file contents, returning the validated generator
+// options. The output directory is left relative to the project file. The
+// line number for the start of the data is used when reporting errors.
+//
+func parseProjectFile(data []byte, firstLine int) (generatorOptions, error) {
+	options := defaultGeneratorOptions()
+	project := projectFile{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&project); err != nil {
+		return options, projectDecodeError(data, firstLine, err)
+	}
+	if decoder.More() {
+		return options, errors.New(fmt.Sprintf("line %d: Unexpected data after project",
+			projectLineNumber(data, decoder.InputOffset(), firstLine)))
+	}
+
+	// Check for the mandatory fields.
+	if project.TargetPlatform == nil {
+		return options, errors.New("Missing field \"targetPlatform\"")
+	}
+	if project.NumMemPorts == nil {
+		return options, errors.New("Missing field \"numMemPorts\"")
+	}
+
+	// Override the default options with the project settings.
+	setString := func(field *string, value *string) {
+		if value != nil {
+			*field = *value
+		}
+	}
+	setUint := func(field *uint, value *uint) {
+		if value != nil {
+			*field = *value
+		}
+	}
+	setBool := func(field *bool, value *bool) {
+		if value != nil {
+			*field = *value
+		}
+	}
+	setString(&options.targetPlatform, project.TargetPlatform)
+	setUint(&options.numMemPorts, project.NumMemPorts)
+	setUint(&options.axiBusWidth, project.AxiBusWidth)
+	setUint(&options.axiBusIdWidth, project.AxiBusIdWidth)
+	setString(&options.kernelName, project.KernelName)
+	setUint(&options.kernelArgsWidth, project.KernelArgsWidth)
+	setString(&options.outputStyle, project.OutputStyle)
+	setString(&options.outputDir, project.OutputDir)
+	setUint(&options.treeOptions.FifoFlitDepth, project.FifoDepth)
+	setUint(&options.treeOptions.FifoFrames, project.FifoFrames)
+	if project.PipelineLayers != nil {
+		options.treeOptions.PipelineLayers = project.PipelineLayers
+	}
+	if project.PipelineClients != nil {
+		options.treeOptions.PipelineClients = project.PipelineClients
+	}
+	setUint(&options.treeOptions.PipelineFanIn, project.PipelineFanIn)
+	setBool(&options.fuzzTest, project.FuzzTest)
+	setBool(&options.verilatorHarness, project.VerilatorHarness)
+	setString(&options.clockCrossing, project.ClockCrossing)
+
+	// The fuzz test kernel uses a fixed set of kernel arguments, so an
+	// explicit setting would be silently overridden.
+	if options.fuzzTest && (project.KernelArgsWidth != nil) {
+		return options, errors.New(
+			"Field \"kernelArgsWidth\" can not be used with \"fuzzTest\"")
+	}
+	return options, options.validateAdaptor()
+}
+
+//
+// Reads and validates a JSON project file, returning the generator options
+// with the output directory resolved relative to the project file. All
+// errors are prefixed with the project file name.
+//
+func loadProjectFile(fileName string) (generatorOptions, error) {
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return generatorOptions{}, err
+	}
+	options, err := parseProjectFile(data, 1)
+	if err != nil {
+		return options, errors.New(fmt.Sprintf("%s: %s", fileName, err.Error()))
+	}
+	options.outputDir = resolveProjectPath(fileName, options.outputDir)
+	return options, nil
+}
+
+//
+// Resolves a path given in a project file relative to the directory
+// containing the project file.
+//
+func resolveProjectPath(projectFileName string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(filepath.Dir(projectFileName), path)
+}
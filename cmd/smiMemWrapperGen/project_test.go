@@ -0,0 +1,125 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+//
+// Tests that valid project files override the default generator options.
+//
+func TestParseProjectFile(t *testing.T) {
+	tests := []struct {
+		project string
+		check   func(options generatorOptions) bool
+	}{
+		{`{"targetPlatform": "sdaccel", "numMemPorts": 4}`,
+			func(options generatorOptions) bool {
+				return (options.targetPlatform == "sdaccel") && (options.numMemPorts == 4) &&
+					(options.scalingFactor == 1) && (options.kernelArgsWidth == 1) &&
+					(options.outputDir == "")
+			}},
+		{`{"targetPlatform": "llvm", "numMemPorts": 8, "axiBusWidth": 256,
+		  "kernelArgsWidth": 3, "outputStyle": "vhdl", "outputDir": "out",
+		  "pipelineLayers": [1], "fifoDepth": 64}`,
+			func(options generatorOptions) bool {
+				return (options.targetPlatform == "llvm") && (options.numMemPorts == 8) &&
+					(options.scalingFactor == 4) && (options.kernelArgsWidth == 3) &&
+					(options.fileExt == "vhd") && (options.outputDir == "out") &&
+					(len(options.treeOptions.PipelineLayers) == 1) &&
+					(options.treeOptions.FifoFlitDepth == 64)
+			}},
+		{`{"targetPlatform": "sdaccel", "numMemPorts": 2, "fuzzTest": true}`,
+			func(options generatorOptions) bool {
+				return options.fuzzTest && (options.kernelArgsWidth == 8)
+			}}}
+	for i, test := range tests {
+		options, err := parseProjectFile([]byte(test.project), 1)
+		if err != nil {
+			t.Errorf("project %d: %v", i, err)
+		} else if !test.check(options) {
+			t.Errorf("project %d: unexpected options %+v", i, options)
+		}
+	}
+}
+
+//
+// Tests that invalid project files are rejected, with syntax and type errors
+// reported against the line number in the containing file.
+//
+func TestParseProjectFileErrors(t *testing.T) {
+	tests := []struct {
+		project   string
+		firstLine int
+		message   string
+	}{
+		{"{\n  \"targetPlatform\": \"sdaccel\",\n  \"numMemPorts\": ,\n}", 1,
+			"line 3: "},
+		{"{\n  \"targetPlatform\": \"sdaccel\",\n  \"numMemPorts\": ,\n}", 10,
+			"line 12: "},
+		{"{\n  \"targetPlatform\": \"sdaccel\",\n  \"numMemPorts\": \"two\"\n}", 1,
+			"line 3: Invalid value for numMemPorts (string), expected uint"},
+		{`{"targetPlatform": "sdaccel", "numMemPorts": 2}` + "\n{}", 1,
+			"line 2: Unexpected data after project"},
+		{`{"targetPlatform": "sdaccel", "numMemPorts": 2, "numPorts": 2}`, 1,
+			`Unknown field "numPorts"`},
+		{`{"numMemPorts": 2}`, 1,
+			`Missing field "targetPlatform"`},
+		{`{"targetPlatform": "sdaccel"}`, 1,
+			`Missing field "numMemPorts"`},
+		{`{"targetPlatform": "sdaccel", "numMemPorts": 2, "fuzzTest": true, "kernelArgsWidth": 8}`, 1,
+			`Field "kernelArgsWidth" can not be used with "fuzzTest"`},
+		{`{"targetPlatform": "sdaccel", "numMemPorts": 2, "kernelArgsWidth": 2000}`, 1,
+			"Invalid number of kernel argument words (2000)"},
+		{`{"targetPlatform": "sdaccel", "numMemPorts": 65}`, 1,
+			"Invalid number of SMI memory ports (65)"},
+		{`{"targetPlatform": "unknown", "numMemPorts": 2}`, 1,
+			"Invalid target platform (unknown)"}}
+	for i, test := range tests {
+		_, err := parseProjectFile([]byte(test.project), test.firstLine)
+		if err == nil {
+			t.Errorf("project %d: not rejected", i)
+		} else if !strings.HasPrefix(err.Error(), test.message) {
+			t.Errorf("project %d: error '%v', expected '%s'", i, err, test.message)
+		}
+	}
+}
+
+//
+// Tests that relative output directories are resolved against the directory
+// containing the project file.
+//
+func TestResolveProjectPath(t *testing.T) {
+	tests := []struct {
+		projectFile string
+		path        string
+		expected    string
+	}{
+		{"project.json", "", "."},
+		{"projects/a/project.json", "out", filepath.Join("projects", "a", "out")},
+		{"projects/a/project.json", "../out", filepath.Join("projects", "out")},
+		{"projects/a/project.json", "/tmp/out", "/tmp/out"}}
+	for _, test := range tests {
+		if path := resolveProjectPath(test.projectFile, test.path); path != test.expected {
+			t.Errorf("%s with %s resolved to %s, expected %s",
+				test.projectFile, test.path, path, test.expected)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemTemplates"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+//
+// Specifies the maximum size of a configuration request body.
+//
+const serveMaxRequestSize = 1 << 20
+
+//
+// Specifies the name of the topology report file in generated archives.
+//
+const serveTopologyFileName = "topology.txt"
+
+//
+// Specifies the error codes used in structured error responses.
+//
+const (
+	serveErrorNotFound      = "not_found"
+	serveErrorMethod        = "method_not_allowed"
+	serveErrorFormat        = "invalid_format"
+	serveErrorRequest       = "invalid_request"
+	serveErrorConfiguration = "invalid_configuration"
+	serveErrorInternal      = "internal_error"
+)
+
+//
+// Specifies the JSON body of an error response.
+//
+type serveErrorResponse struct {
+	Status  int    `json:"status"`  // HTTP status code.
+	Code    string `json:"code"`    // Error category, from the serveError* codes.
+	Message string `json:"message"` // Detailed error message.
+}
+
+//
+// Specifies a single target platform in the platform list response.
+//
+type servePlatformResponse struct {
+	Name         string   `json:"name"`         // Platform name.
+	Description  string   `json:"description"`  // Short platform description.
+	OutputStyles []string `json:"outputStyles"` // Supported output styles.
+}
+
+//
+// Specifies a single file to be included in a generated archive.
+//
+type archiveFile struct {
+	name string // Path within the archive.
+	data []byte // File contents.
+}
+
+//
+// Implements the HTTP generation service. The library directory is the
+// repository root or release directory, which contains the verilog/ library
+// file directory and optionally the test/verilog/ directory which is required
+// for generating fuzz test kernels.
+//
+type generatorServer struct {
+	libraryDir   string // Location of the library file directories.
+	testFilesDir bool   // Set if the test/verilog/ directory is available.
+}
+
+//
+// Writes a structured JSON error response.
+//
+func writeServeError(writer http.ResponseWriter, status int, code string, err error) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(serveErrorResponse{status, code, err.Error()})
+}
+
+//
+// Generates the files for a validated configuration, returning the generated
+// files, the required library files and the topology report. Any errors are
+// service failures, since the configuration has already been validated.
+//
+func (server *generatorServer) generateArchiveFiles(
+	options generatorOptions) ([]archiveFile, error) {
+
+	stagingDir, err := ioutil.TempDir("", "smiMemWrapperGen")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(stagingDir)
+	options.outputDir = stagingDir
+	fileNames, err := generateAdaptor(&options)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]archiveFile, 0)
+	addFile := func(name string, path string) error {
+		data, err := ioutil.ReadFile(path)
+		if err == nil {
+			files = append(files, archiveFile{filepath.ToSlash(name), data})
+		}
+		return err
+	}
+	for _, fileName := range fileNames {
+		if err := addFile(fileName, filepath.Join(stagingDir, fileName)); err != nil {
+			return nil, err
+		}
+	}
+
+	// Include the library files using the same paths as the 'libs' command.
+	libraryFiles, testFiles, err := requiredLibraryFiles(&options)
+	if err != nil {
+		return nil, err
+	}
+	for _, fileName := range libraryFiles {
+		path := filepath.Join("verilog", fileName)
+		if err := addFile(path, filepath.Join(server.libraryDir, path)); err != nil {
+			return nil, err
+		}
+	}
+	for _, fileName := range testFiles {
+		path := filepath.Join("test", "verilog", fileName)
+		if err := addFile(path, filepath.Join(server.libraryDir, path)); err != nil {
+			return nil, err
+		}
+	}
+
+	topology, err := smiMemTemplates.DescribeArbitrationTreeWithOptions(
+		options.treeModuleName(), options.numMemPorts, options.scalingFactor,
+		options.treeOptions)
+	if err != nil {
+		return nil, err
+	}
+	report := bytes.Buffer{}
+	if err := topology.Write(&report); err != nil {
+		return nil, err
+	}
+	files = append(files, archiveFile{serveTopologyFileName, report.Bytes()})
+	return files, nil
+}
+
+//
+// Writes a set of files to a tar archive.
+//
+func writeTarArchive(buffer *bytes.Buffer, files []archiveFile, modTime time.Time) error {
+	archive := tar.NewWriter(buffer)
+	for _, file := range files {
+		header := tar.Header{
+			Name:     file.name,
+			Mode:     0644,
+			Size:     int64(len(file.data)),
+			ModTime:  modTime,
+			Typeflag: tar.TypeReg}
+		if err := archive.WriteHeader(&header); err != nil {
+			return err
+		}
+		if _, err := archive.Write(file.data); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+//
+// Writes a set of files to a zip archive.
+//
+func writeZipArchive(buffer *bytes.Buffer, files []archiveFile, modTime time.Time) error {
+	archive := zip.NewWriter(buffer)
+	for _, file := range files {
+		header := zip.FileHeader{Name: file.name, Method: zip.Deflate}
+		header.SetModTime(modTime)
+		header.SetMode(0644)
+		writer, err := archive.CreateHeader(&header)
+		if err != nil {
+			return err
+		}
+		if _, err := writer.Write(file.data); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+//
+// Handles generation requests. The request body is a JSON configuration using
+// the project file format, without an output directory. The archive format is
+// selected using the 'format' query parameter, which may be 'tar' (the
+// default) or 'zip'.
+//
+func (server *generatorServer) handleGenerate(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodPost {
+		writeServeError(writer, http.StatusMethodNotAllowed, serveErrorMethod,
+			errors.New(fmt.Sprintf("Unsupported request method (%s)", request.Method)))
+		return
+	}
+	format := request.URL.Query().Get("format")
+	if format == "" {
+		format = "tar"
+	}
+	if (format != "tar") && (format != "zip") {
+		writeServeError(writer, http.StatusBadRequest, serveErrorFormat,
+			errors.New(fmt.Sprintf("Invalid archive format (%s)", format)))
+		return
+	}
+
+	data, err := ioutil.ReadAll(http.MaxBytesReader(writer, request.Body, serveMaxRequestSize))
+	if err != nil {
+		writeServeError(writer, http.StatusBadRequest, serveErrorRequest, err)
+		return
+	}
+	options, err := parseProjectFile(data, 1)
+	if (err == nil) && (options.outputDir != "") {
+		err = errors.New("Field \"outputDir\" can not be used with the generation service")
+	}
+	if (err == nil) && options.fuzzTest && !server.testFilesDir {
+		err = errors.New("Fuzz test kernel not supported by this service " +
+			"(test/verilog library directory not found)")
+	}
+	if err != nil {
+		writeServeError(writer, http.StatusBadRequest, serveErrorConfiguration, err)
+		return
+	}
+
+	// The archive is built in memory so that generation errors can still be
+	// reported using an error response. All configuration errors are detected
+	// by the validation above, so any errors from this point are reported as
+	// internal service failures.
+	files, err := server.generateArchiveFiles(options)
+	archive := bytes.Buffer{}
+	modTime := time.Now()
+	if err == nil {
+		if format == "zip" {
+			err = writeZipArchive(&archive, files, modTime)
+		} else {
+			err = writeTarArchive(&archive, files, modTime)
+		}
+	}
+	if err != nil {
+		log.Printf("generation failed: %s", err)
+		writeServeError(writer, http.StatusInternalServerError, serveErrorInternal, err)
+		return
+	}
+
+	contentType := "application/x-tar"
+	if format == "zip" {
+		contentType = "application/zip"
+	}
+	writer.Header().Set("Content-Type", contentType)
+	writer.Header().Set("Content-Disposition", fmt.Sprintf(
+		"attachment; filename=\"%s.%s\"", options.treeModuleName(), format))
+	writer.Write(archive.Bytes())
+}
+
+//
+// Handles platform list requests, returning the supported target platforms
+// as a JSON array.
+//
+func (server *generatorServer) handlePlatforms(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		writeServeError(writer, http.StatusMethodNotAllowed, serveErrorMethod,
+			errors.New(fmt.Sprintf("Unsupported request method (%s)", request.Method)))
+		return
+	}
+	platforms := make([]servePlatformResponse, 0, len(platformConfigs))
+	for _, platform := range platformConfigs {
+		platforms = append(platforms, servePlatformResponse{
+			platform.name, platform.description, platform.outputStyles})
+	}
+	writer.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(writer).Encode(platforms)
+}
+
+//
+// Creates the HTTP request handler for the service endpoints.
+//
+func (server *generatorServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/generate", server.handleGenerate)
+	mux.HandleFunc("/platforms", server.handlePlatforms)
+	mux.HandleFunc("/", func(writer http.ResponseWriter, request *http.Request) {
+		writeServeError(writer, http.StatusNotFound, serveErrorNotFound,
+			errors.New(fmt.Sprintf("Unknown endpoint (%s)", request.URL.Path)))
+	})
+	return mux
+}
+
+//
+// Implements the 'serve' command. The service provides a '/generate' endpoint
+// which returns an archive of the generated files for a JSON configuration,
+// and a '/platforms' endpoint which lists the supported target platforms.
+// Fuzz test kernel generation is only supported if the test/verilog/ library
+// directory is present.
+//
+func runServe(args []string) error {
+	server := generatorServer{}
+	flags := newFlagSet("serve")
+	listenAddress := flags.String("listen", "localhost:8080",
+		"the address on which the HTTP service listens")
+	flags.StringVar(&server.libraryDir, "libDir", ".",
+		"the SMI repository or release directory containing the verilog/ library files")
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+	path := filepath.Join(server.libraryDir, "verilog")
+	if info, err := os.Stat(path); (err != nil) || !info.IsDir() {
+		return usageError{errors.New(fmt.Sprintf(
+			"Library directory not found (%s)", path))}
+	}
+	path = filepath.Join(server.libraryDir, "test", "verilog")
+	if info, err := os.Stat(path); (err == nil) && info.IsDir() {
+		server.testFilesDir = true
+	} else {
+		log.Printf("test library directory not found (%s), fuzz test kernels disabled", path)
+	}
+
+	log.Printf("listening on %s", *listenAddress)
+	return http.ListenAndServe(*listenAddress, server.handler())
+}
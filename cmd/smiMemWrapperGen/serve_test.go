@@ -0,0 +1,235 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+//
+// Specifies the repository root directory relative to the package directory,
+// which contains the verilog/ and test/verilog/ library directories.
+//
+const testLibraryDir = "../.."
+
+//
+// Specifies a small valid service configuration.
+//
+const testServeConfig = `{"targetPlatform": "sdaccel", "numMemPorts": 2}`
+
+//
+// Sends a request to the service handler and returns the recorded response.
+//
+func serveTestRequest(server *generatorServer, method string, url string,
+	body string) *httptest.ResponseRecorder {
+
+	request := httptest.NewRequest(method, url, strings.NewReader(body))
+	recorder := httptest.NewRecorder()
+	server.handler().ServeHTTP(recorder, request)
+	return recorder
+}
+
+//
+// Tests that invalid requests are rejected with structured error responses
+// before any files are generated.
+//
+func TestServeErrors(t *testing.T) {
+	server := &generatorServer{libraryDir: testLibraryDir, testFilesDir: false}
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   string
+		status int
+		code   string
+	}{
+		{"unknown endpoint", "GET", "/unknown", "",
+			http.StatusNotFound, serveErrorNotFound},
+		{"generate method", "GET", "/generate", "",
+			http.StatusMethodNotAllowed, serveErrorMethod},
+		{"platforms method", "POST", "/platforms", "",
+			http.StatusMethodNotAllowed, serveErrorMethod},
+		{"archive format", "POST", "/generate?format=rar", testServeConfig,
+			http.StatusBadRequest, serveErrorFormat},
+		{"malformed json", "POST", "/generate", `{"targetPlatform": `,
+			http.StatusBadRequest, serveErrorConfiguration},
+		{"unknown field", "POST", "/generate",
+			`{"targetPlatform": "sdaccel", "numMemPorts": 2, "numPorts": 2}`,
+			http.StatusBadRequest, serveErrorConfiguration},
+		{"output directory", "POST", "/generate",
+			`{"targetPlatform": "sdaccel", "numMemPorts": 2, "outputDir": "out"}`,
+			http.StatusBadRequest, serveErrorConfiguration},
+		{"kernel arguments", "POST", "/generate",
+			`{"targetPlatform": "sdaccel", "numMemPorts": 2, "kernelArgsWidth": 20000000}`,
+			http.StatusBadRequest, serveErrorConfiguration},
+		{"fuzz test files", "POST", "/generate",
+			`{"targetPlatform": "sdaccel", "numMemPorts": 2, "fuzzTest": true}`,
+			http.StatusBadRequest, serveErrorConfiguration},
+		{"request size", "POST", "/generate", strings.Repeat(" ", serveMaxRequestSize+1),
+			http.StatusBadRequest, serveErrorRequest}}
+	for _, test := range tests {
+		recorder := serveTestRequest(server, test.method, test.url, test.body)
+		response := serveErrorResponse{}
+		if err := json.Unmarshal(recorder.Body.Bytes(), &response); err != nil {
+			t.Errorf("%s: invalid error response (%v): %s", test.name, err, recorder.Body)
+			continue
+		}
+		if (recorder.Code != test.status) || (response.Status != test.status) ||
+			(response.Code != test.code) || (response.Message == "") {
+			t.Errorf("%s: got status %d response %+v, expected status %d code %s",
+				test.name, recorder.Code, response, test.status, test.code)
+		}
+	}
+}
+
+//
+// Reads the files from a generated tar or zip archive.
+//
+func readTestArchive(t *testing.T, format string, data []byte) map[string][]byte {
+	files := make(map[string][]byte)
+	if format == "zip" {
+		archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range archive.File {
+			reader, err := file.Open()
+			if err != nil {
+				t.Fatal(err)
+			}
+			contents, err := ioutil.ReadAll(reader)
+			reader.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+			files[file.Name] = contents
+		}
+		return files
+	}
+	archive := tar.NewReader(bytes.NewReader(data))
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		contents, err := ioutil.ReadAll(archive)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[header.Name] = contents
+	}
+	return files
+}
+
+//
+// Tests that valid requests return archives containing the generated files,
+// the required library files and the topology report, in both archive
+// formats.
+//
+func TestServeGenerate(t *testing.T) {
+	server := &generatorServer{libraryDir: testLibraryDir, testFilesDir: true}
+	options, err := parseProjectFile([]byte(testServeConfig), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	libraryFiles, _, err := requiredLibraryFiles(&options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"component.xml", "kernel.xml", "smiMemArbitrationTreeX2S1.v",
+		"teak__action__top__gmem.v", "teak__action__top__gmem_package.tcl",
+		serveTopologyFileName}
+	for _, fileName := range libraryFiles {
+		expected = append(expected, "verilog/"+fileName)
+	}
+	sort.Strings(expected)
+
+	for _, format := range []string{"tar", "zip"} {
+		recorder := serveTestRequest(server, "POST", "/generate?format="+format,
+			testServeConfig)
+		if recorder.Code != http.StatusOK {
+			t.Errorf("%s: status %d: %s", format, recorder.Code, recorder.Body)
+			continue
+		}
+		contentType := map[string]string{
+			"tar": "application/x-tar", "zip": "application/zip"}[format]
+		if header := recorder.Header().Get("Content-Type"); header != contentType {
+			t.Errorf("%s: content type %s, expected %s", format, header, contentType)
+		}
+		files := readTestArchive(t, format, recorder.Body.Bytes())
+		fileNames := make([]string, 0, len(files))
+		for fileName := range files {
+			fileNames = append(fileNames, fileName)
+		}
+		sort.Strings(fileNames)
+		if strings.Join(fileNames, " ") != strings.Join(expected, " ") {
+			t.Errorf("%s: archive contains %v, expected %v", format, fileNames, expected)
+		}
+
+		// The library files are copied unchanged from the library directory.
+		for _, fileName := range libraryFiles {
+			path := filepath.Join(testLibraryDir, "verilog", fileName)
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(files["verilog/"+fileName], data) {
+				t.Errorf("%s: archive copy of %s differs", format, path)
+			}
+		}
+		if topology := string(files[serveTopologyFileName]); !strings.Contains(
+			topology, "smiMemArbitrationTreeX2S1") {
+			t.Errorf("%s: unexpected topology report:\n%s", format, topology)
+		}
+	}
+}
+
+//
+// Tests that failures after the configuration has been validated are reported
+// as internal service errors, using a library directory which does not
+// contain the required library files.
+//
+func TestServeInternalError(t *testing.T) {
+	libraryDir, err := ioutil.TempDir("", "smiMemWrapperGen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(libraryDir)
+	server := &generatorServer{libraryDir: libraryDir, testFilesDir: true}
+	recorder := serveTestRequest(server, "POST", "/generate", testServeConfig)
+	response := serveErrorResponse{}
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+	if (recorder.Code != http.StatusInternalServerError) ||
+		(response.Code != serveErrorInternal) {
+		t.Errorf("got status %d response %+v, expected status %d code %s",
+			recorder.Code, response, http.StatusInternalServerError, serveErrorInternal)
+	}
+}
@@ -0,0 +1,122 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemTemplates"
+	"github.com/ReconfigureIO/smi/go-template/src/smiTreeModel"
+	"os"
+)
+
+func main() {
+
+	// The arbitration tree is specified using the same parameters as the
+	// wrapper generator.
+	numMemPortsPtr := flag.Uint("numMemPorts", 1,
+		"the number of SMI memory ports")
+	axiBusWidthPtr := flag.Uint("axiBusWidth", 64,
+		"the width of the AXI data bus (64, 128, 256 or 512)")
+
+	// Specify the client traffic, which is either read from a trace file or
+	// generated randomly.
+	tracePtr := flag.String("trace", "",
+		"a recorded traffic trace file to use instead of synthetic traffic")
+	cyclesPtr := flag.Uint64("cycles", 10000,
+		"the number of clock cycles of synthetic traffic to generate")
+	injectionRatePtr := flag.Float64("injectionRate", 0.05,
+		"the probability of each client requesting a transaction per cycle")
+	writeRatioPtr := flag.Float64("writeRatio", 0.5,
+		"the proportion of synthetic transactions which are writes")
+	minLengthPtr := flag.Uint("minLength", 8,
+		"the minimum synthetic transaction length in bytes")
+	maxLengthPtr := flag.Uint("maxLength", 64,
+		"the maximum synthetic transaction length in bytes")
+	seedPtr := flag.Int64("seed", 1,
+		"the random number generator seed for synthetic traffic")
+
+	// Specify the model timing parameters.
+	config := smiTreeModel.DefaultModelConfig()
+	flag.UintVar(&config.ArbiterLatency, "arbiterLatency", config.ArbiterLatency,
+		"the pipeline latency through each arbiter in clock cycles (0 for library figures)")
+	flag.UintVar(&config.ScalerStageLatency, "scalerLatency", config.ScalerStageLatency,
+		"the pipeline latency for each bus width doubling in clock cycles (0 for library figures)")
+	flag.UintVar(&config.ServerLatency, "serverLatency", config.ServerLatency,
+		"the memory access latency in clock cycles")
+	flag.UintVar(&config.ServerQueueDepth, "serverQueueDepth", config.ServerQueueDepth,
+		"the maximum number of requests queued at the memory server")
+	flag.UintVar(&config.ClientMaxOutstanding, "clientOutstanding", config.ClientMaxOutstanding,
+		"the maximum outstanding transactions per client (0 for no limit)")
+	maxCyclesPtr := flag.Uint64("maxCycles", 0,
+		"the maximum number of clock cycles to simulate (0 to run to completion)")
+	flag.Parse()
+
+	// Convert the AXI bus width the bus width scaling factor.
+	scalingFactor := uint(0)
+	switch *axiBusWidthPtr {
+	case 64:
+		scalingFactor = 1
+	case 128:
+		scalingFactor = 2
+	case 256:
+		scalingFactor = 4
+	case 512:
+		scalingFactor = 8
+	default:
+		panic(errors.New(fmt.Sprintf(
+			"Invalid AXI bus width (%d) for arbitration tree model", *axiBusWidthPtr)))
+	}
+
+	moduleName := fmt.Sprintf("smiMemArbitrationTreeX%dS%d", *numMemPortsPtr, scalingFactor)
+	topology, err := smiMemTemplates.DescribeArbitrationTree(
+		moduleName, *numMemPortsPtr, scalingFactor)
+	if err != nil {
+		panic(err)
+	}
+
+	var transactions []smiTreeModel.Transaction
+	if *tracePtr != "" {
+		traceFile, err := os.Open(*tracePtr)
+		if err != nil {
+			panic(err)
+		}
+		transactions, err = smiTreeModel.ReadTrafficTrace(traceFile)
+		traceFile.Close()
+		if err != nil {
+			panic(err)
+		}
+	} else {
+		transactions = smiTreeModel.SyntheticTraffic{
+			NumClients:    *numMemPortsPtr,
+			Cycles:        *cyclesPtr,
+			InjectionRate: *injectionRatePtr,
+			WriteRatio:    *writeRatioPtr,
+			MinLength:     *minLengthPtr,
+			MaxLength:     *maxLengthPtr,
+			Seed:          *seedPtr}.Generate()
+	}
+
+	report, err := smiTreeModel.Simulate(topology, config, transactions, *maxCyclesPtr)
+	if err != nil {
+		panic(err)
+	}
+	if err = report.Write(os.Stdout); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,127 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiVcd"
+	"os"
+	"sort"
+	"strings"
+)
+
+//
+// Implements a repeatable command line flag for specifying SMI links.
+//
+type linkFlags map[string]smiVcd.SmiLinkSignals
+
+func (links linkFlags) String() string {
+	return ""
+}
+
+func (links linkFlags) Set(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return errors.New(fmt.Sprintf(
+			"Invalid SMI link specification (%s), expected <name>=<prefix>", value))
+	}
+	links[parts[0]] = smiVcd.NewSmiLinkSignals(parts[1])
+	return nil
+}
+
+func main() {
+
+	// The VCD file is specified as the only positional argument.
+	links := make(linkFlags)
+	clockNamePtr := flag.String("clock", "TOP.clk",
+		"the full hierarchical name of the clock signal")
+	flag.Var(links, "link",
+		"an SMI link to follow, as <name>=<signal prefix> (may be repeated)")
+	maxDataPtr := flag.Int("maxData", 32,
+		"the maximum number of data bytes to display per frame")
+	violationsOnlyPtr := flag.Bool("violationsOnly", false,
+		"only report protocol violations")
+	listSignalsPtr := flag.Bool("listSignals", false,
+		"list the signals declared in the VCD file and exit")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [options] <file.vcd>\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	vcdFile, err := os.Open(flag.Arg(0))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer vcdFile.Close()
+	reader, err := smiVcd.NewVcdReader(vcdFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Optionally list the signal names, which is useful for identifying the
+	// SMI link prefixes.
+	if *listSignalsPtr {
+		names := make([]string, 0, len(reader.Variables))
+		for name := range reader.Variables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("%s [%d]\n", name, reader.Variables[name].Width)
+		}
+		return
+	}
+	if len(links) == 0 {
+		fmt.Fprintln(os.Stderr, "No SMI links specified")
+		os.Exit(2)
+	}
+
+	analyzer, err := smiVcd.NewSmiAnalyzer(reader, *clockNamePtr, links)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	numViolations := 0
+	err = analyzer.Run(reader, func(event smiVcd.SmiLinkEvent) {
+		if event.Violation != "" {
+			numViolations++
+			fmt.Printf("%12d %-16s VIOLATION: %s\n", event.Time, event.Link, event.Violation)
+		} else if !*violationsOnlyPtr {
+			fmt.Printf("%12d %-16s %s\n", event.Time, event.Link,
+				smiVcd.FormatFrame(event.Frame, *maxDataPtr))
+		}
+	})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Use the exit status to indicate whether violations were found.
+	if numViolations != 0 {
+		fmt.Fprintf(os.Stderr, "%d SMI protocol violations detected\n", numViolations)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,194 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiAxiModel
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+//
+// Specifies the AXI bridge record types. Records from the testbench to the
+// slave model have the most significant bit clear and records from the slave
+// model to the testbench have it set.
+//
+const (
+	AxiBridgeMsgCycle = 0x11 // AXI master outputs for a single clock cycle.
+	AxiBridgeMsgClose = 0x13 // Testbench has finished.
+	AxiBridgeMsgSlave = 0x91 // AXI slave outputs for the next clock cycle.
+)
+
+//
+// Specifies the fixed size portion of the AXI bridge master record. Each
+// clock cycle the testbench sends the AXI master outputs sampled on the
+// rising clock edge using the following little endian fields, followed by the
+// write data:
+//
+//   byte  0      : record type
+//   byte  1      : flags (0: ARVALID, 1: RREADY, 2: AWVALID, 3: WVALID,
+//                  4: WLAST, 5: BREADY)
+//   byte  2      : ARLEN
+//   byte  3      : ARSIZE
+//   byte  4      : AWLEN
+//   byte  5      : AWSIZE
+//   bytes 6-7    : reserved, set to zero
+//   bytes 8-9    : ARID
+//   bytes 10-11  : AWID
+//   bytes 12-15  : reserved, set to zero
+//   bytes 16-23  : ARADDR
+//   bytes 24-31  : AWADDR
+//   bytes 32-39  : WSTRB
+//
+// The slave model replies with the AXI slave outputs to be driven during the
+// next clock cycle, using the following fields followed by the read data:
+//
+//   byte  0      : record type
+//   byte  1      : flags (0: ARREADY, 1: RVALID, 2: RLAST, 3: AWREADY,
+//                  4: WREADY, 5: BVALID)
+//   byte  2      : RRESP
+//   byte  3      : BRESP
+//   bytes 4-5    : RID
+//   bytes 6-7    : BID
+//
+// The data fields use the AXI data bus width, which must be configured to be
+// the same at both ends of the bridge. All slave outputs are deasserted for
+// the first clock cycle after reset.
+//
+const (
+	AxiBridgeMasterSize = 40
+	AxiBridgeSlaveSize  = 8
+)
+
+//
+// Converts a boolean value to a single flag bit.
+//
+func flagBit(value bool, bit uint) uint8 {
+	if value {
+		return 1 << bit
+	}
+	return 0
+}
+
+//
+// EncodeAxiMasterRecord encodes the AXI master outputs as a bridge record,
+// using the specified data bus width in bytes.
+//
+func EncodeAxiMasterRecord(master AxiMasterSignals, dataWidth uint) []byte {
+	record := make([]byte, AxiBridgeMasterSize+dataWidth)
+	record[0] = AxiBridgeMsgCycle
+	record[1] = flagBit(master.ARValid, 0) | flagBit(master.RReady, 1) |
+		flagBit(master.AWValid, 2) | flagBit(master.WValid, 3) |
+		flagBit(master.WLast, 4) | flagBit(master.BReady, 5)
+	record[2] = master.ARLen
+	record[3] = master.ARSize
+	record[4] = master.AWLen
+	record[5] = master.AWSize
+	binary.LittleEndian.PutUint16(record[8:], master.ARId)
+	binary.LittleEndian.PutUint16(record[10:], master.AWId)
+	binary.LittleEndian.PutUint64(record[16:], master.ARAddr)
+	binary.LittleEndian.PutUint64(record[24:], master.AWAddr)
+	binary.LittleEndian.PutUint64(record[32:], master.WStrb)
+	copy(record[AxiBridgeMasterSize:], master.WData)
+	return record
+}
+
+//
+// DecodeAxiMasterRecord decodes a bridge record containing the AXI master
+// outputs. The record type must already have been checked.
+//
+func DecodeAxiMasterRecord(record []byte) AxiMasterSignals {
+	flags := record[1]
+	return AxiMasterSignals{
+		ARValid: (flags & 0x01) != 0,
+		RReady:  (flags & 0x02) != 0,
+		AWValid: (flags & 0x04) != 0,
+		WValid:  (flags & 0x08) != 0,
+		WLast:   (flags & 0x10) != 0,
+		BReady:  (flags & 0x20) != 0,
+		ARLen:   record[2],
+		ARSize:  record[3],
+		AWLen:   record[4],
+		AWSize:  record[5],
+		ARId:    binary.LittleEndian.Uint16(record[8:]),
+		AWId:    binary.LittleEndian.Uint16(record[10:]),
+		ARAddr:  binary.LittleEndian.Uint64(record[16:]),
+		AWAddr:  binary.LittleEndian.Uint64(record[24:]),
+		WStrb:   binary.LittleEndian.Uint64(record[32:]),
+		WData:   record[AxiBridgeMasterSize:],
+	}
+}
+
+//
+// EncodeAxiSlaveRecord encodes the AXI slave outputs as a bridge record,
+// using the specified data bus width in bytes.
+//
+func EncodeAxiSlaveRecord(slave AxiSlaveSignals, dataWidth uint) []byte {
+	record := make([]byte, AxiBridgeSlaveSize+dataWidth)
+	record[0] = AxiBridgeMsgSlave
+	record[1] = flagBit(slave.ARReady, 0) | flagBit(slave.RValid, 1) |
+		flagBit(slave.RLast, 2) | flagBit(slave.AWReady, 3) |
+		flagBit(slave.WReady, 4) | flagBit(slave.BValid, 5)
+	record[2] = slave.RResp
+	record[3] = slave.BResp
+	binary.LittleEndian.PutUint16(record[4:], slave.RId)
+	binary.LittleEndian.PutUint16(record[6:], slave.BId)
+	copy(record[AxiBridgeSlaveSize:], slave.RData)
+	return record
+}
+
+//
+// ServeAxiBridge runs the AXI bridge protocol over the supplied connection,
+// clocking the slave model once for each master record received from the
+// testbench. Runs until the testbench sends a close record or the connection
+// is closed. Returns an error item which will be set to 'nil' on successful
+// completion.
+//
+func ServeAxiBridge(conn io.ReadWriter, slave *AxiSlave) error {
+	dataWidth := slave.config.DataWidth
+	input := bufio.NewReader(conn)
+	output := bufio.NewWriter(conn)
+	record := make([]byte, AxiBridgeMasterSize+dataWidth)
+	for {
+		if _, err := io.ReadFull(input, record[:1]); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		switch record[0] {
+		case AxiBridgeMsgCycle:
+			if _, err := io.ReadFull(input, record[1:]); err != nil {
+				return errors.New("Truncated AXI bridge master record")
+			}
+			next := slave.Clock(DecodeAxiMasterRecord(record))
+			if _, err := output.Write(EncodeAxiSlaveRecord(next, dataWidth)); err != nil {
+				return err
+			}
+			if err := output.Flush(); err != nil {
+				return err
+			}
+		case AxiBridgeMsgClose:
+			return nil
+		default:
+			return errors.New(fmt.Sprintf(
+				"Invalid AXI bridge record type (0x%02X)", record[0]))
+		}
+	}
+}
@@ -0,0 +1,477 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+//
+// Package smiAxiModel provides a cycle based AXI4 slave memory model for
+// testing the SMI AXI memory bus adaptors in simulation. The model supports
+// variable response latency, random ready and valid back pressure, response
+// reordering across transaction IDs and the injection of SLVERR and DECERR
+// responses. A cycle level bridge allows the model to be connected to the AXI
+// master interface of a Verilator simulation.
+//
+package smiAxiModel
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemServer"
+	"github.com/ReconfigureIO/smi/go-template/src/smiProtocol"
+	"math/rand"
+)
+
+//
+// AxiErrorRegion specifies an address range for which all accesses will
+// complete with the specified error response.
+//
+type AxiErrorRegion struct {
+	Base uint64 // Base address of the region.
+	Size uint64 // Size of the region in bytes.
+	Resp uint8  // AXI response code for accesses to the region.
+}
+
+//
+// AxiSlaveConfig specifies the AXI slave model options. Latencies are
+// specified in clock cycles from the acceptance of the read address or the
+// last write data beat to the availability of the response. Rates are
+// specified as probabilities per clock cycle.
+//
+type AxiSlaveConfig struct {
+	DataWidth      uint             // AXI data bus width in bytes.
+	MinLatency     uint             // Minimum response latency.
+	MaxLatency     uint             // Maximum response latency.
+	ReadyRate      float64          // Probability of asserting each ready signal.
+	ValidRate      float64          // Probability of presenting an available response beat.
+	Reorder        bool             // Allow responses to complete out of order across IDs.
+	MaxOutstanding uint             // Maximum outstanding bursts per direction, or zero.
+	SlvErrRate     float64          // Probability of a burst returning SLVERR.
+	DecErrRate     float64          // Probability of a burst returning DECERR.
+	ErrorRegions   []AxiErrorRegion // Address ranges which always return errors.
+	Seed           int64            // Random number generator seed.
+}
+
+//
+// AxiMasterSignals specifies the AXI master outputs sampled on a rising clock
+// edge. Write data and strobes use little endian byte lane ordering.
+//
+type AxiMasterSignals struct {
+	ARValid bool
+	ARId    uint16
+	ARAddr  uint64
+	ARLen   uint8
+	ARSize  uint8
+	RReady  bool
+	AWValid bool
+	AWId    uint16
+	AWAddr  uint64
+	AWLen   uint8
+	AWSize  uint8
+	WValid  bool
+	WData   []byte
+	WStrb   uint64
+	WLast   bool
+	BReady  bool
+}
+
+//
+// AxiSlaveSignals specifies the AXI slave outputs driven during a clock
+// cycle. Read data uses little endian byte lane ordering.
+//
+type AxiSlaveSignals struct {
+	ARReady bool
+	RValid  bool
+	RId     uint16
+	RData   []byte
+	RResp   uint8
+	RLast   bool
+	AWReady bool
+	WReady  bool
+	BValid  bool
+	BId     uint16
+	BResp   uint8
+}
+
+//
+// AxiSlaveStats specifies the activity recorded by the AXI slave model.
+//
+type AxiSlaveStats struct {
+	ReadBursts     uint     // Number of completed read bursts.
+	WriteBursts    uint     // Number of completed write bursts.
+	ReadErrors     uint     // Number of read bursts with error responses.
+	WriteErrors    uint     // Number of write bursts with error responses.
+	ProtocolErrors []string // AXI protocol violations by the master.
+}
+
+//
+// Holds the state of a single accepted burst.
+//
+type axiBurst struct {
+	id       uint16
+	addr     uint64
+	beats    uint
+	size     uint8
+	resp     uint8
+	ready    uint64   // Cycle on which the response becomes available.
+	data     [][]byte // Read data for each beat.
+	beat     uint     // Number of beats transferred.
+	complete bool     // All write data has been received.
+}
+
+//
+// Determines the address of the specified beat in an incrementing burst.
+// The first beat uses the unaligned start address.
+//
+func (burst *axiBurst) beatAddr(beat uint) uint64 {
+	if beat == 0 {
+		return burst.addr
+	}
+	beatSize := uint64(1) << burst.size
+	return (burst.addr &^ (beatSize - 1)) + uint64(beat)*beatSize
+}
+
+//
+// AxiSlave implements the AXI4 slave memory model. Incrementing bursts are
+// supported, with read data being returned one burst at a time without
+// interleaving beats from different bursts.
+//
+type AxiSlave struct {
+	Memory     *smiMemServer.SparseMemory // Memory which is accessed by the slave.
+	Stats      AxiSlaveStats              // Recorded activity.
+	config     AxiSlaveConfig
+	random     *rand.Rand
+	cycle      uint64
+	outputs    AxiSlaveSignals
+	reads      []*axiBurst // Accepted read bursts.
+	activeRead *axiBurst   // Read burst currently being returned.
+	writes     []*axiBurst // Accepted write bursts awaiting responses.
+	activeB    *axiBurst   // Write response currently being presented.
+}
+
+//
+// NewAxiSlave creates a new AXI slave model which accesses the supplied
+// memory using the specified model options. Returns the model and an error
+// item which will be set to 'nil' on successful completion.
+//
+func NewAxiSlave(memory *smiMemServer.SparseMemory,
+	config AxiSlaveConfig) (*AxiSlave, error) {
+
+	switch config.DataWidth {
+	case 8, 16, 32, 64:
+	default:
+		return nil, errors.New(fmt.Sprintf(
+			"Invalid AXI data width (%d bytes) for slave model", config.DataWidth))
+	}
+	if config.MaxLatency < config.MinLatency {
+		return nil, errors.New(fmt.Sprintf(
+			"Invalid AXI slave latency range (%d to %d)", config.MinLatency, config.MaxLatency))
+	}
+	if (config.ReadyRate <= 0) || (config.ReadyRate > 1) ||
+		(config.ValidRate <= 0) || (config.ValidRate > 1) {
+		return nil, errors.New("AXI slave ready and valid rates must be in the range (0, 1]")
+	}
+	if (config.SlvErrRate < 0) || (config.DecErrRate < 0) ||
+		(config.SlvErrRate+config.DecErrRate > 1) {
+		return nil, errors.New("Invalid AXI slave error injection rates")
+	}
+	slave := &AxiSlave{Memory: memory, config: config}
+	slave.random = rand.New(rand.NewSource(config.Seed))
+	slave.outputs.RData = make([]byte, config.DataWidth)
+	return slave, nil
+}
+
+//
+// Outputs returns the slave outputs for the current clock cycle.
+//
+func (slave *AxiSlave) Outputs() AxiSlaveSignals {
+	return slave.outputs
+}
+
+//
+// Records a protocol violation by the AXI master.
+//
+func (slave *AxiSlave) protocolError(format string, args ...interface{}) {
+	slave.Stats.ProtocolErrors = append(slave.Stats.ProtocolErrors,
+		fmt.Sprintf("cycle %d: ", slave.cycle)+fmt.Sprintf(format, args...))
+}
+
+//
+// Selects the response code for a new burst, using the error regions and the
+// random error injection rates.
+//
+func (slave *AxiSlave) selectResp(addr uint64, length uint64) uint8 {
+	for _, region := range slave.config.ErrorRegions {
+		if (addr < region.Base+region.Size) && (region.Base < addr+length) {
+			return region.Resp
+		}
+	}
+	sample := slave.random.Float64()
+	switch {
+	case sample < slave.config.DecErrRate:
+		return smiProtocol.StatusDecErr
+	case sample < slave.config.DecErrRate+slave.config.SlvErrRate:
+		return smiProtocol.StatusSlvErr
+	default:
+		return smiProtocol.StatusOkay
+	}
+}
+
+//
+// Selects a random response latency.
+//
+func (slave *AxiSlave) selectLatency() uint64 {
+	latencyRange := slave.config.MaxLatency - slave.config.MinLatency
+	return uint64(slave.config.MinLatency) + uint64(slave.random.Intn(int(latencyRange)+1))
+}
+
+//
+// Creates a new burst from the address channel signals, checking for
+// unsupported burst parameters.
+//
+func (slave *AxiSlave) newBurst(channel string, id uint16, addr uint64,
+	length uint8, size uint8) *axiBurst {
+
+	burst := &axiBurst{id: id, addr: addr, beats: uint(length) + 1, size: size}
+	if (uint(1) << size) > slave.config.DataWidth {
+		slave.protocolError("%s burst size (%d) exceeds data bus width", channel, size)
+		burst.size = 0
+	}
+	lastAddr := burst.beatAddr(burst.beats) - 1
+	if (addr >> 12) != (lastAddr >> 12) {
+		slave.protocolError("%s burst at 0x%X crosses a 4KB boundary", channel, addr)
+	}
+	burst.resp = slave.selectResp(addr, lastAddr-addr+1)
+	return burst
+}
+
+//
+// Reads the data for all beats of a read burst, mapping each byte to its
+// byte lane.
+//
+func (slave *AxiSlave) readBurstData(burst *axiBurst) {
+	dataWidth := uint64(slave.config.DataWidth)
+	burst.data = make([][]byte, burst.beats)
+	for beat := uint(0); beat < burst.beats; beat++ {
+		data := make([]byte, dataWidth)
+		if burst.resp == smiProtocol.StatusOkay {
+			start := burst.beatAddr(beat)
+			end := burst.beatAddr(beat + 1)
+			slave.Memory.Read(start, data[start%dataWidth:(start%dataWidth)+(end-start)])
+		}
+		burst.data[beat] = data
+	}
+}
+
+//
+// Writes a single beat of write data to memory, using the write strobes to
+// select the active byte lanes.
+//
+func (slave *AxiSlave) writeBeat(burst *axiBurst, data []byte, strobes uint64) {
+	if burst.resp != smiProtocol.StatusOkay {
+		return
+	}
+	dataWidth := uint64(slave.config.DataWidth)
+	base := burst.beatAddr(burst.beat) &^ (dataWidth - 1)
+	for lane := uint64(0); lane < dataWidth; lane++ {
+		if (strobes>>lane)&1 != 0 {
+			slave.Memory.Write(base+lane, data[lane:lane+1])
+		}
+	}
+}
+
+//
+// Selects the next burst to respond to from a list of accepted bursts. When
+// reordering is enabled, any burst which is the oldest for its ID may be
+// selected. Otherwise only the oldest burst may be selected.
+//
+func (slave *AxiSlave) selectBurst(bursts []*axiBurst, now uint64) int {
+	candidates := make([]int, 0)
+	seenIds := make(map[uint16]bool)
+	for i, burst := range bursts {
+		if !seenIds[burst.id] && burst.complete && burst.ready <= now {
+			candidates = append(candidates, i)
+		}
+		seenIds[burst.id] = true
+		if !slave.config.Reorder {
+			break
+		}
+	}
+	if len(candidates) == 0 {
+		return -1
+	}
+	return candidates[slave.random.Intn(len(candidates))]
+}
+
+//
+// Counts the write bursts which are still waiting for write data.
+//
+func (slave *AxiSlave) pendingWriteData() int {
+	count := 0
+	for _, burst := range slave.writes {
+		if !burst.complete {
+			count++
+		}
+	}
+	return count
+}
+
+//
+// Clock advances the model by a single clock cycle, using the AXI master
+// outputs sampled on the rising clock edge at the end of the current cycle.
+// Returns the slave outputs to be driven during the next clock cycle.
+//
+func (slave *AxiSlave) Clock(master AxiMasterSignals) AxiSlaveSignals {
+	current := slave.outputs
+	next := AxiSlaveSignals{RData: make([]byte, slave.config.DataWidth)}
+
+	// Accept new read bursts.
+	if master.ARValid && current.ARReady {
+		burst := slave.newBurst("read", master.ARId, master.ARAddr,
+			master.ARLen, master.ARSize)
+		burst.ready = slave.cycle + slave.selectLatency()
+		burst.complete = true
+		slave.readBurstData(burst)
+		slave.reads = append(slave.reads, burst)
+	}
+
+	// Process read data beat transfers.
+	rHeld := false
+	if current.RValid {
+		if master.RReady {
+			slave.activeRead.beat++
+			if slave.activeRead.beat == slave.activeRead.beats {
+				slave.Stats.ReadBursts++
+				if slave.activeRead.resp != smiProtocol.StatusOkay {
+					slave.Stats.ReadErrors++
+				}
+				slave.activeRead = nil
+			}
+		} else {
+			rHeld = true
+		}
+	}
+
+	// Accept new write bursts.
+	if master.AWValid && current.AWReady {
+		burst := slave.newBurst("write", master.AWId, master.AWAddr,
+			master.AWLen, master.AWSize)
+		slave.writes = append(slave.writes, burst)
+	}
+
+	// Process write data beat transfers. Write data is always associated with
+	// the oldest write burst which is still waiting for data.
+	if master.WValid && current.WReady {
+		for _, burst := range slave.writes {
+			if burst.complete {
+				continue
+			}
+			if len(master.WData) != int(slave.config.DataWidth) {
+				slave.protocolError("write data width (%d bytes) does not match bus", len(master.WData))
+			} else {
+				slave.writeBeat(burst, master.WData, master.WStrb)
+			}
+			burst.beat++
+			last := (burst.beat == burst.beats)
+			if master.WLast != last {
+				slave.protocolError("write last flag mismatch on beat %d of burst at 0x%X",
+					burst.beat-1, burst.addr)
+			}
+			if last {
+				burst.complete = true
+				burst.ready = slave.cycle + slave.selectLatency()
+			}
+			break
+		}
+	}
+
+	// Process write response transfers.
+	bHeld := false
+	if current.BValid {
+		if master.BReady {
+			slave.Stats.WriteBursts++
+			if slave.activeB.resp != smiProtocol.StatusOkay {
+				slave.Stats.WriteErrors++
+			}
+			slave.activeB = nil
+		} else {
+			bHeld = true
+		}
+	}
+	slave.cycle++
+
+	// Determine the ready signals for the next cycle.
+	maxOutstanding := int(slave.config.MaxOutstanding)
+	ready := func() bool {
+		return slave.random.Float64() < slave.config.ReadyRate
+	}
+	numReads := len(slave.reads)
+	if slave.activeRead != nil {
+		numReads++
+	}
+	next.ARReady = ((maxOutstanding == 0) || (numReads < maxOutstanding)) && ready()
+	next.AWReady = ((maxOutstanding == 0) || (len(slave.writes) < maxOutstanding)) && ready()
+	next.WReady = (slave.pendingWriteData() != 0) && ready()
+
+	// Determine the read data output for the next cycle. Valid read data is
+	// held until it is accepted by the master.
+	if rHeld {
+		next.RValid = true
+		next.RId = current.RId
+		next.RData = current.RData
+		next.RResp = current.RResp
+		next.RLast = current.RLast
+	} else if slave.random.Float64() < slave.config.ValidRate {
+		if slave.activeRead == nil {
+			index := slave.selectBurst(slave.reads, slave.cycle)
+			if index >= 0 {
+				slave.activeRead = slave.reads[index]
+				slave.reads = append(slave.reads[:index], slave.reads[index+1:]...)
+			}
+		}
+		if slave.activeRead != nil {
+			burst := slave.activeRead
+			next.RValid = true
+			next.RId = burst.id
+			next.RData = burst.data[burst.beat]
+			next.RResp = burst.resp
+			next.RLast = (burst.beat == burst.beats-1)
+		}
+	}
+
+	// Determine the write response output for the next cycle.
+	if bHeld {
+		next.BValid = true
+		next.BId = current.BId
+		next.BResp = current.BResp
+	} else if slave.random.Float64() < slave.config.ValidRate {
+		index := slave.selectBurst(slave.writes, slave.cycle)
+		if index >= 0 {
+			slave.activeB = slave.writes[index]
+			slave.writes = append(slave.writes[:index], slave.writes[index+1:]...)
+			next.BValid = true
+			next.BId = slave.activeB.id
+			next.BResp = slave.activeB.resp
+		}
+	}
+	slave.outputs = next
+	return next
+}
+
+//
+// Idle indicates whether the slave has no outstanding bursts.
+//
+func (slave *AxiSlave) Idle() bool {
+	return len(slave.reads) == 0 && slave.activeRead == nil &&
+		len(slave.writes) == 0 && slave.activeB == nil
+}
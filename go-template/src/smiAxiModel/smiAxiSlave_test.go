@@ -0,0 +1,411 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiAxiModel
+
+import (
+	"bytes"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemServer"
+	"github.com/ReconfigureIO/smi/go-template/src/smiProtocol"
+	"strings"
+	"testing"
+)
+
+//
+// Specifies the AXI data bus width used for testing.
+//
+const testDataWidth = 8
+
+//
+// Specifies a single burst issued by the test master. Write bursts include
+// the write data for each beat and the beat on which WLAST is asserted.
+//
+type testBurst struct {
+	id       uint16
+	addr     uint64
+	length   uint8
+	data     [][]byte
+	lastBeat int
+}
+
+//
+// Specifies a completed burst as observed by the test master.
+//
+type testCompletion struct {
+	id   uint16
+	resp uint8
+	data []byte
+}
+
+//
+// Implements a simple AXI master which issues the queued read and write
+// bursts and records the responses. All bursts use full width beats.
+//
+type testAxiMaster struct {
+	reads      []testBurst
+	writes     []testBurst
+	writeData  []testBurst // Write bursts with outstanding write data.
+	writeBeat  int
+	readData   []byte
+	readResp   uint8
+	readDone   []testCompletion
+	writeDone  []testCompletion
+	interleave bool // Read beats from different bursts were interleaved.
+	activeRId  int
+}
+
+//
+// Runs the test master for the specified number of clock cycles, or until
+// all the issued bursts have completed.
+//
+func (master *testAxiMaster) run(t *testing.T, slave *AxiSlave, maxCycles int) {
+	numReads := len(master.reads)
+	numWrites := len(master.writes)
+	master.activeRId = -1
+	for cycle := 0; cycle < maxCycles; cycle++ {
+		if (len(master.readDone) == numReads) && (len(master.writeDone) == numWrites) {
+			return
+		}
+		outputs := slave.Outputs()
+		signals := AxiMasterSignals{RReady: (cycle % 3) != 0, BReady: (cycle % 2) == 0}
+		if len(master.reads) != 0 {
+			burst := master.reads[0]
+			signals.ARValid = true
+			signals.ARId = burst.id
+			signals.ARAddr = burst.addr
+			signals.ARLen = burst.length
+			signals.ARSize = 3
+		}
+		if len(master.writes) != 0 {
+			burst := master.writes[0]
+			signals.AWValid = true
+			signals.AWId = burst.id
+			signals.AWAddr = burst.addr
+			signals.AWLen = burst.length
+			signals.AWSize = 3
+		}
+		if len(master.writeData) != 0 {
+			burst := master.writeData[0]
+			signals.WValid = true
+			signals.WData = burst.data[master.writeBeat]
+			signals.WStrb = 0xFF
+			signals.WLast = (master.writeBeat == burst.lastBeat)
+		}
+
+		// Update the master state using the handshakes for this cycle.
+		if signals.ARValid && outputs.ARReady {
+			master.reads = master.reads[1:]
+		}
+		if signals.AWValid && outputs.AWReady {
+			master.writeData = append(master.writeData, master.writes[0])
+			master.writes = master.writes[1:]
+		}
+		if signals.WValid && outputs.WReady {
+			master.writeBeat++
+			if master.writeBeat == len(master.writeData[0].data) {
+				master.writeData = master.writeData[1:]
+				master.writeBeat = 0
+			}
+		}
+		if outputs.RValid && signals.RReady {
+			if (master.activeRId >= 0) && (master.activeRId != int(outputs.RId)) {
+				master.interleave = true
+			}
+			master.activeRId = int(outputs.RId)
+			master.readData = append(master.readData, outputs.RData...)
+			master.readResp = outputs.RResp
+			if outputs.RLast {
+				master.readDone = append(master.readDone,
+					testCompletion{outputs.RId, master.readResp, master.readData})
+				master.readData = nil
+				master.activeRId = -1
+			}
+		}
+		if outputs.BValid && signals.BReady {
+			master.writeDone = append(master.writeDone,
+				testCompletion{outputs.BId, outputs.BResp, nil})
+		}
+		slave.Clock(signals)
+	}
+	t.Fatalf("AXI bursts incomplete after %d cycles (%d/%d reads, %d/%d writes)",
+		maxCycles, len(master.readDone), numReads, len(master.writeDone), numWrites)
+}
+
+//
+// Creates a write burst with an incrementing data pattern.
+//
+func makeWriteBurst(id uint16, addr uint64, beats int, seed byte) testBurst {
+	burst := testBurst{id: id, addr: addr, length: uint8(beats - 1), lastBeat: beats - 1}
+	for beat := 0; beat < beats; beat++ {
+		data := make([]byte, testDataWidth)
+		for i := range data {
+			data[i] = seed + byte(beat*testDataWidth+i)
+		}
+		burst.data = append(burst.data, data)
+	}
+	return burst
+}
+
+//
+// Creates an AXI slave model for testing, failing the test on error.
+//
+func newTestSlave(t *testing.T, config AxiSlaveConfig) *AxiSlave {
+	config.DataWidth = testDataWidth
+	slave, err := NewAxiSlave(smiMemServer.NewSparseMemory(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return slave
+}
+
+//
+// Tests that bursts complete in the order in which they were issued when
+// reordering is disabled, even if they use different IDs, and that the read
+// data matches the data previously written.
+//
+func TestAxiSlaveInOrder(t *testing.T) {
+	slave := newTestSlave(t, AxiSlaveConfig{MinLatency: 1, MaxLatency: 30,
+		ReadyRate: 0.6, ValidRate: 0.6, Seed: 1})
+	master := &testAxiMaster{}
+	for i := 0; i < 16; i++ {
+		master.writes = append(master.writes,
+			makeWriteBurst(uint16(i%4), uint64(i)*0x100, 1+i%4, byte(i*16)))
+	}
+	master.run(t, slave, 10000)
+	for i, done := range master.writeDone {
+		if (done.id != uint16(i%4)) || (done.resp != smiProtocol.StatusOkay) {
+			t.Errorf("write %d completed with ID %d status %d, expected ID %d status 0",
+				i, done.id, done.resp, i%4)
+		}
+	}
+
+	master = &testAxiMaster{}
+	for i := 0; i < 16; i++ {
+		master.reads = append(master.reads,
+			testBurst{id: uint16(i % 4), addr: uint64(i) * 0x100, length: uint8(i % 4)})
+	}
+	master.run(t, slave, 10000)
+	for i, done := range master.readDone {
+		expected := bytes.Join(makeWriteBurst(0, 0, 1+i%4, byte(i*16)).data, nil)
+		if (done.id != uint16(i%4)) || !bytes.Equal(done.data, expected) {
+			t.Errorf("read %d completed with ID %d data % X, expected ID %d data % X",
+				i, done.id, done.data, i%4, expected)
+		}
+	}
+	if master.interleave {
+		t.Errorf("read data beats interleaved between bursts")
+	}
+	if (slave.Stats.ReadBursts != 16) || (slave.Stats.WriteBursts != 16) ||
+		(len(slave.Stats.ProtocolErrors) != 0) || !slave.Idle() {
+		t.Errorf("unexpected slave state after test: %+v", slave.Stats)
+	}
+}
+
+//
+// Tests that when reordering is enabled bursts with different IDs may
+// complete out of order, while bursts with the same ID always complete in
+// order.
+//
+func TestAxiSlaveReordering(t *testing.T) {
+	slave := newTestSlave(t, AxiSlaveConfig{MinLatency: 1, MaxLatency: 100,
+		ReadyRate: 1, ValidRate: 1, Reorder: true, Seed: 2})
+	master := &testAxiMaster{}
+	for i := 0; i < 32; i++ {
+		master.reads = append(master.reads,
+			testBurst{id: uint16(i % 4), addr: uint64(i) * 8, length: uint8(i % 3)})
+		master.writes = append(master.writes,
+			makeWriteBurst(uint16(i%4), 0x10000+uint64(i)*8, 1, 0))
+	}
+	master.run(t, slave, 10000)
+	for _, completions := range [][]testCompletion{master.readDone, master.writeDone} {
+		reordered := false
+		nextIssue := make(map[uint16]int)
+		for i, done := range completions {
+			// Bursts with the same ID were issued with a stride of four.
+			issue := nextIssue[done.id]*4 + int(done.id)
+			nextIssue[done.id]++
+			if issue != i {
+				reordered = true
+			}
+		}
+		for id, count := range nextIssue {
+			if count != 8 {
+				t.Errorf("%d bursts completed for ID %d, expected 8", count, id)
+			}
+		}
+		if !reordered {
+			t.Errorf("no bursts were reordered")
+		}
+	}
+
+	// Check the per-ID ordering using the read burst lengths.
+	nextLength := make(map[uint16]int)
+	for _, done := range master.readDone {
+		index := nextLength[done.id]*4 + int(done.id)
+		nextLength[done.id]++
+		if len(done.data) != (1+index%3)*testDataWidth {
+			t.Errorf("read burst %d for ID %d completed out of order", index, done.id)
+		}
+	}
+	if master.interleave {
+		t.Errorf("read data beats interleaved between bursts")
+	}
+}
+
+//
+// Tests that error regions and random error injection generate SLVERR and
+// DECERR responses, and that writes with error responses do not update the
+// memory.
+//
+func TestAxiSlaveErrorResponses(t *testing.T) {
+	regions := []AxiErrorRegion{
+		{0x1000, 0x1000, smiProtocol.StatusSlvErr},
+		{0x3000, 0x100, smiProtocol.StatusDecErr}}
+	slave := newTestSlave(t, AxiSlaveConfig{MinLatency: 2, MaxLatency: 8,
+		ReadyRate: 1, ValidRate: 1, ErrorRegions: regions, Seed: 3})
+	master := &testAxiMaster{}
+	addrs := []uint64{0x0FF8, 0x1000, 0x2000, 0x30F8, 0x3100}
+	resps := []uint8{smiProtocol.StatusOkay, smiProtocol.StatusSlvErr,
+		smiProtocol.StatusOkay, smiProtocol.StatusDecErr, smiProtocol.StatusOkay}
+	for _, addr := range addrs {
+		master.writes = append(master.writes, makeWriteBurst(0, addr, 1, 0x80))
+		master.reads = append(master.reads, testBurst{id: 1, addr: addr})
+	}
+	master.run(t, slave, 1000)
+	for i := range addrs {
+		if master.writeDone[i].resp != resps[i] {
+			t.Errorf("write to 0x%X returned status %d, expected %d",
+				addrs[i], master.writeDone[i].resp, resps[i])
+		}
+		if master.readDone[i].resp != resps[i] {
+			t.Errorf("read from 0x%X returned status %d, expected %d",
+				addrs[i], master.readDone[i].resp, resps[i])
+		}
+		data := make([]byte, testDataWidth)
+		slave.Memory.Read(addrs[i], data)
+		written := bytes.Equal(data, makeWriteBurst(0, 0, 1, 0x80).data[0])
+		if written != (resps[i] == smiProtocol.StatusOkay) {
+			t.Errorf("write to 0x%X with status %d updated memory: %v",
+				addrs[i], resps[i], written)
+		}
+	}
+	if (slave.Stats.ReadErrors != 2) || (slave.Stats.WriteErrors != 2) {
+		t.Errorf("%d read errors and %d write errors recorded, expected 2 of each",
+			slave.Stats.ReadErrors, slave.Stats.WriteErrors)
+	}
+
+	// Random error injection with each error type always selected.
+	for _, resp := range []uint8{smiProtocol.StatusSlvErr, smiProtocol.StatusDecErr} {
+		config := AxiSlaveConfig{MinLatency: 1, MaxLatency: 1,
+			ReadyRate: 1, ValidRate: 1, Seed: 4}
+		if resp == smiProtocol.StatusSlvErr {
+			config.SlvErrRate = 1
+		} else {
+			config.DecErrRate = 1
+		}
+		slave = newTestSlave(t, config)
+		master = &testAxiMaster{}
+		for i := 0; i < 4; i++ {
+			master.writes = append(master.writes, makeWriteBurst(0, uint64(i)*8, 1, 0))
+			master.reads = append(master.reads, testBurst{id: 0, addr: uint64(i) * 8})
+		}
+		master.run(t, slave, 1000)
+		for i := 0; i < 4; i++ {
+			if (master.writeDone[i].resp != resp) || (master.readDone[i].resp != resp) {
+				t.Errorf("burst %d returned status %d/%d, expected %d", i,
+					master.writeDone[i].resp, master.readDone[i].resp, resp)
+			}
+		}
+	}
+}
+
+//
+// Tests that bursts which cross 4KB boundaries and write bursts with
+// incorrect WLAST flags are reported as protocol errors.
+//
+func TestAxiSlaveProtocolErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		read   *testBurst
+		write  *testBurst
+		errors []string
+	}{
+		{"read 4KB crossing", &testBurst{id: 0, addr: 0x0FF8, length: 1}, nil,
+			[]string{"read burst at 0xFF8 crosses a 4KB boundary"}},
+		{"write 4KB crossing", nil, &testBurst{id: 0, addr: 0x1FF0, length: 2,
+			data: make([][]byte, 3), lastBeat: 2},
+			[]string{"write burst at 0x1FF0 crosses a 4KB boundary"}},
+		{"early WLAST", nil, &testBurst{id: 0, addr: 0x100, length: 1,
+			data: make([][]byte, 2), lastBeat: 0},
+			[]string{"write last flag mismatch on beat 0 of burst at 0x100",
+				"write last flag mismatch on beat 1 of burst at 0x100"}},
+		{"missing WLAST", nil, &testBurst{id: 0, addr: 0x100, length: 1,
+			data: make([][]byte, 2), lastBeat: -1},
+			[]string{"write last flag mismatch on beat 1 of burst at 0x100"}},
+		{"valid bursts", &testBurst{id: 0, addr: 0x0FF0, length: 1},
+			&testBurst{id: 0, addr: 0x1FF0, length: 1, data: make([][]byte, 2), lastBeat: 1},
+			nil}}
+	for _, testCase := range testCases {
+		slave := newTestSlave(t, AxiSlaveConfig{MinLatency: 1, MaxLatency: 4,
+			ReadyRate: 1, ValidRate: 1, Seed: 5})
+		master := &testAxiMaster{}
+		if testCase.read != nil {
+			master.reads = append(master.reads, *testCase.read)
+		}
+		if testCase.write != nil {
+			for i := range testCase.write.data {
+				testCase.write.data[i] = make([]byte, testDataWidth)
+			}
+			master.writes = append(master.writes, *testCase.write)
+		}
+		master.run(t, slave, 1000)
+		errors := slave.Stats.ProtocolErrors
+		if len(errors) != len(testCase.errors) {
+			t.Errorf("%s: protocol errors %v, expected %q",
+				testCase.name, errors, testCase.errors)
+			continue
+		}
+		for i, expected := range testCase.errors {
+			if !strings.HasPrefix(errors[i], "cycle ") || !strings.Contains(errors[i], expected) {
+				t.Errorf("%s: protocol error %q, expected %q",
+					testCase.name, errors[i], expected)
+			}
+		}
+	}
+}
+
+//
+// Tests that invalid slave model options are rejected.
+//
+func TestAxiSlaveInvalidConfig(t *testing.T) {
+	valid := AxiSlaveConfig{DataWidth: 8, MinLatency: 1, MaxLatency: 2,
+		ReadyRate: 1, ValidRate: 1}
+	invalidConfigs := []AxiSlaveConfig{valid, valid, valid, valid, valid}
+	invalidConfigs[0].DataWidth = 12
+	invalidConfigs[1].MaxLatency = 0
+	invalidConfigs[2].ReadyRate = 0
+	invalidConfigs[3].ValidRate = 1.5
+	invalidConfigs[4].SlvErrRate = 0.6
+	invalidConfigs[4].DecErrRate = 0.6
+	for i, config := range invalidConfigs {
+		if _, err := NewAxiSlave(smiMemServer.NewSparseMemory(), config); err == nil {
+			t.Errorf("invalid configuration %d not rejected", i)
+		}
+	}
+	if _, err := NewAxiSlave(smiMemServer.NewSparseMemory(), valid); err != nil {
+		t.Errorf("valid configuration rejected: %v", err)
+	}
+}
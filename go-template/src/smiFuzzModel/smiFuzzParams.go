@@ -0,0 +1,212 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiFuzzModel
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemServer"
+)
+
+//
+// FuzzTestConfig specifies a single fuzz test run, as passed to the
+// configuration input of smiMemLibFuzzTestBurst64.
+//
+type FuzzTestConfig struct {
+	MemAddrBase    uint64 // Base address of the test window.
+	MemBlockSize   uint32 // Size of the test window in bytes.
+	NumTests       uint32 // Number of write and read check bursts.
+	MinBurstLength uint32 // Minimum burst length parameter in bytes.
+	MaxBurstLength uint32 // Maximum burst length parameter in bytes.
+}
+
+//
+// DefaultFuzzTestConfig returns a fuzz test configuration for the specified
+// test window, using the default burst length limits.
+//
+func DefaultFuzzTestConfig(memAddrBase uint64, memBlockSize uint32,
+	numTests uint32) FuzzTestConfig {
+	return FuzzTestConfig{
+		MemAddrBase:    memAddrBase,
+		MemBlockSize:   memBlockSize,
+		NumTests:       numTests,
+		MinBurstLength: 8,
+		MaxBurstLength: 1024 * 1024 * 1024,
+	}
+}
+
+//
+// PortFuzzTestConfig returns the fuzz test configuration used on the specified
+// SMI port of a generated fuzz test kernel. Each port tests a separate window
+// of the specified size, with the windows being allocated contiguously from
+// the kernel base address.
+//
+func PortFuzzTestConfig(port uint, memAddrBase uint64, memBlockSize uint32,
+	numTests uint32) FuzzTestConfig {
+	return DefaultFuzzTestConfig(
+		memAddrBase+uint64(port)*uint64(memBlockSize), memBlockSize, numTests)
+}
+
+//
+// FuzzTestParams specifies the parameters for a single fuzz test burst, as
+// generated by smiMemLibFuzzTestParamGen.
+//
+type FuzzTestParams struct {
+	BaseAddr   uint64 // Burst start address.
+	ByteLength uint32 // Burst length in bytes, before rounding to whole words.
+	DataInit   uint64 // Initial value of the write data counter.
+	DataIncr   uint64 // Increment value for the write data counter.
+}
+
+//
+// WordCount returns the number of 64-bit words transferred by the burst.
+//
+func (params FuzzTestParams) WordCount() uint32 {
+	return params.ByteLength >> 3
+}
+
+//
+// Data returns the 64-bit data words written and checked by the burst.
+//
+func (params FuzzTestParams) Data() []uint64 {
+	data := make([]uint64, params.WordCount())
+	value := params.DataInit
+	for i := range data {
+		data[i] = value
+		value += params.DataIncr
+	}
+	return data
+}
+
+//
+// Apply writes the burst data to the supplied memory model, using little
+// endian byte ordering.
+//
+func (params FuzzTestParams) Apply(memory *smiMemServer.SparseMemory) {
+	data := params.Data()
+	bytes := make([]byte, 8*len(data))
+	for i, word := range data {
+		binary.LittleEndian.PutUint64(bytes[8*i:], word)
+	}
+	memory.Write(params.BaseAddr, bytes)
+}
+
+//
+// Specifies the maximum number of random values which may be rejected when
+// selecting a single burst parameter before the configuration is considered
+// to be invalid. The hardware would retry indefinitely in this case.
+//
+const maxParamRetries = 1 << 16
+
+//
+// FuzzParamGen implements a bit exact model of smiMemLibFuzzTestParamGen. The
+// random number generator state is carried over between fuzz test runs in the
+// same way as the hardware, which is only reset on a system reset.
+//
+type FuzzParamGen struct {
+	rand *RandSource
+}
+
+//
+// NewFuzzParamGen creates a new fuzz test parameter generator model using the
+// specified random number generator seed. Returns the generator and an error
+// item which will be set to 'nil' on successful completion.
+//
+func NewFuzzParamGen(seed uint64) (*FuzzParamGen, error) {
+	rand, err := NewRandSource(64, seed)
+	if err != nil {
+		return nil, err
+	}
+	return &FuzzParamGen{rand: rand}, nil
+}
+
+//
+// Reset restores the generator to its state after a system reset.
+//
+func (gen *FuzzParamGen) Reset() {
+	gen.rand.Reset()
+}
+
+//
+// Generate returns the sequence of burst parameters which will be generated
+// for the specified fuzz test run. Returns the burst parameters and an error
+// item which will be set to 'nil' on successful completion. Note that the
+// fuzz tester reuses the current burst parameters for the next test if a
+// write burst fails, so the sequence only applies to error free runs.
+//
+func (gen *FuzzParamGen) Generate(config FuzzTestConfig) ([]FuzzTestParams, error) {
+	if (config.MemBlockSize < config.MinBurstLength) ||
+		(config.MinBurstLength > config.MaxBurstLength) {
+		return nil, errors.New(fmt.Sprintf(
+			"Fuzz test window size (%d bytes) does not support the burst length range",
+			config.MemBlockSize))
+	}
+
+	// Derive the window mask as the smallest all ones value which is greater
+	// than or equal to the window size.
+	windowMask := uint32(1)
+	for windowMask < config.MemBlockSize {
+		windowMask = (windowMask << 1) | 1
+	}
+
+	params := make([]FuzzTestParams, config.NumTests)
+	for i := range params {
+
+		// Select a burst offset in the lower half of the test window.
+		var offset uint32
+		for retry := 0; ; retry++ {
+			if retry == maxParamRetries {
+				return nil, errors.New("Failed to select fuzz test burst offset")
+			}
+			offset = uint32(gen.rand.Next()>>32) & (windowMask >> 1)
+			if offset <= (config.MemBlockSize >> 1) {
+				break
+			}
+		}
+
+		// Select a burst length which fits in the test window.
+		var length uint32
+		for retry := 0; ; retry++ {
+			if retry == maxParamRetries {
+				return nil, errors.New("Failed to select fuzz test burst length")
+			}
+			length = uint32(gen.rand.Next()>>32) & windowMask
+			if (length <= config.MaxBurstLength) && (length >= config.MinBurstLength) &&
+				(uint64(offset)+uint64(length) <= uint64(config.MemBlockSize)) {
+				break
+			}
+		}
+		params[i].BaseAddr = config.MemAddrBase + uint64(offset)
+		params[i].ByteLength = length
+		params[i].DataInit = gen.rand.Next()
+		params[i].DataIncr = gen.rand.Next()
+	}
+	return params, nil
+}
+
+//
+// ExpectedDataCount returns the total number of bytes which the fuzz tester
+// reports as having been transferred for the specified burst parameters.
+//
+func ExpectedDataCount(params []FuzzTestParams) uint64 {
+	count := uint64(0)
+	for _, param := range params {
+		count += uint64(param.WordCount()) * 8
+	}
+	return count
+}
@@ -0,0 +1,140 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiFuzzModel
+
+import (
+	"encoding/binary"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemServer"
+	"reflect"
+	"testing"
+)
+
+//
+// Lists the burst parameters generated by smiMemLibFuzzTestParamGen for two
+// successive fuzz test runs after a system reset, using the default seed.
+// These were derived from a cycle level transcription of the parameter
+// generator state machine and test/verilog/smiSelfRandSource.v. The second
+// run uses a window size which is not a power of two, so that random offsets
+// and lengths are rejected and regenerated.
+//
+var fuzzParamVectors = []struct {
+	config FuzzTestConfig
+	params []FuzzTestParams
+}{
+	{DefaultFuzzTestConfig(0x10000000, 0x10000, 4), []FuzzTestParams{
+		{0x10007B7D, 0x7EB2, 0x92AF3CE5A90E73A4, 0x3F373072FEF79D75},
+		{0x10000E31, 0x8D26, 0x6741EE85891B9760, 0x31F8B5D6229F3248},
+		{0x1000025A, 0x593F, 0x92CF8451DE67383B, 0x106F096D5E87DF0D},
+		{0x100049CB, 0xA335, 0x400B31EF654ADB15, 0x329FA7F8212868B3}}},
+	{DefaultFuzzTestConfig(0x20000000, 100, 3), []FuzzTestParams{
+		{0x2000000B, 0x08, 0x75955560AB6FAD17, 0x7E8AF81136F5C2DD},
+		{0x2000002C, 0x38, 0xBF8669A7CDF9D8FF, 0xE5AF6FE6E6CED5C1},
+		{0x20000012, 0x17, 0x0CD1B3A01EEA0414, 0x9AABB6D5D65E3EE4}}}}
+
+//
+// Tests the generated burst parameters against the reference vectors, with
+// the random number generator state carried over between runs, and checks
+// that a reset restarts the sequence.
+//
+func TestFuzzParamGenVectors(t *testing.T) {
+	gen, err := NewFuzzParamGen(DefaultRandSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for pass := 0; pass < 2; pass++ {
+		for i, vector := range fuzzParamVectors {
+			params, err := gen.Generate(vector.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(params, vector.params) {
+				t.Errorf("pass %d run %d: got %+v, expected %+v",
+					pass, i, params, vector.params)
+			}
+			for _, param := range params {
+				offset := param.BaseAddr - vector.config.MemAddrBase
+				if (offset > uint64(vector.config.MemBlockSize/2)) ||
+					(offset+uint64(param.ByteLength) > uint64(vector.config.MemBlockSize)) {
+					t.Errorf("pass %d run %d: burst %+v outside test window", pass, i, param)
+				}
+			}
+		}
+		gen.Reset()
+	}
+}
+
+//
+// Tests that fuzz test configurations which can not generate valid bursts
+// are rejected.
+//
+func TestFuzzParamGenInvalid(t *testing.T) {
+	invalidConfigs := []FuzzTestConfig{
+		DefaultFuzzTestConfig(0, 4, 1),
+		{0, 0x1000, 1, 64, 32}}
+	for i, config := range invalidConfigs {
+		gen, err := NewFuzzParamGen(DefaultRandSeed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := gen.Generate(config); err == nil {
+			t.Errorf("invalid configuration %d not rejected", i)
+		}
+	}
+}
+
+//
+// Tests the burst data sequence, the memory contents written by a burst and
+// the expected data count, including rounding down to whole words.
+//
+func TestFuzzTestParamsData(t *testing.T) {
+	params := []FuzzTestParams{
+		{0x1000, 0x1F, 0xFFFFFFFFFFFFFFFF, 2},
+		{0x2004, 0x08, 0x0123456789ABCDEF, 0}}
+	expected := [][]uint64{
+		{0xFFFFFFFFFFFFFFFF, 0x0000000000000001, 0x0000000000000003},
+		{0x0123456789ABCDEF}}
+	memory := smiMemServer.NewSparseMemory()
+	for i, param := range params {
+		if data := param.Data(); !reflect.DeepEqual(data, expected[i]) {
+			t.Errorf("burst %d data %X, expected %X", i, data, expected[i])
+		}
+		param.Apply(memory)
+		bytes := make([]byte, 8*len(expected[i]))
+		memory.Read(param.BaseAddr, bytes)
+		for j, word := range expected[i] {
+			if value := binary.LittleEndian.Uint64(bytes[8*j:]); value != word {
+				t.Errorf("burst %d word %d is %X in memory, expected %X", i, j, value, word)
+			}
+		}
+	}
+	if count := ExpectedDataCount(params); count != 32 {
+		t.Errorf("expected data count %d, expected 32", count)
+	}
+}
+
+//
+// Tests that each port of a fuzz test kernel uses a separate test window.
+//
+func TestPortFuzzTestConfig(t *testing.T) {
+	for port := uint(0); port < 4; port++ {
+		config := PortFuzzTestConfig(port, 0x40000000, 0x100000, 16)
+		expected := DefaultFuzzTestConfig(0x40000000+uint64(port)*0x100000, 0x100000, 16)
+		if config != expected {
+			t.Errorf("port %d configuration %+v, expected %+v", port, config, expected)
+		}
+	}
+}
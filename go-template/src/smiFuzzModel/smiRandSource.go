@@ -0,0 +1,195 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+//
+// Package smiFuzzModel provides bit exact host side models of the SMI memory
+// fuzz test components. This allows host software to predict the burst
+// parameters and memory contents generated by the fuzz testers and to
+// reproduce failing random seeds offline.
+//
+package smiFuzzModel
+
+import (
+	"errors"
+	"fmt"
+)
+
+//
+// DefaultRandSeed specifies the default random number generator seed used by
+// smiSelfRandSource and the fuzz test components.
+//
+const DefaultRandSeed = 0x373E7B7D27C69FA4
+
+//
+// PortRandSeed returns the random number generator seed used by the fuzz
+// tester on the specified SMI port of a generated fuzz test kernel. Port zero
+// uses the default seed and subsequent ports use successive outputs of the
+// default 64-bit generator, excluding its first output which is the default
+// seed itself.
+//
+func PortRandSeed(port uint) uint64 {
+	source, _ := NewRandSource(64, DefaultRandSeed)
+	seed := source.Next()
+	for i := uint(0); i < port; i++ {
+		seed = source.Next()
+		for seed == 0 {
+			seed = source.Next()
+		}
+	}
+	return seed
+}
+
+//
+// RandSource implements a bit exact model of the xorshift+ pseudo-random
+// number generator in test/verilog/smiSelfRandSource.v. Each output value is
+// formed by truncating both state words to the most significant DataWidth
+// bits before adding them, so narrow generators are not simply truncated
+// versions of the 64-bit generator.
+//
+type RandSource struct {
+	dataWidth uint
+	seed      uint64
+	s0        uint64
+	s1        uint64
+}
+
+//
+// NewRandSource creates a new random number generator model with the
+// specified output data width and seed, in the reset state. Returns the
+// generator and an error item which will be set to 'nil' on successful
+// completion.
+//
+func NewRandSource(dataWidth uint, seed uint64) (*RandSource, error) {
+	if (dataWidth == 0) || (dataWidth > 64) {
+		return nil, errors.New(fmt.Sprintf(
+			"Invalid random source data width (%d bits)", dataWidth))
+	}
+	if seed == 0 {
+		return nil, errors.New("Random source seed must be non-zero")
+	}
+	source := &RandSource{dataWidth: dataWidth, seed: seed}
+	source.Reset()
+	return source, nil
+}
+
+//
+// Reset restores the generator state to the value loaded on a synchronous
+// reset of the hardware, so that the next output is the first value
+// generated after reset.
+//
+func (source *RandSource) Reset() {
+	source.s0 = source.seed
+	source.s1 = 0
+}
+
+//
+// Determines the output value for the current generator state.
+//
+func (source *RandSource) value() uint64 {
+	shift := 64 - source.dataWidth
+	value := (source.s0 >> shift) + (source.s1 >> shift)
+	if source.dataWidth < 64 {
+		value &= (uint64(1) << source.dataWidth) - 1
+	}
+	return value
+}
+
+//
+// Advances the generator state by a single step.
+//
+func (source *RandSource) step() {
+	s0 := source.s1
+	s1 := source.s0
+	s1 ^= s1 << 23
+	s1 ^= s1 >> 18
+	s1 ^= s0 ^ (s0 >> 5)
+	source.s0 = s0
+	source.s1 = s1
+}
+
+//
+// Next returns the next value in the output sequence. This corresponds to the
+// value transferred on each successful handshake of the SELF output.
+//
+func (source *RandSource) Next() uint64 {
+	value := source.value()
+	source.step()
+	return value
+}
+
+//
+// Skip advances the output sequence by the specified number of values.
+//
+func (source *RandSource) Skip(count uint64) {
+	for i := uint64(0); i < count; i++ {
+		source.step()
+	}
+}
+
+//
+// RandSourceSim implements a clock cycle accurate model of smiSelfRandSource,
+// including the pipelined reset and the output register behaviour. The
+// output data register is not reset in hardware and is modelled as being
+// zero on startup.
+//
+type RandSourceSim struct {
+	source     *RandSource
+	localReset bool
+	ready      bool
+	data       uint64
+}
+
+//
+// NewRandSourceSim creates a new cycle accurate random source model with the
+// specified output data width and seed. The model is in the reset state
+// until the first clock cycle with the reset input low. Returns the model and
+// an error item which will be set to 'nil' on successful completion.
+//
+func NewRandSourceSim(dataWidth uint, seed uint64) (*RandSourceSim, error) {
+	source, err := NewRandSource(dataWidth, seed)
+	if err != nil {
+		return nil, err
+	}
+	return &RandSourceSim{source: source, localReset: true}, nil
+}
+
+//
+// Outputs returns the current SELF output ready and data signals.
+//
+func (sim *RandSourceSim) Outputs() (bool, uint64) {
+	return sim.ready, sim.data
+}
+
+//
+// Clock updates the model on a rising clock edge, using the synchronous reset
+// and SELF output stop signals sampled on the edge. Returns the updated SELF
+// output ready and data signals.
+//
+func (sim *RandSourceSim) Clock(srst bool, resultStop bool) (bool, uint64) {
+	advance := !(sim.ready && resultStop)
+	if advance {
+		sim.data = sim.source.value()
+	}
+	if sim.localReset {
+		sim.source.Reset()
+		sim.ready = false
+	} else if advance {
+		sim.source.step()
+		sim.ready = true
+	}
+	sim.localReset = srst
+	return sim.ready, sim.data
+}
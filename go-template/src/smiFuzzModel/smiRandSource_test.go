@@ -0,0 +1,136 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiFuzzModel
+
+import (
+	"reflect"
+	"testing"
+)
+
+//
+// Lists the first output values of smiSelfRandSource after reset for each of
+// the tested data widths, using the default seed. These were derived from a
+// cycle level transcription of test/verilog/smiSelfRandSource.v, so the first
+// value is the default seed truncated to its most significant bits.
+//
+var randSourceVectors = map[uint][]uint64{
+	64: {
+		0x373E7B7D27C69FA4, 0x89ADBA5993CA22D5, 0x178E91E4F31E9698, 0xBACC7EB24A8B409D,
+		0x92AF3CE5A90E73A4, 0x3F373072FEF79D75, 0x3E98F15FA57BD08C, 0x4A84A03153110E83},
+	32: {
+		0x373E7B7D, 0x89ADBA59, 0x178E91E4, 0xBACC7EB1,
+		0x92AF3CE4, 0x3F373072, 0x3E98F15F, 0x4A84A030},
+	8: {0x37, 0x89, 0x16, 0xB9, 0x91, 0x3E, 0x3E, 0x4A}}
+
+//
+// Tests the random number generator output sequence against the reference
+// vectors, both from the initial state and after a reset.
+//
+func TestRandSourceVectors(t *testing.T) {
+	for dataWidth, expected := range randSourceVectors {
+		source, err := NewRandSource(dataWidth, DefaultRandSeed)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for pass := 0; pass < 2; pass++ {
+			values := make([]uint64, len(expected))
+			for i := range values {
+				values[i] = source.Next()
+			}
+			if !reflect.DeepEqual(values, expected) {
+				t.Errorf("%d-bit source pass %d: got %X, expected %X",
+					dataWidth, pass, values, expected)
+			}
+			source.Reset()
+		}
+
+		// Skipping values is equivalent to discarding the outputs.
+		source.Skip(5)
+		if value := source.Next(); value != expected[5] {
+			t.Errorf("%d-bit source: got %X after skip, expected %X",
+				dataWidth, value, expected[5])
+		}
+	}
+}
+
+//
+// Tests that unsupported data widths and zero seeds are rejected. The
+// hardware output is limited to 64 bits, so wider sources such as 128 bits
+// are not supported.
+//
+func TestRandSourceInvalid(t *testing.T) {
+	for _, dataWidth := range []uint{0, 65, 128} {
+		if _, err := NewRandSource(dataWidth, DefaultRandSeed); err == nil {
+			t.Errorf("invalid data width %d not rejected", dataWidth)
+		}
+	}
+	if _, err := NewRandSource(64, 0); err == nil {
+		t.Errorf("zero seed not rejected")
+	}
+}
+
+//
+// Tests that the per-port seeds are taken from the 64-bit output sequence.
+//
+func TestPortRandSeed(t *testing.T) {
+	expected := append([]uint64{DefaultRandSeed}, randSourceVectors[64][1:5]...)
+	for port, seed := range expected {
+		if value := PortRandSeed(uint(port)); value != seed {
+			t.Errorf("port %d seed %X, expected %X", port, value, seed)
+		}
+	}
+}
+
+//
+// Tests the cycle accurate model against a reference trace of the 16-bit
+// SELF output, including the pipelined reset, output stalls and a reset
+// which is asserted while the output is stalled. The output data is only
+// defined while the ready signal is asserted.
+//
+func TestRandSourceSim(t *testing.T) {
+	trace := []struct {
+		srst  bool
+		stop  bool
+		ready bool
+		data  uint64
+	}{
+		{true, false, false, 0},
+		{true, false, false, 0},
+		{false, false, false, 0},
+		{false, false, true, 0x373E},
+		{false, true, true, 0x373E},
+		{false, true, true, 0x373E},
+		{false, false, true, 0x89AD},
+		{false, false, true, 0x178D},
+		{true, true, true, 0x178D},
+		{false, false, false, 0},
+		{false, false, true, 0x373E}}
+	sim, err := NewRandSourceSim(16, DefaultRandSeed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for cycle, step := range trace {
+		ready, data := sim.Clock(step.srst, step.stop)
+		if (ready != step.ready) || (ready && (data != step.data)) {
+			t.Errorf("cycle %d: ready %v data %X, expected ready %v data %X",
+				cycle, ready, data, step.ready, step.data)
+		}
+		if outReady, outData := sim.Outputs(); (outReady != ready) || (outData != data) {
+			t.Errorf("cycle %d: outputs do not match clocked values", cycle)
+		}
+	}
+}
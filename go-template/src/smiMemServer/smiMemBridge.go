@@ -0,0 +1,169 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemServer
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+//
+// Specifies the bridge message types. Messages from the testbench to the
+// server have the most significant bit clear and messages from the server to
+// the testbench have it set.
+//
+const (
+	BridgeMsgRequest  = 0x01 // Request frame received from an SMI port.
+	BridgeMsgTick     = 0x02 // Testbench has reached the specified cycle.
+	BridgeMsgClose    = 0x03 // Testbench has finished.
+	BridgeMsgResponse = 0x81 // Response frame to be sent on an SMI port.
+	BridgeMsgTickAck  = 0x82 // All responses for the tick have been sent.
+)
+
+//
+// Specifies the size of the bridge message header. Each message consists of
+// the following little endian fields, followed by the specified number of
+// payload bytes:
+//
+//   byte  0      : message type
+//   byte  1      : SMI port number
+//   bytes 2-3    : reserved, set to zero
+//   bytes 4-7    : payload length in bytes
+//   bytes 8-15   : clock cycle
+//
+// Request and response messages carry a complete SMI frame as the payload.
+// After sending a tick message the testbench must wait for the corresponding
+// tick acknowledgement, which will be preceded by response messages for all
+// responses which become available on or before the tick cycle.
+//
+const BridgeHeaderSize = 16
+
+//
+// Specifies the maximum payload size, which is sufficient for the largest
+// SMI frame.
+//
+const bridgeMaxPayload = 1 << 17
+
+//
+// BridgeMessage specifies a single message exchanged over the bridge.
+//
+type BridgeMessage struct {
+	Type    uint8  // Message type.
+	Port    uint8  // SMI port number.
+	Cycle   uint64 // Clock cycle.
+	Payload []byte // Message payload.
+}
+
+//
+// ReadBridgeMessage reads a single bridge message from the supplied input
+// stream. Returns the message and an error item which will be set to 'nil'
+// on successful completion. The end of the input stream is indicated by
+// returning io.EOF.
+//
+func ReadBridgeMessage(input io.Reader) (BridgeMessage, error) {
+	var message BridgeMessage
+	header := make([]byte, BridgeHeaderSize)
+	if _, err := io.ReadFull(input, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			err = errors.New("Truncated SMI bridge message header")
+		}
+		return message, err
+	}
+	message.Type = header[0]
+	message.Port = header[1]
+	length := binary.LittleEndian.Uint32(header[4:])
+	message.Cycle = binary.LittleEndian.Uint64(header[8:])
+	if length > bridgeMaxPayload {
+		return message, errors.New(fmt.Sprintf(
+			"Invalid SMI bridge message payload length (%d)", length))
+	}
+	message.Payload = make([]byte, length)
+	if _, err := io.ReadFull(input, message.Payload); err != nil {
+		return message, errors.New("Truncated SMI bridge message payload")
+	}
+	return message, nil
+}
+
+//
+// WriteBridgeMessage writes a single bridge message to the supplied output
+// stream. Returns an error item which will be set to 'nil' on successful
+// completion.
+//
+func WriteBridgeMessage(output io.Writer, message BridgeMessage) error {
+	buffer := make([]byte, BridgeHeaderSize+len(message.Payload))
+	buffer[0] = message.Type
+	buffer[1] = message.Port
+	binary.LittleEndian.PutUint32(buffer[4:], uint32(len(message.Payload)))
+	binary.LittleEndian.PutUint64(buffer[8:], message.Cycle)
+	copy(buffer[BridgeHeaderSize:], message.Payload)
+	_, err := output.Write(buffer)
+	return err
+}
+
+//
+// ServeBridge runs the bridge protocol over the supplied connection, passing
+// request frames from the testbench to the memory server and returning the
+// response frames. Runs until the testbench sends a close message or the
+// connection is closed. Returns an error item which will be set to 'nil' on
+// successful completion.
+//
+func ServeBridge(conn io.ReadWriter, server *SmiMemServer) error {
+	input := bufio.NewReader(conn)
+	output := bufio.NewWriter(conn)
+	for {
+		message, err := ReadBridgeMessage(input)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		switch message.Type {
+		case BridgeMsgRequest:
+			err = server.Request(message.Port, message.Cycle, message.Payload)
+			if err != nil {
+				return errors.New(fmt.Sprintf(
+					"Invalid request on SMI port %d at cycle %d: %v",
+					message.Port, message.Cycle, err))
+			}
+		case BridgeMsgTick:
+			for _, response := range server.Responses(message.Cycle) {
+				err = WriteBridgeMessage(output, BridgeMessage{
+					BridgeMsgResponse, response.Port, response.Cycle, response.Frame})
+				if err != nil {
+					return err
+				}
+			}
+			err = WriteBridgeMessage(output, BridgeMessage{
+				BridgeMsgTickAck, 0, message.Cycle, nil})
+			if err == nil {
+				err = output.Flush()
+			}
+			if err != nil {
+				return err
+			}
+		case BridgeMsgClose:
+			return output.Flush()
+		default:
+			return errors.New(fmt.Sprintf(
+				"Invalid SMI bridge message type (0x%02X)", message.Type))
+		}
+	}
+}
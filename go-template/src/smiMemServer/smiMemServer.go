@@ -0,0 +1,150 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemServer
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiProtocol"
+	"sort"
+)
+
+//
+// ServerConfig specifies the memory server options. Latencies are specified
+// in clock cycles from the cycle on which the last flit of the request was
+// received to the cycle on which the response becomes available.
+//
+type ServerConfig struct {
+	ReadLatency  uint64 // Latency for read requests.
+	WriteLatency uint64 // Latency for write requests.
+	AddressLimit uint64 // Size of the valid address range, or zero for no limit.
+}
+
+//
+// ServerResponse specifies a single response frame generated by the server.
+//
+type ServerResponse struct {
+	Port  uint8  // SMI port on which the request was received.
+	Cycle uint64 // Clock cycle on which the response becomes available.
+	Frame []byte // Encoded SMI response frame.
+}
+
+//
+// SmiMemServer implements an SMI memory server for one or more SMI ports.
+// Requests are applied to the memory in the order in which they are received,
+// with the responses being released once the configured latency has elapsed.
+//
+type SmiMemServer struct {
+	Memory  *SparseMemory // Memory which is accessed by the server.
+	config  ServerConfig
+	pending []ServerResponse // Responses ordered by release cycle.
+}
+
+//
+// NewSmiMemServer creates a new SMI memory server which accesses the supplied
+// memory using the specified server options.
+//
+func NewSmiMemServer(memory *SparseMemory, config ServerConfig) *SmiMemServer {
+	return &SmiMemServer{Memory: memory, config: config}
+}
+
+//
+// Checks whether a memory access lies within the valid address range.
+//
+func (server *SmiMemServer) validAccess(addr uint64, length uint64) bool {
+	if server.config.AddressLimit == 0 {
+		return true
+	}
+	return (addr < server.config.AddressLimit) &&
+		(length <= server.config.AddressLimit-addr)
+}
+
+//
+// Request processes an SMI request frame received on the specified port on
+// the specified clock cycle. Accesses outside the valid address range are
+// rejected with a decode error response. Returns an error item which will be
+// set to 'nil' on successful completion. An error is returned if the request
+// frame is not a valid read or write request, in which case no response can
+// be generated.
+//
+func (server *SmiMemServer) Request(port uint8, cycle uint64, frame []byte) error {
+	decoded, err := smiProtocol.DecodeFrame(frame)
+	if err != nil {
+		return err
+	}
+	var response smiProtocol.Frame
+	var latency uint64
+	switch req := decoded.(type) {
+	case smiProtocol.ReadRequest:
+		resp := smiProtocol.ReadResponse{Status: smiProtocol.StatusOkay, Tag: req.Tag}
+		resp.Data = make([]byte, req.Length)
+		if server.validAccess(req.Address, uint64(req.Length)) {
+			server.Memory.Read(req.Address, resp.Data)
+		} else {
+			resp.Status = smiProtocol.StatusDecErr
+		}
+		response = resp
+		latency = server.config.ReadLatency
+	case smiProtocol.WriteRequest:
+		resp := smiProtocol.WriteResponse{Status: smiProtocol.StatusOkay, Tag: req.Tag}
+		if server.validAccess(req.Address, uint64(len(req.Data))) {
+			server.Memory.Write(req.Address, req.Data)
+		} else {
+			resp.Status = smiProtocol.StatusDecErr
+		}
+		response = resp
+		latency = server.config.WriteLatency
+	default:
+		return errors.New(fmt.Sprintf(
+			"Unexpected SMI frame type (0x%02X) received by memory server", frame[0]))
+	}
+
+	// Insert the response after any others which are released on or before
+	// the same clock cycle.
+	respFrame, err := response.Encode()
+	if err != nil {
+		return err
+	}
+	release := cycle + latency
+	index := sort.Search(len(server.pending), func(i int) bool {
+		return server.pending[i].Cycle > release
+	})
+	server.pending = append(server.pending, ServerResponse{})
+	copy(server.pending[index+1:], server.pending[index:])
+	server.pending[index] = ServerResponse{port, release, respFrame}
+	return nil
+}
+
+//
+// Responses returns the list of responses which become available on or
+// before the specified clock cycle, removing them from the server.
+//
+func (server *SmiMemServer) Responses(cycle uint64) []ServerResponse {
+	count := sort.Search(len(server.pending), func(i int) bool {
+		return server.pending[i].Cycle > cycle
+	})
+	responses := server.pending[:count:count]
+	server.pending = server.pending[count:]
+	return responses
+}
+
+//
+// Pending returns the number of responses which have not yet been released.
+//
+func (server *SmiMemServer) Pending() int {
+	return len(server.pending)
+}
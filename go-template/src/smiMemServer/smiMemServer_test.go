@@ -0,0 +1,349 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemServer
+
+import (
+	"bytes"
+	"github.com/ReconfigureIO/smi/go-template/src/smiProtocol"
+	"net"
+	"reflect"
+	"testing"
+)
+
+//
+// Creates a byte sequence with a simple incrementing pattern.
+//
+func makeTestData(length int, seed byte) []byte {
+	data := make([]byte, length)
+	for i := range data {
+		data[i] = seed + byte(i)
+	}
+	return data
+}
+
+//
+// Creates read and write request frames using the default options.
+//
+func readReq(tag uint16, addr uint64, length uint16) smiProtocol.Frame {
+	return smiProtocol.ReadRequest{Options: smiProtocol.ReadOptDefault,
+		Tag: tag, Address: addr, Length: length}
+}
+
+func writeReq(tag uint16, addr uint64, data []byte) smiProtocol.Frame {
+	return smiProtocol.WriteRequest{Options: smiProtocol.WriteOptDefault,
+		Tag: tag, Address: addr, Data: data}
+}
+
+//
+// Creates read and write response frames.
+//
+func readResp(status uint8, tag uint16, data []byte) smiProtocol.Frame {
+	return smiProtocol.ReadResponse{Status: status, Tag: tag, Data: data}
+}
+
+func writeResp(status uint8, tag uint16) smiProtocol.Frame {
+	return smiProtocol.WriteResponse{Status: status, Tag: tag}
+}
+
+//
+// Encodes a frame, failing the test on error.
+//
+func encodeTestFrame(t *testing.T, frame smiProtocol.Frame) []byte {
+	encoded, err := frame.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return encoded
+}
+
+//
+// Tests that sparse memory accesses which cross page boundaries are split
+// correctly and that unwritten locations read as zero.
+//
+func TestSparseMemory(t *testing.T) {
+	memory := NewSparseMemory()
+	data := makeTestData(16, 0x40)
+	memory.Write(sparsePageSize-8, data)
+	if len(memory.pages) != 2 {
+		t.Errorf("%d pages allocated, expected 2", len(memory.pages))
+	}
+
+	readData := make([]byte, 16)
+	memory.Read(sparsePageSize-8, readData)
+	if !bytes.Equal(readData, data) {
+		t.Errorf("read % X across page boundary, expected % X", readData, data)
+	}
+
+	// Reads which include unwritten locations and unallocated pages.
+	readData = make([]byte, 32)
+	for i := range readData {
+		readData[i] = 0xFF
+	}
+	memory.Read(sparsePageSize-16, readData)
+	expected := append(append(make([]byte, 8), data...), make([]byte, 8)...)
+	if !bytes.Equal(readData, expected) {
+		t.Errorf("read % X around written data, expected % X", readData, expected)
+	}
+	readData = []byte{0xFF, 0xFF, 0xFF, 0xFF}
+	memory.Read(0x123456789000, readData)
+	if !bytes.Equal(readData, make([]byte, 4)) {
+		t.Errorf("read % X from unallocated page, expected zero", readData)
+	}
+	if len(memory.pages) != 2 {
+		t.Errorf("%d pages allocated after reads, expected 2", len(memory.pages))
+	}
+
+	// Accesses wrap at the top of the address space.
+	memory.Write(0xFFFFFFFFFFFFFFFC, data[:8])
+	readData = make([]byte, 4)
+	memory.Read(0, readData)
+	if !bytes.Equal(readData, data[4:8]) {
+		t.Errorf("read % X after wrapping write, expected % X", readData, data[4:8])
+	}
+}
+
+//
+// Tests that read and write requests are applied to the memory, including
+// bursts which cross page boundaries, and that accesses outside the address
+// limit are rejected with decode errors.
+//
+func TestServerRequests(t *testing.T) {
+	memory := NewSparseMemory()
+	server := NewSmiMemServer(memory, ServerConfig{AddressLimit: 0x10000})
+	data := makeTestData(64, 0x10)
+	requests := []smiProtocol.Frame{
+		writeReq(1, 0x0FE0, data),
+		readReq(2, 0x0FE0, 64),
+		readReq(3, 0x2000, 8),
+		writeReq(4, 0xFFF8, data[:16]),
+		readReq(5, 0x10000, 8),
+		readReq(6, 0xFFF8, 8)}
+	expected := []smiProtocol.Frame{
+		writeResp(smiProtocol.StatusOkay, 1),
+		readResp(smiProtocol.StatusOkay, 2, data),
+		readResp(smiProtocol.StatusOkay, 3, make([]byte, 8)),
+		writeResp(smiProtocol.StatusDecErr, 4),
+		readResp(smiProtocol.StatusDecErr, 5, make([]byte, 8)),
+		readResp(smiProtocol.StatusOkay, 6, make([]byte, 8))}
+	for i, request := range requests {
+		if err := server.Request(0, uint64(i), encodeTestFrame(t, request)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	responses := server.Responses(uint64(len(requests)))
+	if len(responses) != len(expected) {
+		t.Fatalf("%d responses, expected %d", len(responses), len(expected))
+	}
+	for i, response := range responses {
+		decoded, err := smiProtocol.DecodeFrame(response.Frame)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(decoded, expected[i]) {
+			t.Errorf("response %d: got %+v, expected %+v", i, decoded, expected[i])
+		}
+	}
+	if len(memory.pages) != 2 {
+		t.Errorf("%d pages allocated, expected 2", len(memory.pages))
+	}
+
+	// Only request frames are accepted by the server.
+	response := encodeTestFrame(t, writeResp(smiProtocol.StatusOkay, 7))
+	if err := server.Request(0, 0, response); err == nil {
+		t.Errorf("response frame not rejected")
+	}
+	if err := server.Request(0, 0, []byte{0x00}); err == nil {
+		t.Errorf("invalid frame not rejected")
+	}
+}
+
+//
+// Tests that responses are released in order of their release cycle on the
+// ports on which the requests were received.
+//
+func TestResponseOrder(t *testing.T) {
+	server := NewSmiMemServer(NewSparseMemory(),
+		ServerConfig{ReadLatency: 10, WriteLatency: 2})
+	requests := []struct {
+		port  uint8
+		cycle uint64
+		frame smiProtocol.Frame
+	}{
+		{0, 0, readReq(0, 0x100, 4)},
+		{1, 1, writeReq(1, 0x200, []byte{1})},
+		{2, 5, readReq(2, 0x300, 4)},
+		{3, 8, writeReq(3, 0x400, []byte{2})},
+		{1, 8, writeReq(4, 0x500, []byte{3})}}
+	for _, request := range requests {
+		err := server.Request(request.port, request.cycle, encodeTestFrame(t, request.frame))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Lists the expected tag, port and release cycle for each tick.
+	type release struct {
+		tag   uint16
+		port  uint8
+		cycle uint64
+	}
+	ticks := []struct {
+		cycle    uint64
+		releases []release
+	}{
+		{2, nil},
+		{3, []release{{1, 1, 3}}},
+		{9, nil},
+		{10, []release{{0, 0, 10}, {3, 3, 10}, {4, 1, 10}}},
+		{20, []release{{2, 2, 15}}}}
+	for _, tick := range ticks {
+		responses := server.Responses(tick.cycle)
+		if len(responses) != len(tick.releases) {
+			t.Errorf("cycle %d: %d responses, expected %d",
+				tick.cycle, len(responses), len(tick.releases))
+			continue
+		}
+		for i, response := range responses {
+			decoded, err := smiProtocol.DecodeFrame(response.Frame)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var tag uint16
+			switch resp := decoded.(type) {
+			case smiProtocol.ReadResponse:
+				tag = resp.Tag
+			case smiProtocol.WriteResponse:
+				tag = resp.Tag
+			}
+			got := release{tag, response.Port, response.Cycle}
+			if got != tick.releases[i] {
+				t.Errorf("cycle %d: response %d is %+v, expected %+v",
+					tick.cycle, i, got, tick.releases[i])
+			}
+		}
+	}
+	if server.Pending() != 0 {
+		t.Errorf("%d responses still pending", server.Pending())
+	}
+}
+
+//
+// Tests the bridge protocol by exchanging messages with the server over a
+// synchronous in-memory connection.
+//
+func TestServeBridge(t *testing.T) {
+	testbench, conn := net.Pipe()
+	defer testbench.Close()
+	server := NewSmiMemServer(NewSparseMemory(),
+		ServerConfig{ReadLatency: 4, WriteLatency: 4})
+	result := make(chan error, 1)
+	go func() {
+		result <- ServeBridge(conn, server)
+		conn.Close()
+	}()
+
+	send := func(message BridgeMessage) {
+		if err := WriteBridgeMessage(testbench, message); err != nil {
+			t.Fatal(err)
+		}
+	}
+	receive := func() BridgeMessage {
+		message, err := ReadBridgeMessage(testbench)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return message
+	}
+
+	data := makeTestData(8, 0xA0)
+	send(BridgeMessage{BridgeMsgRequest, 2, 10, encodeTestFrame(t,
+		writeReq(0x11, 0x1000, data))})
+	send(BridgeMessage{BridgeMsgRequest, 5, 12, encodeTestFrame(t,
+		readReq(0x22, 0x1000, 8))})
+
+	// The tick at cycle 14 releases the write response only.
+	send(BridgeMessage{BridgeMsgTick, 0, 14, nil})
+	expected := []BridgeMessage{
+		{BridgeMsgResponse, 2, 14, encodeTestFrame(t,
+			writeResp(smiProtocol.StatusOkay, 0x11))},
+		{BridgeMsgTickAck, 0, 14, []byte{}}}
+	for i := range expected {
+		if message := receive(); !reflect.DeepEqual(message, expected[i]) {
+			t.Errorf("message %d: got %+v, expected %+v", i, message, expected[i])
+		}
+	}
+
+	// The tick at cycle 16 releases the read response.
+	send(BridgeMessage{BridgeMsgTick, 0, 16, nil})
+	expected = []BridgeMessage{
+		{BridgeMsgResponse, 5, 16, encodeTestFrame(t,
+			readResp(smiProtocol.StatusOkay, 0x22, data))},
+		{BridgeMsgTickAck, 0, 16, []byte{}}}
+	for i := range expected {
+		if message := receive(); !reflect.DeepEqual(message, expected[i]) {
+			t.Errorf("message %d: got %+v, expected %+v", i, message, expected[i])
+		}
+	}
+
+	send(BridgeMessage{BridgeMsgClose, 0, 16, nil})
+	if err := <-result; err != nil {
+		t.Errorf("bridge closed with error: %v", err)
+	}
+}
+
+//
+// Tests that invalid bridge messages terminate the bridge with an error.
+//
+func TestServeBridgeErrors(t *testing.T) {
+	invalidMessages := []BridgeMessage{
+		{0x7F, 0, 0, nil},
+		{BridgeMsgRequest, 0, 0, []byte{0xFD, 0x00, 0x00, 0x00}}}
+	for i, message := range invalidMessages {
+		buffer := &bytes.Buffer{}
+		if err := WriteBridgeMessage(buffer, message); err != nil {
+			t.Fatal(err)
+		}
+		server := NewSmiMemServer(NewSparseMemory(), ServerConfig{})
+		if err := ServeBridge(&bridgeBuffer{buffer, &bytes.Buffer{}}, server); err == nil {
+			t.Errorf("invalid message %d not rejected", i)
+		}
+	}
+
+	// Truncated messages are also rejected.
+	buffer := &bytes.Buffer{}
+	buffer.Write(make([]byte, BridgeHeaderSize/2))
+	server := NewSmiMemServer(NewSparseMemory(), ServerConfig{})
+	if err := ServeBridge(&bridgeBuffer{buffer, &bytes.Buffer{}}, server); err == nil {
+		t.Errorf("truncated message not rejected")
+	}
+}
+
+//
+// Implements a bridge connection using separate input and output buffers.
+//
+type bridgeBuffer struct {
+	input  *bytes.Buffer
+	output *bytes.Buffer
+}
+
+func (conn *bridgeBuffer) Read(data []byte) (int, error) {
+	return conn.input.Read(data)
+}
+
+func (conn *bridgeBuffer) Write(data []byte) (int, error) {
+	return conn.output.Write(data)
+}
@@ -0,0 +1,94 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+//
+// Package smiMemServer provides a Go implementation of an SMI memory server
+// for use in co-simulation. The server accepts SMI read and write request
+// frames, applies them to a sparse byte addressable memory and generates the
+// correspondingly tagged response frames after a configurable latency. A
+// simple message based bridge protocol allows a simulation testbench to
+// connect its SMI ports to the server over a pipe or Unix domain socket.
+//
+package smiMemServer
+
+//
+// Specifies the size of the pages used to implement the sparse memory.
+//
+const sparsePageSize = 4096
+
+//
+// SparseMemory implements a byte addressable memory covering the full 64-bit
+// address space, with storage only being allocated for pages which have been
+// written. Unwritten locations read as zero.
+//
+type SparseMemory struct {
+	pages map[uint64][]byte
+}
+
+//
+// NewSparseMemory creates a new sparse memory with all locations set to zero.
+//
+func NewSparseMemory() *SparseMemory {
+	return &SparseMemory{make(map[uint64][]byte)}
+}
+
+//
+// Read copies the contents of the memory starting at the specified address
+// into the supplied data buffer. Addresses wrap at the top of the address
+// space.
+//
+func (memory *SparseMemory) Read(addr uint64, data []byte) {
+	for len(data) != 0 {
+		offset := addr % sparsePageSize
+		count := sparsePageSize - offset
+		if count > uint64(len(data)) {
+			count = uint64(len(data))
+		}
+		page, ok := memory.pages[addr/sparsePageSize]
+		if ok {
+			copy(data[:count], page[offset:])
+		} else {
+			for i := range data[:count] {
+				data[i] = 0
+			}
+		}
+		data = data[count:]
+		addr += count
+	}
+}
+
+//
+// Write copies the contents of the supplied data buffer into the memory
+// starting at the specified address. Addresses wrap at the top of the address
+// space.
+//
+func (memory *SparseMemory) Write(addr uint64, data []byte) {
+	for len(data) != 0 {
+		offset := addr % sparsePageSize
+		count := sparsePageSize - offset
+		if count > uint64(len(data)) {
+			count = uint64(len(data))
+		}
+		page, ok := memory.pages[addr/sparsePageSize]
+		if !ok {
+			page = make([]byte, sparsePageSize)
+			memory.pages[addr/sparsePageSize] = page
+		}
+		copy(page[offset:], data[:count])
+		data = data[count:]
+		addr += count
+	}
+}
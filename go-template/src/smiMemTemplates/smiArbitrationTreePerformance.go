@@ -0,0 +1,158 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"fmt"
+	"io"
+)
+
+//
+// Specifies the minimum request and response path latencies through a tree
+// component, in clock cycles. These are derived from the register stages on
+// each path through the library components for single flit frames.
+//
+type treeNodeLatency struct {
+	request  uint // Client to server request latency.
+	response uint // Server to client response latency.
+}
+
+//
+// Specifies the minimum latencies for each type of tree component. Arbiter
+// requests pass through the transaction matcher, frame assembler FIFO and
+// frame arbiter, while responses pass through the frame steering, frame
+// buffer FIFO and transaction matcher. Bus width scaling adds a further
+// double buffered stage in each direction. Pipeline stages add a single
+// double buffered stage in each direction.
+//
+var treeNodeLatencies = map[string]treeNodeLatency{
+	TreeNodeArbiter:    {6, 5},
+	TreeNodeScaler:     {2, 2},
+	TreeNodeAssignment: {0, 0},
+	TreeNodePipeline:   {1, 1}}
+
+//
+// Specifies the additional latency for arbiters which include bus width
+// scaling.
+//
+var scaledArbiterLatency = treeNodeLatency{2, 2}
+
+//
+// ArbitrationTreeClientPath specifies the analytical performance figures for
+// the path between a single SMI client and the arbitration tree server port.
+// The bandwidth share is the fraction of the server bandwidth available to
+// the client when all clients are continuously issuing equal length frames,
+// given round robin arbitration at each arbiter.
+//
+type ArbitrationTreeClientPath struct {
+	ClientConn       ArbitrationTreeConn // Client side connection.
+	ArbiterStages    uint                // Number of arbiters on the path.
+	ScalerStages     uint                // Number of bus width scaling stages.
+	PipelineStages   uint                // Number of link pipeline stages.
+	RoundTripLatency uint                // Minimum round trip latency in cycles.
+	BandwidthShare   float64             // Worst case share of server bandwidth.
+	Bandwidth        float64             // Worst case bandwidth in bytes per cycle.
+}
+
+//
+// Determines the minimum latencies for a tree component.
+//
+func (node ArbitrationTreeNode) latency() treeNodeLatency {
+	latency := treeNodeLatencies[node.Kind]
+	if (node.Kind == TreeNodeArbiter) && (node.ScaleFactor != 1) {
+		latency.request += scaledArbiterLatency.request
+		latency.response += scaledArbiterLatency.response
+	}
+	return latency
+}
+
+//
+// Latency returns the minimum request and response path latencies through
+// the tree component in clock cycles, as used for the client path figures.
+//
+func (node ArbitrationTreeNode) Latency() (uint, uint) {
+	latency := node.latency()
+	return latency.request, latency.response
+}
+
+//
+// ClientPaths determines the analytical performance figures for each of the
+// arbitration tree clients, in client connection order. The round trip
+// latency excludes the memory access latency beyond the server port. The
+// bandwidth is limited by both the client share of the server bandwidth and
+// the width of the client connection, assuming one flit per clock cycle.
+//
+func (topology ArbitrationTreeTopology) ClientPaths() []ArbitrationTreeClientPath {
+	consumers := make(map[string]int)
+	for i, node := range topology.Nodes {
+		for _, conn := range node.ClientConns {
+			consumers[conn.Name] = i
+		}
+	}
+	paths := make([]ArbitrationTreeClientPath, 0, len(topology.ClientConns))
+	for _, clientConn := range topology.ClientConns {
+		path := ArbitrationTreeClientPath{ClientConn: clientConn, BandwidthShare: 1}
+		name := clientConn.Name
+		for hops := 0; (name != topology.ServerConn.Name) && (hops < len(topology.Nodes)); hops++ {
+			consumer, ok := consumers[name]
+			if !ok {
+				break
+			}
+			node := topology.Nodes[consumer]
+			latency := node.latency()
+			path.RoundTripLatency += latency.request + latency.response
+			if node.Kind == TreeNodeArbiter {
+				path.ArbiterStages++
+				path.BandwidthShare /= float64(len(node.ClientConns))
+			}
+			if node.ScaleFactor != 1 {
+				path.ScalerStages++
+			}
+			if node.Kind == TreeNodePipeline {
+				path.PipelineStages++
+			}
+			name = node.ServerConn.Name
+		}
+		path.Bandwidth = path.BandwidthShare * float64(topology.ServerConn.FlitWidth)
+		if path.Bandwidth > float64(clientConn.FlitWidth) {
+			path.Bandwidth = float64(clientConn.FlitWidth)
+		}
+		paths = append(paths, path)
+	}
+	return paths
+}
+
+//
+// Writes the client performance section of the topology report.
+//
+func (topology ArbitrationTreeTopology) writeClientPaths(output io.Writer) error {
+	_, err := fmt.Fprintf(output, "\n%-20s %8s %7s %9s %7s %7s %10s\n",
+		"client", "arbiters", "scalers", "pipelines", "latency", "share", "bytes/cyc")
+	if err != nil {
+		return err
+	}
+	for _, path := range topology.ClientPaths() {
+		_, err = fmt.Fprintf(output, "%-20s %8d %7d %9d %7d %7.4f %10.3f\n",
+			path.ClientConn.Name, path.ArbiterStages, path.ScalerStages,
+			path.PipelineStages, path.RoundTripLatency, path.BandwidthShare,
+			path.Bandwidth)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"math"
+	"testing"
+)
+
+//
+// Checks that every client has a path to the server, that the client
+// bandwidth shares account for all of the server bandwidth and that bus width
+// scaling is only present on the client paths of scaled trees.
+//
+func TestClientPathProperties(t *testing.T) {
+	for _, scalingFactor := range testScalingFactors {
+		for numClients := uint(1); numClients <= testMaxClients; numClients++ {
+			topology, err := DescribeArbitrationTree("tree", numClients, scalingFactor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			paths := topology.ClientPaths()
+			if uint(len(paths)) != numClients {
+				t.Errorf("X%dS%d: expected %d client paths, got %d",
+					numClients, scalingFactor, numClients, len(paths))
+				continue
+			}
+			totalShare := 0.0
+			for _, path := range paths {
+				totalShare += path.BandwidthShare
+				if (numClients > 1) && (path.ArbiterStages == 0) {
+					t.Errorf("X%dS%d: client %s has no arbiters",
+						numClients, scalingFactor, path.ClientConn.Name)
+				}
+				if (scalingFactor == 1) != (path.ScalerStages == 0) {
+					t.Errorf("X%dS%d: client %s has %d scaling stages",
+						numClients, scalingFactor, path.ClientConn.Name, path.ScalerStages)
+				}
+			}
+			if math.Abs(totalShare-1) > 1e-9 {
+				t.Errorf("X%dS%d: bandwidth shares sum to %f",
+					numClients, scalingFactor, totalShare)
+			}
+		}
+	}
+}
+
+//
+// Checks the client path figures for a single arbiter tree.
+//
+func TestClientPathFigures(t *testing.T) {
+	topology, err := DescribeArbitrationTree("tree", 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	latency := treeNodeLatencies[TreeNodeArbiter]
+	expected := ArbitrationTreeClientPath{
+		ArbiterStages:    1,
+		RoundTripLatency: latency.request + latency.response,
+		BandwidthShare:   0.5,
+		Bandwidth:        4}
+	for _, path := range topology.ClientPaths() {
+		expected.ClientConn = path.ClientConn
+		if path != expected {
+			t.Errorf("expected %+v, got %+v", expected, path)
+		}
+	}
+}
+
+//
+// Checks that pipeline stages are reflected in the client path latencies,
+// without changing the client bandwidth figures.
+//
+func TestClientPathPipelines(t *testing.T) {
+	latency := treeNodeLatencies[TreeNodePipeline]
+	for _, scalingFactor := range testScalingFactors {
+		for _, numClients := range []uint{6, 17, testMaxClients} {
+			plain, err := DescribeArbitrationTree("tree", numClients, scalingFactor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pipelined, err := DescribeArbitrationTreeWithOptions(
+				"tree", numClients, scalingFactor, testPipelineOptions())
+			if err != nil {
+				t.Fatal(err)
+			}
+			plainPaths := plain.ClientPaths()
+			for i, path := range pipelined.ClientPaths() {
+				expected := plainPaths[i]
+				expected.PipelineStages = path.PipelineStages
+				expected.RoundTripLatency +=
+					path.PipelineStages * (latency.request + latency.response)
+				if path != expected {
+					t.Errorf("X%dS%d: expected %+v, got %+v",
+						numClients, scalingFactor, expected, path)
+				}
+				if path.PipelineStages == 0 {
+					t.Errorf("X%dS%d: client %s has no pipeline stages",
+						numClients, scalingFactor, path.ClientConn.Name)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,226 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"errors"
+	"fmt"
+)
+
+//
+// Defines the template configuration options for an SMI memory bus pipeline
+// stage, which registers all the signals on a single arbitration tree link.
+//
+type smiMemBusPipelineConfig struct {
+	InstanceName        string                    // Name of the pipeline stage instance.
+	SmiMemBusFlitWidth  uint                      // Number of bytes in each SMI flit.
+	SmiMemBusClientConn smiMemBusConnectionConfig // Single client side connection.
+	SmiMemBusServerConn smiMemBusConnectionConfig // Single server side connection.
+}
+
+//
+// Defines the template for instantiating an SMI memory bus pipeline stage.
+//
+var smiMemBusPipelineTemplate = `
+{{define "smiMemBusPipeline"}}
+// Instantiate SMI pipeline stage {{.InstanceName}}
+smiMemBusPipelineStage #({{.SmiMemBusFlitWidth}}) {{.InstanceName}} (
+
+  .smiReqInReady   ({{.SmiMemBusClientConn.SmiNetReqName}}Ready),
+  .smiReqInEofc    ({{.SmiMemBusClientConn.SmiNetReqName}}Eofc),
+  .smiReqInData    ({{.SmiMemBusClientConn.SmiNetReqName}}Data),
+  .smiReqInStop    ({{.SmiMemBusClientConn.SmiNetReqName}}Stop),
+  .smiRespOutReady ({{.SmiMemBusClientConn.SmiNetRespName}}Ready),
+  .smiRespOutEofc  ({{.SmiMemBusClientConn.SmiNetRespName}}Eofc),
+  .smiRespOutData  ({{.SmiMemBusClientConn.SmiNetRespName}}Data),
+  .smiRespOutStop  ({{.SmiMemBusClientConn.SmiNetRespName}}Stop),
+
+  .smiReqOutReady  ({{.SmiMemBusServerConn.SmiNetReqName}}Ready),
+  .smiReqOutEofc   ({{.SmiMemBusServerConn.SmiNetReqName}}Eofc),
+  .smiReqOutData   ({{.SmiMemBusServerConn.SmiNetReqName}}Data),
+  .smiReqOutStop   ({{.SmiMemBusServerConn.SmiNetReqName}}Stop),
+  .smiRespInReady  ({{.SmiMemBusServerConn.SmiNetRespName}}Ready),
+  .smiRespInEofc   ({{.SmiMemBusServerConn.SmiNetRespName}}Eofc),
+  .smiRespInData   ({{.SmiMemBusServerConn.SmiNetRespName}}Data),
+  .smiRespInStop   ({{.SmiMemBusServerConn.SmiNetRespName}}Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+{{end}}`
+
+//
+// Selects the names of the arbitration tree links which are to be pipelined,
+// as specified by the pipeline options. Layers are numbered in the same way
+// as the topology report, with the layer 0 server side link being the link to
+// the tree server port.
+//
+func selectArbitrationTreePipelines(config arbitrationTreeConfig,
+	options ArbitrationTreeOptions) (map[string]bool, error) {
+
+	selected := make(map[string]bool)
+	numClients := uint(len(config.SmiMemBusClientConns))
+	for _, client := range options.PipelineClients {
+		if client >= numClients {
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid pipeline client (%d) for arbitration tree with %d clients",
+				client, numClients))
+		}
+		selected[config.SmiMemBusClientConns[client].SmiNetReqName] = true
+	}
+
+	topology := makeArbitrationTreeTopology(config)
+	layers := topology.NodeLayers()
+	numLayers := uint(0)
+	for _, layer := range layers {
+		if layer >= numLayers {
+			numLayers = layer + 1
+		}
+	}
+	for _, layer := range options.PipelineLayers {
+		if layer >= numLayers {
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid pipeline layer (%d) for arbitration tree with %d layers",
+				layer, numLayers))
+		}
+		for i, node := range topology.Nodes {
+			if layers[i] == layer {
+				selected[node.ServerConn.Name] = true
+			}
+		}
+	}
+
+	if options.PipelineFanIn != 0 {
+		for _, node := range topology.Nodes {
+			if (node.Kind == TreeNodeArbiter) &&
+				(uint(len(node.ClientConns)) > options.PipelineFanIn) {
+				for _, conn := range node.ClientConns {
+					selected[conn.Name] = true
+				}
+			}
+		}
+	}
+	return selected, nil
+}
+
+//
+// Replaces the client side connection of the tree component which consumes
+// the named link.
+//
+func replaceTreeClientConn(config *arbitrationTreeConfig, linkName string,
+	conn smiMemBusConnectionConfig) {
+	for i := range config.SmiMemBusAssignments {
+		if config.SmiMemBusAssignments[i].SmiMemBusClientConn.SmiNetReqName == linkName {
+			config.SmiMemBusAssignments[i].SmiMemBusClientConn = conn
+		}
+	}
+	for i := range config.SmiMemBusWidthScalers {
+		if config.SmiMemBusWidthScalers[i].SmiMemBusClientConn.SmiNetReqName == linkName {
+			config.SmiMemBusWidthScalers[i].SmiMemBusClientConn = conn
+		}
+	}
+	for i := range config.SmiMemBusArbiters {
+		for j := range config.SmiMemBusArbiters[i].SmiMemBusClientConns {
+			if config.SmiMemBusArbiters[i].SmiMemBusClientConns[j].SmiNetReqName == linkName {
+				config.SmiMemBusArbiters[i].SmiMemBusClientConns[j] = conn
+			}
+		}
+	}
+}
+
+//
+// Replaces the server side connection of the tree component which drives the
+// named link.
+//
+func replaceTreeServerConn(config *arbitrationTreeConfig, linkName string,
+	conn smiMemBusConnectionConfig) {
+	for i := range config.SmiMemBusAssignments {
+		if config.SmiMemBusAssignments[i].SmiMemBusServerConn.SmiNetReqName == linkName {
+			config.SmiMemBusAssignments[i].SmiMemBusServerConn = conn
+		}
+	}
+	for i := range config.SmiMemBusWidthScalers {
+		if config.SmiMemBusWidthScalers[i].SmiMemBusServerConn.SmiNetReqName == linkName {
+			config.SmiMemBusWidthScalers[i].SmiMemBusServerConn = conn
+		}
+	}
+	for i := range config.SmiMemBusArbiters {
+		if config.SmiMemBusArbiters[i].SmiMemBusServerConn.SmiNetReqName == linkName {
+			config.SmiMemBusArbiters[i].SmiMemBusServerConn = conn
+		}
+	}
+}
+
+//
+// Inserts pipeline stages on the arbitration tree links selected by the
+// pipeline options. Each pipeline stage is connected to the original link on
+// its client side and to a new internal wire on its server side, apart from
+// the pipeline stage for the tree server port which is connected to a new
+// internal wire on its client side. The component lists are copied before
+// being modified, since arbiters may share connection lists with the wire
+// list.
+//
+func insertArbitrationTreePipelines(config arbitrationTreeConfig,
+	options ArbitrationTreeOptions) (arbitrationTreeConfig, error) {
+
+	selected, err := selectArbitrationTreePipelines(config, options)
+	if (err != nil) || (len(selected) == 0) {
+		return config, err
+	}
+
+	pipelined := config
+	pipelined.SmiMemBusWireConns = append(
+		[]smiMemBusConnectionConfig{}, config.SmiMemBusWireConns...)
+	pipelined.SmiMemBusAssignments = append(
+		[]smiMemBusAssignmentConfig{}, config.SmiMemBusAssignments...)
+	pipelined.SmiMemBusWidthScalers = append(
+		[]smiMemBusWidthScalerConfig{}, config.SmiMemBusWidthScalers...)
+	pipelined.SmiMemBusArbiters = make([]smiMemBusArbiterConfig, len(config.SmiMemBusArbiters))
+	for i, arbiter := range config.SmiMemBusArbiters {
+		arbiter.SmiMemBusClientConns = append(
+			[]smiMemBusConnectionConfig{}, arbiter.SmiMemBusClientConns...)
+		pipelined.SmiMemBusArbiters[i] = arbiter
+	}
+	pipelined.SmiMemBusPipelines = make([]smiMemBusPipelineConfig, 0)
+
+	// Visit the links in a fixed order so that the generated names are stable.
+	serverConn := config.SmiMemBusServerConn[0]
+	links := append([]smiMemBusConnectionConfig{}, config.SmiMemBusClientConns...)
+	links = append(links, config.SmiMemBusWireConns...)
+	links = append(links, serverConn)
+	for _, link := range links {
+		if !selected[link.SmiNetReqName] {
+			continue
+		}
+		index := len(pipelined.SmiMemBusPipelines)
+		pipeConn := smiMemBusConnectionConfig{
+			fmt.Sprintf("smiPipeReq%d", index),
+			fmt.Sprintf("smiPipeResp%d", index), link.SmiMemBusFlitWidth}
+		pipeline := smiMemBusPipelineConfig{
+			fmt.Sprintf("busPipeline%d", index), link.SmiMemBusFlitWidth, link, pipeConn}
+		if link.SmiNetReqName == serverConn.SmiNetReqName {
+			replaceTreeServerConn(&pipelined, link.SmiNetReqName, pipeConn)
+			pipeline.SmiMemBusClientConn = pipeConn
+			pipeline.SmiMemBusServerConn = link
+		} else {
+			replaceTreeClientConn(&pipelined, link.SmiNetReqName, pipeConn)
+		}
+		pipelined.SmiMemBusWireConns = append(pipelined.SmiMemBusWireConns, pipeConn)
+		pipelined.SmiMemBusPipelines = append(pipelined.SmiMemBusPipelines, pipeline)
+	}
+	return pipelined, nil
+}
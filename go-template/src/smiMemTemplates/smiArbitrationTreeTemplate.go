@@ -36,6 +36,48 @@ type arbitrationTreeConfig struct {
 	SmiMemBusAssignments  []smiMemBusAssignmentConfig  // List of direct bus assignments.
 	SmiMemBusWidthScalers []smiMemBusWidthScalerConfig // List of bus scaler components.
 	SmiMemBusArbiters     []smiMemBusArbiterConfig     // List of bus arbiter components.
+	SmiMemBusPipelines    []smiMemBusPipelineConfig    // List of link pipeline stages.
+}
+
+//
+// ArbitrationTreeOptions specifies the optional arbitration tree parameters.
+// The FIFO settings are applied to every arbiter in the tree and the pipeline
+// settings select the tree links on which pipeline stages are inserted. The
+// default settings are given by DefaultArbitrationTreeOptions, which do not
+// insert any pipeline stages.
+//
+type ArbitrationTreeOptions struct {
+	FifoFlitDepth   uint   // Depth of the arbiter flit FIFOs (4 to 1024).
+	FifoFrames      uint   // Maximum number of frames per arbiter FIFO (1 to 63).
+	PipelineLayers  []uint // Layers with pipelined server side links.
+	PipelineClients []uint // Clients with pipelined client port links.
+	PipelineFanIn   uint   // Pipeline arbiter client links above this fan in (0 to disable).
+}
+
+//
+// DefaultArbitrationTreeOptions returns the arbitration tree options used by
+// CreateArbitrationTree and the other functions which do not take an options
+// parameter.
+//
+func DefaultArbitrationTreeOptions() ArbitrationTreeOptions {
+	return ArbitrationTreeOptions{FifoFlitDepth: 32, FifoFrames: 4}
+}
+
+//
+// Validate checks that the arbitration tree options are supported by the
+// Verilog library components. Returns an error item which will be set to
+// 'nil' if the options are valid.
+//
+func (options ArbitrationTreeOptions) Validate() error {
+	if (options.FifoFlitDepth < 4) || (options.FifoFlitDepth > 1024) {
+		return errors.New(fmt.Sprintf(
+			"Invalid arbiter FIFO depth (%d), expected 4 to 1024", options.FifoFlitDepth))
+	}
+	if (options.FifoFrames < 1) || (options.FifoFrames > 63) {
+		return errors.New(fmt.Sprintf(
+			"Invalid arbiter FIFO frame count (%d), expected 1 to 63", options.FifoFrames))
+	}
+	return nil
 }
 
 //
@@ -54,7 +96,8 @@ module {{.ModuleName}} (
   {{template "smiMemBusConnectionWireList" .SmiMemBusWireConns}}
   {{range .SmiMemBusAssignments}}{{template "smiMemBusAssignment" .}}{{end}}
   {{range .SmiMemBusWidthScalers}}{{template "smiMemBusWidthScaler" .}}{{end}}
-  {{range .SmiMemBusArbiters}}{{template "smiMemBusArbiter" .}}{{end}}
+  {{range .SmiMemBusArbiters}}{{template "smiMemBusArbiter" .}}{{end}}` +
+	`{{range .SmiMemBusPipelines}}{{template "smiMemBusPipeline" .}}{{end}}
 endmodule
 {{end}}`
 
@@ -67,6 +110,8 @@ var smiMemBusArbitrationTreeCache *template.Template = nil
 // Implement lazy construction of the arbitration tree template.
 //
 func getArbitrationTreeTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
 	if smiMemBusArbitrationTreeCache == nil {
 		templGroup := template.New("").Funcs(smiTemplateFunctions)
 		templGroup = template.Must(templGroup.Parse(smiMemBusFileHeaderTemplate))
@@ -76,6 +121,7 @@ func getArbitrationTreeTemplate() *template.Template {
 		templGroup = template.Must(templGroup.Parse(smiMemBusAssignmentTemplate))
 		templGroup = template.Must(templGroup.Parse(smiMemBusArbiterTemplate))
 		templGroup = template.Must(templGroup.Parse(smiMemBusWidthScalerTemplate))
+		templGroup = template.Must(templGroup.Parse(smiMemBusPipelineTemplate))
 		templGroup = template.Must(templGroup.Parse(smiMemBusArbitrationTreeTemplate))
 		smiMemBusArbitrationTreeCache = templGroup
 	}
@@ -151,29 +197,23 @@ func configureArbitrationTree(moduleName string, numClients uint,
 		// on all layers. Start by determining the various arbitration fan ins.
 		averageFanIn := math.Cbrt(float64(numClients))
 		fanInLayer0 := uint(math.Ceil(averageFanIn))
-		fmt.Printf("  fanInLayer0 = %d (avg %f)\n", fanInLayer0, averageFanIn)
 
 		averageFanIn = math.Sqrt(float64(numClients) / float64(fanInLayer0))
 		fanInLayer1 := uint(math.Ceil(averageFanIn))
-		fmt.Printf("  fanInLayer1 = %d (avg %f)\n", fanInLayer1, averageFanIn)
 
 		numServers := fanInLayer0 * fanInLayer1
 		averageFanIn = float64(numClients) / float64(numServers)
 		fanInLayer2 := uint(math.Ceil(averageFanIn))
-		fmt.Printf("  fanInLayer2 = %d (avg %f)\n", fanInLayer2, averageFanIn)
 
 		fanInsLayer2 := make([]uint, numServers)
-		fmt.Printf("  fanInsLayer2 = [")
 		remainingClients := numClients
 		for i := uint(0); i < numServers; i++ {
 			fanInsLayer2[i] = fanInLayer2
-			fmt.Printf(" %d", fanInLayer2)
 			remainingClients -= fanInLayer2
 			if remainingClients <= (numServers-i-1)*(fanInLayer2-1) {
 				fanInLayer2--
 			}
 		}
-		fmt.Printf(" ]\n")
 
 		// Determine bus scaling parameters.
 		flitWidthLayer0 := uint(32)
@@ -282,6 +322,28 @@ func configureArbitrationTree(moduleName string, numClients uint,
 	return arbitrationTree, nil
 }
 
+//
+// Generates an arbitration tree configuration given the supplied parameters,
+// applying the optional FIFO settings to each of the arbiters and inserting
+// the selected pipeline stages.
+//
+func configureArbitrationTreeWithOptions(moduleName string, numClients uint,
+	scalingFactor uint, options ArbitrationTreeOptions) (arbitrationTreeConfig, error) {
+
+	if err := options.Validate(); err != nil {
+		return arbitrationTreeConfig{}, err
+	}
+	config, err := configureArbitrationTree(moduleName, numClients, scalingFactor)
+	if err != nil {
+		return config, err
+	}
+	for i := range config.SmiMemBusArbiters {
+		config.SmiMemBusArbiters[i].SmiFifoFlitDepth = options.FifoFlitDepth
+		config.SmiMemBusArbiters[i].SmiFifoFrameDepth = options.FifoFrames
+	}
+	return insertArbitrationTreePipelines(config, options)
+}
+
 //
 // Execute the template using the supplied output file handle and configuration.
 //
@@ -0,0 +1,382 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"fmt"
+	"testing"
+)
+
+//
+// Specifies the maximum number of supported SMI clients.
+//
+const testMaxClients = 64
+
+//
+// Tracks the usage of a single named SMI connection in an arbitration tree.
+//
+type treeNetUsage struct {
+	drivers   int  // Number of components driving the request channel.
+	sinks     int  // Number of components consuming the request channel.
+	flitWidth uint // Flit width from the first use of the connection.
+}
+
+//
+// Accumulates the invariant violations found in an arbitration tree.
+//
+type treeChecker struct {
+	nets       map[string]*treeNetUsage
+	next       map[string]string
+	violations []string
+}
+
+func (checker *treeChecker) fail(format string, args ...interface{}) {
+	checker.violations = append(checker.violations, fmt.Sprintf(format, args...))
+}
+
+//
+// Records a use of a connection by a component, checking that the flit width
+// is consistent with all other uses of the same connection.
+//
+func (checker *treeChecker) use(conn smiMemBusConnectionConfig, driver bool) {
+	net, ok := checker.nets[conn.SmiNetReqName]
+	if !ok {
+		net = &treeNetUsage{flitWidth: conn.SmiMemBusFlitWidth}
+		checker.nets[conn.SmiNetReqName] = net
+	} else if net.flitWidth != conn.SmiMemBusFlitWidth {
+		checker.fail("flit width mismatch on %s (%d and %d bytes)",
+			conn.SmiNetReqName, net.flitWidth, conn.SmiMemBusFlitWidth)
+	}
+	if driver {
+		net.drivers++
+	} else {
+		net.sinks++
+	}
+}
+
+//
+// Records a component which connects a client side connection to a server
+// side connection.
+//
+func (checker *treeChecker) link(clientConn smiMemBusConnectionConfig,
+	serverConn smiMemBusConnectionConfig) {
+	checker.use(clientConn, false)
+	checker.next[clientConn.SmiNetReqName] = serverConn.SmiNetReqName
+}
+
+//
+// Walks an arbitration tree configuration and returns the list of violated
+// structural invariants.
+//
+func checkArbitrationTree(config arbitrationTreeConfig, numClients uint,
+	scalingFactor uint) []string {
+
+	checker := &treeChecker{
+		nets: make(map[string]*treeNetUsage),
+		next: make(map[string]string)}
+
+	// Check the external ports, which are driven and consumed externally.
+	if uint(len(config.SmiMemBusClientConns)) != numClients {
+		checker.fail("found %d client ports", len(config.SmiMemBusClientConns))
+	}
+	for i, conn := range config.SmiMemBusClientConns {
+		if conn.SmiNetReqName != fmt.Sprintf("smiMemClientReq%d", i) {
+			checker.fail("client port %d is named %s", i, conn.SmiNetReqName)
+		}
+		if conn.SmiMemBusFlitWidth != 8 {
+			checker.fail("client port %s has %d byte flits",
+				conn.SmiNetReqName, conn.SmiMemBusFlitWidth)
+		}
+		checker.use(conn, true)
+	}
+	if len(config.SmiMemBusServerConn) != 1 {
+		checker.fail("found %d server ports", len(config.SmiMemBusServerConn))
+		return checker.violations
+	}
+	serverConn := config.SmiMemBusServerConn[0]
+	if serverConn.SmiMemBusFlitWidth != scalingFactor*8 {
+		checker.fail("server port has %d byte flits", serverConn.SmiMemBusFlitWidth)
+	}
+	checker.use(serverConn, false)
+
+	// Check the individual tree components.
+	for _, assignment := range config.SmiMemBusAssignments {
+		if assignment.SmiMemBusClientConn.SmiMemBusFlitWidth !=
+			assignment.SmiMemBusServerConn.SmiMemBusFlitWidth {
+			checker.fail("assignment to %s changes flit width",
+				assignment.SmiMemBusServerConn.SmiNetReqName)
+		}
+		checker.link(assignment.SmiMemBusClientConn, assignment.SmiMemBusServerConn)
+		checker.use(assignment.SmiMemBusServerConn, true)
+	}
+	for _, scaler := range config.SmiMemBusWidthScalers {
+		factor := scaler.SmiMemBusScaleFactor
+		if (factor != 2) && (factor != 4) && (factor != 8) {
+			checker.fail("scaler %s has scale factor %d", scaler.InstanceName, factor)
+		}
+		if scaler.SmiMemBusFlitWidth != scaler.SmiMemBusClientConn.SmiMemBusFlitWidth {
+			checker.fail("scaler %s client flit width is inconsistent", scaler.InstanceName)
+		}
+		if scaler.SmiMemBusServerConn.SmiMemBusFlitWidth != scaler.SmiMemBusFlitWidth*factor {
+			checker.fail("scaler %s server flit width is inconsistent", scaler.InstanceName)
+		}
+		checker.link(scaler.SmiMemBusClientConn, scaler.SmiMemBusServerConn)
+		checker.use(scaler.SmiMemBusServerConn, true)
+	}
+	for _, arbiter := range config.SmiMemBusArbiters {
+		fanIn := len(arbiter.SmiMemBusClientConns)
+		if (fanIn < 2) || (fanIn > 4) {
+			checker.fail("arbiter %s has fan in %d", arbiter.InstanceName, fanIn)
+		}
+		factor := uint(1)
+		if arbiter.SmiMemBusScaleWidth {
+			factor = 2
+		}
+		for _, conn := range arbiter.SmiMemBusClientConns {
+			if conn.SmiMemBusFlitWidth != arbiter.SmiMemBusFlitWidth {
+				checker.fail("arbiter %s client %s flit width is inconsistent",
+					arbiter.InstanceName, conn.SmiNetReqName)
+			}
+			checker.link(conn, arbiter.SmiMemBusServerConn)
+		}
+		if arbiter.SmiMemBusServerConn.SmiMemBusFlitWidth != arbiter.SmiMemBusFlitWidth*factor {
+			checker.fail("arbiter %s server flit width is inconsistent", arbiter.InstanceName)
+		}
+		checker.use(arbiter.SmiMemBusServerConn, true)
+	}
+	for _, pipeline := range config.SmiMemBusPipelines {
+		if (pipeline.SmiMemBusClientConn.SmiMemBusFlitWidth != pipeline.SmiMemBusFlitWidth) ||
+			(pipeline.SmiMemBusServerConn.SmiMemBusFlitWidth != pipeline.SmiMemBusFlitWidth) {
+			checker.fail("pipeline stage %s flit width is inconsistent", pipeline.InstanceName)
+		}
+		checker.link(pipeline.SmiMemBusClientConn, pipeline.SmiMemBusServerConn)
+		checker.use(pipeline.SmiMemBusServerConn, true)
+	}
+
+	// Each connection must have a single driver and a single sink.
+	for name, net := range checker.nets {
+		if (net.drivers != 1) || (net.sinks != 1) {
+			checker.fail("connection %s has %d drivers and %d sinks",
+				name, net.drivers, net.sinks)
+		}
+	}
+
+	// All internal connections must be declared as wires, using the same flit
+	// width. External ports must not be redeclared.
+	declared := make(map[string]bool)
+	for _, conn := range config.SmiMemBusWireConns {
+		if declared[conn.SmiNetReqName] {
+			checker.fail("wire %s is declared more than once", conn.SmiNetReqName)
+		}
+		declared[conn.SmiNetReqName] = true
+		net, ok := checker.nets[conn.SmiNetReqName]
+		if !ok {
+			checker.fail("wire %s is not used", conn.SmiNetReqName)
+		} else if net.flitWidth != conn.SmiMemBusFlitWidth {
+			checker.fail("wire %s is declared with %d byte flits",
+				conn.SmiNetReqName, conn.SmiMemBusFlitWidth)
+		}
+	}
+	for _, conn := range config.SmiMemBusClientConns {
+		if declared[conn.SmiNetReqName] {
+			checker.fail("client port %s is declared as a wire", conn.SmiNetReqName)
+		}
+		declared[conn.SmiNetReqName] = true
+	}
+	declared[serverConn.SmiNetReqName] = true
+	for name := range checker.nets {
+		if !declared[name] {
+			checker.fail("connection %s is not declared", name)
+		}
+	}
+
+	// Every client must reach the server by a single path, without loops.
+	for _, conn := range config.SmiMemBusClientConns {
+		name := conn.SmiNetReqName
+		for hops := 0; name != serverConn.SmiNetReqName; hops++ {
+			nextName, ok := checker.next[name]
+			if !ok || (hops > len(checker.next)) {
+				checker.fail("client %s does not reach the server", conn.SmiNetReqName)
+				break
+			}
+			name = nextName
+		}
+	}
+	return checker.violations
+}
+
+//
+// Checks the arbitration tree structural invariants for every supported client
+// count and scaling factor.
+//
+func TestArbitrationTreeStructure(t *testing.T) {
+	for _, scalingFactor := range testScalingFactors {
+		for numClients := uint(1); numClients <= testMaxClients; numClients++ {
+			config, err := configureArbitrationTree("", numClients, scalingFactor)
+			if err != nil {
+				t.Errorf("X%dS%d: %v", numClients, scalingFactor, err)
+				continue
+			}
+			for _, violation := range checkArbitrationTree(config, numClients, scalingFactor) {
+				t.Errorf("X%dS%d: %s", numClients, scalingFactor, violation)
+			}
+		}
+	}
+}
+
+//
+// Checks that the library modules required by every arbitration tree are
+// known, which also confirms that each arbiter and scaler has a matching
+// library component.
+//
+func TestArbitrationTreeLibraryModules(t *testing.T) {
+	for _, scalingFactor := range testScalingFactors {
+		for numClients := uint(1); numClients <= testMaxClients; numClients++ {
+			_, err := RequiredLibraryFiles(numClients, scalingFactor, "")
+			if err != nil {
+				t.Errorf("X%dS%d: %v", numClients, scalingFactor, err)
+			}
+		}
+	}
+}
+
+//
+// Checks that unsupported client counts are rejected.
+//
+func TestArbitrationTreeInvalidClients(t *testing.T) {
+	for _, numClients := range []uint{0, testMaxClients + 1} {
+		if _, err := configureArbitrationTree("", numClients, 1); err == nil {
+			t.Errorf("X%d: expected an error", numClients)
+		}
+	}
+}
+
+//
+// Checks that the arbitration tree options are applied to every arbiter and
+// that unsupported options are rejected.
+//
+func TestArbitrationTreeOptions(t *testing.T) {
+	options := ArbitrationTreeOptions{FifoFlitDepth: 64, FifoFrames: 8}
+	for _, numClients := range []uint{2, 9, testMaxClients} {
+		config, err := configureArbitrationTreeWithOptions("", numClients, 2, options)
+		if err != nil {
+			t.Fatalf("X%d: %v", numClients, err)
+		}
+		for _, arbiter := range config.SmiMemBusArbiters {
+			if (arbiter.SmiFifoFlitDepth != 64) || (arbiter.SmiFifoFrameDepth != 8) {
+				t.Errorf("X%d: arbiter %s has FIFO depth %d and %d frames", numClients,
+					arbiter.InstanceName, arbiter.SmiFifoFlitDepth, arbiter.SmiFifoFrameDepth)
+			}
+		}
+	}
+
+	invalidOptions := []ArbitrationTreeOptions{
+		{FifoFlitDepth: 3, FifoFrames: 4}, {FifoFlitDepth: 2048, FifoFrames: 4},
+		{FifoFlitDepth: 32, FifoFrames: 0}, {FifoFlitDepth: 32, FifoFrames: 64}}
+	for _, options := range invalidOptions {
+		if _, err := configureArbitrationTreeWithOptions("", 4, 1, options); err == nil {
+			t.Errorf("%+v: expected an error", options)
+		}
+	}
+}
+
+//
+// Checks that the selected pipeline stages preserve the arbitration tree
+// structural invariants and the arbitration layers of the other components,
+// and that the pipeline stages are placed on the selected links.
+//
+func TestArbitrationTreePipelines(t *testing.T) {
+	options := testPipelineOptions()
+	for _, scalingFactor := range testScalingFactors {
+		for numClients := uint(6); numClients <= testMaxClients; numClients++ {
+			config, err := configureArbitrationTreeWithOptions(
+				"", numClients, scalingFactor, options)
+			if err != nil {
+				t.Errorf("X%dS%d: %v", numClients, scalingFactor, err)
+				continue
+			}
+			for _, violation := range checkArbitrationTree(config, numClients, scalingFactor) {
+				t.Errorf("X%dS%d: %s", numClients, scalingFactor, violation)
+			}
+
+			// The selected client links must be pipelined and the last pipeline
+			// stage must drive the server port.
+			pipelines := config.SmiMemBusPipelines
+			if len(pipelines) < 3 {
+				t.Errorf("X%dS%d: found %d pipeline stages",
+					numClients, scalingFactor, len(pipelines))
+				continue
+			}
+			pipelined := make(map[string]bool)
+			for _, pipeline := range pipelines {
+				pipelined[pipeline.SmiMemBusClientConn.SmiNetReqName] = true
+			}
+			for _, client := range options.PipelineClients {
+				clientName := config.SmiMemBusClientConns[client].SmiNetReqName
+				if !pipelined[clientName] {
+					t.Errorf("X%dS%d: client %s is not pipelined",
+						numClients, scalingFactor, clientName)
+				}
+			}
+			serverName := config.SmiMemBusServerConn[0].SmiNetReqName
+			if pipelines[len(pipelines)-1].SmiMemBusServerConn.SmiNetReqName != serverName {
+				t.Errorf("X%dS%d: server port is not pipelined", numClients, scalingFactor)
+			}
+
+			// Pipeline stages must not change the layers of the other components.
+			plain, err := DescribeArbitrationTree("", numClients, scalingFactor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			plainLayers := plain.NodeLayers()
+			pipelinedLayers := makeArbitrationTreeTopology(config).NodeLayers()
+			for i := range plainLayers {
+				if plainLayers[i] != pipelinedLayers[i] {
+					t.Errorf("X%dS%d: component %d moved from layer %d to layer %d",
+						numClients, scalingFactor, i, plainLayers[i], pipelinedLayers[i])
+				}
+			}
+		}
+	}
+
+	// Without any pipeline options the tree is unchanged.
+	for _, numClients := range []uint{1, 3, 17} {
+		config, err := configureArbitrationTreeWithOptions(
+			"", numClients, 2, DefaultArbitrationTreeOptions())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(config.SmiMemBusPipelines) != 0 {
+			t.Errorf("X%d: found unexpected pipeline stages", numClients)
+		}
+	}
+}
+
+//
+// Checks that pipeline options which select links outside the arbitration tree
+// are rejected.
+//
+func TestArbitrationTreeInvalidPipelines(t *testing.T) {
+	invalidOptions := []ArbitrationTreeOptions{
+		{FifoFlitDepth: 32, FifoFrames: 4, PipelineClients: []uint{4}},
+		{FifoFlitDepth: 32, FifoFrames: 4, PipelineLayers: []uint{3}}}
+	for _, options := range invalidOptions {
+		if _, err := configureArbitrationTreeWithOptions("", 4, 1, options); err == nil {
+			t.Errorf("%+v: expected an error", options)
+		}
+	}
+}
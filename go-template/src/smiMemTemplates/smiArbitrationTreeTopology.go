@@ -0,0 +1,267 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+//
+// Specifies the types of component which may be used in an arbitration tree.
+//
+const (
+	TreeNodeArbiter    = "arbiter"
+	TreeNodeScaler     = "scaler"
+	TreeNodeAssignment = "assignment"
+	TreeNodePipeline   = "pipeline"
+)
+
+//
+// ArbitrationTreeConn specifies a single SMI memory bus connection in an
+// arbitration tree, identified by the name of its request channel.
+//
+type ArbitrationTreeConn struct {
+	Name      string // Name of the SMI request connection.
+	FlitWidth uint   // Number of bytes in each SMI flit.
+}
+
+//
+// ArbitrationTreeNode specifies a single arbiter, bus width scaler, direct
+// assignment or link pipeline stage in an arbitration tree. FIFO and tag
+// parameters are only set for arbiter components.
+//
+type ArbitrationTreeNode struct {
+	Kind          string                // Component type.
+	InstanceName  string                // Instance name, if any.
+	ClientConns   []ArbitrationTreeConn // List of client side connections.
+	ServerConn    ArbitrationTreeConn   // Single server side connection.
+	ScaleFactor   uint                  // Bus width scaling factor.
+	FifoFlitDepth uint                  // Depth of internal flit FIFOs.
+	FifoFrames    uint                  // Maximum number of frames per FIFO.
+	TagIdWidth    uint                  // Number of bits used for ID tagging.
+}
+
+//
+// ArbitrationTreeTopology specifies the structure of a generated arbitration
+// tree, independently of the output language.
+//
+type ArbitrationTreeTopology struct {
+	ModuleName  string                // Name of the arbitration tree module.
+	ClientConns []ArbitrationTreeConn // List of client side connections.
+	ServerConn  ArbitrationTreeConn   // Single server side connection.
+	Nodes       []ArbitrationTreeNode // List of tree components.
+}
+
+//
+// Converts a bus connection configuration to its topology description.
+//
+func makeArbitrationTreeConn(conn smiMemBusConnectionConfig) ArbitrationTreeConn {
+	return ArbitrationTreeConn{conn.SmiNetReqName, conn.SmiMemBusFlitWidth}
+}
+
+//
+// Derives the topology description from an arbitration tree configuration.
+//
+func makeArbitrationTreeTopology(config arbitrationTreeConfig) ArbitrationTreeTopology {
+	topology := ArbitrationTreeTopology{ModuleName: config.ModuleName}
+	for _, conn := range config.SmiMemBusClientConns {
+		topology.ClientConns = append(topology.ClientConns, makeArbitrationTreeConn(conn))
+	}
+	topology.ServerConn = makeArbitrationTreeConn(config.SmiMemBusServerConn[0])
+
+	for _, assignment := range config.SmiMemBusAssignments {
+		topology.Nodes = append(topology.Nodes, ArbitrationTreeNode{
+			Kind: TreeNodeAssignment,
+			ClientConns: []ArbitrationTreeConn{
+				makeArbitrationTreeConn(assignment.SmiMemBusClientConn)},
+			ServerConn:  makeArbitrationTreeConn(assignment.SmiMemBusServerConn),
+			ScaleFactor: 1})
+	}
+	for _, scaler := range config.SmiMemBusWidthScalers {
+		topology.Nodes = append(topology.Nodes, ArbitrationTreeNode{
+			Kind:         TreeNodeScaler,
+			InstanceName: scaler.InstanceName,
+			ClientConns: []ArbitrationTreeConn{
+				makeArbitrationTreeConn(scaler.SmiMemBusClientConn)},
+			ServerConn:  makeArbitrationTreeConn(scaler.SmiMemBusServerConn),
+			ScaleFactor: scaler.SmiMemBusScaleFactor})
+	}
+	for _, arbiter := range config.SmiMemBusArbiters {
+		node := ArbitrationTreeNode{
+			Kind:          TreeNodeArbiter,
+			InstanceName:  arbiter.InstanceName,
+			ServerConn:    makeArbitrationTreeConn(arbiter.SmiMemBusServerConn),
+			ScaleFactor:   1,
+			FifoFlitDepth: arbiter.SmiFifoFlitDepth,
+			FifoFrames:    arbiter.SmiFifoFrameDepth,
+			TagIdWidth:    arbiter.SmiMemBusTagIdWidth}
+		if arbiter.SmiMemBusScaleWidth {
+			node.ScaleFactor = 2
+		}
+		for _, conn := range arbiter.SmiMemBusClientConns {
+			node.ClientConns = append(node.ClientConns, makeArbitrationTreeConn(conn))
+		}
+		topology.Nodes = append(topology.Nodes, node)
+	}
+	for _, pipeline := range config.SmiMemBusPipelines {
+		topology.Nodes = append(topology.Nodes, ArbitrationTreeNode{
+			Kind:         TreeNodePipeline,
+			InstanceName: pipeline.InstanceName,
+			ClientConns: []ArbitrationTreeConn{
+				makeArbitrationTreeConn(pipeline.SmiMemBusClientConn)},
+			ServerConn:  makeArbitrationTreeConn(pipeline.SmiMemBusServerConn),
+			ScaleFactor: 1})
+	}
+	return topology
+}
+
+//
+// DescribeArbitrationTree determines the structure of the SMI memory
+// arbitration tree which would be generated by CreateArbitrationTree for the
+// number of SMI client endpoints specified by the 'numClients' parameter and
+// the bus width scaling specified by the 'scalingFactor' parameter. Returns
+// the tree topology and an error item which will be set to 'nil' on
+// successful completion.
+//
+func DescribeArbitrationTree(moduleName string, numClients uint,
+	scalingFactor uint) (ArbitrationTreeTopology, error) {
+	return DescribeArbitrationTreeWithOptions(moduleName, numClients,
+		scalingFactor, DefaultArbitrationTreeOptions())
+}
+
+//
+// DescribeArbitrationTreeWithOptions is the same as DescribeArbitrationTree,
+// with the arbiter FIFO and pipeline settings being specified by the
+// 'options' parameter instead of using the default settings.
+//
+func DescribeArbitrationTreeWithOptions(moduleName string, numClients uint,
+	scalingFactor uint, options ArbitrationTreeOptions) (ArbitrationTreeTopology, error) {
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		return ArbitrationTreeTopology{}, errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for arbitration tree", scalingFactor))
+	}
+
+	config, err := configureArbitrationTreeWithOptions(
+		moduleName, numClients, scalingFactor, options)
+	if err != nil {
+		return ArbitrationTreeTopology{}, err
+	}
+	return makeArbitrationTreeTopology(config), nil
+}
+
+//
+// NodeLayers determines the arbitration layer for each of the tree components,
+// where layer 0 contains the component driving the server side connection and
+// each subsequent layer is one component further from the server. Pipeline
+// stages do not count as separate layers, so inserting pipeline stages does
+// not change the layers of the other components. The layers are returned in
+// the same order as the topology component list.
+//
+func (topology ArbitrationTreeTopology) NodeLayers() []uint {
+	consumers := make(map[string]int)
+	for i, node := range topology.Nodes {
+		for _, conn := range node.ClientConns {
+			consumers[conn.Name] = i
+		}
+	}
+	layers := make([]uint, len(topology.Nodes))
+	for i, node := range topology.Nodes {
+		name := node.ServerConn.Name
+		for hops := 0; (name != topology.ServerConn.Name) && (hops < len(topology.Nodes)); hops++ {
+			consumer, ok := consumers[name]
+			if !ok {
+				break
+			}
+			if topology.Nodes[consumer].Kind != TreeNodePipeline {
+				layers[i]++
+			}
+			name = topology.Nodes[consumer].ServerConn.Name
+		}
+	}
+	return layers
+}
+
+//
+// Write generates a human readable topology report for the arbitration tree,
+// listing the arbiter fan ins for each layer, the individual tree components
+// and the analytical performance figures for each client.
+//
+func (topology ArbitrationTreeTopology) Write(output io.Writer) error {
+	clientWidth := uint(0)
+	if len(topology.ClientConns) != 0 {
+		clientWidth = topology.ClientConns[0].FlitWidth * 8
+	}
+	_, err := fmt.Fprintf(output,
+		"Arbitration tree %s: %d clients, %d bit client flits, %d bit server flits\n\n",
+		topology.ModuleName, len(topology.ClientConns), clientWidth,
+		topology.ServerConn.FlitWidth*8)
+	if err != nil {
+		return err
+	}
+
+	// Summarise the arbiter fan ins for each layer.
+	layers := topology.NodeLayers()
+	numLayers := uint(0)
+	for _, layer := range layers {
+		if layer >= numLayers {
+			numLayers = layer + 1
+		}
+	}
+	for layer := uint(0); layer < numLayers; layer++ {
+		fanIns := make([]int, 0)
+		numClients := 0
+		for i, node := range topology.Nodes {
+			if (layers[i] == layer) && (node.Kind == TreeNodeArbiter) {
+				fanIns = append(fanIns, len(node.ClientConns))
+				numClients += len(node.ClientConns)
+			}
+		}
+		if len(fanIns) == 0 {
+			continue
+		}
+		_, err = fmt.Fprintf(output, "Layer %d arbiter fan ins: %v (avg %.2f)\n",
+			layer, fanIns, float64(numClients)/float64(len(fanIns)))
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(output, "\n%-5s %-10s %-20s %6s %6s %6s %6s\n",
+		"layer", "kind", "instance", "fan in", "scale", "client", "server")
+	if err != nil {
+		return err
+	}
+	for i, node := range topology.Nodes {
+		instanceName := node.InstanceName
+		if instanceName == "" {
+			instanceName = "-"
+		}
+		_, err = fmt.Fprintf(output, "%-5d %-10s %-20s %6d %6d %6d %6d\n",
+			layers[i], node.Kind, instanceName, len(node.ClientConns),
+			node.ScaleFactor, node.ClientConns[0].FlitWidth*8,
+			node.ServerConn.FlitWidth*8)
+		if err != nil {
+			return err
+		}
+	}
+	return topology.writeClientPaths(output)
+}
@@ -0,0 +1,246 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"fmt"
+	"os"
+	"text/template"
+)
+
+//
+// Defines the template configuration options for an SMI Intel Avalon-MM kernel
+// adaptor module.
+//
+type smiAvalonKernelAdaptorConfig struct {
+	ModuleName            string                      // Name of the kernel adaptor module.
+	ArbitrationModuleName string                      // Name of the arbitration tree module.
+	KernelModuleName      string                      // Name of the SMI kernel module.
+	AvmByteIndexSize      uint                        // Size of Avalon-MM data byte index values.
+	AvmBusDataWidth       uint                        // Width of Avalon-MM data bus in bytes.
+	AxiBusIdWidth         uint                        // Width of internal AXI ID signal.
+	KernelArgsWidth       uint                        // Number of 32-bit kernel arguments.
+	SmiMemBusClientConns  []smiMemBusConnectionConfig // List of client side connections.
+	SmiMemBusServerConn   []smiMemBusConnectionConfig // Single server side connection.
+	SmiMemBusWireConns    []smiMemBusConnectionConfig // Internal wire connections.
+}
+
+//
+// Defines the template for instantiating an SMI Intel Avalon-MM kernel adaptor
+// module. The Avalon-MM response status is forwarded to the SMI response
+// frames, so slave errors are reported to the kernel. Platforms which do not
+// provide the optional response signal should tie it to 2'b00. Write
+// responses are only used if the 'AvmWriteResponseEnable' parameter is set,
+// otherwise writes are posted and always complete with an 'OKAY' status.
+//
+var smiAvalonKernelAdaptorTemplate = `
+{{define "smiAvalonKernelAdaptor"}}{{template "smiMemBusFileHeaderTemplate" . }}
+module {{.ModuleName}} #(parameter AvmWriteResponseEnable = 0) (
+
+  // Kernel control signals.
+  input          argsReady,
+  input  {{makeBitSliceFromScaledWidth .KernelArgsWidth 32}} argsData,
+  output         argsStop,
+  output         retValReady,
+  input          retValStop,
+
+  // Specifies the Avalon-MM master command signals.
+  output [ 63:0] avm_gmem_address,
+  output [  8:0] avm_gmem_burstcount,
+  output         avm_gmem_read,
+  output         avm_gmem_write,
+  output {{makeBitSliceFromScaledWidth .AvmBusDataWidth 8}} avm_gmem_writedata,
+  output {{makeBitSliceFromScaledWidth .AvmBusDataWidth 1}} avm_gmem_byteenable,
+  input          avm_gmem_waitrequest,
+
+  // Specifies the Avalon-MM master read and write response signals.
+  input  {{makeBitSliceFromScaledWidth .AvmBusDataWidth 8}} avm_gmem_readdata,
+  input          avm_gmem_readdatavalid,
+  input  [  1:0] avm_gmem_response,
+  input          avm_gmem_writeresponsevalid,
+
+  // Specify system level signals.
+  input          clk,
+  input          reset
+);
+{{template "smiMemBusConnectionWireList" .SmiMemBusWireConns}}
+// Concatenated SMI flit vectors. {{range .SmiMemBusClientConns}}
+wire [ 71:0] {{.SmiNetReqName}}Flit;
+wire [ 71:0] {{.SmiNetRespName}}Flit;{{end}}
+
+//
+// Instantiate the SMI/Avalon-MM memory controller adaptor.
+//
+smiAvmMemBusAdaptor #({{.AvmByteIndexSize}}, {{.AxiBusIdWidth}}, 33, AvmWriteResponseEnable) avmBusAdaptor (
+  {{with $wire := index .SmiMemBusServerConn 0}}
+  // Connect SMI main memory bus.
+  .smiReqReady  ({{$wire.SmiNetReqName}}Ready),
+  .smiReqEofc   ({{$wire.SmiNetReqName}}Eofc),
+  .smiReqData   ({{$wire.SmiNetReqName}}Data),
+  .smiReqStop   ({{$wire.SmiNetReqName}}Stop),
+  .smiRespReady ({{$wire.SmiNetRespName}}Ready),
+  .smiRespEofc  ({{$wire.SmiNetRespName}}Eofc),
+  .smiRespData  ({{$wire.SmiNetRespName}}Data),
+  .smiRespStop  ({{$wire.SmiNetRespName}}Stop),
+  {{end}}
+  // Connect Avalon-MM command signals.
+  .avmAddress       (avm_gmem_address),
+  .avmBurstCount    (avm_gmem_burstcount),
+  .avmRead          (avm_gmem_read),
+  .avmWrite         (avm_gmem_write),
+  .avmWriteData     (avm_gmem_writedata),
+  .avmByteEnable    (avm_gmem_byteenable),
+  .avmWaitRequest   (avm_gmem_waitrequest),
+
+  // Connect Avalon-MM read and write response signals.
+  .avmReadData           (avm_gmem_readdata),
+  .avmReadDataValid      (avm_gmem_readdatavalid),
+  .avmResponse           (avm_gmem_response),
+  .avmWriteResponseValid (avm_gmem_writeresponsevalid),
+
+  // Connect system level signals.
+  .avmReset     (reset),
+  .clk          (clk),
+  .srst         (reset)
+);
+
+//
+// Instantiate the memory access arbitration logic.
+//
+{{.ArbitrationModuleName}} memArbitrationTree (
+{{template "smiMemBusConnectionPortLink" .SmiMemBusClientConns}}
+{{template "smiMemBusConnectionPortLink" .SmiMemBusServerConn}}
+
+  // Connect system level signals.
+  .clk  (clk),
+  .srst (reset)
+);
+
+//
+// Map SMI flit vector signals.
+// {{range .SmiMemBusClientConns}}
+assign {{.SmiNetReqName}}Data  = {{.SmiNetReqName}}Flit [63:0];
+assign {{.SmiNetReqName}}Eofc  = {{.SmiNetReqName}}Flit [71:64];
+assign {{.SmiNetRespName}}Flit = { {{.SmiNetRespName}}Eofc, {{.SmiNetRespName}}Data };
+{{end}}
+//
+// Instantiate the SMI kernel logic.
+//
+{{.KernelModuleName}} smiKernel (
+
+  // Connect kernel control signals.
+  .args0_0Ready   (argsReady),
+` + "`ifdef KERNEL_ARGS_DATA" + `
+  .args0_0Data    (argsData),
+` + "`endif" + `
+  .args0_0Stop    (argsStop),
+  .retVal1_0Ready (retValReady),
+  .retVal1_0Stop  (retValStop),
+{{range $index, $element := .SmiMemBusClientConns}}
+  // Connect SMI for {{$element.SmiNetReqName}}/{{$element.SmiNetRespName}}.
+  {{makePortIdIndexName ".request%d_0Ready " $index 2 2}} ({{$element.SmiNetReqName}}Ready),
+  {{makePortIdIndexName ".request%d_0Data  " $index 2 2}} ({{$element.SmiNetReqName}}Flit),
+  {{makePortIdIndexName ".request%d_0Stop  " $index 2 2}} ({{$element.SmiNetReqName}}Stop),
+  {{makePortIdIndexName ".response%d_0Ready" $index 3 2}} ({{$element.SmiNetRespName}}Ready),
+  {{makePortIdIndexName ".response%d_0Data " $index 3 2}} ({{$element.SmiNetRespName}}Flit),
+  {{makePortIdIndexName ".response%d_0Stop " $index 3 2}} ({{$element.SmiNetRespName}}Stop),
+{{end}}
+  // Connect system level signals.
+  .clk   (clk),
+  .reset (reset)
+);
+
+endmodule
+{{end}}`
+
+//
+// Cache the parsed SMI Avalon-MM kernel adaptor template.
+//
+var smiAvalonKernelAdaptorCache *template.Template = nil
+
+//
+// Implement lazy construction of the SMI Avalon-MM kernel adaptor template.
+//
+func getSmiAvalonKernelAdaptorTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
+	if smiAvalonKernelAdaptorCache == nil {
+		templGroup := template.New("").Funcs(smiTemplateFunctions)
+		templGroup = template.Must(templGroup.Parse(smiMemBusFileHeaderTemplate))
+		templGroup = template.Must(templGroup.Parse(smiMemBusConnectionPortLinkTemplate))
+		templGroup = template.Must(templGroup.Parse(smiMemBusConnectionWireListTemplate))
+		templGroup = template.Must(templGroup.Parse(smiAvalonKernelAdaptorTemplate))
+		smiAvalonKernelAdaptorCache = templGroup
+	}
+	return smiAvalonKernelAdaptorCache
+}
+
+//
+// Generates an SMI Avalon-MM kernel adaptor configuration given the supplied
+// parameters. Avalon-MM responses are always returned in order, so the
+// internal AXI ID width only determines the number of outstanding reads.
+//
+func configureSmiAvalonKernelAdaptor(moduleName string, kernelName string,
+	numPorts uint, scalingFactor uint,
+	kernelArgsWidth uint) (smiAvalonKernelAdaptorConfig, error) {
+
+	var smiAvalonKernelAdaptor = smiAvalonKernelAdaptorConfig{}
+	smiAvalonKernelAdaptor.ModuleName = moduleName
+	smiAvalonKernelAdaptor.KernelModuleName = kernelName
+	smiAvalonKernelAdaptor.ArbitrationModuleName =
+		fmt.Sprintf("smiMemArbitrationTreeX%dS%d", numPorts, scalingFactor)
+	smiAvalonKernelAdaptor.AvmBusDataWidth = scalingFactor * 8
+	smiAvalonKernelAdaptor.AxiBusIdWidth = 4
+	smiAvalonKernelAdaptor.KernelArgsWidth = kernelArgsWidth
+
+	smiAvalonKernelAdaptor.AvmByteIndexSize = 2
+	for i := scalingFactor; i != 0; i = i >> 1 {
+		smiAvalonKernelAdaptor.AvmByteIndexSize += 1
+	}
+
+	// Add the common connection signals.
+	smiAvalonKernelAdaptor.SmiMemBusClientConns = make([]smiMemBusConnectionConfig, 0)
+	smiAvalonKernelAdaptor.SmiMemBusServerConn = make([]smiMemBusConnectionConfig, 1)
+	smiAvalonKernelAdaptor.SmiMemBusWireConns = make([]smiMemBusConnectionConfig, 1)
+	serverConn := smiMemBusConnectionConfig{
+		"smiMemServerReq", "smiMemServerResp", scalingFactor * 8}
+	smiAvalonKernelAdaptor.SmiMemBusServerConn[0] = serverConn
+	smiAvalonKernelAdaptor.SmiMemBusWireConns[0] = serverConn
+
+	// Add the variable number of internal SMI port connections.
+	for i := uint(0); i < numPorts; i++ {
+		clientConn := smiMemBusConnectionConfig{
+			fmt.Sprintf("smiMemClientReq%d", i),
+			fmt.Sprintf("smiMemClientResp%d", i), 8}
+		smiAvalonKernelAdaptor.SmiMemBusClientConns = append(
+			smiAvalonKernelAdaptor.SmiMemBusClientConns, clientConn)
+		smiAvalonKernelAdaptor.SmiMemBusWireConns = append(
+			smiAvalonKernelAdaptor.SmiMemBusWireConns, clientConn)
+	}
+
+	return smiAvalonKernelAdaptor, nil
+}
+
+//
+// Execute the template using the supplied output file handle and configuration.
+//
+func executeSmiAvalonKernelAdaptorTemplate(outFile *os.File,
+	config smiAvalonKernelAdaptorConfig) error {
+
+	return getSmiAvalonKernelAdaptorTemplate().ExecuteTemplate(
+		outFile, "smiAvalonKernelAdaptor", config)
+}
@@ -36,6 +36,7 @@ type smiFp1KernelAdaptorConfig struct {
 	SmiMemBusClientConns  []smiMemBusConnectionConfig // List of client side connections.
 	SmiMemBusServerConn   []smiMemBusConnectionConfig // Single server side connection.
 	SmiMemBusWireConns    []smiMemBusConnectionConfig // Internal wire connections.
+	ClockDomains          smiMemClockDomainConfig     // Kernel and memory clock domains.
 }
 
 //
@@ -176,9 +177,9 @@ smiAxiMemBusAdaptor #({{.AxiByteIndexSize}}, {{.AxiBusIdWidth}}, 33) axiBusAdapt
   .axiBResp     (m_axi_gmem_bresp),
 
   // Connect system level signals.
-  .axiReset     (reset),  // TODO: This should be passed in.
-  .clk          (clk),
-  .srst         (reset)
+  .axiReset     ({{.ClockDomains.MemResetName}}),
+  .clk          ({{.ClockDomains.MemClockName}}),
+  .srst         ({{.ClockDomains.MemResetName}})
 );
 
 //
@@ -258,6 +259,8 @@ var smiFp1KernelAdaptorCache *template.Template = nil
 // Implement lazy construction of the SMI SDAccel kernel adaptor template.
 //
 func getSmiFp1KernelAdaptorTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
 	if smiFp1KernelAdaptorCache == nil {
 		templGroup := template.New("").Funcs(smiTemplateFunctions)
 		templGroup = template.Must(templGroup.Parse(smiMemBusFileHeaderTemplate))
@@ -309,6 +312,15 @@ func configureSmiFp1KernelAdaptor(moduleName string, kernelName string,
 			smiFp1KernelAdaptor.SmiMemBusWireConns, clientConn)
 	}
 
+	// The FP1 shell uses a single clock domain, so no clock domain crossing
+	// is supported.
+	clockDomains, _, err := configureSmiMemClockDomains(
+		ClockCrossingNone, smiFp1KernelAdaptor.SmiMemBusClientConns, serverConn)
+	if err != nil {
+		return smiFp1KernelAdaptor, err
+	}
+	smiFp1KernelAdaptor.ClockDomains = clockDomains
+
 	return smiFp1KernelAdaptor, nil
 }
 
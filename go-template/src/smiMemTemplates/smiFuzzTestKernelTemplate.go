@@ -0,0 +1,664 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"errors"
+	"github.com/ReconfigureIO/smi/go-template/src/smiFuzzModel"
+	"os"
+	"text/template"
+)
+
+//
+// FuzzTestKernelArgsWidth specifies the number of 32-bit kernel argument
+// words used by the generated fuzz test kernel. These are the 64-bit test
+// memory base address, the 32-bit test window size per SMI port, the 32-bit
+// number of test bursts per SMI port, the 64-bit error count result address
+// and the 64-bit data count result address.
+//
+const FuzzTestKernelArgsWidth = 8
+
+//
+// Defines the template configuration options for a single SMI port of the
+// fuzz test kernel.
+//
+type smiFuzzTestPortConfig struct {
+	Index    uint   // SMI port index.
+	RandSeed uint64 // Random number generator seed for the port.
+}
+
+//
+// Defines the template configuration options for a kernel argument read by
+// the fuzz test kernel.
+//
+type smiFuzzTestParamConfig struct {
+	Count  uint // Parameter request counter value.
+	Offset uint // Control register offset for the argument word.
+}
+
+//
+// Defines the template configuration options for an SMI fuzz test kernel.
+//
+type smiFuzzTestKernelConfig struct {
+	ModuleName   string                   // Name of the fuzz test kernel module.
+	NumPorts     uint                     // Number of SMI memory ports.
+	FuzzPorts    []smiFuzzTestPortConfig  // List of SMI memory ports.
+	FuzzParams   []smiFuzzTestParamConfig // List of kernel argument words.
+	PortMsbIndex uint                     // Most significant bit of per-port vectors.
+}
+
+//
+// Defines the template for an SMI fuzz test kernel, which implements the SMI
+// kernel interface used by the SDAccel kernel adaptor. A burst fuzz tester is
+// connected to each SMI port, with the status result writer sharing the first
+// SMI port.
+//
+var smiFuzzTestKernelTemplate = `
+{{define "smiFuzzTestKernel"}}{{template "smiMemBusFileHeaderTemplate" . }}
+module {{.ModuleName}} (
+
+  // Action control signals.
+  input          go_0Ready,
+  output         go_0Stop,
+  output         done_0Ready,
+  input          done_0Stop,
+
+  // Specifies the parameter register file data access signals.
+  output         paramaddr_0Ready,
+  output [ 31:0] paramaddr_0Data,
+  input          paramaddr_0Stop,
+  input          paramdata_0Ready,
+  input  [ 31:0] paramdata_0Data,
+  output         paramdata_0Stop,
+{{range .FuzzPorts}}
+  // Specifies the SMI memory port {{.Index}} signals.
+  output         smiport{{.Index}}req_0Ready,
+  output [ 71:0] smiport{{.Index}}req_0Data,
+  input          smiport{{.Index}}req_0Stop,
+  input          smiport{{.Index}}resp_0Ready,
+  input  [ 71:0] smiport{{.Index}}resp_0Data,
+  output         smiport{{.Index}}resp_0Stop,
+{{end}}
+  // Specifies the AXI slave read bus signals.
+  input  [ 31:0] s_axi_araddr,
+  input  [  3:0] s_axi_arcache,
+  input  [  2:0] s_axi_arprot,
+  input          s_axi_arvalid,
+  output         s_axi_arready,
+  output [ 31:0] s_axi_rdata,
+  output [  1:0] s_axi_rresp,
+  output         s_axi_rvalid,
+  input          s_axi_rready,
+
+  // Specifies the AXI slave write bus signals.
+  input  [ 31:0] s_axi_awaddr,
+  input  [  3:0] s_axi_awcache,
+  input  [  2:0] s_axi_awprot,
+  input          s_axi_awvalid,
+  output         s_axi_awready,
+  input  [ 31:0] s_axi_wdata,
+  input  [  3:0] s_axi_wstrb,
+  input          s_axi_wvalid,
+  output         s_axi_wready,
+  output [  1:0] s_axi_bresp,
+  output         s_axi_bvalid,
+  input          s_axi_bready,
+
+  // Specify system level signals.
+  input          clk,
+  input          reset
+);
+
+// Specify state space for test runner state machine.
+parameter [3:0]
+  TestStateReset = 0,
+  TestStateIdle = 1,
+  TestStateGetParams = 2,
+  TestStateSetConfig = 3,
+  TestStateGetStatus = 4,
+  TestStateWriteErrCountReq = 5,
+  TestStateWriteErrCountDone = 6,
+  TestStateWriteDataCountReq = 7,
+  TestStateWriteDataCountDone = 8,
+  TestStateReportResult = 9;
+
+// Parameter request state machine signals.
+reg [3:0] paramReqCount_d;
+reg [3:0] paramReqCount_q;
+reg       paramReq;
+reg       paramAddrReady;
+reg [31:0] paramAddrData;
+
+// Action execution state machine signals. The kernel arguments are shifted
+// into a single parameter register.
+reg [3:0]   testState_d;
+reg [3:0]   paramCount_d;
+reg [255:0] params_d;
+reg [31:0]  errorCount_d;
+reg [63:0]  dataCount_d;
+
+reg [3:0]   testState_q;
+reg [3:0]   paramCount_q;
+reg [255:0] params_q;
+reg [31:0]  errorCount_q;
+reg [63:0]  dataCount_q;
+
+reg goHalt;
+reg doneReady;
+reg paramReadHalt;
+
+// Kernel argument values.
+wire [63:0] memBaseAddr = params_q [63:0];
+wire [31:0] memBlockSize = params_q [95:64];
+wire [31:0] fuzzTestCount = params_q [127:96];
+wire [63:0] errResultAddr = params_q [191:128];
+wire [63:0] dcountResultAddr = params_q [255:192];
+
+// Per-port fuzz tester handshake signals.
+reg  [{{.PortMsbIndex}}:0] fuzzConfigDone_q;
+reg  [{{.PortMsbIndex}}:0] fuzzStatusDone_q;
+wire [{{.PortMsbIndex}}:0] fuzzConfigValid;
+wire [{{.PortMsbIndex}}:0] fuzzConfigStop;
+wire [{{.PortMsbIndex}}:0] fuzzConfigAccept;
+wire [{{.PortMsbIndex}}:0] fuzzStatusValid;
+wire [{{.PortMsbIndex}}:0] fuzzStatusStop;
+wire [{{.PortMsbIndex}}:0] fuzzStatusAccept;
+{{range .FuzzPorts}}
+// Fuzz tester signals for SMI port {{.Index}}.
+wire [63:0] fuzzMemAddrBase{{.Index}};
+wire [31:0] fuzzStatusErrorCount{{.Index}};
+wire [63:0] fuzzStatusDataCount{{.Index}};
+wire        smiFuzzReqReady{{.Index}};
+wire [7:0]  smiFuzzReqEofc{{.Index}};
+wire [63:0] smiFuzzReqData{{.Index}};
+wire        smiFuzzReqStop{{.Index}};
+wire        smiFuzzRespReady{{.Index}};
+wire [7:0]  smiFuzzRespEofc{{.Index}};
+wire [63:0] smiFuzzRespData{{.Index}};
+wire        smiFuzzRespStop{{.Index}};
+{{end}}
+// Status writer signals, which share SMI port 0.
+reg         statusWriteValid;
+reg  [63:0] statusWriteData;
+reg  [63:0] statusWriteAddr;
+wire        statusWriteStop;
+wire        statusWriteDoneValid;
+wire        statusWriteDoneStatusOk;
+reg         statusWriteDoneStop;
+
+wire        smiStatReqReady;
+wire [7:0]  smiStatReqEofc;
+wire [63:0] smiStatReqData;
+wire        smiStatReqStop;
+wire        smiStatRespReady;
+wire [7:0]  smiStatRespEofc;
+wire [63:0] smiStatRespData;
+wire        smiStatRespStop;
+
+wire        smiPortReqReady0;
+wire [7:0]  smiPortReqEofc0;
+wire [63:0] smiPortReqData0;
+wire        smiPortReqStop0;
+wire        smiPortRespReady0;
+wire [7:0]  smiPortRespEofc0;
+wire [63:0] smiPortRespData0;
+wire        smiPortRespStop0;
+
+// AXI slave loopback signals. Initialised to zero to avoid locking the slave
+// AXI bus on reset.
+reg s_axi_read_ready_q = 1'b0;
+reg s_axi_read_complete_q = 1'b0;
+reg s_axi_write_ready_q = 1'b0;
+reg s_axi_write_complete_q = 1'b0;
+
+// Implement combinatorial logic for parameter request state machine.
+always @(paramReqCount_q, paramReq, paramaddr_0Stop)
+begin
+
+  // Hold current state by default.
+  paramReqCount_d = paramReqCount_q;
+  paramAddrReady = 1'b0;
+  paramAddrData = 32'd0;
+
+  // From the idle state, wait for parameter request to be initiated.
+  if (paramReqCount_q == 4'd0)
+  begin
+    if (paramReq)
+      paramReqCount_d = 4'd1;
+  end
+
+  // Issue parameter requests.
+  else if (paramReqCount_q <= 4'd{{len .FuzzParams}})
+  begin
+    paramAddrReady = 1'b1;
+    case (paramReqCount_q){{range .FuzzParams}}
+      4'd{{.Count}} : paramAddrData = 32'h{{printf "%X" .Offset}};{{end}}
+      default : paramAddrData = 32'd0;
+    endcase
+    if (~paramaddr_0Stop)
+      paramReqCount_d = paramReqCount_q + 4'd1;
+  end
+
+  // Revert to idle state.
+  else
+  begin
+    paramReqCount_d = 4'd0;
+  end
+
+end
+
+// Derive the per-port fuzz tester handshakes.
+assign fuzzConfigValid = (testState_q == TestStateSetConfig) ?
+  ~fuzzConfigDone_q : {{.NumPorts}}'d0;
+assign fuzzConfigAccept = fuzzConfigValid & ~fuzzConfigStop;
+assign fuzzStatusStop = (testState_q == TestStateGetStatus) ?
+  fuzzStatusDone_q : ~{{.NumPorts}}'d0;
+assign fuzzStatusAccept = fuzzStatusValid & ~fuzzStatusStop;
+
+// Implement combinatorial logic for action execution state machine.
+always @(testState_q, paramCount_q, params_q, errorCount_q, dataCount_q,
+  go_0Ready, done_0Stop, paramdata_0Ready, paramdata_0Data, fuzzConfigDone_q,
+  fuzzConfigAccept, fuzzStatusDone_q, fuzzStatusAccept,{{range .FuzzPorts}}
+  fuzzStatusErrorCount{{.Index}}, fuzzStatusDataCount{{.Index}},{{end}}
+  errResultAddr, dcountResultAddr, statusWriteStop, statusWriteDoneValid)
+begin
+
+  // Hold current state by default.
+  testState_d = testState_q;
+  paramCount_d = paramCount_q;
+  params_d = params_q;
+  errorCount_d = errorCount_q;
+  dataCount_d = dataCount_q;
+
+  goHalt = 1'b1;
+  doneReady = 1'b0;
+  paramReq = 1'b0;
+  paramReadHalt = 1'b1;
+  statusWriteValid = 1'b0;
+  statusWriteData = 64'd0;
+  statusWriteAddr = 64'd0;
+  statusWriteDoneStop = 1'b1;
+
+  // Implement state machine.
+  case (testState_q)
+
+    // In the idle state, wait for the 'go' request.
+    TestStateIdle :
+    begin
+      goHalt = 1'b0;
+      paramCount_d = 4'd0;
+      errorCount_d = 32'd0;
+      dataCount_d = 64'd0;
+      if (go_0Ready)
+      begin
+        testState_d = TestStateGetParams;
+        paramReq = 1'b1;
+      end
+    end
+
+    // Shift the kernel argument words into the parameter register.
+    TestStateGetParams :
+    begin
+      paramReadHalt = 1'b0;
+      if (paramdata_0Ready)
+      begin
+        params_d = { paramdata_0Data, params_q [255:32] };
+        paramCount_d = paramCount_q + 4'd1;
+        if (paramCount_q == 4'd{{len .FuzzParams}} - 4'd1)
+          testState_d = TestStateSetConfig;
+      end
+    end
+
+    // Set the configuration parameters for all fuzz testers, initiating the
+    // fuzz testing.
+    TestStateSetConfig :
+    begin
+      if ((fuzzConfigDone_q | fuzzConfigAccept) == ~{{.NumPorts}}'d0)
+        testState_d = TestStateGetStatus;
+    end
+
+    // Accumulate the fuzz testing status values from all fuzz testers.
+    TestStateGetStatus :
+    begin
+      errorCount_d = errorCount_q{{range .FuzzPorts}} +
+        (fuzzStatusAccept [{{.Index}}] ? fuzzStatusErrorCount{{.Index}} : 32'd0){{end}};
+      dataCount_d = dataCount_q{{range .FuzzPorts}} +
+        (fuzzStatusAccept [{{.Index}}] ? fuzzStatusDataCount{{.Index}} : 64'd0){{end}};
+      if ((fuzzStatusDone_q | fuzzStatusAccept) == ~{{.NumPorts}}'d0)
+        testState_d = TestStateWriteErrCountReq;
+    end
+
+    // Write the status error count value to the return location in shared memory.
+    TestStateWriteErrCountReq :
+    begin
+      statusWriteValid = 1'b1;
+      statusWriteAddr = errResultAddr;
+      statusWriteData = { 32'd0, errorCount_q };
+      if (~statusWriteStop)
+        testState_d = TestStateWriteErrCountDone;
+    end
+
+    TestStateWriteErrCountDone :
+    begin
+      statusWriteDoneStop = 1'b0;
+      if (statusWriteDoneValid)
+        testState_d = TestStateWriteDataCountReq;
+    end
+
+    // Write the status data count value to the return location in shared memory.
+    TestStateWriteDataCountReq :
+    begin
+      statusWriteValid = 1'b1;
+      statusWriteAddr = dcountResultAddr;
+      statusWriteData = dataCount_q;
+      if (~statusWriteStop)
+        testState_d = TestStateWriteDataCountDone;
+    end
+
+    TestStateWriteDataCountDone :
+    begin
+      statusWriteDoneStop = 1'b0;
+      if (statusWriteDoneValid)
+        testState_d = TestStateReportResult;
+    end
+
+    // Indicate completion to the SDAccel framework.
+    TestStateReportResult :
+    begin
+      doneReady = 1'b1;
+      if (~done_0Stop)
+        testState_d = TestStateIdle;
+    end
+
+    // From the reset state, transition to the idle state.
+    default :
+    begin
+      testState_d = TestStateIdle;
+    end
+  endcase
+
+end
+
+// Implement resettable state registers for test control state machine.
+always @(posedge clk)
+begin
+  if (reset)
+  begin
+    testState_q <= TestStateReset;
+    paramReqCount_q <= 4'd0;
+  end
+  else
+  begin
+    testState_q <= testState_d;
+    paramReqCount_q <= paramReqCount_d;
+  end
+end
+
+// Implement non-resettable data registers for test control state machine.
+always @(posedge clk)
+begin
+  paramCount_q <= paramCount_d;
+  params_q <= params_d;
+  errorCount_q <= errorCount_d;
+  dataCount_q <= dataCount_d;
+end
+
+// Track the per-port fuzz tester handshakes. These are cleared in the idle
+// state.
+always @(posedge clk)
+begin
+  if (testState_q == TestStateIdle)
+  begin
+    fuzzConfigDone_q <= {{.NumPorts}}'d0;
+    fuzzStatusDone_q <= {{.NumPorts}}'d0;
+  end
+  else
+  begin
+    fuzzConfigDone_q <= fuzzConfigDone_q | fuzzConfigAccept;
+    fuzzStatusDone_q <= fuzzStatusDone_q | fuzzStatusAccept;
+  end
+end
+
+// Connect external handshake signals.
+assign go_0Stop = goHalt;
+assign done_0Ready = doneReady;
+
+assign paramaddr_0Ready = paramAddrReady;
+assign paramaddr_0Data = paramAddrData;
+assign paramdata_0Stop = paramReadHalt;
+{{range .FuzzPorts}}
+//
+// Instantiate the fuzz tester for SMI port {{.Index}}.
+//
+assign fuzzMemAddrBase{{.Index}} = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd{{.Index}});
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h{{printf "%016X" .RandSeed}})) fuzzTester{{.Index}} (
+  .configValid        (fuzzConfigValid [{{.Index}}]),
+  .configMemAddrBase  (fuzzMemAddrBase{{.Index}}),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [{{.Index}}]),
+  .statusValid        (fuzzStatusValid [{{.Index}}]),
+  .statusErrorCount   (fuzzStatusErrorCount{{.Index}}),
+  .statusDataCount    (fuzzStatusDataCount{{.Index}}),
+  .statusStop         (fuzzStatusStop [{{.Index}}]),
+  .smiReqValid        (smiFuzzReqReady{{.Index}}),
+  .smiReqEofc         (smiFuzzReqEofc{{.Index}}),
+  .smiReqData         (smiFuzzReqData{{.Index}}),
+  .smiReqStop         (smiFuzzReqStop{{.Index}}),
+  .smiRespValid       (smiFuzzRespReady{{.Index}}),
+  .smiRespEofc        (smiFuzzRespEofc{{.Index}}),
+  .smiRespData        (smiFuzzRespData{{.Index}}),
+  .smiRespStop        (smiFuzzRespStop{{.Index}}),
+  .clk                (clk),
+  .srst               (reset)
+);
+{{if eq .Index 0}}
+//
+// Instantiate the status memory write module and arbitrate with the port 0
+// fuzz tester.
+//
+smiMemLibWriteWord64 statusWriter (
+  .paramsValid  (statusWriteValid),
+  .paramAddr    (statusWriteAddr),
+  .paramOpts    (8'h01),
+  .paramData    (statusWriteData),
+  .paramsStop   (statusWriteStop),
+  .doneValid    (statusWriteDoneValid),
+  .doneStatusOk (statusWriteDoneStatusOk),
+  .doneStop     (statusWriteDoneStop),
+  .smiReqValid  (smiStatReqReady),
+  .smiReqEofc   (smiStatReqEofc),
+  .smiReqData   (smiStatReqData),
+  .smiReqStop   (smiStatReqStop),
+  .smiRespValid (smiStatRespReady),
+  .smiRespEofc  (smiStatRespEofc),
+  .smiRespData  (smiStatRespData),
+  .smiRespStop  (smiStatRespStop),
+  .clk          (clk),
+  .srst         (reset)
+);
+
+smiTransactionArbiterX2 #(8, 2, 64, 4) statusArbiter (
+  .smiReqAInReady   (smiFuzzReqReady0),
+  .smiReqAInEofc    (smiFuzzReqEofc0),
+  .smiReqAInData    (smiFuzzReqData0),
+  .smiReqAInStop    (smiFuzzReqStop0),
+  .smiRespAOutReady (smiFuzzRespReady0),
+  .smiRespAOutEofc  (smiFuzzRespEofc0),
+  .smiRespAOutData  (smiFuzzRespData0),
+  .smiRespAOutStop  (smiFuzzRespStop0),
+  .smiReqBInReady   (smiStatReqReady),
+  .smiReqBInEofc    (smiStatReqEofc),
+  .smiReqBInData    (smiStatReqData),
+  .smiReqBInStop    (smiStatReqStop),
+  .smiRespBOutReady (smiStatRespReady),
+  .smiRespBOutEofc  (smiStatRespEofc),
+  .smiRespBOutData  (smiStatRespData),
+  .smiRespBOutStop  (smiStatRespStop),
+  .smiReqOutReady   (smiPortReqReady0),
+  .smiReqOutEofc    (smiPortReqEofc0),
+  .smiReqOutData    (smiPortReqData0),
+  .smiReqOutStop    (smiPortReqStop0),
+  .smiRespInReady   (smiPortRespReady0),
+  .smiRespInEofc    (smiPortRespEofc0),
+  .smiRespInData    (smiPortRespData0),
+  .smiRespInStop    (smiPortRespStop0),
+  .clk              (clk),
+  .srst             (reset)
+);
+
+assign smiport0req_0Ready = smiPortReqReady0;
+assign smiport0req_0Data  = { smiPortReqEofc0, smiPortReqData0 };
+assign smiPortReqStop0    = smiport0req_0Stop;
+assign smiPortRespReady0  = smiport0resp_0Ready;
+assign smiPortRespEofc0   = smiport0resp_0Data [71:64];
+assign smiPortRespData0   = smiport0resp_0Data [63:0];
+assign smiport0resp_0Stop = smiPortRespStop0;
+{{else}}
+assign smiport{{.Index}}req_0Ready = smiFuzzReqReady{{.Index}};
+assign smiport{{.Index}}req_0Data  = { smiFuzzReqEofc{{.Index}}, smiFuzzReqData{{.Index}} };
+assign smiFuzzReqStop{{.Index}}    = smiport{{.Index}}req_0Stop;
+assign smiFuzzRespReady{{.Index}}  = smiport{{.Index}}resp_0Ready;
+assign smiFuzzRespEofc{{.Index}}   = smiport{{.Index}}resp_0Data [71:64];
+assign smiFuzzRespData{{.Index}}   = smiport{{.Index}}resp_0Data [63:0];
+assign smiport{{.Index}}resp_0Stop = smiFuzzRespStop{{.Index}};
+{{end}}{{end}}
+//
+// Implement AXI read control loopback, returning the error count.
+//
+always @(posedge clk)
+begin
+  if (s_axi_read_complete_q)
+  begin
+    s_axi_read_complete_q <= ~s_axi_rready;
+  end
+  else if (s_axi_read_ready_q)
+  begin
+    s_axi_read_ready_q <= 1'b0;
+    s_axi_read_complete_q <= 1'b1;
+  end
+  else
+  begin
+    s_axi_read_ready_q <= s_axi_arvalid;
+  end
+end
+
+assign s_axi_arready = s_axi_read_ready_q;
+assign s_axi_rdata = errorCount_q;
+assign s_axi_rresp = 2'b0;
+assign s_axi_rvalid = s_axi_read_complete_q;
+
+//
+// Implement AXI write control loopback.
+//
+always @(posedge clk)
+begin
+  if (s_axi_write_complete_q)
+  begin
+    s_axi_write_complete_q <= ~s_axi_bready;
+  end
+  else if (s_axi_write_ready_q)
+  begin
+    s_axi_write_ready_q <= 1'b0;
+    s_axi_write_complete_q <= 1'b1;
+  end
+  else
+  begin
+    s_axi_write_ready_q <= s_axi_awvalid & s_axi_wvalid;
+  end
+end
+
+assign s_axi_awready = s_axi_write_ready_q;
+assign s_axi_wready = s_axi_write_ready_q;
+assign s_axi_bresp = 2'b0;
+assign s_axi_bvalid = s_axi_write_complete_q;
+
+endmodule
+{{end}}`
+
+//
+// Cache the parsed SMI fuzz test kernel template.
+//
+var smiFuzzTestKernelCache *template.Template = nil
+
+//
+// Implement lazy construction of the SMI fuzz test kernel template.
+//
+func getSmiFuzzTestKernelTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
+	if smiFuzzTestKernelCache == nil {
+		templGroup := template.New("").Funcs(smiTemplateFunctions)
+		templGroup = template.Must(templGroup.Parse(smiMemBusFileHeaderTemplate))
+		templGroup = template.Must(templGroup.Parse(smiFuzzTestKernelTemplate))
+		smiFuzzTestKernelCache = templGroup
+	}
+	return smiFuzzTestKernelCache
+}
+
+//
+// Generates an SMI fuzz test kernel configuration given the supplied
+// parameters. Each SMI port uses a different random number generator seed,
+// as specified by smiFuzzModel.PortRandSeed.
+//
+func configureSmiFuzzTestKernel(moduleName string,
+	numPorts uint) (smiFuzzTestKernelConfig, error) {
+
+	var smiFuzzTestKernel = smiFuzzTestKernelConfig{}
+	if numPorts == 0 {
+		return smiFuzzTestKernel, errors.New(
+			"Fuzz test kernel requires at least one SMI port")
+	}
+	smiFuzzTestKernel.ModuleName = moduleName
+	smiFuzzTestKernel.NumPorts = numPorts
+	smiFuzzTestKernel.PortMsbIndex = numPorts - 1
+
+	// Add the SMI port fuzz testers.
+	smiFuzzTestKernel.FuzzPorts = make([]smiFuzzTestPortConfig, numPorts)
+	for i := uint(0); i < numPorts; i++ {
+		smiFuzzTestKernel.FuzzPorts[i] = smiFuzzTestPortConfig{
+			i, smiFuzzModel.PortRandSeed(i)}
+	}
+
+	// Add the kernel argument words, using the SDAccel control register map.
+	smiFuzzTestKernel.FuzzParams = make([]smiFuzzTestParamConfig, FuzzTestKernelArgsWidth)
+	for i := uint(0); i < FuzzTestKernelArgsWidth; i++ {
+		smiFuzzTestKernel.FuzzParams[i] = smiFuzzTestParamConfig{
+			i + 1, smiSdaKernelArgsOffset + 4*i}
+	}
+	return smiFuzzTestKernel, nil
+}
+
+//
+// Execute the template using the supplied output file handle and configuration.
+//
+func executeSmiFuzzTestKernelTemplate(outFile *os.File,
+	config smiFuzzTestKernelConfig) error {
+
+	return getSmiFuzzTestKernelTemplate().ExecuteTemplate(
+		outFile, "smiFuzzTestKernel", config)
+}
+
+//
+// Lists the library and test modules which are directly instantiated by the
+// fuzz test kernel.
+//
+func getFuzzTestKernelModules() []string {
+	return []string{"smiMemLibFuzzTestBurst64", "smiMemLibWriteWord64",
+		"smiTransactionArbiterX2"}
+}
@@ -0,0 +1,313 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+//
+// Specifies the library modules which are directly instantiated by each of the
+// Verilog library components that may be used by the generated code. This must
+// be kept in step with the contents of the verilog/ directory.
+//
+var smiLibraryModuleDependencies = map[string][]string{
+	"smiAvmMemBusAdaptor": {"smiAxiMemBusAdaptor", "smiSelfLinkBufferFifoL",
+		"smiSelfLinkBufferFifoS"},
+	"smiAxiInputBuffer": {},
+	"smiAxiMemBusAdaptor": {"smiAxiMemReadAdaptor", "smiAxiMemWriteAdaptor",
+		"smiFrameArbiterX2", "smiFrameSteerX2"},
+	"smiAxiMemReadAdaptor": {"smiAxiInputBuffer", "smiFlitDataPack",
+		"smiFlitScaleX2", "smiHeaderInjectPf1", "smiSelfFlowForkControl",
+		"smiSelfLinkToggleBuffer"},
+	"smiAxiMemWriteAdaptor": {"smiAxiInputBuffer", "smiAxiOutputBuffer",
+		"smiByteDataAlign", "smiHeaderExtractPf1", "smiHeaderExtractPf2"},
+	"smiAxiOutputBuffer": {},
+	"smiByteDataAlign":   {"smiSelfLinkDoubleBuffer"},
+	"smiFlitDataPack":    {"smiSelfLinkDoubleBuffer"},
+	"smiFlitScaleD2": {"smiFlitScaleStageD2", "smiSelfLinkDoubleBuffer",
+		"smiSelfLinkToggleBuffer"},
+	"smiFlitScaleD4": {"smiFlitScaleStageD2", "smiSelfLinkDoubleBuffer",
+		"smiSelfLinkToggleBuffer"},
+	"smiFlitScaleD8": {"smiFlitScaleStageD2", "smiSelfLinkDoubleBuffer",
+		"smiSelfLinkToggleBuffer"},
+	"smiFlitScaleStageD2": {},
+	"smiFlitScaleStageX2": {},
+	"smiFlitScaleX2": {"smiFlitScaleStageX2", "smiSelfLinkDoubleBuffer",
+		"smiSelfLinkToggleBuffer"},
+	"smiFlitScaleX4": {"smiFlitScaleStageX2", "smiSelfLinkDoubleBuffer",
+		"smiSelfLinkToggleBuffer"},
+	"smiFlitScaleX8": {"smiFlitScaleStageX2", "smiSelfLinkDoubleBuffer",
+		"smiSelfLinkToggleBuffer"},
+	"smiFrameArbiterX2": {"smiSelfLinkDoubleBuffer"},
+	"smiFrameArbiterX3": {"smiSelfLinkDoubleBuffer"},
+	"smiFrameArbiterX4": {"smiSelfLinkDoubleBuffer"},
+	"smiFrameAssembler": {"smiSelfLinkBufferFifoL", "smiSelfLinkBufferFifoS"},
+	"smiFrameBuffer":    {"smiSelfLinkBufferFifoL", "smiSelfLinkBufferFifoS"},
+	"smiFrameSteerX2":   {"smiSelfLinkDoubleBuffer"},
+	"smiFrameSteerX3":   {"smiSelfLinkDoubleBuffer"},
+	"smiFrameSteerX4":   {"smiSelfLinkDoubleBuffer"},
+	"smiHeaderExtractPf1": {"smiSelfLinkBufferFifoS",
+		"smiSelfLinkToggleBuffer"},
+	"smiHeaderExtractPf2": {"smiSelfLinkBufferFifoS",
+		"smiSelfLinkToggleBuffer"},
+	"smiHeaderInjectPf1":     {"smiSelfLinkBufferFifoS"},
+	"smiHeaderInjectPf2":     {"smiSelfLinkBufferFifoS"},
+	"smiMemBusPipelineStage": {"smiSelfLinkDoubleBuffer"},
+	"smiMemClockBridge":      {"smiSelfLinkAsyncFifo"},
+	"smiMemLibReadBurstCore": {"smiHeaderExtractPf1",
+		"smiSelfLinkDoubleBuffer"},
+	"smiMemLibReadBurstSegmented64": {"smiMemLibReadBurstCore",
+		"smiSelfLinkBufferFifoS", "smiSelfLinkDoubleBuffer",
+		"smiSelfLinkToggleBuffer"},
+	"smiMemLibReadBurstSingle64": {"smiMemLibReadBurstCore",
+		"smiSelfLinkDoubleBuffer", "smiSelfLinkToggleBuffer"},
+	"smiMemLibWriteBurstCore": {"smiHeaderInjectPf2"},
+	"smiMemLibWriteBurstSegmented64": {"smiMemLibWriteBurstCore",
+		"smiSelfLinkBufferFifoS", "smiSelfLinkDoubleBuffer",
+		"smiSelfLinkToggleBuffer"},
+	"smiMemLibWriteBurstSingle64": {"smiMemLibWriteBurstCore",
+		"smiSelfFlowForkControl", "smiSelfLinkDoubleBuffer",
+		"smiSelfLinkToggleBuffer"},
+	"smiMemLibWriteWord32":    {"smiSelfLinkToggleBuffer"},
+	"smiMemLibWriteWord64":    {"smiSelfLinkToggleBuffer"},
+	"smiSelfFlowForkControl":  {},
+	"smiSelfLinkAsyncFifo":    {},
+	"smiSelfLinkBufferFifoL":  {},
+	"smiSelfLinkBufferFifoS":  {},
+	"smiSelfLinkDoubleBuffer": {},
+	"smiSelfLinkToggleBuffer": {},
+	"smiTransactionArbiterX2": {"smiFrameArbiterX2", "smiFrameAssembler",
+		"smiFrameBuffer", "smiFrameSteerX2", "smiTransactionMatcher"},
+	"smiTransactionArbiterX3": {"smiFrameArbiterX3", "smiFrameAssembler",
+		"smiFrameBuffer", "smiFrameSteerX3", "smiTransactionMatcher"},
+	"smiTransactionArbiterX4": {"smiFrameArbiterX4", "smiFrameAssembler",
+		"smiFrameBuffer", "smiFrameSteerX4", "smiTransactionMatcher"},
+	"smiTransactionMatcher": {"smiSelfLinkDoubleBuffer"},
+	"smiTransactionScaledArbiterX2": {"smiFlitScaleD2", "smiFlitScaleX2",
+		"smiFrameArbiterX2", "smiFrameAssembler", "smiFrameBuffer",
+		"smiFrameSteerX2", "smiTransactionMatcher"},
+	"smiTransactionScaledArbiterX3": {"smiFlitScaleD2", "smiFlitScaleX2",
+		"smiFrameArbiterX3", "smiFrameAssembler", "smiFrameBuffer",
+		"smiFrameSteerX3", "smiTransactionMatcher"},
+	"smiTransactionScaledArbiterX4": {"smiFlitScaleD2", "smiFlitScaleX2",
+		"smiFrameArbiterX4", "smiFrameAssembler", "smiFrameBuffer",
+		"smiFrameSteerX4", "smiTransactionMatcher"}}
+
+//
+// Specifies the test modules from the test/verilog/ directory which are
+// directly instantiated by each of the Verilog test components that may be used
+// by the generated code. These may also instantiate library modules.
+//
+var smiTestModuleDependencies = map[string][]string{
+	"smiMemLibFuzzTestBurst64": {"smiMemLibFuzzTestParamGen",
+		"smiMemLibReadBurstSegmented64", "smiMemLibReadBurstTestCheck64",
+		"smiMemLibWriteBurstSegmented64", "smiMemLibWriteBurstTestSource64",
+		"smiSelfFlowForkControl", "smiSelfLinkToggleBuffer",
+		"smiTransactionArbiterX2"},
+	"smiMemLibFuzzTestParamGen": {"smiSelfLinkToggleBuffer",
+		"smiSelfRandSource"},
+	"smiMemLibReadBurstTestCheck64":   {},
+	"smiMemLibWriteBurstTestSource64": {},
+	"smiSelfRandSource":               {}}
+
+//
+// Derives the full set of library modules required to build the specified
+// root modules, including all indirectly instantiated modules. The module
+// names are returned in sorted order. Any test modules are omitted from the
+// list of library modules and are returned separately.
+//
+func collectLibraryModules(rootModules []string) ([]string, error) {
+	moduleNames, _, err := collectLibraryAndTestModules(rootModules)
+	return moduleNames, err
+}
+
+//
+// Derives the full set of library and test modules required to build the
+// specified root modules, including all indirectly instantiated modules. The
+// library and test module names are returned as separate sorted lists.
+//
+func collectLibraryAndTestModules(rootModules []string) ([]string, []string, error) {
+	moduleSet := make(map[string]bool)
+	testModuleSet := make(map[string]bool)
+	pending := append([]string{}, rootModules...)
+	for len(pending) != 0 {
+		moduleName := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if moduleSet[moduleName] || testModuleSet[moduleName] {
+			continue
+		}
+		if dependencies, ok := smiLibraryModuleDependencies[moduleName]; ok {
+			moduleSet[moduleName] = true
+			pending = append(pending, dependencies...)
+		} else if dependencies, ok := smiTestModuleDependencies[moduleName]; ok {
+			testModuleSet[moduleName] = true
+			pending = append(pending, dependencies...)
+		} else {
+			return nil, nil, errors.New(fmt.Sprintf(
+				"Unknown SMI library module (%s)", moduleName))
+		}
+	}
+	return sortedModuleNames(moduleSet), sortedModuleNames(testModuleSet), nil
+}
+
+//
+// Converts a set of module names to a sorted list.
+//
+func sortedModuleNames(moduleSet map[string]bool) []string {
+	moduleNames := make([]string, 0, len(moduleSet))
+	for moduleName := range moduleSet {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+	return moduleNames
+}
+
+//
+// Converts a list of module names to the corresponding Verilog file names.
+//
+func moduleFileNames(moduleNames []string) []string {
+	fileNames := make([]string, len(moduleNames))
+	for i, moduleName := range moduleNames {
+		fileNames[i] = moduleName + ".v"
+	}
+	return fileNames
+}
+
+//
+// MergeLibraryFiles merges two lists of library file names, such as those
+// returned by RequiredLibraryFiles and FuzzTestKernelFiles, removing any
+// duplicates. The merged file names are returned in sorted order.
+//
+func MergeLibraryFiles(fileNames []string, extraFileNames []string) []string {
+	fileSet := make(map[string]bool)
+	for _, fileName := range fileNames {
+		fileSet[fileName] = true
+	}
+	for _, fileName := range extraFileNames {
+		fileSet[fileName] = true
+	}
+	return sortedModuleNames(fileSet)
+}
+
+//
+// Lists the library modules which are directly instantiated by an arbitration
+// tree configuration.
+//
+func getArbitrationTreeLibraryModules(config arbitrationTreeConfig) []string {
+	moduleNames := make([]string, 0)
+	for _, scaler := range config.SmiMemBusWidthScalers {
+		moduleNames = append(moduleNames,
+			fmt.Sprintf("smiFlitScaleX%d", scaler.SmiMemBusScaleFactor),
+			fmt.Sprintf("smiFlitScaleD%d", scaler.SmiMemBusScaleFactor))
+	}
+	for _, arbiter := range config.SmiMemBusArbiters {
+		if arbiter.SmiMemBusScaleWidth {
+			moduleNames = append(moduleNames, fmt.Sprintf(
+				"smiTransactionScaledArbiterX%d", len(arbiter.SmiMemBusClientConns)))
+		} else {
+			moduleNames = append(moduleNames, fmt.Sprintf(
+				"smiTransactionArbiterX%d", len(arbiter.SmiMemBusClientConns)))
+		}
+	}
+	if len(config.SmiMemBusPipelines) > 0 {
+		moduleNames = append(moduleNames, "smiMemBusPipelineStage")
+	}
+	return moduleNames
+}
+
+//
+// RequiredLibraryFiles lists the Verilog library source files from the
+// verilog/ directory which are required to build the arbitration tree for the
+// number of SMI client endpoints specified by the 'numClients' parameter and
+// the bus width scaling specified by the 'scalingFactor' parameter, together
+// with the library memory bus adaptor module specified by the
+// 'busAdaptorName' parameter. The adaptor name may be empty if only the
+// arbitration tree is being built. Returns the sorted list of file names and
+// an error item which will be set to 'nil' on successful completion.
+//
+func RequiredLibraryFiles(numClients uint, scalingFactor uint,
+	busAdaptorName string) ([]string, error) {
+	return RequiredLibraryFilesWithOptions(numClients, scalingFactor,
+		busAdaptorName, DefaultArbitrationTreeOptions())
+}
+
+//
+// RequiredLibraryFilesWithOptions is the same as RequiredLibraryFiles, for an
+// arbitration tree built using the options specified by the 'options'
+// parameter. This includes the pipeline stage components if any pipeline
+// stages are selected.
+//
+func RequiredLibraryFilesWithOptions(numClients uint, scalingFactor uint,
+	busAdaptorName string, options ArbitrationTreeOptions) ([]string, error) {
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		return nil, errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for arbitration tree", scalingFactor))
+	}
+
+	config, err := configureArbitrationTreeWithOptions(
+		"", numClients, scalingFactor, options)
+	if err != nil {
+		return nil, err
+	}
+	rootModules := getArbitrationTreeLibraryModules(config)
+	if busAdaptorName != "" {
+		rootModules = append(rootModules, busAdaptorName)
+	}
+	moduleNames, err := collectLibraryModules(rootModules)
+	if err != nil {
+		return nil, err
+	}
+	return moduleFileNames(moduleNames), nil
+}
+
+//
+// FuzzTestKernelFiles lists the Verilog source files which are required to
+// build a generated fuzz test kernel, in addition to the generated kernel
+// module itself. The first list contains the library files from the verilog/
+// directory and the second list contains the test component files from the
+// test/verilog/ directory. Returns the sorted lists of file names and an error
+// item which will be set to 'nil' on successful completion.
+//
+func FuzzTestKernelFiles() ([]string, []string, error) {
+	moduleNames, testModuleNames, err :=
+		collectLibraryAndTestModules(getFuzzTestKernelModules())
+	if err != nil {
+		return nil, nil, err
+	}
+	return moduleFileNames(moduleNames), moduleFileNames(testModuleNames), nil
+}
+
+//
+// ClockBridgeFiles lists the Verilog library source files from the verilog/
+// directory which are required to build the clock domain bridges used by
+// kernel adaptors with a separate memory clock. Returns the sorted list of
+// file names and an error item which will be set to 'nil' on successful
+// completion.
+//
+func ClockBridgeFiles() ([]string, error) {
+	moduleNames, err := collectLibraryModules([]string{"smiMemClockBridge"})
+	if err != nil {
+		return nil, err
+	}
+	return moduleFileNames(moduleNames), nil
+}
@@ -0,0 +1,306 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+)
+
+//
+// Specifies the library and test component directories relative to the
+// package directory.
+//
+const (
+	testLibraryDir = "../../../verilog"
+	testSourceDir  = "../../../test/verilog"
+)
+
+//
+// Lists the client counts used when linting the generated arbitration trees.
+//
+var testLintClientCounts = []uint{1, 2, 3, 4, 5, 8, 9, 17, 33, 64}
+
+//
+// Matches Verilator warning and error messages, extracting the message code
+// and source file name.
+//
+var verilatorMessageRegexp = regexp.MustCompile(
+	`^%(Warning|Error)(-([A-Z0-9_]+))?: ([^:]+):[0-9]+`)
+
+//
+// Lists the Verilator warning codes which are treated as lint failures when
+// reported against generated files. Width warnings use the WIDTH prefix, which
+// covers the split width codes used by recent Verilator releases.
+//
+var verilatorLintCodes = []string{"PINMISSING", "PINNOCONNECT",
+	"PINCONNECTEMPTY", "WIDTH", "UNOPTFLAT"}
+
+//
+// Specifies a set of generated files to be linted together.
+//
+type lintConfig struct {
+	topModule    string   // Name of the top level module.
+	sourceFiles  []string // Generated source files.
+	libraryFiles []string // Required files from the verilog/ directory.
+	testFiles    []string // Required files from the test/verilog/ directory.
+}
+
+//
+// Runs Verilator in lint only mode on a set of generated files together with
+// their required library files. Unconnected ports, width mismatches and
+// UNOPTFLAT warnings reported against the generated files are treated as
+// failures, as are any Verilator errors. The test is skipped if Verilator is
+// not installed.
+//
+func lintGeneratedVerilog(t *testing.T, config lintConfig) {
+	verilator, err := exec.LookPath("verilator")
+	if err != nil {
+		t.Skip("verilator not found on PATH")
+	}
+
+	args := []string{"--lint-only", "-Wno-fatal", "--report-unoptflat",
+		"-Wwarn-PINMISSING", "-Wwarn-PINCONNECTEMPTY", "-Wwarn-UNOPTFLAT",
+		"--top-module", config.topModule}
+	args = append(args, config.sourceFiles...)
+	for _, fileName := range config.libraryFiles {
+		args = append(args, filepath.Join(testLibraryDir, fileName))
+	}
+	for _, fileName := range config.testFiles {
+		args = append(args, filepath.Join(testSourceDir, fileName))
+	}
+	output, err := exec.Command(verilator, args...).CombinedOutput()
+
+	// Report the relevant messages. UNOPTFLAT may be reported against a
+	// library file if the combinatorial loop passes through generated code.
+	generated := make(map[string]bool)
+	for _, fileName := range config.sourceFiles {
+		generated[filepath.Base(fileName)] = true
+	}
+	for _, line := range strings.Split(string(output), "\n") {
+		match := verilatorMessageRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		level, code, fileName := match[1], match[3], filepath.Base(match[4])
+		if level == "Error" {
+			t.Error(line)
+			continue
+		}
+		for _, lintCode := range verilatorLintCodes {
+			if strings.HasPrefix(code, lintCode) &&
+				(generated[fileName] || (lintCode == "UNOPTFLAT")) {
+				t.Error(line)
+			}
+		}
+	}
+	if err != nil {
+		t.Errorf("verilator failed (%v):\n%s", err, output)
+	}
+}
+
+//
+// Builds the list of generated configurations to be linted, writing the
+// generated files to the specified directory. Only the SDAccel kernel adaptor
+// is linted, since the fuzz test kernel can be used in place of the user
+// kernel. The kernels for the other platforms are not available.
+//
+func makeLintConfigs(dir string) ([]lintConfig, error) {
+	configs := make([]lintConfig, 0)
+	for _, scalingFactor := range testScalingFactors {
+		for _, numClients := range testLintClientCounts {
+			moduleName := fmt.Sprintf("smiMemArbitrationTreeX%dS%d", numClients, scalingFactor)
+			fileName := filepath.Join(dir, moduleName+".v")
+			err := CreateArbitrationTree(fileName, moduleName, numClients, scalingFactor)
+			if err != nil {
+				return nil, err
+			}
+			libraryFiles, err := RequiredLibraryFiles(numClients, scalingFactor, "")
+			if err != nil {
+				return nil, err
+			}
+			configs = append(configs,
+				lintConfig{moduleName, []string{fileName}, libraryFiles, nil})
+		}
+	}
+
+	// Add a pipelined arbitration tree for a single representative
+	// configuration.
+	moduleName := "smiMemArbitrationTreeX17S2"
+	fileName := filepath.Join(dir, moduleName+"_pipelined.v")
+	err := CreateArbitrationTreeWithOptions(
+		fileName, moduleName, 17, 2, testPipelineOptions())
+	if err != nil {
+		return nil, err
+	}
+	libraryFiles, err := RequiredLibraryFilesWithOptions(
+		17, 2, "", testPipelineOptions())
+	if err != nil {
+		return nil, err
+	}
+	configs = append(configs,
+		lintConfig{moduleName, []string{fileName}, libraryFiles, nil})
+
+	fuzzLibraryFiles, fuzzTestFiles, err := FuzzTestKernelFiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, scalingFactor := range testScalingFactors {
+		for _, numClients := range testAdaptorClientCounts {
+			configDir := filepath.Join(dir, fmt.Sprintf("sdaccelX%dS%d", numClients, scalingFactor))
+			if err := os.Mkdir(configDir, 0755); err != nil {
+				return nil, err
+			}
+			treeName := fmt.Sprintf("smiMemArbitrationTreeX%dS%d", numClients, scalingFactor)
+			kernelName := fmt.Sprintf("teak__action__top__smi__x%d", numClients)
+			adaptorName := "teak__action__top__gmem"
+			sourceFiles := []string{filepath.Join(configDir, treeName+".v"),
+				filepath.Join(configDir, kernelName+".v"),
+				filepath.Join(configDir, adaptorName+".v")}
+			err := CreateArbitrationTree(sourceFiles[0], treeName, numClients, scalingFactor)
+			if err == nil {
+				err = CreateSmiFuzzTestKernel(sourceFiles[1], kernelName, numClients)
+			}
+			if err == nil {
+				err = CreateSmiSdaKernelAdaptor(sourceFiles[2], adaptorName,
+					kernelName, numClients, scalingFactor)
+			}
+			if err != nil {
+				return nil, err
+			}
+			libraryFiles, err := RequiredLibraryFiles(
+				numClients, scalingFactor, "smiAxiMemBusAdaptor")
+			if err != nil {
+				return nil, err
+			}
+			configs = append(configs, lintConfig{adaptorName, sourceFiles,
+				MergeLibraryFiles(libraryFiles, fuzzLibraryFiles), fuzzTestFiles})
+		}
+	}
+
+	// Add the clock domain crossing options for a single representative
+	// configuration.
+	bridgeLibraryFiles, err := ClockBridgeFiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, clockCrossing := range []string{ClockCrossingKernel, ClockCrossingRoot} {
+		configDir := filepath.Join(dir, "sdaccelX3S2_"+clockCrossing)
+		if err := os.Mkdir(configDir, 0755); err != nil {
+			return nil, err
+		}
+		treeName := "smiMemArbitrationTreeX3S2"
+		kernelName := "teak__action__top__smi__x3"
+		adaptorName := "teak__action__top__gmem"
+		sourceFiles := []string{filepath.Join(configDir, treeName+".v"),
+			filepath.Join(configDir, kernelName+".v"),
+			filepath.Join(configDir, adaptorName+".v")}
+		err := CreateArbitrationTree(sourceFiles[0], treeName, 3, 2)
+		if err == nil {
+			err = CreateSmiFuzzTestKernel(sourceFiles[1], kernelName, 3)
+		}
+		if err == nil {
+			err = CreateSmiSdaKernelAdaptorWithClockCrossing(sourceFiles[2],
+				adaptorName, kernelName, 3, 2, clockCrossing)
+		}
+		if err != nil {
+			return nil, err
+		}
+		libraryFiles, err := RequiredLibraryFiles(3, 2, "smiAxiMemBusAdaptor")
+		if err != nil {
+			return nil, err
+		}
+		libraryFiles = MergeLibraryFiles(libraryFiles, fuzzLibraryFiles)
+		configs = append(configs, lintConfig{adaptorName, sourceFiles,
+			MergeLibraryFiles(libraryFiles, bridgeLibraryFiles), fuzzTestFiles})
+	}
+	return configs, nil
+}
+
+//
+// Lints each generated configuration using Verilator.
+//
+func TestVerilatorLint(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping Verilator lint in short mode")
+	}
+	if _, err := exec.LookPath("verilator"); err != nil {
+		t.Skip("verilator not found on PATH")
+	}
+
+	dir, err := ioutil.TempDir("", "smiLint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	configs, err := makeLintConfigs(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, config := range configs {
+		config := config
+		name := strings.TrimPrefix(config.sourceFiles[0], dir+string(filepath.Separator))
+		t.Run(name, func(t *testing.T) {
+			lintGeneratedVerilog(t, config)
+		})
+	}
+}
+
+//
+// Checks that every library and test module listed in the dependency tables
+// has a matching source file, so that the lint configurations are complete.
+//
+func TestLibraryModuleFiles(t *testing.T) {
+	check := func(dir string, dependencies map[string][]string) {
+		moduleNames := make([]string, 0, len(dependencies))
+		for moduleName := range dependencies {
+			moduleNames = append(moduleNames, moduleName)
+		}
+		sort.Strings(moduleNames)
+		for _, moduleName := range moduleNames {
+			if _, err := os.Stat(filepath.Join(dir, moduleName+".v")); err != nil {
+				t.Error(err)
+			}
+		}
+	}
+	check(testLibraryDir, smiLibraryModuleDependencies)
+	check(testSourceDir, smiTestModuleDependencies)
+}
+
+//
+// Checks that merged library file lists are sorted and free of duplicates.
+//
+func TestMergeLibraryFiles(t *testing.T) {
+	merged := MergeLibraryFiles(
+		[]string{"smiFrameBuffer.v", "smiFlitScaleX2.v", "smiFrameBuffer.v"},
+		[]string{"smiMemClockBridge.v", "smiFlitScaleX2.v"})
+	expected := []string{"smiFlitScaleX2.v", "smiFrameBuffer.v", "smiMemClockBridge.v"}
+	if fmt.Sprint(merged) != fmt.Sprint(expected) {
+		t.Errorf("merged files %v, expected %v", merged, expected)
+	}
+	if merged := MergeLibraryFiles(nil, nil); len(merged) != 0 {
+		t.Errorf("merged empty lists to %v", merged)
+	}
+}
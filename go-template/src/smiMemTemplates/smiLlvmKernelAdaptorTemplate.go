@@ -37,6 +37,7 @@ type smiLlvmKernelAdaptorConfig struct {
 	SmiMemBusClientConns  []smiMemBusConnectionConfig // List of client side connections.
 	SmiMemBusServerConn   []smiMemBusConnectionConfig // Single server side connection.
 	SmiMemBusWireConns    []smiMemBusConnectionConfig // Internal wire connections.
+	ClockDomains          smiMemClockDomainConfig     // Kernel and memory clock domains.
 }
 
 //
@@ -111,10 +112,12 @@ module {{.ModuleName}} (
 
   // Specify system level signals.
   input          clk,
-  input          reset
+  input          reset{{if .ClockDomains.MemClockSeparate}},
+  input          memClk,
+  input          memReset{{end}}
 );
 {{template "smiMemBusConnectionWireList" .SmiMemBusWireConns}}
-// Concatenated SMI flit vectors. {{range .SmiMemBusClientConns}}
+// Concatenated SMI flit vectors. {{range .ClockDomains.SmiMemBusKernelConns}}
 wire [ 71:0] {{.SmiNetReqName}}Flit;
 wire [ 71:0] {{.SmiNetRespName}}Flit;{{end}}
 
@@ -122,7 +125,7 @@ wire [ 71:0] {{.SmiNetRespName}}Flit;{{end}}
 // Instantiate the SMI/AXI memory controller adaptor.
 //
 smiAxiMemBusAdaptor #({{.AxiByteIndexSize}}, {{.AxiBusIdWidth}}, 33) axiBusAdaptor (
-  {{with $wire := index .SmiMemBusServerConn 0}}
+  {{with $wire := index .ClockDomains.SmiMemBusAdaptorConn 0}}
   // Connect SMI main memory bus.
   .smiReqReady  ({{$wire.SmiNetReqName}}Ready),
   .smiReqEofc   ({{$wire.SmiNetReqName}}Eofc),
@@ -171,9 +174,9 @@ smiAxiMemBusAdaptor #({{.AxiByteIndexSize}}, {{.AxiBusIdWidth}}, 33) axiBusAdapt
   .axiBResp     (m_axi_gmem_bresp),
 
   // Connect system level signals.
-  .axiReset     (reset),  // TODO: This should be passed in.
-  .clk          (clk),
-  .srst         (reset)
+  .axiReset     ({{.ClockDomains.MemResetName}}),
+  .clk          ({{.ClockDomains.MemClockName}}),
+  .srst         ({{.ClockDomains.MemResetName}})
 );
 
 //
@@ -202,13 +205,16 @@ assign m_axi_gmem_wuser    = 1'b0;
 {{template "smiMemBusConnectionPortLink" .SmiMemBusServerConn}}
 
   // Connect system level signals.
-  .clk  (clk),
-  .srst (reset)
+  .clk  ({{.ClockDomains.TreeClockName}}),
+  .srst ({{.ClockDomains.TreeResetName}})
 );
-
+{{if .ClockDomains.SmiMemClockBridges}}
+//
+// Instantiate the clock domain bridges.
+//{{range .ClockDomains.SmiMemClockBridges}}{{template "smiMemClockBridge" .}}{{end}}{{end}}
 //
 // Map SMI flit vector signals.
-// {{range .SmiMemBusClientConns}}
+// {{range .ClockDomains.SmiMemBusKernelConns}}
 assign {{.SmiNetReqName}}Data  = {{.SmiNetReqName}}Flit [63:0];
 assign {{.SmiNetReqName}}Eofc  = {{.SmiNetReqName}}Flit [71:64];
 assign {{.SmiNetRespName}}Flit = { {{.SmiNetRespName}}Eofc, {{.SmiNetRespName}}Data };
@@ -226,7 +232,7 @@ assign {{.SmiNetRespName}}Flit = { {{.SmiNetRespName}}Eofc, {{.SmiNetRespName}}D
   .args0_0Stop    (argsStop),
   .retVal1_0Ready (retValReady),
   .retVal1_0Stop  (retValStop),
-{{range $index, $element := .SmiMemBusClientConns}}
+{{range $index, $element := .ClockDomains.SmiMemBusKernelConns}}
   // Connect SMI for {{$element.SmiNetReqName}}/{{$element.SmiNetRespName}}.
   {{makePortIdIndexName ".request%d_0Ready " $index 2 2}} ({{$element.SmiNetReqName}}Ready),
   {{makePortIdIndexName ".request%d_0Data  " $index 2 2}} ({{$element.SmiNetReqName}}Flit),
@@ -252,11 +258,14 @@ var smiLlvmKernelAdaptorCache *template.Template = nil
 // Implement lazy construction of the SMI LLVM kernel adaptor template.
 //
 func getSmiLlvmKernelAdaptorTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
 	if smiLlvmKernelAdaptorCache == nil {
 		templGroup := template.New("").Funcs(smiTemplateFunctions)
 		templGroup = template.Must(templGroup.Parse(smiMemBusFileHeaderTemplate))
 		templGroup = template.Must(templGroup.Parse(smiMemBusConnectionPortLinkTemplate))
 		templGroup = template.Must(templGroup.Parse(smiMemBusConnectionWireListTemplate))
+		templGroup = template.Must(templGroup.Parse(smiMemClockBridgeTemplate))
 		templGroup = template.Must(templGroup.Parse(smiLlvmKernelAdaptorTemplate))
 		smiLlvmKernelAdaptorCache = templGroup
 	}
@@ -265,11 +274,12 @@ func getSmiLlvmKernelAdaptorTemplate() *template.Template {
 
 //
 // Generates a common SMI LLVM kernel adaptor configuration given the supplied
-// parameters.
+// parameters. The clock domain crossing location must be one of the
+// ClockCrossing constants.
 //
 func configureSmiLlvmKernelAdaptor(moduleName string, kernelName string,
 	numPorts uint, scalingFactor uint, axiBusIdWidth uint,
-	kernelArgsWidth uint) (smiLlvmKernelAdaptorConfig, error) {
+	kernelArgsWidth uint, clockCrossing string) (smiLlvmKernelAdaptorConfig, error) {
 
 	var smiLlvmKernelAdaptor = smiLlvmKernelAdaptorConfig{}
 	smiLlvmKernelAdaptor.ModuleName = moduleName
@@ -305,6 +315,16 @@ func configureSmiLlvmKernelAdaptor(moduleName string, kernelName string,
 			smiLlvmKernelAdaptor.SmiMemBusWireConns, clientConn)
 	}
 
+	// Add the clock domain crossing, if required.
+	clockDomains, bridgeWireConns, err := configureSmiMemClockDomains(
+		clockCrossing, smiLlvmKernelAdaptor.SmiMemBusClientConns, serverConn)
+	if err != nil {
+		return smiLlvmKernelAdaptor, err
+	}
+	smiLlvmKernelAdaptor.ClockDomains = clockDomains
+	smiLlvmKernelAdaptor.SmiMemBusWireConns = append(
+		smiLlvmKernelAdaptor.SmiMemBusWireConns, bridgeWireConns...)
+
 	return smiLlvmKernelAdaptor, nil
 }
 
@@ -0,0 +1,175 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"errors"
+	"fmt"
+)
+
+//
+// Specifies the supported locations for the clock domain crossing between the
+// kernel clock and the memory clock. When no crossing is used, the kernel,
+// arbitration tree and memory bus adaptor all share the kernel clock. A kernel
+// side crossing places a clock domain bridge on each of the kernel SMI ports,
+// so that the arbitration tree runs on the memory clock. A root crossing
+// places a single clock domain bridge between the arbitration tree server
+// port and the memory bus adaptor, so that the arbitration tree runs on the
+// kernel clock.
+//
+const (
+	ClockCrossingNone   = "none"
+	ClockCrossingKernel = "kernel"
+	ClockCrossingRoot   = "root"
+)
+
+//
+// Defines the template configuration options for an SMI memory bus clock
+// domain bridge. The client side always runs on the kernel clock and the
+// server side always runs on the memory clock.
+//
+type smiMemClockBridgeConfig struct {
+	InstanceName        string                    // Name of the clock domain bridge instance.
+	SmiMemBusClientConn smiMemBusConnectionConfig // Kernel clock side connection.
+	SmiMemBusServerConn smiMemBusConnectionConfig // Memory clock side connection.
+}
+
+//
+// Defines the clock domain assignments for a kernel adaptor module. The kernel
+// always uses the kernel clock and the memory bus adaptor always uses the
+// memory clock, which will be the same as the kernel clock if no clock domain
+// crossing is used.
+//
+type smiMemClockDomainConfig struct {
+	MemClockSeparate     bool                        // Indicates whether a separate memory clock is used.
+	MemClockName         string                      // Name of the memory clock signal.
+	MemResetName         string                      // Name of the memory reset signal.
+	TreeClockName        string                      // Name of the arbitration tree clock signal.
+	TreeResetName        string                      // Name of the arbitration tree reset signal.
+	SmiMemBusKernelConns []smiMemBusConnectionConfig // Connections to the kernel SMI ports.
+	SmiMemBusAdaptorConn []smiMemBusConnectionConfig // Single memory bus adaptor connection.
+	SmiMemClockBridges   []smiMemClockBridgeConfig   // List of clock domain bridges.
+}
+
+//
+// Defines the template for instantiating SMI memory bus clock domain bridges.
+//
+var smiMemClockBridgeTemplate = `
+{{define "smiMemClockBridge"}}
+// Instantiate SMI clock domain bridge {{.InstanceName}}
+smiMemClockBridge #({{.SmiMemBusServerConn.SmiMemBusFlitWidth}}) {{.InstanceName}} (
+
+  .smiReqInReady   ({{.SmiMemBusClientConn.SmiNetReqName}}Ready),
+  .smiReqInEofc    ({{.SmiMemBusClientConn.SmiNetReqName}}Eofc),
+  .smiReqInData    ({{.SmiMemBusClientConn.SmiNetReqName}}Data),
+  .smiReqInStop    ({{.SmiMemBusClientConn.SmiNetReqName}}Stop),
+  .smiRespOutReady ({{.SmiMemBusClientConn.SmiNetRespName}}Ready),
+  .smiRespOutEofc  ({{.SmiMemBusClientConn.SmiNetRespName}}Eofc),
+  .smiRespOutData  ({{.SmiMemBusClientConn.SmiNetRespName}}Data),
+  .smiRespOutStop  ({{.SmiMemBusClientConn.SmiNetRespName}}Stop),
+
+  .smiReqOutReady  ({{.SmiMemBusServerConn.SmiNetReqName}}Ready),
+  .smiReqOutEofc   ({{.SmiMemBusServerConn.SmiNetReqName}}Eofc),
+  .smiReqOutData   ({{.SmiMemBusServerConn.SmiNetReqName}}Data),
+  .smiReqOutStop   ({{.SmiMemBusServerConn.SmiNetReqName}}Stop),
+  .smiRespInReady  ({{.SmiMemBusServerConn.SmiNetRespName}}Ready),
+  .smiRespInEofc   ({{.SmiMemBusServerConn.SmiNetRespName}}Eofc),
+  .smiRespInData   ({{.SmiMemBusServerConn.SmiNetRespName}}Data),
+  .smiRespInStop   ({{.SmiMemBusServerConn.SmiNetRespName}}Stop),
+
+  .clientClk       (clk),
+  .clientSrst      (reset),
+  .serverClk       (memClk),
+  .serverSrst      (memReset)
+);
+{{end}}`
+
+//
+// Checks that the specified clock domain crossing location is supported.
+//
+func validateClockCrossing(clockCrossing string) error {
+	switch clockCrossing {
+	case ClockCrossingNone, ClockCrossingKernel, ClockCrossingRoot:
+		return nil
+	default:
+		return errors.New(fmt.Sprintf(
+			"Invalid clock crossing (%s), expected %s, %s or %s", clockCrossing,
+			ClockCrossingNone, ClockCrossingKernel, ClockCrossingRoot))
+	}
+}
+
+//
+// Generates the clock domain configuration for a kernel adaptor, given the
+// arbitration tree client and server connections. Returns the clock domain
+// configuration and the list of additional internal wire connections which
+// are required by the clock domain bridges.
+//
+func configureSmiMemClockDomains(clockCrossing string,
+	clientConns []smiMemBusConnectionConfig,
+	serverConn smiMemBusConnectionConfig) (
+	smiMemClockDomainConfig, []smiMemBusConnectionConfig, error) {
+
+	var clockDomains = smiMemClockDomainConfig{}
+	wireConns := make([]smiMemBusConnectionConfig, 0)
+	if err := validateClockCrossing(clockCrossing); err != nil {
+		return clockDomains, wireConns, err
+	}
+	clockDomains.MemClockSeparate = (clockCrossing != ClockCrossingNone)
+	clockDomains.MemClockName = "clk"
+	clockDomains.MemResetName = "reset"
+	clockDomains.TreeClockName = "clk"
+	clockDomains.TreeResetName = "reset"
+	if clockDomains.MemClockSeparate {
+		clockDomains.MemClockName = "memClk"
+		clockDomains.MemResetName = "memReset"
+	}
+	clockDomains.SmiMemBusKernelConns = clientConns
+	clockDomains.SmiMemBusAdaptorConn = []smiMemBusConnectionConfig{serverConn}
+	clockDomains.SmiMemClockBridges = make([]smiMemClockBridgeConfig, 0)
+
+	switch clockCrossing {
+
+	// Insert a clock domain bridge on each of the kernel SMI ports, with the
+	// arbitration tree running on the memory clock.
+	case ClockCrossingKernel:
+		clockDomains.TreeClockName = clockDomains.MemClockName
+		clockDomains.TreeResetName = clockDomains.MemResetName
+		clockDomains.SmiMemBusKernelConns = make([]smiMemBusConnectionConfig, 0)
+		for i, clientConn := range clientConns {
+			kernelConn := smiMemBusConnectionConfig{
+				fmt.Sprintf("smiMemKernelReq%d", i),
+				fmt.Sprintf("smiMemKernelResp%d", i), clientConn.SmiMemBusFlitWidth}
+			clockDomains.SmiMemBusKernelConns = append(
+				clockDomains.SmiMemBusKernelConns, kernelConn)
+			clockDomains.SmiMemClockBridges = append(clockDomains.SmiMemClockBridges,
+				smiMemClockBridgeConfig{
+					fmt.Sprintf("memClockBridge%d", i), kernelConn, clientConn})
+			wireConns = append(wireConns, kernelConn)
+		}
+
+	// Insert a single clock domain bridge between the arbitration tree server
+	// port and the memory bus adaptor.
+	case ClockCrossingRoot:
+		adaptorConn := smiMemBusConnectionConfig{
+			"smiMemAdaptorReq", "smiMemAdaptorResp", serverConn.SmiMemBusFlitWidth}
+		clockDomains.SmiMemBusAdaptorConn[0] = adaptorConn
+		clockDomains.SmiMemClockBridges = append(clockDomains.SmiMemClockBridges,
+			smiMemClockBridgeConfig{"memClockBridge", serverConn, adaptorConn})
+		wireConns = append(wireConns, adaptorConn)
+	}
+	return clockDomains, wireConns, nil
+}
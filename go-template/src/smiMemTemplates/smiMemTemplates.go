@@ -41,6 +41,17 @@ import (
 //
 func CreateArbitrationTree(fileName string, moduleName string, numClients uint,
 	scalingFactor uint) error {
+	return CreateArbitrationTreeWithOptions(fileName, moduleName, numClients,
+		scalingFactor, DefaultArbitrationTreeOptions())
+}
+
+//
+// CreateArbitrationTreeWithOptions is the same as CreateArbitrationTree, with
+// the arbiter FIFO settings being specified by the 'options' parameter instead
+// of using the default settings.
+//
+func CreateArbitrationTreeWithOptions(fileName string, moduleName string,
+	numClients uint, scalingFactor uint, options ArbitrationTreeOptions) error {
 
 	var outFile *os.File
 	var config arbitrationTreeConfig
@@ -62,7 +73,8 @@ func CreateArbitrationTree(fileName string, moduleName string, numClients uint,
 	defer outFile.Close()
 
 	// Set up the template configuration.
-	config, err = configureArbitrationTree(moduleName, numClients, scalingFactor)
+	config, err = configureArbitrationTreeWithOptions(
+		moduleName, numClients, scalingFactor, options)
 	if err != nil {
 		return err
 	}
@@ -84,6 +96,21 @@ func CreateArbitrationTree(fileName string, moduleName string, numClients uint,
 //
 func CreateSmiSdaKernelAdaptor(fileName string, moduleName string,
 	kernelName string, numClients uint, scalingFactor uint) error {
+	return CreateSmiSdaKernelAdaptorWithClockCrossing(fileName, moduleName,
+		kernelName, numClients, scalingFactor, ClockCrossingNone)
+}
+
+//
+// CreateSmiSdaKernelAdaptorWithClockCrossing is the same as
+// CreateSmiSdaKernelAdaptor, with the location of the clock domain crossing
+// between the kernel and memory clocks being specified by the 'clockCrossing'
+// parameter. This must be one of the ClockCrossing constants. If a clock
+// domain crossing is used, the adaptor has additional 'memClk' and 'memReset'
+// ports which are used for the AXI memory interface.
+//
+func CreateSmiSdaKernelAdaptorWithClockCrossing(fileName string,
+	moduleName string, kernelName string, numClients uint,
+	scalingFactor uint, clockCrossing string) error {
 
 	var outFile *os.File
 	var config smiSdaKernelAdaptorConfig
@@ -97,6 +124,12 @@ func CreateSmiSdaKernelAdaptor(fileName string, moduleName string,
 		return err
 	}
 
+	// Check for valid clock domain crossing.
+	err = validateClockCrossing(clockCrossing)
+	if err != nil {
+		return err
+	}
+
 	// Attempt to open the specified file for output.
 	outFile, err = os.Create(fileName)
 	if err != nil {
@@ -106,7 +139,7 @@ func CreateSmiSdaKernelAdaptor(fileName string, moduleName string,
 
 	// Set up the template configuration.
 	config, err = configureSmiSdaKernelAdaptor(
-		moduleName, kernelName, numClients, scalingFactor)
+		moduleName, kernelName, numClients, scalingFactor, clockCrossing)
 	if err != nil {
 		return err
 	}
@@ -131,6 +164,23 @@ func CreateSmiSdaKernelAdaptor(fileName string, moduleName string,
 func CreateSmiLlvmKernelAdaptor(fileName string, moduleName string,
 	kernelName string, numClients uint, scalingFactor uint,
 	axiIdBusWidth uint, kernelArgsWidth uint) error {
+	return CreateSmiLlvmKernelAdaptorWithClockCrossing(fileName, moduleName,
+		kernelName, numClients, scalingFactor, axiIdBusWidth, kernelArgsWidth,
+		ClockCrossingNone)
+}
+
+//
+// CreateSmiLlvmKernelAdaptorWithClockCrossing is the same as
+// CreateSmiLlvmKernelAdaptor, with the location of the clock domain crossing
+// between the kernel and memory clocks being specified by the 'clockCrossing'
+// parameter. This must be one of the ClockCrossing constants. If a clock
+// domain crossing is used, the adaptor has additional 'memClk' and 'memReset'
+// ports which are used for the AXI memory interface.
+//
+func CreateSmiLlvmKernelAdaptorWithClockCrossing(fileName string,
+	moduleName string, kernelName string, numClients uint,
+	scalingFactor uint, axiIdBusWidth uint, kernelArgsWidth uint,
+	clockCrossing string) error {
 
 	var outFile *os.File
 	var config smiLlvmKernelAdaptorConfig
@@ -144,6 +194,12 @@ func CreateSmiLlvmKernelAdaptor(fileName string, moduleName string,
 		return err
 	}
 
+	// Check for valid clock domain crossing.
+	err = validateClockCrossing(clockCrossing)
+	if err != nil {
+		return err
+	}
+
 	// Attempt to open the specified file for output.
 	outFile, err = os.Create(fileName)
 	if err != nil {
@@ -153,7 +209,7 @@ func CreateSmiLlvmKernelAdaptor(fileName string, moduleName string,
 
 	// Set up the template configuration.
 	config, err = configureSmiLlvmKernelAdaptor(moduleName, kernelName,
-		numClients, scalingFactor, axiIdBusWidth, kernelArgsWidth)
+		numClients, scalingFactor, axiIdBusWidth, kernelArgsWidth, clockCrossing)
 	if err != nil {
 		return err
 	}
@@ -205,3 +261,685 @@ func CreateSmiFp1KernelAdaptor(fileName string, moduleName string,
 	// Generate the Verilog file.
 	return executeSmiFp1KernelAdaptorTemplate(outFile, config)
 }
+
+//
+// CreateSmiAvalonKernelAdaptor generates a configurable SMI kernel adaptor for
+// Intel devices with Avalon-MM memory controller interfaces. It writes the
+// module source code to the Verilog source file specified by the 'fileName'
+// parameter using the Verilog module name specified by the 'moduleName'
+// parameter. The wrapper supports the number of independent SMI memory access
+// ports specified by the 'numClients' parameter and the internal bus scaling
+// specfied by the 'scalingFactor' parameter. Scaling factors of 1, 2, 4 and 8
+// are supported, giving Avalon-MM data widths of 64, 128, 256 and 512 bits.
+// The number of 32-bit kernel argument words are specified by the
+// 'kernelArgsWidth' parameter. Avalon-MM response errors are forwarded to the
+// kernel, with write responses being enabled via the 'AvmWriteResponseEnable'
+// parameter of the generated module. Returns an error item which will be set
+// to 'nil' on successful completion.
+//
+func CreateSmiAvalonKernelAdaptor(fileName string, moduleName string,
+	kernelName string, numClients uint, scalingFactor uint,
+	kernelArgsWidth uint) error {
+
+	var outFile *os.File
+	var config smiAvalonKernelAdaptorConfig
+	var err error
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		err = errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for kernel adaptor", scalingFactor))
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Set up the template configuration.
+	config, err = configureSmiAvalonKernelAdaptor(moduleName, kernelName,
+		numClients, scalingFactor, kernelArgsWidth)
+	if err != nil {
+		return err
+	}
+
+	// Generate the Verilog file.
+	return executeSmiAvalonKernelAdaptorTemplate(outFile, config)
+}
+
+//
+// CreateSmiSdaKernelXml generates the Vitis/XRT RTL kernel description file
+// for the SMI kernel adaptor generated by CreateSmiSdaKernelAdaptor with the
+// same 'moduleName', 'kernelName', 'numClients' and 'scalingFactor'
+// parameters. This describes the m_axi_gmem and s_axi_control interfaces and
+// the control register offsets for the number of 32-bit kernel argument words
+// specified by the 'kernelArgsWidth' parameter. The kernel description is
+// written to the file specified by the 'fileName' parameter. Returns an error
+// item which will be set to 'nil' on successful completion.
+//
+func CreateSmiSdaKernelXml(fileName string, moduleName string,
+	kernelName string, numClients uint, scalingFactor uint,
+	kernelArgsWidth uint) error {
+
+	var outFile *os.File
+	var config smiSdaKernelPackageConfig
+	var err error
+
+	// Set up the template configuration.
+	config, err = configureSmiSdaKernelPackageFromParams(moduleName,
+		kernelName, numClients, scalingFactor, kernelArgsWidth, ClockCrossingNone)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Generate the XML file.
+	return executeSmiSdaKernelXmlTemplate(outFile, config)
+}
+
+//
+// CreateSmiSdaComponentXml generates the IP-XACT component description file
+// for the SMI kernel adaptor generated by CreateSmiSdaKernelAdaptor with the
+// same 'moduleName', 'kernelName', 'numClients' and 'scalingFactor'
+// parameters. This describes the adaptor bus interfaces and top level ports.
+// The 'kernelArgsWidth' parameter specifies the number of 32-bit kernel
+// argument words. The component description is written to the file specified
+// by the 'fileName' parameter. Returns an error item which will be set to
+// 'nil' on successful completion.
+//
+func CreateSmiSdaComponentXml(fileName string, moduleName string,
+	kernelName string, numClients uint, scalingFactor uint,
+	kernelArgsWidth uint) error {
+	return CreateSmiSdaComponentXmlWithClockCrossing(fileName, moduleName,
+		kernelName, numClients, scalingFactor, kernelArgsWidth, ClockCrossingNone)
+}
+
+//
+// CreateSmiSdaComponentXmlWithClockCrossing is the same as
+// CreateSmiSdaComponentXml, for the SMI kernel adaptor generated by
+// CreateSmiSdaKernelAdaptorWithClockCrossing with the same 'clockCrossing'
+// parameter. If a clock domain crossing is used, the m_axi_gmem interface is
+// associated with the 'memClk' clock and 'memReset' reset interfaces.
+//
+func CreateSmiSdaComponentXmlWithClockCrossing(fileName string,
+	moduleName string, kernelName string, numClients uint,
+	scalingFactor uint, kernelArgsWidth uint, clockCrossing string) error {
+
+	var outFile *os.File
+	var config smiSdaKernelPackageConfig
+	var err error
+
+	// Set up the template configuration.
+	config, err = configureSmiSdaKernelPackageFromParams(moduleName,
+		kernelName, numClients, scalingFactor, kernelArgsWidth, clockCrossing)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Generate the XML file.
+	return executeSmiSdaComponentXmlTemplate(outFile, config)
+}
+
+//
+// Derives the SDAccel kernel packaging configuration from the kernel adaptor
+// parameters.
+//
+func configureSmiSdaKernelPackageFromParams(moduleName string,
+	kernelName string, numClients uint, scalingFactor uint,
+	kernelArgsWidth uint, clockCrossing string) (smiSdaKernelPackageConfig, error) {
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		return smiSdaKernelPackageConfig{}, errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for kernel adaptor", scalingFactor))
+	}
+
+	adaptorConfig, err := configureSmiSdaKernelAdaptor(
+		moduleName, kernelName, numClients, scalingFactor, clockCrossing)
+	if err != nil {
+		return smiSdaKernelPackageConfig{}, err
+	}
+	return configureSmiSdaKernelPackage(adaptorConfig, kernelArgsWidth)
+}
+
+//
+// CreateVivadoPackageScript generates a Tcl script which packages an AXI based
+// SMI kernel adaptor as a Vivado IP. The generated source files listed in the
+// 'sourceFiles' parameter are added to the IP together with the library files
+// required for the number of SMI client endpoints specified by the
+// 'numClients' parameter and the bus scaling specified by the 'scalingFactor'
+// parameter. The top level module is specified by the 'moduleName' parameter.
+// The AXI master interface is configured using the AXI ID bus width specified
+// by the 'axiIdBusWidth' parameter and an AXI control slave interface will be
+// configured if the 'axiControlSlave' parameter is set. The library and test
+// files required by a generated fuzz test kernel are also added if the
+// 'fuzzTestKernel' parameter is set. The script is written to the file
+// specified by the 'fileName' parameter. Returns an error item which will be
+// set to 'nil' on successful completion.
+//
+func CreateVivadoPackageScript(fileName string, moduleName string,
+	sourceFiles []string, numClients uint, scalingFactor uint,
+	axiIdBusWidth uint, axiControlSlave bool, fuzzTestKernel bool) error {
+	return CreateVivadoPackageScriptWithClockCrossing(fileName, moduleName,
+		sourceFiles, numClients, scalingFactor, axiIdBusWidth, axiControlSlave,
+		fuzzTestKernel, ClockCrossingNone)
+}
+
+//
+// CreateVivadoPackageScriptWithClockCrossing is the same as
+// CreateVivadoPackageScript, for a kernel adaptor which uses the clock domain
+// crossing specified by the 'clockCrossing' parameter. If a clock domain
+// crossing is used, the clock domain bridge library files are added to the IP
+// and the AXI memory master interface is associated with the 'memClk' clock
+// and 'memReset' reset ports.
+//
+func CreateVivadoPackageScriptWithClockCrossing(fileName string,
+	moduleName string, sourceFiles []string, numClients uint,
+	scalingFactor uint, axiIdBusWidth uint, axiControlSlave bool,
+	fuzzTestKernel bool, clockCrossing string) error {
+	return CreateVivadoPackageScriptWithOptions(fileName, moduleName,
+		sourceFiles, numClients, scalingFactor, axiIdBusWidth, axiControlSlave,
+		fuzzTestKernel, clockCrossing, DefaultArbitrationTreeOptions())
+}
+
+//
+// CreateVivadoPackageScriptWithOptions is the same as
+// CreateVivadoPackageScriptWithClockCrossing, for an arbitration tree which
+// was generated using the options specified by the 'options' parameter. Any
+// library files required by the selected pipeline stages are added to the IP.
+//
+func CreateVivadoPackageScriptWithOptions(fileName string,
+	moduleName string, sourceFiles []string, numClients uint,
+	scalingFactor uint, axiIdBusWidth uint, axiControlSlave bool,
+	fuzzTestKernel bool, clockCrossing string, options ArbitrationTreeOptions) error {
+
+	var outFile *os.File
+	var config smiVivadoPackageConfig
+	var err error
+
+	// Set up the template configuration.
+	config, err = configureSmiVivadoPackage(moduleName, sourceFiles,
+		numClients, scalingFactor, axiIdBusWidth, axiControlSlave, fuzzTestKernel,
+		clockCrossing, options)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Generate the Tcl file.
+	return executeSmiVivadoPackageTemplate(outFile, config)
+}
+
+//
+// CreateSmiFuzzTestKernel generates an SMI memory fuzz test kernel which may be
+// used in place of the user kernel with the SDAccel kernel adaptor generated
+// by CreateSmiSdaKernelAdaptor. A burst fuzz tester is connected to each of
+// the SMI memory ports specified by the 'numClients' parameter, with each
+// fuzz tester using a different random seed and testing a separate window of
+// the memory under test. The kernel arguments are read from the control
+// register map using the layout described by FuzzTestKernelArgsWidth. This
+// writes the module source code to the Verilog source file specified by the
+// 'fileName' parameter and using the module name specified by the
+// 'moduleName' parameter. Returns an error item which will be set to 'nil' on
+// successful completion.
+//
+func CreateSmiFuzzTestKernel(fileName string, moduleName string,
+	numClients uint) error {
+
+	var outFile *os.File
+	var config smiFuzzTestKernelConfig
+	var err error
+
+	// Set up the template configuration.
+	config, err = configureSmiFuzzTestKernel(moduleName, numClients)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Generate the Verilog file.
+	return executeSmiFuzzTestKernelTemplate(outFile, config)
+}
+
+//
+// CreateVerilatorHarness generates a Verilator C++ simulation harness for the
+// AXI based kernel adaptor specified by the 'moduleName' parameter, as
+// generated for the target platform specified by the 'platform' parameter.
+// The harness drives the clock, reset and kernel control signals, supplies
+// the number of 32-bit kernel argument words specified by 'kernelArgsWidth'
+// and attaches a behavioural AXI memory model with the data width given by
+// the 'scalingFactor' parameter. Default simulation arguments for the fuzz
+// test kernel with 'numClients' SMI ports are used if the 'fuzzTestKernel'
+// parameter is set. The harness is written to the C++ source file specified
+// by the 'fileName' parameter. Returns an error item which will be set to
+// 'nil' on successful completion.
+//
+func CreateVerilatorHarness(fileName string, moduleName string,
+	platform string, numClients uint, scalingFactor uint,
+	kernelArgsWidth uint, fuzzTestKernel bool) error {
+
+	var outFile *os.File
+	var config smiVerilatorHarnessConfig
+	var err error
+
+	// Set up the template configuration.
+	config, err = configureSmiVerilatorHarness(moduleName, platform, nil,
+		numClients, scalingFactor, kernelArgsWidth, fuzzTestKernel)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Generate the C++ file.
+	return executeSmiVerilatorHarnessTemplate(outFile, config)
+}
+
+//
+// CreateVerilatorHarnessMakefile generates a Makefile fragment which builds
+// and runs the simulation harness generated by CreateVerilatorHarness with
+// the same parameters. The generated Verilog files listed in the
+// 'sourceFiles' parameter are compiled together with the harness, using the
+// SMI library directory to resolve library modules. The fragment is written
+// to the file specified by the 'fileName' parameter, which should be named
+// '<moduleName>_harness.mk'. Returns an error item which will be set to 'nil'
+// on successful completion.
+//
+func CreateVerilatorHarnessMakefile(fileName string, moduleName string,
+	platform string, sourceFiles []string, numClients uint,
+	scalingFactor uint, kernelArgsWidth uint, fuzzTestKernel bool) error {
+
+	var outFile *os.File
+	var config smiVerilatorHarnessConfig
+	var err error
+
+	// Set up the template configuration.
+	config, err = configureSmiVerilatorHarness(moduleName, platform,
+		sourceFiles, numClients, scalingFactor, kernelArgsWidth, fuzzTestKernel)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Generate the Makefile fragment.
+	return executeSmiVerilatorMakefileTemplate(outFile, config)
+}
+
+//
+// CreateSmiSvInterfaces generates the SystemVerilog interface declarations
+// which are used by the SystemVerilog output style. This includes the 'smi_if'
+// SMI memory bus interface and the 'axi4_if' AXI4 memory bus interface. Writes
+// the interface source code to the SystemVerilog source file specified by the
+// 'fileName' parameter. Returns an error item which will be set to 'nil' on
+// successful completion.
+//
+func CreateSmiSvInterfaces(fileName string) error {
+
+	// Attempt to open the specified file for output.
+	outFile, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Generate the SystemVerilog file.
+	return executeSmiSvTemplate(outFile, "smiSvInterfaces", nil)
+}
+
+//
+// CreateArbitrationTreeSv generates an SMI memory arbitration tree module using
+// the SystemVerilog output style, where each SMI memory bus connection is
+// represented by an 'smi_if' interface port. The arbitration tree structure is
+// identical to that generated by CreateArbitrationTree for the same
+// 'numClients' and 'scalingFactor' parameters. This writes the module source
+// code to the SystemVerilog source file specified by the 'fileName' parameter
+// and using the module name specified by the 'moduleName' parameter. Returns
+// an error item which will be set to 'nil' on successful completion.
+//
+func CreateArbitrationTreeSv(fileName string, moduleName string,
+	numClients uint, scalingFactor uint) error {
+	return CreateArbitrationTreeSvWithOptions(fileName, moduleName, numClients,
+		scalingFactor, DefaultArbitrationTreeOptions())
+}
+
+//
+// CreateArbitrationTreeSvWithOptions is the same as CreateArbitrationTreeSv,
+// with the arbiter FIFO settings being specified by the 'options' parameter
+// instead of using the default settings.
+//
+func CreateArbitrationTreeSvWithOptions(fileName string, moduleName string,
+	numClients uint, scalingFactor uint, options ArbitrationTreeOptions) error {
+
+	var outFile *os.File
+	var config arbitrationTreeConfig
+	var err error
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		err = errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for arbitration tree", scalingFactor))
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Set up the template configuration.
+	config, err = configureArbitrationTreeWithOptions(
+		moduleName, numClients, scalingFactor, options)
+	if err != nil {
+		return err
+	}
+
+	// Generate the SystemVerilog file.
+	return executeSmiSvTemplate(outFile, "smiSvMemBusArbitrationTree", config)
+}
+
+//
+// CreateSmiSdaKernelAdaptorSv generates a configurable SMI kernel adaptor for
+// the standard SDAccel build process using the SystemVerilog output style. The
+// AXI memory master is exposed as an 'axi4_if' interface port named
+// 'm_axi_gmem' and the arbitration tree must also be generated using the
+// SystemVerilog output style. The parameters are the same as for
+// CreateSmiSdaKernelAdaptor. Returns an error item which will be set to 'nil'
+// on successful completion.
+//
+func CreateSmiSdaKernelAdaptorSv(fileName string, moduleName string,
+	kernelName string, numClients uint, scalingFactor uint) error {
+
+	var outFile *os.File
+	var config smiSdaKernelAdaptorConfig
+	var err error
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		err = errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for kernel adaptor", scalingFactor))
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Set up the template configuration.
+	config, err = configureSmiSdaKernelAdaptor(
+		moduleName, kernelName, numClients, scalingFactor, ClockCrossingNone)
+	if err != nil {
+		return err
+	}
+
+	// Generate the SystemVerilog file.
+	return executeSmiSvTemplate(outFile, "smiSvSdaKernelAdaptor", config)
+}
+
+//
+// CreateSmiLlvmKernelAdaptorSv generates a configurable SMI kernel adaptor for
+// generic LLVM kernel wrappers using the SystemVerilog output style. The AXI
+// memory master is exposed as an 'axi4_if' interface port named 'm_axi_gmem'
+// and the arbitration tree must also be generated using the SystemVerilog
+// output style. The parameters are the same as for CreateSmiLlvmKernelAdaptor.
+// Returns an error item which will be set to 'nil' on successful completion.
+//
+func CreateSmiLlvmKernelAdaptorSv(fileName string, moduleName string,
+	kernelName string, numClients uint, scalingFactor uint,
+	axiIdBusWidth uint, kernelArgsWidth uint) error {
+
+	var outFile *os.File
+	var config smiLlvmKernelAdaptorConfig
+	var err error
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		err = errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for kernel adaptor", scalingFactor))
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Set up the template configuration.
+	config, err = configureSmiLlvmKernelAdaptor(moduleName, kernelName,
+		numClients, scalingFactor, axiIdBusWidth, kernelArgsWidth, ClockCrossingNone)
+	if err != nil {
+		return err
+	}
+
+	// Generate the SystemVerilog file.
+	return executeSmiSvTemplate(outFile, "smiSvLlvmKernelAdaptor", config)
+}
+
+//
+// CreateSmiFp1KernelAdaptorSv generates a configurable SMI kernel adaptor for
+// the Huawei FP1 build process using the SystemVerilog output style. The AXI
+// memory master is exposed as an 'axi4_if' interface port named 'm_axi_gmem'
+// and the arbitration tree must also be generated using the SystemVerilog
+// output style. The parameters are the same as for CreateSmiFp1KernelAdaptor.
+// Returns an error item which will be set to 'nil' on successful completion.
+//
+func CreateSmiFp1KernelAdaptorSv(fileName string, moduleName string,
+	kernelName string, numClients uint, scalingFactor uint) error {
+
+	var outFile *os.File
+	var config smiFp1KernelAdaptorConfig
+	var err error
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		err = errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for kernel adaptor", scalingFactor))
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Set up the template configuration.
+	config, err = configureSmiFp1KernelAdaptor(
+		moduleName, kernelName, numClients, scalingFactor)
+	if err != nil {
+		return err
+	}
+
+	// Generate the SystemVerilog file.
+	return executeSmiSvTemplate(outFile, "smiSvFp1KernelAdaptor", config)
+}
+
+//
+// CreateArbitrationTreeVhdl generates a VHDL entity and architecture for the
+// SMI memory arbitration tree. The arbitration tree structure is identical to
+// that generated by CreateArbitrationTree for the same 'numClients' and
+// 'scalingFactor' parameters, with the Verilog library modules being
+// instantiated as VHDL components for use in mixed language flows. This writes
+// the source code to the VHDL source file specified by the 'fileName'
+// parameter and using the entity name specified by the 'moduleName' parameter.
+// Returns an error item which will be set to 'nil' on successful completion.
+//
+func CreateArbitrationTreeVhdl(fileName string, moduleName string,
+	numClients uint, scalingFactor uint) error {
+	return CreateArbitrationTreeVhdlWithOptions(fileName, moduleName, numClients,
+		scalingFactor, DefaultArbitrationTreeOptions())
+}
+
+//
+// CreateArbitrationTreeVhdlWithOptions is the same as
+// CreateArbitrationTreeVhdl, with the arbiter FIFO settings being specified by
+// the 'options' parameter instead of using the default settings.
+//
+func CreateArbitrationTreeVhdlWithOptions(fileName string, moduleName string,
+	numClients uint, scalingFactor uint, options ArbitrationTreeOptions) error {
+
+	var outFile *os.File
+	var config smiVhdlArbitrationTreeConfig
+	var err error
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		err = errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for arbitration tree", scalingFactor))
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Set up the template configuration.
+	config, err = configureVhdlArbitrationTree(
+		moduleName, numClients, scalingFactor, options)
+	if err != nil {
+		return err
+	}
+
+	// Generate the VHDL file.
+	return executeSmiVhdlTemplate(outFile, "smiVhdlArbitrationTree", config)
+}
+
+//
+// CreateSmiSdaKernelAdaptorVhdl generates a VHDL version of the configurable
+// SMI kernel adaptor for the standard SDAccel build process. The kernel and
+// Verilog library modules are instantiated as VHDL components and the
+// arbitration tree must also be generated as VHDL. The parameters are the
+// same as for CreateSmiSdaKernelAdaptor. Returns an error item which will be
+// set to 'nil' on successful completion.
+//
+func CreateSmiSdaKernelAdaptorVhdl(fileName string, moduleName string,
+	kernelName string, numClients uint, scalingFactor uint) error {
+
+	var outFile *os.File
+	var config smiVhdlKernelAdaptorConfig
+	var err error
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		err = errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for kernel adaptor", scalingFactor))
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Set up the template configuration.
+	config, err = configureVhdlSdaKernelAdaptor(
+		moduleName, kernelName, numClients, scalingFactor)
+	if err != nil {
+		return err
+	}
+
+	// Generate the VHDL file.
+	return executeSmiVhdlTemplate(outFile, "smiVhdlKernelAdaptor", config)
+}
+
+//
+// CreateSmiLlvmKernelAdaptorVhdl generates a VHDL version of the configurable
+// SMI kernel adaptor for generic LLVM kernel wrappers. The kernel and Verilog
+// library modules are instantiated as VHDL components and the arbitration
+// tree must also be generated as VHDL. The kernel argument data connection is
+// enabled using the 'KernelArgsData' generic. The parameters are the same as
+// for CreateSmiLlvmKernelAdaptor. Returns an error item which will be set to
+// 'nil' on successful completion.
+//
+func CreateSmiLlvmKernelAdaptorVhdl(fileName string, moduleName string,
+	kernelName string, numClients uint, scalingFactor uint,
+	axiIdBusWidth uint, kernelArgsWidth uint) error {
+
+	var outFile *os.File
+	var config smiVhdlKernelAdaptorConfig
+	var err error
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		err = errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for kernel adaptor", scalingFactor))
+		return err
+	}
+
+	// Attempt to open the specified file for output.
+	outFile, err = os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer outFile.Close()
+
+	// Set up the template configuration.
+	config, err = configureVhdlLlvmKernelAdaptor(moduleName, kernelName,
+		numClients, scalingFactor, axiIdBusWidth, kernelArgsWidth)
+	if err != nil {
+		return err
+	}
+
+	// Generate the VHDL file.
+	return executeSmiVhdlTemplate(outFile, "smiVhdlKernelAdaptor", config)
+}
@@ -0,0 +1,561 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+//
+// Regenerates the golden output files instead of checking against them. Run
+// using 'go test -run TestGoldenOutput -update' and review the resulting diffs.
+//
+var updateGolden = flag.Bool("update", false, "regenerate the golden output files")
+
+//
+// Specifies the directory containing the golden output files.
+//
+const goldenDir = "testdata/golden"
+
+//
+// Specifies the fixed timestamp used in the generated file headers.
+//
+var goldenTimestamp = time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+//
+// Lists the supported bus width scaling factors.
+//
+var testScalingFactors = []uint{1, 2, 4, 8}
+
+//
+// Specifies the arbitration tree options used for the pipelined arbitration
+// tree tests. These select pipeline stages by client, by layer and by arbiter
+// fan in, for trees with at least six clients.
+//
+func testPipelineOptions() ArbitrationTreeOptions {
+	options := DefaultArbitrationTreeOptions()
+	options.PipelineClients = []uint{0, 5}
+	options.PipelineLayers = []uint{0}
+	options.PipelineFanIn = 2
+	return options
+}
+
+//
+// Lists the client counts used for the kernel adaptor golden files. The
+// adaptor templates repeat the same client connections for each SMI port, so
+// these cover the single client case, odd and power of two client counts and
+// the maximum client count. The generated adaptors for all other client
+// counts are checked by TestAdaptorClientCounts.
+//
+var testAdaptorClientCounts = []uint{1, 3, 8, testMaxClients}
+
+//
+// Specifies a single golden output test case.
+//
+type goldenTestCase struct {
+	name     string                      // Golden file path relative to goldenDir.
+	generate func(fileName string) error // Generates the output file.
+}
+
+//
+// Builds the table of golden output test cases. The arbitration tree is
+// tested for every client count and bus width, with a single pipelined
+// arbitration tree being tested for each output style. The kernel adaptors
+// are tested for every platform and bus width, with the clock domain crossing
+// options, the SystemVerilog and VHDL output styles and the platform
+// packaging and simulation files being tested for a single representative
+// configuration.
+//
+func makeGoldenTestCases() []goldenTestCase {
+	testCases := make([]goldenTestCase, 0)
+	addTree := func(numClients uint, scalingFactor uint) {
+		moduleName := fmt.Sprintf("smiMemArbitrationTreeX%dS%d", numClients, scalingFactor)
+		testCases = append(testCases, goldenTestCase{"tree/" + moduleName + ".v",
+			func(fileName string) error {
+				return CreateArbitrationTree(fileName, moduleName, numClients, scalingFactor)
+			}})
+	}
+	for _, scalingFactor := range testScalingFactors {
+		for numClients := uint(1); numClients <= testMaxClients; numClients++ {
+			addTree(numClients, scalingFactor)
+		}
+	}
+	for _, fileExt := range []string{"v", "sv", "vhd"} {
+		fileExt := fileExt
+		moduleName := "smiMemArbitrationTreeX17S2"
+		testCases = append(testCases, goldenTestCase{
+			"tree/" + moduleName + "_pipelined." + fileExt,
+			func(fileName string) error {
+				switch fileExt {
+				case "sv":
+					return CreateArbitrationTreeSvWithOptions(
+						fileName, moduleName, 17, 2, testPipelineOptions())
+				case "vhd":
+					return CreateArbitrationTreeVhdlWithOptions(
+						fileName, moduleName, 17, 2, testPipelineOptions())
+				default:
+					return CreateArbitrationTreeWithOptions(
+						fileName, moduleName, 17, 2, testPipelineOptions())
+				}
+			}})
+	}
+
+	for _, scalingFactor := range testScalingFactors {
+		for _, numClients := range testAdaptorClientCounts {
+			numClients := numClients
+			scalingFactor := scalingFactor
+			suffix := fmt.Sprintf("X%dS%d.v", numClients, scalingFactor)
+			testCases = append(testCases,
+				goldenTestCase{"sdaccel/teak__action__top__gmem" + suffix,
+					func(fileName string) error {
+						return CreateSmiSdaKernelAdaptor(fileName, "teak__action__top__gmem",
+							fmt.Sprintf("teak__action__top__smi__x%d", numClients),
+							numClients, scalingFactor)
+					}},
+				goldenTestCase{"llvm/llvm_kernel_smi_adaptor" + suffix,
+					func(fileName string) error {
+						return CreateSmiLlvmKernelAdaptor(fileName, "llvm_kernel_smi_adaptor",
+							"teak___x24_main_x2e_Top_x3a_public", numClients, scalingFactor, 1, 1)
+					}},
+				goldenTestCase{"huawei-fp1/fp1_teak_action_top_gmem" + suffix,
+					func(fileName string) error {
+						return CreateSmiFp1KernelAdaptor(fileName, "fp1_teak_action_top_gmem",
+							"teak__main_x2e_Top", numClients, scalingFactor)
+					}},
+				goldenTestCase{"intel-avalon/avalon_kernel_smi_adaptor" + suffix,
+					func(fileName string) error {
+						return CreateSmiAvalonKernelAdaptor(fileName, "avalon_kernel_smi_adaptor",
+							"teak___x24_main_x2e_Top_x3a_public", numClients, scalingFactor, 1)
+					}})
+		}
+	}
+
+	for _, clockCrossing := range []string{ClockCrossingKernel, ClockCrossingRoot} {
+		clockCrossing := clockCrossing
+		suffix := fmt.Sprintf("X3S2_%s.v", clockCrossing)
+		testCases = append(testCases,
+			goldenTestCase{"sdaccel/teak__action__top__gmem" + suffix,
+				func(fileName string) error {
+					return CreateSmiSdaKernelAdaptorWithClockCrossing(fileName,
+						"teak__action__top__gmem", "teak__action__top__smi__x3", 3, 2,
+						clockCrossing)
+				}},
+			goldenTestCase{"llvm/llvm_kernel_smi_adaptor" + suffix,
+				func(fileName string) error {
+					return CreateSmiLlvmKernelAdaptorWithClockCrossing(fileName,
+						"llvm_kernel_smi_adaptor", "teak___x24_main_x2e_Top_x3a_public",
+						3, 2, 1, 1, clockCrossing)
+				}})
+	}
+
+	// SystemVerilog and VHDL output styles.
+	testCases = append(testCases,
+		goldenTestCase{"sv/smiInterfaces.sv",
+			func(fileName string) error {
+				return CreateSmiSvInterfaces(fileName)
+			}},
+		goldenTestCase{"sv/smiMemArbitrationTreeX3S2.sv",
+			func(fileName string) error {
+				return CreateArbitrationTreeSv(fileName, "smiMemArbitrationTreeX3S2", 3, 2)
+			}},
+		goldenTestCase{"sv/teak__action__top__gmemX3S2.sv",
+			func(fileName string) error {
+				return CreateSmiSdaKernelAdaptorSv(fileName, "teak__action__top__gmem",
+					"teak__action__top__smi__x3", 3, 2)
+			}},
+		goldenTestCase{"sv/llvm_kernel_smi_adaptorX3S2.sv",
+			func(fileName string) error {
+				return CreateSmiLlvmKernelAdaptorSv(fileName, "llvm_kernel_smi_adaptor",
+					"teak___x24_main_x2e_Top_x3a_public", 3, 2, 4, 2)
+			}},
+		goldenTestCase{"sv/fp1_teak_action_top_gmemX3S2.sv",
+			func(fileName string) error {
+				return CreateSmiFp1KernelAdaptorSv(fileName, "fp1_teak_action_top_gmem",
+					"teak__main_x2e_Top", 3, 2)
+			}},
+		goldenTestCase{"vhdl/smiMemArbitrationTreeX3S2.vhd",
+			func(fileName string) error {
+				return CreateArbitrationTreeVhdl(fileName, "smiMemArbitrationTreeX3S2", 3, 2)
+			}},
+		goldenTestCase{"vhdl/teak__action__top__gmemX3S2.vhd",
+			func(fileName string) error {
+				return CreateSmiSdaKernelAdaptorVhdl(fileName, "teak__action__top__gmem",
+					"teak__action__top__smi__x3", 3, 2)
+			}},
+		goldenTestCase{"vhdl/llvm_kernel_smi_adaptorX3S2.vhd",
+			func(fileName string) error {
+				return CreateSmiLlvmKernelAdaptorVhdl(fileName, "llvm_kernel_smi_adaptor",
+					"teak___x24_main_x2e_Top_x3a_public", 3, 2, 4, 2)
+			}})
+
+	// SDAccel kernel packaging files.
+	testCases = append(testCases,
+		goldenTestCase{"sdaccel/kernelX3S2.xml",
+			func(fileName string) error {
+				return CreateSmiSdaKernelXml(fileName, "teak__action__top__gmem",
+					"teak__action__top__smi__x3", 3, 2, FuzzTestKernelArgsWidth)
+			}})
+	for _, clockCrossing := range []string{ClockCrossingNone, ClockCrossingKernel} {
+		clockCrossing := clockCrossing
+		testCases = append(testCases,
+			goldenTestCase{"sdaccel/componentX3S2_" + clockCrossing + ".xml",
+				func(fileName string) error {
+					return CreateSmiSdaComponentXmlWithClockCrossing(fileName,
+						"teak__action__top__gmem", "teak__action__top__smi__x3", 3, 2,
+						FuzzTestKernelArgsWidth, clockCrossing)
+				}})
+	}
+
+	// Vivado IP packaging scripts, covering the control slave, fuzz test,
+	// clock domain crossing and pipeline options.
+	vivadoTestCases := []struct {
+		name            string
+		moduleName      string
+		axiIdBusWidth   uint
+		axiControlSlave bool
+		fuzzTestKernel  bool
+		clockCrossing   string
+		options         ArbitrationTreeOptions
+	}{
+		{"sdaccel", "teak__action__top__gmem", 1, true, false,
+			ClockCrossingNone, DefaultArbitrationTreeOptions()},
+		{"sdaccel_fuzz_kernel", "teak__action__top__gmem", 1, true, true,
+			ClockCrossingKernel, DefaultArbitrationTreeOptions()},
+		{"llvm_pipelined", "llvm_kernel_smi_adaptor", 4, false, false,
+			ClockCrossingNone, testPipelineOptions()}}
+	for _, vivado := range vivadoTestCases {
+		vivado := vivado
+		testCases = append(testCases,
+			goldenTestCase{"vivado/packageX17S2_" + vivado.name + ".tcl",
+				func(fileName string) error {
+					sourceFiles := []string{"smiMemArbitrationTreeX17S2.v", vivado.moduleName + ".v"}
+					if vivado.fuzzTestKernel {
+						sourceFiles = append(sourceFiles, "teak__action__top__smi__x17.v")
+					}
+					return CreateVivadoPackageScriptWithOptions(fileName, vivado.moduleName,
+						sourceFiles, 17, 2, vivado.axiIdBusWidth, vivado.axiControlSlave,
+						vivado.fuzzTestKernel, vivado.clockCrossing, vivado.options)
+				}})
+	}
+
+	// Verilator simulation harnesses.
+	for _, platform := range []string{"sdaccel", "llvm"} {
+		platform := platform
+		moduleName := "llvm_kernel_smi_adaptor"
+		fuzzTestKernel := false
+		sourceFiles := []string{"smiMemArbitrationTreeX3S2.v", moduleName + ".v"}
+		if platform == "sdaccel" {
+			moduleName = "teak__action__top__gmem"
+			fuzzTestKernel = true
+			sourceFiles = []string{"smiMemArbitrationTreeX3S2.v",
+				"teak__action__top__smi__x3.v", moduleName + ".v"}
+		}
+		testCases = append(testCases,
+			goldenTestCase{"verilator/" + platform + "_harnessX3S2.cpp",
+				func(fileName string) error {
+					return CreateVerilatorHarness(fileName, moduleName, platform,
+						3, 2, FuzzTestKernelArgsWidth, fuzzTestKernel)
+				}},
+			goldenTestCase{"verilator/" + platform + "_harnessX3S2.mk",
+				func(fileName string) error {
+					return CreateVerilatorHarnessMakefile(fileName, moduleName, platform,
+						sourceFiles, 3, 2, FuzzTestKernelArgsWidth, fuzzTestKernel)
+				}})
+	}
+
+	for _, numClients := range testAdaptorClientCounts {
+		numClients := numClients
+		kernelName := fmt.Sprintf("teak__action__top__smi__x%d", numClients)
+		testCases = append(testCases, goldenTestCase{"fuzz/" + kernelName + ".v",
+			func(fileName string) error {
+				return CreateSmiFuzzTestKernel(fileName, kernelName, numClients)
+			}})
+	}
+	return testCases
+}
+
+//
+// Tests that the SDAccel kernel packaging accepts the maximum number of kernel
+// argument words, with the global memory pointer at the end of the control
+// register map, and rejects any more.
+//
+func TestSdaKernelArgsLimit(t *testing.T) {
+	config, err := configureSmiSdaKernelPackageFromParams("teak__action__top__gmem",
+		"teak__action__top__smi__x3", 3, 2, MaxKernelArgsWidth, ClockCrossingNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gmem := config.KernelArgs[len(config.KernelArgs)-1]
+	if gmem.Offset+gmem.Size != config.ControlRange {
+		t.Errorf("global memory pointer at 0x%X, expected 0x%X",
+			gmem.Offset, config.ControlRange-gmem.Size)
+	}
+	_, err = configureSmiSdaKernelPackageFromParams("teak__action__top__gmem",
+		"teak__action__top__smi__x3", 3, 2, MaxKernelArgsWidth+1, ClockCrossingNone)
+	if err == nil {
+		t.Errorf("%d kernel argument words not rejected", MaxKernelArgsWidth+1)
+	}
+}
+
+//
+// Generates the kernel adaptors for every supported client count and bus
+// width, checking that each adaptor connects the same set of signals for
+// every SMI client. This complements the golden files, which only cover a
+// representative set of client counts.
+//
+func TestAdaptorClientCounts(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "smiMemTemplates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	clientRegexp := regexp.MustCompile(`smiMemClientReq([0-9]+)\b`)
+	fileName := filepath.Join(tempDir, "adaptor.v")
+	for _, scalingFactor := range testScalingFactors {
+		for numClients := uint(1); numClients <= testMaxClients; numClients++ {
+			kernelName := fmt.Sprintf("teak__action__top__smi__x%d", numClients)
+			adaptors := map[string]func() error{
+				"sdaccel": func() error {
+					return CreateSmiSdaKernelAdaptor(fileName, "teak__action__top__gmem",
+						kernelName, numClients, scalingFactor)
+				},
+				"llvm": func() error {
+					return CreateSmiLlvmKernelAdaptor(fileName, "llvm_kernel_smi_adaptor",
+						kernelName, numClients, scalingFactor, 1, 1)
+				},
+				"huawei-fp1": func() error {
+					return CreateSmiFp1KernelAdaptor(fileName, "fp1_teak_action_top_gmem",
+						kernelName, numClients, scalingFactor)
+				},
+				"intel-avalon": func() error {
+					return CreateSmiAvalonKernelAdaptor(fileName, "avalon_kernel_smi_adaptor",
+						kernelName, numClients, scalingFactor, 1)
+				}}
+			for platform, generate := range adaptors {
+				if err := generate(); err != nil {
+					t.Fatalf("%s X%dS%d: %v", platform, numClients, scalingFactor, err)
+				}
+				data, err := ioutil.ReadFile(fileName)
+				if err != nil {
+					t.Fatal(err)
+				}
+				clientRefs := make(map[string]int)
+				for _, match := range clientRegexp.FindAllSubmatch(data, -1) {
+					clientRefs[string(match[1])]++
+				}
+				for i := uint(0); i < numClients; i++ {
+					count := clientRefs[fmt.Sprintf("%d", i)]
+					if (count == 0) || (count != clientRefs["0"]) {
+						t.Errorf("%s X%dS%d: client %d has %d references, expected %d",
+							platform, numClients, scalingFactor, i, count, clientRefs["0"])
+					}
+				}
+				if uint(len(clientRefs)) != numClients {
+					t.Errorf("%s X%dS%d: %d clients referenced, expected %d",
+						platform, numClients, scalingFactor, len(clientRefs), numClients)
+				}
+			}
+		}
+	}
+	for numClients := uint(1); numClients <= testMaxClients; numClients++ {
+		kernelName := fmt.Sprintf("teak__action__top__smi__x%d", numClients)
+		if err := CreateSmiFuzzTestKernel(fileName, kernelName, numClients); err != nil {
+			t.Errorf("fuzz test kernel X%d: %v", numClients, err)
+		}
+	}
+}
+
+//
+// Finds the first line which differs between two files, returning the line
+// number and the differing lines.
+//
+func firstDifference(got []byte, want []byte) (int, string, string) {
+	gotLines := strings.Split(string(got), "\n")
+	wantLines := strings.Split(string(want), "\n")
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		gotLine, wantLine := "<EOF>", "<EOF>"
+		if i < len(gotLines) {
+			gotLine = gotLines[i]
+		}
+		if i < len(wantLines) {
+			wantLine = wantLines[i]
+		}
+		if gotLine != wantLine {
+			return i + 1, gotLine, wantLine
+		}
+	}
+	return 0, "", ""
+}
+
+//
+// Checks the generated output for each test case against the golden files.
+//
+func TestGoldenOutput(t *testing.T) {
+	fileTimestampSource = func() time.Time { return goldenTimestamp }
+	defer func() { fileTimestampSource = time.Now }()
+
+	tempDir, err := ioutil.TempDir("", "smiMemTemplates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	for _, testCase := range makeGoldenTestCases() {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			outName := filepath.Join(tempDir, strings.Replace(testCase.name, "/", "_", -1))
+			if err := testCase.generate(outName); err != nil {
+				t.Fatal(err)
+			}
+			got, err := ioutil.ReadFile(outName)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			goldenName := filepath.Join(goldenDir, filepath.FromSlash(testCase.name))
+			if *updateGolden {
+				if err := os.MkdirAll(filepath.Dir(goldenName), 0755); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(goldenName, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := ioutil.ReadFile(goldenName)
+			if err != nil {
+				t.Fatalf("%v (run with -update to create the golden file)", err)
+			}
+			if !bytes.Equal(got, want) {
+				line, gotLine, wantLine := firstDifference(got, want)
+				t.Errorf("output differs from %s at line %d:\n  got:  %q\n  want: %q",
+					goldenName, line, gotLine, wantLine)
+			}
+		})
+	}
+}
+
+//
+// Checks that generated files which only differ in their creation timestamps
+// are treated as having the same content.
+//
+func TestSameGeneratedContent(t *testing.T) {
+	defer func() { fileTimestampSource = time.Now }()
+	tempDir, err := ioutil.TempDir("", "smiMemTemplates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	generate := func(timestamp time.Time, numClients uint) []byte {
+		fileTimestampSource = func() time.Time { return timestamp }
+		fileName := filepath.Join(tempDir, "tree.v")
+		if err := CreateArbitrationTree(fileName, "tree", numClients, 1); err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadFile(fileName)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	original := generate(goldenTimestamp, 4)
+	if !SameGeneratedContent(original, generate(goldenTimestamp.Add(time.Hour), 4)) {
+		t.Error("timestamp change detected as a content change")
+	}
+	if SameGeneratedContent(original, generate(goldenTimestamp, 5)) {
+		t.Error("content change not detected")
+	}
+}
+
+//
+// Clears the parsed template caches, so that they are rebuilt on next use.
+//
+func resetTemplateCaches() {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
+	smiMemBusArbitrationTreeCache = nil
+	smiAvalonKernelAdaptorCache = nil
+	smiFp1KernelAdaptorCache = nil
+	smiFuzzTestKernelCache = nil
+	smiLlvmKernelAdaptorCache = nil
+	smiSdaKernelAdaptorCache = nil
+	smiSdaKernelPackageCache = nil
+	smiSvTemplateCache = nil
+	smiVerilatorHarnessCache = nil
+	smiVhdlTemplateCache = nil
+	smiVivadoPackageCache = nil
+}
+
+//
+// Generates the golden output test cases concurrently, starting with empty
+// template caches. This should be run with the race detector enabled.
+//
+func TestConcurrentGeneration(t *testing.T) {
+	fileTimestampSource = func() time.Time { return goldenTimestamp }
+	defer func() { fileTimestampSource = time.Now }()
+	resetTemplateCaches()
+
+	tempDir, err := ioutil.TempDir("", "smiMemTemplates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	testCases := makeGoldenTestCases()
+	errs := make([]error, len(testCases))
+	var wg sync.WaitGroup
+	for i, testCase := range testCases {
+		wg.Add(1)
+		go func(i int, testCase goldenTestCase) {
+			defer wg.Done()
+			outName := filepath.Join(tempDir, strings.Replace(testCase.name, "/", "_", -1))
+			if err := testCase.generate(outName); err != nil {
+				errs[i] = err
+				return
+			}
+			got, err := ioutil.ReadFile(outName)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			want, err := ioutil.ReadFile(filepath.Join(goldenDir, filepath.FromSlash(testCase.name)))
+			if err == nil && !bytes.Equal(got, want) {
+				err = errors.New("output differs from golden file")
+			}
+			errs[i] = err
+		}(i, testCase)
+	}
+	wg.Wait()
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("%s: %v", testCases[i].name, err)
+		}
+	}
+}
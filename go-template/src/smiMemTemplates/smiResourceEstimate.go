@@ -0,0 +1,281 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+//
+// Specifies the FIFO size at which the SMI frame buffers switch from the SRL
+// based 'small' SELF FIFO to the block RAM based 'large' SELF FIFO. This must
+// match the selection logic in smiFrameBuffer and smiFrameAssembler.
+//
+const smiLargeFifoThreshold = 128
+
+//
+// ResourceCost specifies the estimated FPGA resource usage for a component,
+// given as the number of lookup tables, flip flops and block RAMs.
+//
+type ResourceCost struct {
+	LUTs  float64 `json:"luts"`  // Number of lookup tables.
+	FFs   float64 `json:"ffs"`   // Number of flip flops.
+	BRAMs float64 `json:"brams"` // Number of block RAMs.
+}
+
+//
+// Adds a scaled resource cost to an existing resource cost.
+//
+func (cost ResourceCost) add(other ResourceCost, scale float64) ResourceCost {
+	return ResourceCost{
+		cost.LUTs + other.LUTs*scale,
+		cost.FFs + other.FFs*scale,
+		cost.BRAMs + other.BRAMs*scale}
+}
+
+//
+// ResourcePrimitiveCost specifies the calibrated resource usage for a single
+// library primitive. The cost of each instance is derived from a fixed base
+// cost, a cost per byte of the instance flit width and, for FIFO buffers, a
+// cost per bit of FIFO storage.
+//
+type ResourcePrimitiveCost struct {
+	Base        ResourceCost `json:"base"`        // Fixed cost per instance.
+	PerFlitByte ResourceCost `json:"perFlitByte"` // Cost per byte of flit width.
+	PerFifoBit  ResourceCost `json:"perFifoBit"`  // Cost per FIFO storage bit.
+}
+
+//
+// ResourceCalibration specifies the primitive costs used for resource
+// estimation, together with the block RAM geometry used to determine the
+// number of block RAMs required by large FIFOs. The primitive costs exclude
+// any FIFO buffers, which are estimated separately. The calibrated flag must
+// only be set if the primitive costs were derived from post-synthesis
+// utilisation reports.
+//
+type ResourceCalibration struct {
+	Description string                           `json:"description"` // Source of calibration data.
+	Calibrated  bool                             `json:"calibrated"`  // Set if derived from synthesis results.
+	BramWidth   uint                             `json:"bramWidth"`   // Maximum block RAM data width.
+	BramDepth   uint                             `json:"bramDepth"`   // Block RAM depth at maximum width.
+	Primitives  map[string]ResourcePrimitiveCost `json:"primitives"`  // Costs for each primitive module.
+}
+
+//
+// ResourceEstimateItem specifies the estimated resource usage for a single
+// component instance.
+//
+type ResourceEstimateItem struct {
+	InstanceName string       // Instance name, if any.
+	ModuleName   string       // Name of the instantiated library module.
+	Cost         ResourceCost // Estimated resource usage, including FIFOs.
+}
+
+//
+// ResourceEstimate specifies the estimated resource usage for a generated
+// configuration, listing the individual component instances and the total.
+//
+type ResourceEstimate struct {
+	Items       []ResourceEstimateItem // Estimates for each component instance.
+	Total       ResourceCost           // Total estimated resource usage.
+	Calibrated  bool                   // Set if using calibrated primitive costs.
+	Description string                 // Source of the primitive costs.
+}
+
+//
+// ReadResourceCalibration reads a set of JSON format resource calibration
+// data from the specified input. Returns the calibration data and an error
+// item which will be set to 'nil' on successful completion.
+//
+func ReadResourceCalibration(input io.Reader) (ResourceCalibration, error) {
+	calibration := ResourceCalibration{}
+	decoder := json.NewDecoder(input)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&calibration); err != nil {
+		return calibration, errors.New(fmt.Sprintf(
+			"Invalid resource calibration data (%s)", err.Error()))
+	}
+	if (calibration.BramWidth == 0) || (calibration.BramDepth == 0) {
+		return calibration, errors.New(
+			"Invalid resource calibration data (missing block RAM geometry)")
+	}
+	return calibration, nil
+}
+
+//
+// Determines the cost of a single primitive instance with the specified flit
+// width in bytes and number of FIFO storage bits.
+//
+func (calibration ResourceCalibration) primitiveCost(
+	moduleName string, flitWidth uint, fifoBits uint) (ResourceCost, error) {
+	primitive, ok := calibration.Primitives[moduleName]
+	if !ok {
+		return ResourceCost{}, errors.New(fmt.Sprintf(
+			"Missing resource calibration for module (%s)", moduleName))
+	}
+	cost := primitive.Base
+	cost = cost.add(primitive.PerFlitByte, float64(flitWidth))
+	cost = cost.add(primitive.PerFifoBit, float64(fifoBits))
+	return cost, nil
+}
+
+//
+// Determines the cost of a single SMI frame FIFO buffer, using the same FIFO
+// component selection as the Verilog library. Each FIFO entry holds a flit
+// and its associated control byte. Large FIFOs are mapped to block RAMs, with
+// the number of block RAMs being determined by the block RAM geometry.
+//
+func (calibration ResourceCalibration) fifoBufferCost(
+	flitWidth uint, fifoSize uint) (ResourceCost, error) {
+	dataWidth := flitWidth*8 + 8
+	if fifoSize < smiLargeFifoThreshold {
+		return calibration.primitiveCost(
+			"smiSelfLinkBufferFifoS", flitWidth, dataWidth*(fifoSize+1))
+	}
+	cost, err := calibration.primitiveCost("smiSelfLinkBufferFifoL", flitWidth, 0)
+	numBlocks := ((dataWidth + calibration.BramWidth - 1) / calibration.BramWidth) *
+		((fifoSize + calibration.BramDepth - 1) / calibration.BramDepth)
+	cost.BRAMs += float64(numBlocks)
+	return cost, err
+}
+
+//
+// Determines the cost of an arbitration tree arbiter, bus width scaler or
+// pipeline stage. Scalers are listed using the request and response scaler
+// module names. Each arbiter client has a request frame assembler and a
+// response frame buffer, which operate at the server side flit width for
+// arbiters that include bus width scaling.
+//
+func (calibration ResourceCalibration) treeNodeCost(
+	node ArbitrationTreeNode) (ResourceEstimateItem, error) {
+	item := ResourceEstimateItem{InstanceName: node.InstanceName}
+	if item.InstanceName == "" {
+		item.InstanceName = "-"
+	}
+	flitWidth := node.ClientConns[0].FlitWidth
+	var err error
+	switch node.Kind {
+	case TreeNodeScaler:
+		reqModuleName := fmt.Sprintf("smiFlitScaleX%d", node.ScaleFactor)
+		respModuleName := fmt.Sprintf("smiFlitScaleD%d", node.ScaleFactor)
+		item.ModuleName = reqModuleName + "/" + respModuleName
+		item.Cost, err = calibration.primitiveCost(reqModuleName, flitWidth, 0)
+		if err == nil {
+			var respCost ResourceCost
+			respCost, err = calibration.primitiveCost(
+				respModuleName, flitWidth*node.ScaleFactor, 0)
+			item.Cost = item.Cost.add(respCost, 1)
+		}
+
+	case TreeNodeArbiter:
+		numClients := len(node.ClientConns)
+		if node.ScaleFactor != 1 {
+			item.ModuleName = fmt.Sprintf("smiTransactionScaledArbiterX%d", numClients)
+		} else {
+			item.ModuleName = fmt.Sprintf("smiTransactionArbiterX%d", numClients)
+		}
+		item.Cost, err = calibration.primitiveCost(item.ModuleName, flitWidth, 0)
+		if err == nil {
+			var fifoCost ResourceCost
+			fifoCost, err = calibration.fifoBufferCost(
+				node.ServerConn.FlitWidth, node.FifoFlitDepth)
+			item.Cost = item.Cost.add(fifoCost, float64(2*numClients))
+		}
+
+	case TreeNodePipeline:
+		item.ModuleName = "smiMemBusPipelineStage"
+		item.Cost, err = calibration.primitiveCost(item.ModuleName, flitWidth, 0)
+
+	default:
+		err = errors.New(fmt.Sprintf(
+			"Unknown arbitration tree component type (%s)", node.Kind))
+	}
+	return item, err
+}
+
+//
+// EstimateResources estimates the FPGA resource usage for the arbitration tree
+// specified by the 'topology' parameter, using the primitive costs specified
+// by the 'calibration' parameter. The 'busAdaptorName' parameter specifies
+// the name of the platform specific memory bus adaptor module which connects
+// to the arbitration tree server port, or the empty string if the adaptor is
+// not required. Returns the resource estimate and an error item which will be
+// set to 'nil' on successful completion.
+//
+func EstimateResources(topology ArbitrationTreeTopology, busAdaptorName string,
+	calibration ResourceCalibration) (ResourceEstimate, error) {
+	estimate := ResourceEstimate{Items: []ResourceEstimateItem{},
+		Calibrated: calibration.Calibrated, Description: calibration.Description}
+	for _, node := range topology.Nodes {
+		if node.Kind == TreeNodeAssignment {
+			continue
+		}
+		item, err := calibration.treeNodeCost(node)
+		if err != nil {
+			return estimate, err
+		}
+		estimate.Items = append(estimate.Items, item)
+		estimate.Total = estimate.Total.add(item.Cost, 1)
+	}
+	if busAdaptorName != "" {
+		cost, err := calibration.primitiveCost(
+			busAdaptorName, topology.ServerConn.FlitWidth, 0)
+		if err != nil {
+			return estimate, err
+		}
+		estimate.Items = append(estimate.Items,
+			ResourceEstimateItem{"-", busAdaptorName, cost})
+		estimate.Total = estimate.Total.add(cost, 1)
+	}
+	return estimate, nil
+}
+
+//
+// Write generates a human readable resource estimate report, listing the
+// estimated resource usage for each component instance followed by the total.
+// Estimates which do not use calibrated primitive costs are preceded by a
+// warning which includes the calibration data description.
+//
+func (estimate ResourceEstimate) Write(output io.Writer) error {
+	if !estimate.Calibrated {
+		_, err := fmt.Fprintf(output,
+			"WARNING: uncalibrated estimate, figures are indicative only (%s)\n\n",
+			estimate.Description)
+		if err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(output, "%-20s %-30s %8s %8s %6s\n",
+		"instance", "module", "LUTs", "FFs", "BRAMs")
+	if err != nil {
+		return err
+	}
+	for _, item := range estimate.Items {
+		_, err = fmt.Fprintf(output, "%-20s %-30s %8.0f %8.0f %6.1f\n",
+			item.InstanceName, item.ModuleName, item.Cost.LUTs, item.Cost.FFs,
+			item.Cost.BRAMs)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(output, "%-20s %-30s %8.0f %8.0f %6.1f\n", "total", "",
+		estimate.Total.LUTs, estimate.Total.FFs, estimate.Total.BRAMs)
+	return err
+}
@@ -0,0 +1,163 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"strings"
+	"testing"
+)
+
+//
+// Specifies the location of the resource calibration data file relative to
+// the package directory.
+//
+const testCalibrationFile = "../../../data/smiResourceCalibration.json"
+
+//
+// Checks that the resource calibration data file covers all of the primitives
+// which may be used by the generated arbitration trees and bus adaptors,
+// including the pipeline stages, and that the estimate totals match the
+// individual component estimates.
+//
+func TestResourceCalibrationFile(t *testing.T) {
+	file, err := os.Open(testCalibrationFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	calibration, err := ReadResourceCalibration(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	busAdaptorNames := []string{"", "smiAxiMemBusAdaptor", "smiAvmMemBusAdaptor"}
+	for _, scalingFactor := range testScalingFactors {
+		for numClients := uint(1); numClients <= 64; numClients++ {
+			options := DefaultArbitrationTreeOptions()
+			if numClients >= 6 {
+				options = testPipelineOptions()
+			}
+			topology, err := DescribeArbitrationTreeWithOptions(
+				"tree", numClients, scalingFactor, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, busAdaptorName := range busAdaptorNames {
+				estimate, err := EstimateResources(topology, busAdaptorName, calibration)
+				if err != nil {
+					t.Errorf("X%dS%d %s: %s", numClients, scalingFactor, busAdaptorName, err)
+					continue
+				}
+				total := ResourceCost{}
+				for _, item := range estimate.Items {
+					total = total.add(item.Cost, 1)
+				}
+				if math.Abs(total.LUTs-estimate.Total.LUTs) > 1e-6 ||
+					math.Abs(total.FFs-estimate.Total.FFs) > 1e-6 ||
+					math.Abs(total.BRAMs-estimate.Total.BRAMs) > 1e-6 {
+					t.Errorf("X%dS%d %s: total %v does not match items %v",
+						numClients, scalingFactor, busAdaptorName, estimate.Total, total)
+				}
+			}
+		}
+	}
+}
+
+//
+// Checks that small arbiter FIFOs are estimated as SRL based FIFOs and that
+// large arbiter FIFOs are mapped to the expected number of block RAMs.
+//
+func TestResourceEstimateFifoMapping(t *testing.T) {
+	calibration, err := ReadResourceCalibration(strings.NewReader(`{
+		"bramWidth": 72, "bramDepth": 512, "primitives": {
+		"smiTransactionArbiterX2": {},
+		"smiSelfLinkBufferFifoS": {"perFifoBit": {"luts": 1}},
+		"smiSelfLinkBufferFifoL": {"base": {"ffs": 10}}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The two client tree uses a single arbiter with four 72 bit FIFOs.
+	testCases := []struct {
+		fifoDepth uint
+		expected  ResourceCost
+	}{
+		{32, ResourceCost{4 * 72 * 33, 0, 0}},
+		{127, ResourceCost{4 * 72 * 128, 0, 0}},
+		{128, ResourceCost{0, 40, 4}},
+		{512, ResourceCost{0, 40, 4}},
+		{1024, ResourceCost{0, 40, 8}}}
+	for _, testCase := range testCases {
+		options := DefaultArbitrationTreeOptions()
+		options.FifoFlitDepth = testCase.fifoDepth
+		topology, err := DescribeArbitrationTreeWithOptions("tree", 2, 1, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		estimate, err := EstimateResources(topology, "", calibration)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if estimate.Total != testCase.expected {
+			t.Errorf("FIFO depth %d: expected %v, got %v",
+				testCase.fifoDepth, testCase.expected, estimate.Total)
+		}
+	}
+
+	// Missing primitives are reported as errors.
+	topology, err := DescribeArbitrationTree("tree", 3, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := EstimateResources(topology, "", calibration); err == nil {
+		t.Error("expected missing calibration error")
+	}
+}
+
+//
+// Checks that estimates using uncalibrated primitive costs are reported with
+// a warning which includes the calibration data description.
+//
+func TestResourceEstimateWrite(t *testing.T) {
+	topology, err := DescribeArbitrationTree("tree", 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, calibrated := range []bool{false, true} {
+		calibration := ResourceCalibration{Description: "test figures",
+			Calibrated: calibrated, BramWidth: 72, BramDepth: 512,
+			Primitives: map[string]ResourcePrimitiveCost{
+				"smiTransactionArbiterX2": {},
+				"smiSelfLinkBufferFifoS":  {}}}
+		estimate, err := EstimateResources(topology, "", calibration)
+		if err != nil {
+			t.Fatal(err)
+		}
+		report := bytes.Buffer{}
+		if err := estimate.Write(&report); err != nil {
+			t.Fatal(err)
+		}
+		warned := strings.HasPrefix(report.String(), "WARNING: uncalibrated estimate") &&
+			strings.Contains(report.String(), "(test figures)")
+		if warned == calibrated {
+			t.Errorf("calibrated %v: unexpected report header\n%s", calibrated, report.String())
+		}
+	}
+}
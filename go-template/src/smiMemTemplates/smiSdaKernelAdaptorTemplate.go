@@ -36,6 +36,7 @@ type smiSdaKernelAdaptorConfig struct {
 	SmiMemBusClientConns  []smiMemBusConnectionConfig // List of client side connections.
 	SmiMemBusServerConn   []smiMemBusConnectionConfig // Single server side connection.
 	SmiMemBusWireConns    []smiMemBusConnectionConfig // Internal wire connections.
+	ClockDomains          smiMemClockDomainConfig     // Kernel and memory clock domains.
 }
 
 //
@@ -141,10 +142,12 @@ module {{.ModuleName}} (
 
   // Specify system level signals.
   input          clk,
-  input          reset
+  input          reset{{if .ClockDomains.MemClockSeparate}},
+  input          memClk,
+  input          memReset{{end}}
 );
 {{template "smiMemBusConnectionWireList" .SmiMemBusWireConns}}
-// Concatenated SMI flit vectors. {{range .SmiMemBusClientConns}}
+// Concatenated SMI flit vectors. {{range .ClockDomains.SmiMemBusKernelConns}}
 wire [ 71:0] {{.SmiNetReqName}}Flit;
 wire [ 71:0] {{.SmiNetRespName}}Flit;{{end}}
 
@@ -152,7 +155,7 @@ wire [ 71:0] {{.SmiNetRespName}}Flit;{{end}}
 // Instantiate the SMI/AXI memory controller adaptor.
 //
 smiAxiMemBusAdaptor #({{.AxiByteIndexSize}}, {{.AxiBusIdWidth}}, 33) axiBusAdaptor (
-  {{with $wire := index .SmiMemBusServerConn 0}}
+  {{with $wire := index .ClockDomains.SmiMemBusAdaptorConn 0}}
   // Connect SMI main memory bus.
   .smiReqReady  ({{$wire.SmiNetReqName}}Ready),
   .smiReqEofc   ({{$wire.SmiNetReqName}}Eofc),
@@ -201,9 +204,9 @@ smiAxiMemBusAdaptor #({{.AxiByteIndexSize}}, {{.AxiBusIdWidth}}, 33) axiBusAdapt
   .axiBResp     (m_axi_gmem_bresp),
 
   // Connect system level signals.
-  .axiReset     (reset),  // TODO: This should be passed in.
-  .clk          (clk),
-  .srst         (reset)
+  .axiReset     ({{.ClockDomains.MemResetName}}),
+  .clk          ({{.ClockDomains.MemClockName}}),
+  .srst         ({{.ClockDomains.MemResetName}})
 );
 
 //
@@ -232,13 +235,16 @@ assign m_axi_gmem_wuser    = 1'b0;
 {{template "smiMemBusConnectionPortLink" .SmiMemBusServerConn}}
 
   // Connect system level signals.
-  .clk  (clk),
-  .srst (reset)
+  .clk  ({{.ClockDomains.TreeClockName}}),
+  .srst ({{.ClockDomains.TreeResetName}})
 );
-
+{{if .ClockDomains.SmiMemClockBridges}}
+//
+// Instantiate the clock domain bridges.
+//{{range .ClockDomains.SmiMemClockBridges}}{{template "smiMemClockBridge" .}}{{end}}{{end}}
 //
 // Map SMI flit vector signals.
-// {{range .SmiMemBusClientConns}}
+// {{range .ClockDomains.SmiMemBusKernelConns}}
 assign {{.SmiNetReqName}}Data  = {{.SmiNetReqName}}Flit [63:0];
 assign {{.SmiNetReqName}}Eofc  = {{.SmiNetReqName}}Flit [71:64];
 assign {{.SmiNetRespName}}Flit = { {{.SmiNetRespName}}Eofc, {{.SmiNetRespName}}Data };
@@ -262,7 +268,7 @@ assign {{.SmiNetRespName}}Flit = { {{.SmiNetRespName}}Eofc, {{.SmiNetRespName}}D
   .paramdata_0Data  (paramdata_0Data),
   .paramdata_0Stop  (paramdata_0Stop),
 
-{{range $index, $element := .SmiMemBusClientConns}}
+{{range $index, $element := .ClockDomains.SmiMemBusKernelConns}}
   // Connect SMI for {{$element.SmiNetReqName}}/{{$element.SmiNetRespName}}.
   {{printf ".smiport%dreq_0Ready" $index}}  ({{$element.SmiNetReqName}}Ready),
   {{printf ".smiport%dreq_0Data" $index}}   ({{$element.SmiNetReqName}}Flit),
@@ -313,11 +319,14 @@ var smiSdaKernelAdaptorCache *template.Template = nil
 // Implement lazy construction of the SMI SDAccel kernel adaptor template.
 //
 func getSmiSdaKernelAdaptorTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
 	if smiSdaKernelAdaptorCache == nil {
 		templGroup := template.New("").Funcs(smiTemplateFunctions)
 		templGroup = template.Must(templGroup.Parse(smiMemBusFileHeaderTemplate))
 		templGroup = template.Must(templGroup.Parse(smiMemBusConnectionPortLinkTemplate))
 		templGroup = template.Must(templGroup.Parse(smiMemBusConnectionWireListTemplate))
+		templGroup = template.Must(templGroup.Parse(smiMemClockBridgeTemplate))
 		templGroup = template.Must(templGroup.Parse(smiSdaKernelAdaptorTemplate))
 		smiSdaKernelAdaptorCache = templGroup
 	}
@@ -326,10 +335,12 @@ func getSmiSdaKernelAdaptorTemplate() *template.Template {
 
 //
 // Generates an SMI SDaccel kernel adaptor configuration given the supplied
-// parameters.
+// parameters. The clock domain crossing location must be one of the
+// ClockCrossing constants.
 //
 func configureSmiSdaKernelAdaptor(moduleName string, kernelName string,
-	numPorts uint, scalingFactor uint) (smiSdaKernelAdaptorConfig, error) {
+	numPorts uint, scalingFactor uint,
+	clockCrossing string) (smiSdaKernelAdaptorConfig, error) {
 
 	var smiSdaKernelAdaptor = smiSdaKernelAdaptorConfig{}
 	smiSdaKernelAdaptor.ModuleName = moduleName
@@ -364,6 +375,16 @@ func configureSmiSdaKernelAdaptor(moduleName string, kernelName string,
 			smiSdaKernelAdaptor.SmiMemBusWireConns, clientConn)
 	}
 
+	// Add the clock domain crossing, if required.
+	clockDomains, bridgeWireConns, err := configureSmiMemClockDomains(
+		clockCrossing, smiSdaKernelAdaptor.SmiMemBusClientConns, serverConn)
+	if err != nil {
+		return smiSdaKernelAdaptor, err
+	}
+	smiSdaKernelAdaptor.ClockDomains = clockDomains
+	smiSdaKernelAdaptor.SmiMemBusWireConns = append(
+		smiSdaKernelAdaptor.SmiMemBusWireConns, bridgeWireConns...)
+
 	return smiSdaKernelAdaptor, nil
 }
 
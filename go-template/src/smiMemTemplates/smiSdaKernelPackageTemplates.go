@@ -0,0 +1,434 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+)
+
+//
+// Defines a single top level port of a packaged kernel adaptor, together with
+// the bus interface signal that it implements.
+//
+type smiKernelPortConfig struct {
+	PortName    string // Name of the Verilog module port.
+	LogicalName string // Name of the bus interface signal (empty if unmapped).
+	Direction   string // Port direction ('in' or 'out').
+	Width       uint   // Width of the port in bits.
+}
+
+//
+// Defines a named bus interface parameter setting.
+//
+type smiKernelParamConfig struct {
+	Name  string // Name of the bus interface parameter.
+	Value string // Value of the bus interface parameter.
+}
+
+//
+// Defines a packaged kernel adaptor bus interface.
+//
+type smiKernelBusInterfaceConfig struct {
+	Name            string                 // Name of the bus interface.
+	Mode            string                 // Bus interface mode ('master' or 'slave').
+	BusType         string                 // Xilinx bus definition name.
+	AbstractionType string                 // Xilinx bus abstraction name.
+	Ports           []smiKernelPortConfig  // List of mapped ports.
+	Parameters      []smiKernelParamConfig // List of bus interface parameters.
+}
+
+//
+// Defines a single kernel argument as seen by the host runtime.
+//
+type smiKernelArgConfig struct {
+	Name             string // Name of the kernel argument.
+	Id               uint   // Kernel argument index.
+	AddressQualifier uint   // Set to 0 for scalars and 1 for global memory.
+	Port             string // Name of the associated bus interface.
+	Size             uint   // Size of the argument register in bytes.
+	Offset           uint   // Offset of the argument in the control register map.
+	Type             string // Host side argument type.
+}
+
+//
+// Specifies the control register offset of the first kernel argument word in
+// the SDAccel kernel control register map.
+//
+const smiSdaKernelArgsOffset = 0x10
+
+//
+// Specifies the size of the SDAccel kernel control register map.
+//
+const smiSdaControlRange = 0x1000
+
+//
+// MaxKernelArgsWidth specifies the maximum number of 32-bit kernel argument
+// words. The kernel argument words and the 64-bit global memory pointer which
+// follows them must fit in the SDAccel kernel control register map.
+//
+const MaxKernelArgsWidth = (smiSdaControlRange - smiSdaKernelArgsOffset - 8) / 4
+
+//
+// Defines the template configuration options for the packaging metadata
+// associated with an SMI SDAccel kernel adaptor module.
+//
+type smiSdaKernelPackageConfig struct {
+	Adaptor          smiSdaKernelAdaptorConfig     // Kernel adaptor configuration.
+	Vendor           string                        // IP vendor name.
+	Library          string                        // IP library name.
+	Version          string                        // IP version string.
+	ControlRange     uint                          // Size of the control register map.
+	BusInterfaces    []smiKernelBusInterfaceConfig // List of bus interfaces.
+	UnmappedPorts    []smiKernelPortConfig         // Ports not part of any bus interface.
+	KernelArgs       []smiKernelArgConfig          // List of kernel arguments.
+	KernelArgsOffset uint                          // Offset of the first kernel argument.
+}
+
+//
+// Defines the template for the Vitis/XRT RTL kernel description file.
+//
+var smiSdaKernelXmlTemplate = `
+{{define "smiSdaKernelXml"}}<?xml version="1.0" encoding="UTF-8"?>
+<!--
+  Created {{makeFileTimestamp}}
+  Machine generated file - DO NOT EDIT
+-->
+<root versionMajor="1" versionMinor="6">
+  <kernel name="{{.Adaptor.ModuleName}}" language="ip_c" ` +
+	`vlnv="{{.Vendor}}:{{.Library}}:{{.Adaptor.ModuleName}}:{{.Version}}" ` +
+	`attributes="" preferredWorkGroupSizeMultiple="0" workGroupSize="1" ` +
+	`interrupt="false" hwControlProtocol="ap_ctrl_hs">
+    <ports>{{range .BusInterfaces}}{{if eq .BusType "aximm"}}
+      <port name="{{.Name}}" mode="{{.Mode}}" ` +
+	`range="{{if eq .Mode "master"}}0xFFFFFFFFFFFFFFFF{{else}}{{printf "0x%X" $.ControlRange}}{{end}}" ` +
+	`dataWidth="{{makeBusDataWidth .Ports}}" portType="addressable" base="0x0"/>{{end}}{{end}}
+    </ports>
+    <args>{{range .KernelArgs}}
+      <arg name="{{.Name}}" addressQualifier="{{.AddressQualifier}}" id="{{.Id}}" ` +
+	`port="{{.Port}}" size="{{printf "0x%X" .Size}}" offset="{{printf "0x%03X" .Offset}}" ` +
+	`type="{{.Type}}" hostOffset="0x0" hostSize="{{printf "0x%X" .Size}}"/>{{end}}
+    </args>
+  </kernel>
+</root>
+{{end}}`
+
+//
+// Defines the template for the IP-XACT component description file.
+//
+var smiSdaComponentXmlTemplate = `
+{{define "smiSdaComponentXml"}}<?xml version="1.0" encoding="UTF-8"?>
+<!--
+  Created {{makeFileTimestamp}}
+  Machine generated file - DO NOT EDIT
+-->
+<spirit:component xmlns:xilinx="http://www.xilinx.com" ` +
+	`xmlns:spirit="http://www.spiritconsortium.org/XMLSchema/SPIRIT/1685-2009" ` +
+	`xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+  <spirit:vendor>{{.Vendor}}</spirit:vendor>
+  <spirit:library>{{.Library}}</spirit:library>
+  <spirit:name>{{.Adaptor.ModuleName}}</spirit:name>
+  <spirit:version>{{.Version}}</spirit:version>
+  <spirit:busInterfaces>{{range .BusInterfaces}}
+    <spirit:busInterface>
+      <spirit:name>{{.Name}}</spirit:name>
+      <spirit:busType spirit:vendor="xilinx.com" spirit:library="interface" ` +
+	`spirit:name="{{.BusType}}" spirit:version="1.0"/>
+      <spirit:abstractionType spirit:vendor="xilinx.com" spirit:library="interface" ` +
+	`spirit:name="{{.AbstractionType}}" spirit:version="1.0"/>{{if eq .BusType "aximm"}}{{if eq .Mode "master"}}
+      <spirit:master>
+        <spirit:addressSpaceRef spirit:addressSpaceRef="{{.Name}}"/>
+      </spirit:master>{{else}}
+      <spirit:slave>
+        <spirit:memoryMapRef spirit:memoryMapRef="{{.Name}}"/>
+      </spirit:slave>{{end}}{{else}}
+      <spirit:{{.Mode}}/>{{end}}
+      <spirit:portMaps>{{range .Ports}}
+        <spirit:portMap>
+          <spirit:logicalPort>
+            <spirit:name>{{.LogicalName}}</spirit:name>
+          </spirit:logicalPort>
+          <spirit:physicalPort>
+            <spirit:name>{{.PortName}}</spirit:name>
+          </spirit:physicalPort>
+        </spirit:portMap>{{end}}
+      </spirit:portMaps>{{if .Parameters}}
+      <spirit:parameters>{{range .Parameters}}
+        <spirit:parameter>
+          <spirit:name>{{.Name}}</spirit:name>
+          <spirit:value>{{.Value}}</spirit:value>
+        </spirit:parameter>{{end}}
+      </spirit:parameters>{{end}}
+    </spirit:busInterface>{{end}}
+  </spirit:busInterfaces>
+  <spirit:addressSpaces>{{range .BusInterfaces}}{{if and (eq .BusType "aximm") (eq .Mode "master")}}
+    <spirit:addressSpace>
+      <spirit:name>{{.Name}}</spirit:name>
+      <spirit:range>16E</spirit:range>
+      <spirit:width>{{makeBusDataWidth .Ports}}</spirit:width>
+    </spirit:addressSpace>{{end}}{{end}}
+  </spirit:addressSpaces>
+  <spirit:memoryMaps>{{range .BusInterfaces}}{{if and (eq .BusType "aximm") (eq .Mode "slave")}}
+    <spirit:memoryMap>
+      <spirit:name>{{.Name}}</spirit:name>
+      <spirit:addressBlock>
+        <spirit:name>reg0</spirit:name>
+        <spirit:baseAddress>0x0</spirit:baseAddress>
+        <spirit:range>{{$.ControlRange}}</spirit:range>
+        <spirit:width>{{makeBusDataWidth .Ports}}</spirit:width>
+        <spirit:usage>register</spirit:usage>
+      </spirit:addressBlock>
+    </spirit:memoryMap>{{end}}{{end}}
+  </spirit:memoryMaps>
+  <spirit:model>
+    <spirit:views>
+      <spirit:view>
+        <spirit:name>xilinx_verilogsynthesis</spirit:name>
+        <spirit:envIdentifier>verilogSource:vivado.xilinx.com:synthesis</spirit:envIdentifier>
+        <spirit:language>verilog</spirit:language>
+        <spirit:modelName>{{.Adaptor.ModuleName}}</spirit:modelName>
+      </spirit:view>
+    </spirit:views>
+    <spirit:ports>{{range .BusInterfaces}}{{range .Ports}}{{template "smiComponentXmlPort" .}}{{end}}{{end}}` +
+	`{{range .UnmappedPorts}}{{template "smiComponentXmlPort" .}}{{end}}
+    </spirit:ports>
+  </spirit:model>
+</spirit:component>
+{{end}}` +
+	`{{define "smiComponentXmlPort"}}
+      <spirit:port>
+        <spirit:name>{{.PortName}}</spirit:name>
+        <spirit:wire>
+          <spirit:direction>{{.Direction}}</spirit:direction>{{if gt .Width 1}}
+          <spirit:vector>
+            <spirit:left spirit:format="long">{{makeVectorMsbIndex .Width}}</spirit:left>
+            <spirit:right spirit:format="long">0</spirit:right>
+          </spirit:vector>{{end}}
+        </spirit:wire>
+      </spirit:port>{{end}}`
+
+//
+// Cache the parsed SMI SDAccel kernel packaging templates.
+//
+var smiSdaKernelPackageCache *template.Template = nil
+
+//
+// Implement lazy construction of the SMI SDAccel kernel packaging templates.
+//
+func getSmiSdaKernelPackageTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
+	if smiSdaKernelPackageCache == nil {
+		templGroup := template.New("").Funcs(smiTemplateFunctions)
+		templGroup = template.Must(templGroup.Parse(smiSdaKernelXmlTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSdaComponentXmlTemplate))
+		smiSdaKernelPackageCache = templGroup
+	}
+	return smiSdaKernelPackageCache
+}
+
+//
+// Builds the list of ports for an AXI bus interface given the port name
+// prefix and a list of signal names, directions and widths. The signal
+// directions are specified relative to the interface master.
+//
+func makeAxiBusPorts(prefix string, master bool,
+	signals []smiKernelPortConfig) []smiKernelPortConfig {
+
+	ports := make([]smiKernelPortConfig, len(signals))
+	for i, signal := range signals {
+		direction := signal.Direction
+		if !master {
+			if direction == "in" {
+				direction = "out"
+			} else {
+				direction = "in"
+			}
+		}
+		ports[i] = smiKernelPortConfig{
+			prefix + signal.PortName, strings.ToUpper(signal.PortName),
+			direction, signal.Width}
+	}
+	return ports
+}
+
+//
+// Generates the SDAccel kernel packaging configuration for the supplied
+// kernel adaptor configuration. The kernel arguments consist of the specified
+// number of 32-bit scalar words, followed by a 64-bit global memory pointer
+// which associates the m_axi_gmem interface with a memory bank.
+//
+func configureSmiSdaKernelPackage(adaptor smiSdaKernelAdaptorConfig,
+	kernelArgsWidth uint) (smiSdaKernelPackageConfig, error) {
+
+	var smiSdaKernelPackage = smiSdaKernelPackageConfig{}
+	smiSdaKernelPackage.Adaptor = adaptor
+	smiSdaKernelPackage.Vendor = "reconfigure.io"
+	smiSdaKernelPackage.Library = "smi"
+	smiSdaKernelPackage.Version = "1.0"
+	smiSdaKernelPackage.ControlRange = smiSdaControlRange
+	smiSdaKernelPackage.KernelArgsOffset = smiSdaKernelArgsOffset
+
+	dataWidth := adaptor.AxiBusDataWidth * 8
+	idWidth := adaptor.AxiBusIdWidth
+
+	// Add the AXI memory master interface, matching the port list used in the
+	// kernel adaptor template.
+	gmemPorts := makeAxiBusPorts("m_axi_gmem_", true, []smiKernelPortConfig{
+		{"awaddr", "", "out", 64}, {"awlen", "", "out", 8},
+		{"awsize", "", "out", 3}, {"awburst", "", "out", 2},
+		{"awlock", "", "out", 1}, {"awcache", "", "out", 4},
+		{"awprot", "", "out", 3}, {"awqos", "", "out", 4},
+		{"awregion", "", "out", 4}, {"awuser", "", "out", 1},
+		{"awid", "", "out", idWidth}, {"awvalid", "", "out", 1},
+		{"awready", "", "in", 1},
+		{"wdata", "", "out", dataWidth}, {"wstrb", "", "out", dataWidth / 8},
+		{"wid", "", "out", idWidth}, {"wlast", "", "out", 1},
+		{"wuser", "", "out", 1}, {"wvalid", "", "out", 1},
+		{"wready", "", "in", 1},
+		{"bresp", "", "in", 2}, {"buser", "", "in", 1},
+		{"bid", "", "in", idWidth}, {"bvalid", "", "in", 1},
+		{"bready", "", "out", 1},
+		{"araddr", "", "out", 64}, {"arlen", "", "out", 8},
+		{"arsize", "", "out", 3}, {"arburst", "", "out", 2},
+		{"arlock", "", "out", 1}, {"arcache", "", "out", 4},
+		{"arprot", "", "out", 3}, {"arqos", "", "out", 4},
+		{"arregion", "", "out", 4}, {"aruser", "", "out", 1},
+		{"arid", "", "out", idWidth}, {"arvalid", "", "out", 1},
+		{"arready", "", "in", 1},
+		{"rdata", "", "in", dataWidth}, {"rresp", "", "in", 2},
+		{"rlast", "", "in", 1}, {"ruser", "", "in", 1},
+		{"rid", "", "in", idWidth}, {"rvalid", "", "in", 1},
+		{"rready", "", "out", 1}})
+
+	// Add the AXI control slave interface.
+	controlPorts := makeAxiBusPorts("s_axi_", false, []smiKernelPortConfig{
+		{"araddr", "", "out", 32}, {"arcache", "", "out", 4},
+		{"arprot", "", "out", 3}, {"arvalid", "", "out", 1},
+		{"arready", "", "in", 1},
+		{"rdata", "", "in", 32}, {"rresp", "", "in", 2},
+		{"rvalid", "", "in", 1}, {"rready", "", "out", 1},
+		{"awaddr", "", "out", 32}, {"awcache", "", "out", 4},
+		{"awprot", "", "out", 3}, {"awvalid", "", "out", 1},
+		{"awready", "", "in", 1},
+		{"wdata", "", "out", 32}, {"wstrb", "", "out", 4},
+		{"wvalid", "", "out", 1}, {"wready", "", "in", 1},
+		{"bresp", "", "in", 2}, {"bvalid", "", "in", 1},
+		{"bready", "", "out", 1}})
+
+	// The AXI memory master interface is moved to the memory clock domain if a
+	// separate memory clock is being used.
+	clockDomains := adaptor.ClockDomains
+	clockBusInterfaces := "m_axi_gmem:s_axi_control"
+	if clockDomains.MemClockSeparate {
+		clockBusInterfaces = "s_axi_control"
+	}
+
+	smiSdaKernelPackage.BusInterfaces = []smiKernelBusInterfaceConfig{
+		{"m_axi_gmem", "master", "aximm", "aximm_rtl", gmemPorts,
+			[]smiKernelParamConfig{
+				{"PROTOCOL", "AXI4"},
+				{"DATA_WIDTH", fmt.Sprintf("%d", dataWidth)},
+				{"ID_WIDTH", fmt.Sprintf("%d", idWidth)},
+				{"ADDR_WIDTH", "64"}}},
+		{"s_axi_control", "slave", "aximm", "aximm_rtl", controlPorts,
+			[]smiKernelParamConfig{
+				{"PROTOCOL", "AXI4LITE"},
+				{"DATA_WIDTH", "32"},
+				{"ADDR_WIDTH", "32"}}},
+		{"clk", "slave", "clock", "clock_rtl",
+			[]smiKernelPortConfig{{"clk", "CLK", "in", 1}},
+			[]smiKernelParamConfig{
+				{"ASSOCIATED_BUSIF", clockBusInterfaces},
+				{"ASSOCIATED_RESET", "reset"}}},
+		{"reset", "slave", "reset", "reset_rtl",
+			[]smiKernelPortConfig{{"reset", "RST", "in", 1}},
+			[]smiKernelParamConfig{{"POLARITY", "ACTIVE_HIGH"}}}}
+	if clockDomains.MemClockSeparate {
+		smiSdaKernelPackage.BusInterfaces = append(smiSdaKernelPackage.BusInterfaces,
+			smiKernelBusInterfaceConfig{clockDomains.MemClockName, "slave", "clock", "clock_rtl",
+				[]smiKernelPortConfig{{clockDomains.MemClockName, "CLK", "in", 1}},
+				[]smiKernelParamConfig{
+					{"ASSOCIATED_BUSIF", "m_axi_gmem"},
+					{"ASSOCIATED_RESET", clockDomains.MemResetName}}},
+			smiKernelBusInterfaceConfig{clockDomains.MemResetName, "slave", "reset", "reset_rtl",
+				[]smiKernelPortConfig{{clockDomains.MemResetName, "RST", "in", 1}},
+				[]smiKernelParamConfig{{"POLARITY", "ACTIVE_HIGH"}}})
+	}
+
+	// Add the action control and parameter register file ports, which are
+	// driven by the Teak action wrapper rather than a standard bus interface.
+	smiSdaKernelPackage.UnmappedPorts = []smiKernelPortConfig{
+		{"go_0Ready", "", "in", 1}, {"go_0Stop", "", "out", 1},
+		{"done_0Ready", "", "out", 1}, {"done_0Stop", "", "in", 1},
+		{"paramaddr_0Ready", "", "out", 1}, {"paramaddr_0Data", "", "out", 32},
+		{"paramaddr_0Stop", "", "in", 1}, {"paramdata_0Ready", "", "in", 1},
+		{"paramdata_0Data", "", "in", 32}, {"paramdata_0Stop", "", "out", 1}}
+
+	// Add the kernel arguments.
+	if kernelArgsWidth > MaxKernelArgsWidth {
+		return smiSdaKernelPackage, errors.New(fmt.Sprintf(
+			"Too many kernel arguments (%d), expected at most %d",
+			kernelArgsWidth, MaxKernelArgsWidth))
+	}
+	offset := smiSdaKernelPackage.KernelArgsOffset
+	smiSdaKernelPackage.KernelArgs = make([]smiKernelArgConfig, 0)
+	for i := uint(0); i < kernelArgsWidth; i++ {
+		kernelArg := smiKernelArgConfig{
+			fmt.Sprintf("arg%d", i), i, 0, "s_axi_control", 4, offset, "uint"}
+		smiSdaKernelPackage.KernelArgs = append(
+			smiSdaKernelPackage.KernelArgs, kernelArg)
+		offset += 4
+	}
+	offset = (offset + 7) &^ 7
+	smiSdaKernelPackage.KernelArgs = append(smiSdaKernelPackage.KernelArgs,
+		smiKernelArgConfig{"gmem", kernelArgsWidth, 1, "m_axi_gmem", 8, offset, "void*"})
+
+	// This can only fail if MaxKernelArgsWidth is inconsistent with the
+	// control register map layout.
+	if offset+8 > smiSdaKernelPackage.ControlRange {
+		return smiSdaKernelPackage, errors.New(fmt.Sprintf(
+			"Kernel arguments (%d) overflow the control register map", kernelArgsWidth))
+	}
+
+	return smiSdaKernelPackage, nil
+}
+
+//
+// Execute the kernel description template using the supplied output file
+// handle and configuration.
+//
+func executeSmiSdaKernelXmlTemplate(outFile *os.File,
+	config smiSdaKernelPackageConfig) error {
+
+	return getSmiSdaKernelPackageTemplate().ExecuteTemplate(
+		outFile, "smiSdaKernelXml", config)
+}
+
+//
+// Execute the IP-XACT component template using the supplied output file
+// handle and configuration.
+//
+func executeSmiSdaComponentXmlTemplate(outFile *os.File,
+	config smiSdaKernelPackageConfig) error {
+
+	return getSmiSdaKernelPackageTemplate().ExecuteTemplate(
+		outFile, "smiSdaComponentXml", config)
+}
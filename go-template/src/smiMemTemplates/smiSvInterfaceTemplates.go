@@ -0,0 +1,727 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"os"
+	"text/template"
+)
+
+//
+// Defines the template for the SystemVerilog interface declarations used by
+// the SystemVerilog output style. The smi_if interface groups the request and
+// response signals of a single SMI memory bus connection. The 'client' modport
+// is used by components which issue memory requests and the 'server' modport
+// is used by components which service them. The axi4_if interface groups the
+// AXI4 memory bus signals.
+//
+var smiSvInterfacesTemplate = `
+{{define "smiSvInterfaces"}}{{template "smiMemBusFileHeaderTemplate" . }}
+//
+// SMI memory bus connection, where FlitWidth specifies the number of bytes in
+// each SMI flit.
+//
+interface smi_if #(parameter FlitWidth = 8);
+  logic                   reqReady;
+  logic [7:0]             reqEofc;
+  logic [FlitWidth*8-1:0] reqData;
+  logic                   reqStop;
+  logic                   respReady;
+  logic [7:0]             respEofc;
+  logic [FlitWidth*8-1:0] respData;
+  logic                   respStop;
+
+  modport client (
+    output reqReady, reqEofc, reqData, respStop,
+    input  reqStop, respReady, respEofc, respData);
+
+  modport server (
+    input  reqReady, reqEofc, reqData, respStop,
+    output reqStop, respReady, respEofc, respData);
+endinterface
+
+//
+// AXI4 memory bus, where DataWidth specifies the data bus width in bits and
+// IdWidth specifies the width of the ID signals.
+//
+interface axi4_if #(parameter DataWidth = 64, parameter IdWidth = 1);
+  logic [63:0]          awaddr;
+  logic [7:0]           awlen;
+  logic [2:0]           awsize;
+  logic [1:0]           awburst;
+  logic                 awlock;
+  logic [3:0]           awcache;
+  logic [2:0]           awprot;
+  logic [3:0]           awqos;
+  logic [3:0]           awregion;
+  logic [0:0]           awuser;
+  logic [IdWidth-1:0]   awid;
+  logic                 awvalid;
+  logic                 awready;
+
+  logic [DataWidth-1:0] wdata;
+  logic [DataWidth/8-1:0] wstrb;
+  logic [IdWidth-1:0]   wid;
+  logic                 wlast;
+  logic [0:0]           wuser;
+  logic                 wvalid;
+  logic                 wready;
+
+  logic [1:0]           bresp;
+  logic [0:0]           buser;
+  logic [IdWidth-1:0]   bid;
+  logic                 bvalid;
+  logic                 bready;
+
+  logic [63:0]          araddr;
+  logic [7:0]           arlen;
+  logic [2:0]           arsize;
+  logic [1:0]           arburst;
+  logic                 arlock;
+  logic [3:0]           arcache;
+  logic [2:0]           arprot;
+  logic [3:0]           arqos;
+  logic [3:0]           arregion;
+  logic [0:0]           aruser;
+  logic [IdWidth-1:0]   arid;
+  logic                 arvalid;
+  logic                 arready;
+
+  logic [DataWidth-1:0] rdata;
+  logic [1:0]           rresp;
+  logic                 rlast;
+  logic [0:0]           ruser;
+  logic [IdWidth-1:0]   rid;
+  logic                 rvalid;
+  logic                 rready;
+
+  modport master (
+    output awaddr, awlen, awsize, awburst, awlock, awcache, awprot, awqos,
+      awregion, awuser, awid, awvalid,
+    input  awready,
+    output wdata, wstrb, wid, wlast, wuser, wvalid,
+    input  wready,
+    input  bresp, buser, bid, bvalid,
+    output bready,
+    output araddr, arlen, arsize, arburst, arlock, arcache, arprot, arqos,
+      arregion, aruser, arid, arvalid,
+    input  arready,
+    input  rdata, rresp, rlast, ruser, rid, rvalid,
+    output rready);
+
+  modport slave (
+    input  awaddr, awlen, awsize, awburst, awlock, awcache, awprot, awqos,
+      awregion, awuser, awid, awvalid,
+    output awready,
+    input  wdata, wstrb, wid, wlast, wuser, wvalid,
+    output wready,
+    output bresp, buser, bid, bvalid,
+    input  bready,
+    input  araddr, arlen, arsize, arburst, arlock, arcache, arprot, arqos,
+      arregion, aruser, arid, arvalid,
+    output arready,
+    output rdata, rresp, rlast, ruser, rid, rvalid,
+    input  rready);
+endinterface
+{{end}}`
+
+//
+// Defines the template for declaring a list of SMI memory bus interface
+// instances for a given memory bus array.
+//
+var smiSvConnectionInstanceListTemplate = `
+{{define "smiSvConnectionInstanceList"}}{{if .}}{{range .}}
+smi_if #({{.SmiMemBusFlitWidth}}) {{makeSmiIfName .SmiNetReqName}} ();{{end}}
+{{end}}{{end}}`
+
+//
+// Defines the template for implementing direct SMI memory bus interface
+// assignments.
+//
+var smiSvMemBusAssignmentTemplate = `
+{{define "smiSvMemBusAssignment"}}{{$client := makeSmiIfName .SmiMemBusClientConn.SmiNetReqName}}` +
+	`{{$server := makeSmiIfName .SmiMemBusServerConn.SmiNetReqName}}
+// Directly map {{$client}} -> {{$server}}
+assign {{$server}}.reqReady = {{$client}}.reqReady;
+assign {{$server}}.reqEofc = {{$client}}.reqEofc;
+assign {{$server}}.reqData = {{$client}}.reqData;
+assign {{$client}}.reqStop = {{$server}}.reqStop;
+assign {{$client}}.respReady = {{$server}}.respReady;
+assign {{$client}}.respEofc = {{$server}}.respEofc;
+assign {{$client}}.respData = {{$server}}.respData;
+assign {{$server}}.respStop = {{$client}}.respStop;
+{{end}}`
+
+//
+// Defines the template for instantiating SMI memory bus width scaling modules
+// using SMI memory bus interfaces.
+//
+var smiSvMemBusWidthScalerTemplate = `
+{{define "smiSvMemBusWidthScaler"}}{{$client := makeSmiIfName .SmiMemBusClientConn.SmiNetReqName}}` +
+	`{{$server := makeSmiIfName .SmiMemBusServerConn.SmiNetReqName}}
+// Instantiate SMI request scaler {{.InstanceName}}Req
+{{printf "smiFlitScaleX%d" .SmiMemBusScaleFactor}} #(` +
+	`{{.SmiMemBusFlitWidth}}) {{.InstanceName}}Req (
+
+  .smiInReady  ({{$client}}.reqReady),
+  .smiInEofc   ({{$client}}.reqEofc),
+  .smiInData   ({{$client}}.reqData),
+  .smiInStop   ({{$client}}.reqStop),
+
+  .smiOutReady ({{$server}}.reqReady),
+  .smiOutEofc  ({{$server}}.reqEofc),
+  .smiOutData  ({{$server}}.reqData),
+  .smiOutStop  ({{$server}}.reqStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI response scaler {{.InstanceName}}Resp
+{{printf "smiFlitScaleD%d" .SmiMemBusScaleFactor}} #(` +
+	`{{.SmiMemBusFlitWidth}}*{{.SmiMemBusScaleFactor}}) {{.InstanceName}}Resp (
+
+  .smiInReady  ({{$server}}.respReady),
+  .smiInEofc   ({{$server}}.respEofc),
+  .smiInData   ({{$server}}.respData),
+  .smiInStop   ({{$server}}.respStop),
+
+  .smiOutReady ({{$client}}.respReady),
+  .smiOutEofc  ({{$client}}.respEofc),
+  .smiOutData  ({{$client}}.respData),
+  .smiOutStop  ({{$client}}.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+{{end}}`
+
+//
+// Defines the template for instantiating a single SMI memory bus arbitration
+// module using SMI memory bus interfaces.
+//
+var smiSvMemBusArbiterTemplate = `
+{{define "smiSvMemBusArbiter"}}{{$server := makeSmiIfName .SmiMemBusServerConn.SmiNetReqName}}
+// Instantiate transaction arbiter {{.InstanceName}}
+{{if .SmiMemBusScaleWidth}}` +
+	`{{len .SmiMemBusClientConns | printf "smiTransactionScaledArbiterX%d"}}` +
+	`{{else}}` +
+	`{{len .SmiMemBusClientConns | printf "smiTransactionArbiterX%d"}}` +
+	`{{end}} #({{.SmiMemBusFlitWidth}}, {{.SmiMemBusTagIdWidth}}, ` +
+	`{{.SmiFifoFlitDepth}}, {{.SmiFifoFrameDepth}}) {{.InstanceName}} (
+  {{range $index, $element := .SmiMemBusClientConns}}{{$client := makeSmiIfName $element.SmiNetReqName}}
+  {{makePortIdCharName ".smiReq%cInReady" $index}}   ({{$client}}.reqReady),
+  {{makePortIdCharName ".smiReq%cInEofc" $index}}    ({{$client}}.reqEofc),
+  {{makePortIdCharName ".smiReq%cInData" $index}}    ({{$client}}.reqData),
+  {{makePortIdCharName ".smiReq%cInStop" $index}}    ({{$client}}.reqStop),
+  {{makePortIdCharName ".smiResp%cOutReady" $index}} ({{$client}}.respReady),
+  {{makePortIdCharName ".smiResp%cOutEofc" $index}}  ({{$client}}.respEofc),
+  {{makePortIdCharName ".smiResp%cOutData" $index}}  ({{$client}}.respData),
+  {{makePortIdCharName ".smiResp%cOutStop" $index}}  ({{$client}}.respStop),
+  {{end}}
+  .smiReqOutReady ({{$server}}.reqReady),
+  .smiReqOutEofc  ({{$server}}.reqEofc),
+  .smiReqOutData  ({{$server}}.reqData),
+  .smiReqOutStop  ({{$server}}.reqStop),
+  .smiRespInReady ({{$server}}.respReady),
+  .smiRespInEofc  ({{$server}}.respEofc),
+  .smiRespInData  ({{$server}}.respData),
+  .smiRespInStop  ({{$server}}.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+{{end}}`
+
+//
+// Defines the template for instantiating an SMI memory bus pipeline stage
+// using SMI memory bus interfaces.
+//
+var smiSvMemBusPipelineTemplate = `
+{{define "smiSvMemBusPipeline"}}{{$client := makeSmiIfName .SmiMemBusClientConn.SmiNetReqName}}` +
+	`{{$server := makeSmiIfName .SmiMemBusServerConn.SmiNetReqName}}
+// Instantiate SMI pipeline stage {{.InstanceName}}
+smiMemBusPipelineStage #({{.SmiMemBusFlitWidth}}) {{.InstanceName}} (
+
+  .smiReqInReady   ({{$client}}.reqReady),
+  .smiReqInEofc    ({{$client}}.reqEofc),
+  .smiReqInData    ({{$client}}.reqData),
+  .smiReqInStop    ({{$client}}.reqStop),
+  .smiRespOutReady ({{$client}}.respReady),
+  .smiRespOutEofc  ({{$client}}.respEofc),
+  .smiRespOutData  ({{$client}}.respData),
+  .smiRespOutStop  ({{$client}}.respStop),
+
+  .smiReqOutReady  ({{$server}}.reqReady),
+  .smiReqOutEofc   ({{$server}}.reqEofc),
+  .smiReqOutData   ({{$server}}.reqData),
+  .smiReqOutStop   ({{$server}}.reqStop),
+  .smiRespInReady  ({{$server}}.respReady),
+  .smiRespInEofc   ({{$server}}.respEofc),
+  .smiRespInData   ({{$server}}.respData),
+  .smiRespInStop   ({{$server}}.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+{{end}}`
+
+//
+// Defines the template for instantiating an arbitration tree using the SMI
+// memory bus interfaces.
+//
+var smiSvMemBusArbitrationTreeTemplate = `
+{{define "smiSvMemBusArbitrationTree"}}{{template "smiMemBusFileHeaderTemplate" . }}
+module {{.ModuleName}} (
+{{range .SmiMemBusClientConns}}
+  // SMI client port {{makeSmiIfName .SmiNetReqName}}
+  smi_if.server {{makeSmiIfName .SmiNetReqName}},
+{{end}}{{range .SmiMemBusServerConn}}
+  // SMI server port {{makeSmiIfName .SmiNetReqName}}
+  smi_if.client {{makeSmiIfName .SmiNetReqName}},
+{{end}}
+  // Specify system level signals.
+  input logic clk,
+  input logic srst
+);
+{{template "smiSvConnectionInstanceList" .SmiMemBusWireConns}}
+{{range .SmiMemBusAssignments}}{{template "smiSvMemBusAssignment" .}}{{end}}
+{{range .SmiMemBusWidthScalers}}{{template "smiSvMemBusWidthScaler" .}}{{end}}
+{{range .SmiMemBusArbiters}}{{template "smiSvMemBusArbiter" .}}{{end}}` +
+	`{{range .SmiMemBusPipelines}}{{template "smiSvMemBusPipeline" .}}{{end}}
+endmodule
+{{end}}`
+
+//
+// Defines the common kernel adaptor template sections for the SystemVerilog
+// output style. These may be used with any of the AXI based kernel adaptor
+// configurations.
+//
+var smiSvKernelAdaptorCommonTemplate = `
+{{define "smiSvKernelAdaptorWires"}}
+// SMI memory bus interfaces.
+{{template "smiSvConnectionInstanceList" .SmiMemBusWireConns}}
+// Concatenated SMI flit vectors. {{range .SmiMemBusClientConns}}
+logic [ 71:0] {{.SmiNetReqName}}Flit;
+logic [ 71:0] {{.SmiNetRespName}}Flit;{{end}}
+{{end}}` +
+	`{{define "smiSvAxiBusAdaptor"}}
+//
+// Instantiate the SMI/AXI memory controller adaptor.
+//
+smiAxiMemBusAdaptor #({{.AxiByteIndexSize}}, {{.AxiBusIdWidth}}, 33) axiBusAdaptor (
+  {{with $wire := index .SmiMemBusServerConn 0}}{{$server := makeSmiIfName $wire.SmiNetReqName}}
+  // Connect SMI main memory bus.
+  .smiReqReady  ({{$server}}.reqReady),
+  .smiReqEofc   ({{$server}}.reqEofc),
+  .smiReqData   ({{$server}}.reqData),
+  .smiReqStop   ({{$server}}.reqStop),
+  .smiRespReady ({{$server}}.respReady),
+  .smiRespEofc  ({{$server}}.respEofc),
+  .smiRespData  ({{$server}}.respData),
+  .smiRespStop  ({{$server}}.respStop),
+  {{end}}
+  // Connect active AXI read data bus signals.
+  .axiARValid   (m_axi_gmem.arvalid),
+  .axiARReady   (m_axi_gmem.arready),
+  .axiARId      (m_axi_gmem.arid),
+  .axiARAddr    (m_axi_gmem.araddr),
+  .axiARLen     (m_axi_gmem.arlen),
+  .axiARSize    (m_axi_gmem.arsize),
+  .axiARCache   (m_axi_gmem.arcache),
+
+  .axiRValid    (m_axi_gmem.rvalid),
+  .axiRReady    (m_axi_gmem.rready),
+  .axiRId       (m_axi_gmem.rid),
+  .axiRData     (m_axi_gmem.rdata),
+  .axiRResp     (m_axi_gmem.rresp),
+  .axiRLast     (m_axi_gmem.rlast),
+
+  // Connect active AXI write data bus signals.
+  .axiAWValid   (m_axi_gmem.awvalid),
+  .axiAWReady   (m_axi_gmem.awready),
+  .axiAWId      (m_axi_gmem.awid),
+  .axiAWAddr    (m_axi_gmem.awaddr),
+  .axiAWLen     (m_axi_gmem.awlen),
+  .axiAWSize    (m_axi_gmem.awsize),
+  .axiAWCache   (m_axi_gmem.awcache),
+
+  .axiWValid    (m_axi_gmem.wvalid),
+  .axiWReady    (m_axi_gmem.wready),
+  .axiWId       (m_axi_gmem.wid),
+  .axiWData     (m_axi_gmem.wdata),
+  .axiWStrb     (m_axi_gmem.wstrb),
+  .axiWLast     (m_axi_gmem.wlast),
+
+  .axiBValid    (m_axi_gmem.bvalid),
+  .axiBReady    (m_axi_gmem.bready),
+  .axiBId       (m_axi_gmem.bid),
+  .axiBResp     (m_axi_gmem.bresp),
+
+  // Connect system level signals.
+  .axiReset     ({{.ClockDomains.MemResetName}}),
+  .clk          ({{.ClockDomains.MemClockName}}),
+  .srst         ({{.ClockDomains.MemResetName}})
+);
+
+//
+// Tie off static AXI signals.
+//
+assign m_axi_gmem.arburst  = 2'b01;
+assign m_axi_gmem.arlock   = 1'b0;
+assign m_axi_gmem.arprot   = 3'b000;
+assign m_axi_gmem.arqos    = 4'b0000;
+assign m_axi_gmem.arregion = 4'b0000;
+assign m_axi_gmem.aruser   = 1'b0;
+
+assign m_axi_gmem.awburst  = 2'b01;
+assign m_axi_gmem.awlock   = 1'b0;
+assign m_axi_gmem.awprot   = 3'b000;
+assign m_axi_gmem.awqos    = 4'b0000;
+assign m_axi_gmem.awregion = 4'b0000;
+assign m_axi_gmem.awuser   = 1'b0;
+assign m_axi_gmem.wuser    = 1'b0;
+{{end}}` +
+	`{{define "smiSvArbitrationTreeInstance"}}
+//
+// Instantiate the memory access arbitration logic.
+//
+{{.ArbitrationModuleName}} memArbitrationTree (
+{{range .SmiMemBusClientConns}}{{$client := makeSmiIfName .SmiNetReqName}}
+  .{{$client}} ({{$client}}),{{end}}{{range .SmiMemBusServerConn}}{{$server := makeSmiIfName .SmiNetReqName}}
+  .{{$server}} ({{$server}}),{{end}}
+
+  // Connect system level signals.
+  .clk  (clk),
+  .srst (reset)
+);
+
+//
+// Map SMI flit vector signals.
+// {{range .SmiMemBusClientConns}}{{$client := makeSmiIfName .SmiNetReqName}}
+assign {{$client}}.reqData = {{.SmiNetReqName}}Flit [63:0];
+assign {{$client}}.reqEofc = {{.SmiNetReqName}}Flit [71:64];
+assign {{.SmiNetRespName}}Flit = { {{$client}}.respEofc, {{$client}}.respData };
+{{end}}{{end}}`
+
+//
+// Defines the template for instantiating a common SMI LLVM kernel adaptor
+// module using the SystemVerilog output style.
+//
+var smiSvLlvmKernelAdaptorTemplate = `
+{{define "smiSvLlvmKernelAdaptor"}}{{template "smiMemBusFileHeaderTemplate" . }}
+module {{.ModuleName}} (
+
+  // Kernel control signals.
+  input  logic         argsReady,
+  input  logic {{makeBitSliceFromScaledWidth .KernelArgsWidth 32}} argsData,
+  output logic         argsStop,
+  output logic         retValReady,
+  input  logic         retValStop,
+
+  // Specifies the AXI master interface.
+  axi4_if.master       m_axi_gmem,
+
+  // Specify system level signals.
+  input  logic         clk,
+  input  logic         reset
+);
+{{template "smiSvKernelAdaptorWires" .}}
+{{template "smiSvAxiBusAdaptor" .}}
+{{template "smiSvArbitrationTreeInstance" .}}
+//
+// Instantiate the SMI kernel logic.
+//
+{{.KernelModuleName}} smiKernel (
+
+  // Connect kernel control signals.
+  .args0_0Ready   (argsReady),
+` + "`ifdef KERNEL_ARGS_DATA" + `
+  .args0_0Data    (argsData),
+` + "`endif" + `
+  .args0_0Stop    (argsStop),
+  .retVal1_0Ready (retValReady),
+  .retVal1_0Stop  (retValStop),
+{{range $index, $element := .SmiMemBusClientConns}}{{$client := makeSmiIfName $element.SmiNetReqName}}
+  // Connect SMI for {{$client}}.
+  {{makePortIdIndexName ".request%d_0Ready " $index 2 2}} ({{$client}}.reqReady),
+  {{makePortIdIndexName ".request%d_0Data  " $index 2 2}} ({{$element.SmiNetReqName}}Flit),
+  {{makePortIdIndexName ".request%d_0Stop  " $index 2 2}} ({{$client}}.reqStop),
+  {{makePortIdIndexName ".response%d_0Ready" $index 3 2}} ({{$client}}.respReady),
+  {{makePortIdIndexName ".response%d_0Data " $index 3 2}} ({{$element.SmiNetRespName}}Flit),
+  {{makePortIdIndexName ".response%d_0Stop " $index 3 2}} ({{$client}}.respStop),
+{{end}}
+  // Connect system level signals.
+  .clk   (clk),
+  .reset (reset)
+);
+
+endmodule
+{{end}}`
+
+//
+// Defines the template for instantiating an SMI SDAccel kernel adaptor module
+// using the SystemVerilog output style.
+//
+var smiSvSdaKernelAdaptorTemplate = `
+{{define "smiSvSdaKernelAdaptor"}}{{template "smiMemBusFileHeaderTemplate" . }}
+module {{.ModuleName}} (
+
+  // Action control signals.
+  input  logic         go_0Ready,
+  output logic         go_0Stop,
+  output logic         done_0Ready,
+  input  logic         done_0Stop,
+
+  // Specifies the AXI slave read bus signals.
+  input  logic [ 31:0] s_axi_araddr,
+  input  logic [  3:0] s_axi_arcache,
+  input  logic [  2:0] s_axi_arprot,
+  input  logic         s_axi_arvalid,
+  output logic         s_axi_arready,
+  output logic [ 31:0] s_axi_rdata,
+  output logic [  1:0] s_axi_rresp,
+  output logic         s_axi_rvalid,
+  input  logic         s_axi_rready,
+
+  // Specifies the AXI slave write bus signals.
+  input  logic [ 31:0] s_axi_awaddr,
+  input  logic [  3:0] s_axi_awcache,
+  input  logic [  2:0] s_axi_awprot,
+  input  logic         s_axi_awvalid,
+  output logic         s_axi_awready,
+  input  logic [ 31:0] s_axi_wdata,
+  input  logic [  3:0] s_axi_wstrb,
+  input  logic         s_axi_wvalid,
+  output logic         s_axi_wready,
+  output logic [  1:0] s_axi_bresp,
+  output logic         s_axi_bvalid,
+  input  logic         s_axi_bready,
+
+  // Specifies the AXI master interface.
+  axi4_if.master       m_axi_gmem,
+
+  // Specifies the parameter register file data access signals.
+  output logic         paramaddr_0Ready,
+  output logic [ 31:0] paramaddr_0Data,
+  input  logic         paramaddr_0Stop,
+  input  logic         paramdata_0Ready,
+  input  logic [ 31:0] paramdata_0Data,
+  output logic         paramdata_0Stop,
+
+  // Specify system level signals.
+  input  logic         clk,
+  input  logic         reset
+);
+{{template "smiSvKernelAdaptorWires" .}}
+{{template "smiSvAxiBusAdaptor" .}}
+{{template "smiSvArbitrationTreeInstance" .}}
+//
+// Instantiate the SMI kernel logic.
+//
+{{.KernelModuleName}} smiKernel (
+
+  // Connect action control signals.
+  .go_0Ready   (go_0Ready),
+  .go_0Stop    (go_0Stop),
+  .done_0Ready (done_0Ready),
+  .done_0Stop  (done_0Stop),
+
+  // Connect parameter register file access signals.
+  .paramaddr_0Ready (paramaddr_0Ready),
+  .paramaddr_0Data  (paramaddr_0Data),
+  .paramaddr_0Stop  (paramaddr_0Stop),
+  .paramdata_0Ready (paramdata_0Ready),
+  .paramdata_0Data  (paramdata_0Data),
+  .paramdata_0Stop  (paramdata_0Stop),
+
+{{range $index, $element := .SmiMemBusClientConns}}{{$client := makeSmiIfName $element.SmiNetReqName}}
+  // Connect SMI for {{$client}}.
+  {{printf ".smiport%dreq_0Ready" $index}}  ({{$client}}.reqReady),
+  {{printf ".smiport%dreq_0Data" $index}}   ({{$element.SmiNetReqName}}Flit),
+  {{printf ".smiport%dreq_0Stop" $index}}   ({{$client}}.reqStop),
+  {{printf ".smiport%dresp_0Ready" $index}} ({{$client}}.respReady),
+  {{printf ".smiport%dresp_0Data" $index}}  ({{$element.SmiNetRespName}}Flit),
+  {{printf ".smiport%dresp_0Stop" $index}}  ({{$client}}.respStop),
+{{end}}
+  // Connect AXI slave read bus signals.
+  .s_axi_araddr  (s_axi_araddr),
+  .s_axi_arcache (s_axi_arcache),
+  .s_axi_arprot  (s_axi_arprot),
+  .s_axi_arvalid (s_axi_arvalid),
+  .s_axi_arready (s_axi_arready),
+  .s_axi_rdata   (s_axi_rdata),
+  .s_axi_rresp   (s_axi_rresp),
+  .s_axi_rvalid  (s_axi_rvalid),
+  .s_axi_rready  (s_axi_rready),
+
+  // Connect AXI slave write bus signals.
+  .s_axi_awaddr  (s_axi_awaddr),
+  .s_axi_awcache (s_axi_awcache),
+  .s_axi_awprot  (s_axi_awprot),
+  .s_axi_awvalid (s_axi_awvalid),
+  .s_axi_awready (s_axi_awready),
+  .s_axi_wdata   (s_axi_wdata),
+  .s_axi_wstrb   (s_axi_wstrb),
+  .s_axi_wvalid  (s_axi_wvalid),
+  .s_axi_wready  (s_axi_wready),
+  .s_axi_bresp   (s_axi_bresp),
+  .s_axi_bvalid  (s_axi_bvalid),
+  .s_axi_bready  (s_axi_bready),
+
+  // Connect system level signals.
+  .clk   (clk),
+  .reset (reset)
+);
+
+endmodule
+{{end}}`
+
+//
+// Defines the template for instantiating a Huawei FP1 SMI kernel adaptor module
+// using the SystemVerilog output style.
+//
+var smiSvFp1KernelAdaptorTemplate = `
+{{define "smiSvFp1KernelAdaptor"}}{{template "smiMemBusFileHeaderTemplate" . }}
+module {{.ModuleName}} (
+
+  // Action control signals.
+  input  logic         go_ready,
+  output logic         go_stop,
+  output logic         done_ready,
+  input  logic         done_stop,
+
+  // Configuration register file access signals.
+  output logic         config_req_valid,
+  output logic [ 31:0] config_req_data,
+  input  logic         config_req_stop,
+  input  logic         config_resp_valid,
+  input  logic [ 31:0] config_resp_data,
+  output logic         config_resp_stop,
+
+  // Kernel internal register access signals.
+  input  logic         control_req_valid,
+  input  logic [ 64:0] control_req_data,
+  output logic         control_req_stop,
+  output logic         control_resp_valid,
+  output logic [ 33:0] control_resp_data,
+  input  logic         control_resp_stop,
+
+  // Kernel interrupt queue signals.
+  output logic         interrupt_valid,
+  output logic [ 31:0] interrupt_data,
+  input  logic         interrupt_stop,
+
+  // Specifies the AXI master interface.
+  axi4_if.master       m_axi_gmem,
+
+  // Specify system level signals.
+  input  logic         clk,
+  input  logic         reset
+);
+{{template "smiSvKernelAdaptorWires" .}}
+{{template "smiSvAxiBusAdaptor" .}}
+{{template "smiSvArbitrationTreeInstance" .}}
+//
+// Instantiate the SMI kernel logic. Uses positional signal assignment since
+// the Go kernel parameter names may vary.
+//
+{{.KernelModuleName}} smiKernel (
+
+  // Connect action control signals.
+  go_ready,
+  go_stop,
+  done_ready,
+  done_stop,
+
+  // Connect configuration register file access signals.
+  config_req_valid,
+  config_req_data,
+  config_req_stop,
+  config_resp_valid,
+  config_resp_data,
+  config_resp_stop,
+
+  // Kernel internal register access signals.
+  control_req_valid,
+  control_req_data,
+  control_req_stop,
+  control_resp_valid,
+  control_resp_data,
+  control_resp_stop,
+
+  // Kernel interrupt queue signals.
+  interrupt_valid,
+  interrupt_data,
+  interrupt_stop,
+{{range $index, $element := .SmiMemBusClientConns}}{{$client := makeSmiIfName $element.SmiNetReqName}}
+  // Connect SMI for {{$client}}.
+  {{$client}}.reqReady,
+  {{$element.SmiNetReqName}}Flit,
+  {{$client}}.reqStop,
+  {{$client}}.respReady,
+  {{$element.SmiNetRespName}}Flit,
+  {{$client}}.respStop,
+{{end}}
+  // Connect system level signals.
+  clk,
+  reset
+);
+
+endmodule
+{{end}}`
+
+//
+// Cache the parsed SystemVerilog templates.
+//
+var smiSvTemplateCache *template.Template = nil
+
+//
+// Implement lazy construction of the SystemVerilog templates.
+//
+func getSmiSvTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
+	if smiSvTemplateCache == nil {
+		templGroup := template.New("").Funcs(smiTemplateFunctions)
+		templGroup = template.Must(templGroup.Parse(smiMemBusFileHeaderTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvInterfacesTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvConnectionInstanceListTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvMemBusAssignmentTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvMemBusWidthScalerTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvMemBusArbiterTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvMemBusPipelineTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvMemBusArbitrationTreeTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvKernelAdaptorCommonTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvLlvmKernelAdaptorTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvSdaKernelAdaptorTemplate))
+		templGroup = template.Must(templGroup.Parse(smiSvFp1KernelAdaptorTemplate))
+		smiSvTemplateCache = templGroup
+	}
+	return smiSvTemplateCache
+}
+
+//
+// Execute the named SystemVerilog template using the supplied output file
+// handle and configuration. The configuration may be any of the arbitration
+// tree or AXI based kernel adaptor configurations.
+//
+func executeSmiSvTemplate(outFile *os.File, templateName string,
+	config interface{}) error {
+
+	return getSmiSvTemplate().ExecuteTemplate(outFile, templateName, config)
+}
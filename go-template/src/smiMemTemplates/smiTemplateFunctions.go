@@ -18,6 +18,10 @@ package smiMemTemplates
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"text/template"
 	"time"
 )
@@ -53,12 +57,114 @@ func makePortIdIndexName(portNamePattern string, index int, a int, b int) string
 	return fmt.Sprintf(portNamePattern, a+b*index)
 }
 
+//
+// Serialises the lazy construction of the parsed template caches, so that
+// files may be generated concurrently. The parsed templates may be executed
+// concurrently once they have been constructed.
+//
+var templateCacheLock sync.Mutex
+
+//
+// Specifies the time source used when timestamping generated files. This may
+// be replaced in order to generate reproducible output.
+//
+var fileTimestampSource = time.Now
+
 //
 // Creates a time and date string which can be used for timestamping generated
 // files.
 //
 func makeFileTimestamp() string {
-	return time.Now().Format(time.RFC1123)
+	return fileTimestampSource().Format(time.RFC1123)
+}
+
+//
+// Matches the file creation timestamps included in generated files.
+//
+var fileTimestampRegexp = regexp.MustCompile(
+	`Created [A-Z][a-z]{2}, [0-9]{2} [A-Z][a-z]{2} [0-9]{4} [0-9]{2}:[0-9]{2}:[0-9]{2} [A-Z0-9+-]+`)
+
+//
+// SameGeneratedContent compares the contents of two generated files, ignoring
+// any differences in the file creation timestamps. This can be used to avoid
+// rewriting generated files which have not changed.
+//
+func SameGeneratedContent(a []byte, b []byte) bool {
+	a = fileTimestampRegexp.ReplaceAllLiteral(a, []byte("Created"))
+	b = fileTimestampRegexp.ReplaceAllLiteral(b, []byte("Created"))
+	return string(a) == string(b)
+}
+
+//
+// Derives the most significant bit index for a vector of the specified width.
+//
+func makeVectorMsbIndex(width uint) uint {
+	return width - 1
+}
+
+//
+// Derives the data width of a memory mapped bus interface from the width of
+// its read data port.
+//
+func makeBusDataWidth(ports []smiKernelPortConfig) uint {
+	for _, port := range ports {
+		if port.LogicalName == "RDATA" {
+			return port.Width
+		}
+	}
+	return 0
+}
+
+//
+// Derives the bus interface name which will be inferred by the Vivado IP
+// packager from the port name prefix of the first bus interface port.
+//
+func makeInferredBusName(ports []smiKernelPortConfig) string {
+	if len(ports) == 0 {
+		return ""
+	}
+	portName := ports[0].PortName
+	return portName[:strings.LastIndex(portName, "_")]
+}
+
+//
+// Derives the SystemVerilog SMI memory bus interface instance name from the
+// name of the SMI request connection by removing the 'Req' qualifier.
+//
+func makeSmiIfName(reqName string) string {
+	return strings.Replace(reqName, "Req", "", 1)
+}
+
+//
+// Derives the VHDL type for a port or signal of the specified width, which
+// may be an integer or a generic expression. An empty width denotes a single
+// bit.
+//
+func makeVhdlType(width string) string {
+	if width == "" {
+		return "std_logic"
+	}
+	if n, err := strconv.Atoi(width); err == nil {
+		return fmt.Sprintf("std_logic_vector(%d downto 0)", n-1)
+	}
+	return fmt.Sprintf("std_logic_vector(%s-1 downto 0)", width)
+}
+
+//
+// Matches names which are valid VHDL basic identifiers.
+//
+var vhdlBasicIdentifierRegexp = regexp.MustCompile("^[A-Za-z](_?[A-Za-z0-9])*$")
+
+//
+// Converts a Verilog module name to a VHDL identifier. Names which are not
+// valid VHDL basic identifiers, such as those containing consecutive
+// underscores, are converted to VHDL extended identifiers.
+//
+func makeVhdlIdentifier(name string) string {
+	if vhdlBasicIdentifierRegexp.MatchString(name) {
+		return name
+	}
+	return "\\" + name + "\\"
 }
 
 //
@@ -69,4 +175,10 @@ var smiTemplateFunctions = template.FuncMap{
 	"makeBitSliceFromIndexSize":   makeBitSliceFromIndexSize,
 	"makePortIdCharName":          makePortIdCharName,
 	"makePortIdIndexName":         makePortIdIndexName,
-	"makeFileTimestamp":           makeFileTimestamp}
+	"makeFileTimestamp":           makeFileTimestamp,
+	"makeVectorMsbIndex":          makeVectorMsbIndex,
+	"makeBusDataWidth":            makeBusDataWidth,
+	"makeInferredBusName":         makeInferredBusName,
+	"makeSmiIfName":               makeSmiIfName,
+	"makeVhdlType":                makeVhdlType,
+	"makeVhdlIdentifier":          makeVhdlIdentifier}
@@ -0,0 +1,767 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiFuzzModel"
+	"os"
+	"strings"
+	"text/template"
+)
+
+//
+// Specifies the Verilator model class name used by the generated harness. This
+// avoids any dependency on the Verilator name mangling of the top level module.
+//
+const smiVerilatorHarnessPrefix = "VsmiHarnessTop"
+
+//
+// Specifies the default fuzz test kernel arguments used by the generated
+// harness simulation.
+//
+const (
+	smiHarnessFuzzBlockSize = 4096
+	smiHarnessFuzzNumTests  = 16
+)
+
+//
+// Defines the template configuration options for a Verilator simulation
+// harness and its associated Makefile fragment.
+//
+type smiVerilatorHarnessConfig struct {
+	ModuleName       string   // Name of the top level adaptor module.
+	HarnessName      string   // Base name of the harness files.
+	ModelPrefix      string   // Verilator model class name.
+	Platform         string   // Target platform ('sdaccel' or 'llvm').
+	AxiDataBytes     uint     // AXI memory bus data width in bytes.
+	AxiWideData      bool     // AXI data is represented as a Verilator wide array.
+	KernelArgsWidth  uint     // Number of 32-bit kernel argument words.
+	KernelArgsOffset uint     // Control register offset of the first kernel argument.
+	ArgsWideData     bool     // Kernel arguments are represented as a wide array.
+	SourceFiles      []string // List of generated source files.
+	UseTestFiles     bool     // Test component directory is required.
+	SimArgs          string   // Default simulation arguments.
+}
+
+//
+// Defines the template for the Verilator C++ simulation harness. This drives
+// the clock, reset and kernel start and completion handshakes, supplies the
+// kernel arguments and attaches a behavioural AXI memory model to the
+// m_axi_gmem interface.
+//
+var smiVerilatorHarnessTemplate = `
+{{define "smiVerilatorHarness"}}//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Created {{makeFileTimestamp}}
+// Machine generated file - DO NOT EDIT
+//
+// Verilator smoke test harness for {{.ModuleName}}. Run using:
+//   {{.ModelPrefix}} [options] [<kernel argument word> ...]
+//
+// Options:
+//   -cycles <n>             maximum number of clock cycles to simulate
+//   -latency <n>            AXI memory access latency in clock cycles
+//   -load <addr>=<file>     load a binary file into memory before starting
+//   -print <addr>           print a 64-bit memory word on completion
+//   -expect <addr>=<value>  check a 64-bit memory word on completion
+//
+
+#include <cstdint>
+#include <cstdio>
+#include <cstdlib>
+#include <cstring>
+#include <deque>
+#include <fstream>
+#include <iterator>
+#include <map>
+#include <string>
+#include <vector>
+
+#include "verilated.h"
+#include "{{.ModelPrefix}}.h"
+
+typedef {{.ModelPrefix}} HarnessTop;
+
+static const size_t AXI_DATA_BYTES = {{.AxiDataBytes}};
+static const unsigned KERNEL_ARGS_WIDTH = {{.KernelArgsWidth}};
+static const unsigned RESET_CYCLES = 16;
+static const uint64_t PAGE_SIZE = 4096;
+
+//
+// Implements a sparse byte addressed memory, with unwritten locations reading
+// as zero.
+//
+class HarnessMemory {
+ public:
+  void read(uint64_t addr, uint8_t* data, size_t size) {
+    for (size_t i = 0; i < size; i++) {
+      std::map<uint64_t, std::vector<uint8_t> >::iterator page =
+          pages.find((addr + i) / PAGE_SIZE);
+      data[i] = (page == pages.end()) ? 0 : page->second[(addr + i) % PAGE_SIZE];
+    }
+  }
+
+  void write(uint64_t addr, const uint8_t* data, size_t size) {
+    for (size_t i = 0; i < size; i++) {
+      std::vector<uint8_t>& page = pages[(addr + i) / PAGE_SIZE];
+      if (page.empty()) {
+        page.resize(PAGE_SIZE, 0);
+      }
+      page[(addr + i) % PAGE_SIZE] = data[i];
+    }
+  }
+
+  uint64_t readWord(uint64_t addr) {
+    uint8_t data[8];
+    read(addr, data, 8);
+    uint64_t value = 0;
+    for (int i = 7; i >= 0; i--) {
+      value = (value << 8) | data[i];
+    }
+    return value;
+  }
+
+ private:
+  std::map<uint64_t, std::vector<uint8_t> > pages;
+};
+
+//
+// Specifies an AXI burst held by the memory model.
+//
+struct HarnessAxiBurst {
+  uint32_t id;
+  uint64_t addr;
+  unsigned len;
+  unsigned size;
+  unsigned beat;
+  uint64_t readyCycle;
+
+  uint64_t beatAddr() const {
+    uint64_t bytes = uint64_t(1) << size;
+    if (beat == 0) {
+      return addr;
+    }
+    return (addr & ~(bytes - 1)) + beat * bytes;
+  }
+};
+
+//
+// Transfers AXI data between the model ports and little endian byte arrays.
+//
+static void setReadData(HarnessTop* top, const uint8_t* data) {
+{{- if .AxiWideData}}
+  for (size_t i = 0; i < AXI_DATA_BYTES / 4; i++) {
+    uint32_t word = 0;
+    for (int j = 3; j >= 0; j--) {
+      word = (word << 8) | data[4 * i + j];
+    }
+    top->m_axi_gmem_rdata[i] = word;
+  }
+{{- else}}
+  uint64_t word = 0;
+  for (int j = AXI_DATA_BYTES - 1; j >= 0; j--) {
+    word = (word << 8) | data[j];
+  }
+  top->m_axi_gmem_rdata = word;
+{{- end}}
+}
+
+static void getWriteData(HarnessTop* top, uint8_t* data) {
+{{- if .AxiWideData}}
+  for (size_t i = 0; i < AXI_DATA_BYTES / 4; i++) {
+    uint32_t word = top->m_axi_gmem_wdata[i];
+    for (int j = 0; j < 4; j++) {
+      data[4 * i + j] = uint8_t(word >> (8 * j));
+    }
+  }
+{{- else}}
+  uint64_t word = top->m_axi_gmem_wdata;
+  for (size_t j = 0; j < AXI_DATA_BYTES; j++) {
+    data[j] = uint8_t(word >> (8 * j));
+  }
+{{- end}}
+}
+
+//
+// Implements a behavioural AXI memory slave with a fixed access latency. Read
+// and write bursts are completed in order.
+//
+class HarnessAxiMemory {
+ public:
+  HarnessAxiMemory(HarnessMemory& memory, unsigned latency)
+      : memory(memory), latency(latency), protocolErrors(0) {}
+
+  // Drives the slave outputs for the current clock cycle.
+  void drive(HarnessTop* top, uint64_t cycle) {
+    top->m_axi_gmem_arready = (reads.size() < MAX_OUTSTANDING) ? 1 : 0;
+    top->m_axi_gmem_rvalid = 0;
+    top->m_axi_gmem_rlast = 0;
+    top->m_axi_gmem_rresp = 0;
+    if (!reads.empty() && (cycle >= reads.front().readyCycle)) {
+      const HarnessAxiBurst& burst = reads.front();
+      uint8_t data[AXI_DATA_BYTES];
+      memory.read(burst.beatAddr() & ~uint64_t(AXI_DATA_BYTES - 1), data, AXI_DATA_BYTES);
+      setReadData(top, data);
+      top->m_axi_gmem_rvalid = 1;
+      top->m_axi_gmem_rid = burst.id;
+      top->m_axi_gmem_rlast = (burst.beat == burst.len) ? 1 : 0;
+    }
+    top->m_axi_gmem_awready = (writes.size() < MAX_OUTSTANDING) ? 1 : 0;
+    top->m_axi_gmem_wready = writes.empty() ? 0 : 1;
+    top->m_axi_gmem_bvalid = 0;
+    top->m_axi_gmem_bresp = 0;
+    if (!responses.empty() && (cycle >= responses.front().readyCycle)) {
+      top->m_axi_gmem_bvalid = 1;
+      top->m_axi_gmem_bid = responses.front().id;
+    }
+  }
+
+  // Updates the model state using the handshakes prior to the clock edge.
+  void clock(HarnessTop* top, uint64_t cycle) {
+    if (top->m_axi_gmem_arvalid && top->m_axi_gmem_arready) {
+      HarnessAxiBurst burst = {uint32_t(top->m_axi_gmem_arid), top->m_axi_gmem_araddr,
+          unsigned(top->m_axi_gmem_arlen), unsigned(top->m_axi_gmem_arsize), 0,
+          cycle + latency};
+      checkBurst(burst);
+      reads.push_back(burst);
+    }
+    if (top->m_axi_gmem_rvalid && top->m_axi_gmem_rready) {
+      if (reads.front().beat++ == reads.front().len) {
+        reads.pop_front();
+      }
+    }
+    if (top->m_axi_gmem_awvalid && top->m_axi_gmem_awready) {
+      HarnessAxiBurst burst = {uint32_t(top->m_axi_gmem_awid), top->m_axi_gmem_awaddr,
+          unsigned(top->m_axi_gmem_awlen), unsigned(top->m_axi_gmem_awsize), 0, 0};
+      checkBurst(burst);
+      writes.push_back(burst);
+    }
+    if (top->m_axi_gmem_wvalid && top->m_axi_gmem_wready) {
+      HarnessAxiBurst& burst = writes.front();
+      uint8_t data[AXI_DATA_BYTES];
+      uint64_t strobes = top->m_axi_gmem_wstrb;
+      uint64_t wordAddr = burst.beatAddr() & ~uint64_t(AXI_DATA_BYTES - 1);
+      getWriteData(top, data);
+      for (size_t i = 0; i < AXI_DATA_BYTES; i++) {
+        if ((strobes >> i) & 1) {
+          memory.write(wordAddr + i, &data[i], 1);
+        }
+      }
+      bool last = (burst.beat++ == burst.len);
+      if (last != bool(top->m_axi_gmem_wlast)) {
+        std::fprintf(stderr, "AXI write burst at 0x%016llX has mismatched WLAST\n",
+            (unsigned long long) burst.addr);
+        protocolErrors++;
+      }
+      if (last) {
+        burst.readyCycle = cycle + latency;
+        responses.push_back(burst);
+        writes.pop_front();
+      }
+    }
+    if (top->m_axi_gmem_bvalid && top->m_axi_gmem_bready) {
+      responses.pop_front();
+    }
+  }
+
+  unsigned errors() const { return protocolErrors; }
+
+ private:
+  static const size_t MAX_OUTSTANDING = 8;
+
+  // Bursts must not cross a 4KB address boundary.
+  void checkBurst(const HarnessAxiBurst& burst) {
+    uint64_t bytes = uint64_t(1) << burst.size;
+    uint64_t start = burst.addr & ~(bytes - 1);
+    uint64_t end = start + (burst.len + 1) * bytes - 1;
+    if ((bytes > AXI_DATA_BYTES) || ((start / 4096) != (end / 4096))) {
+      std::fprintf(stderr, "Invalid AXI burst at 0x%016llX (len %u, size %u)\n",
+          (unsigned long long) burst.addr, burst.len, burst.size);
+      protocolErrors++;
+    }
+  }
+
+  HarnessMemory& memory;
+  unsigned latency;
+  unsigned protocolErrors;
+  std::deque<HarnessAxiBurst> reads;
+  std::deque<HarnessAxiBurst> writes;
+  std::deque<HarnessAxiBurst> responses;
+};
+{{if eq .Platform "sdaccel"}}
+//
+// Implements the kernel control sequence. The kernel arguments are held in a
+// model of the SDAccel control register file, which is read by the adaptor
+// using the parameter access ports. On completion the kernel status register
+// at offset 0 is read back over the s_axi control interface.
+//
+class HarnessControl {
+ public:
+  enum State { Start, Running, ReadStatusAddr, ReadStatusData, Finished };
+
+  explicit HarnessControl(const std::vector<uint32_t>& args)
+      : state(Start), status(0) {
+    for (size_t i = 0; i < args.size(); i++) {
+      registers[{{.KernelArgsOffset}} + 4 * i] = args[i];
+    }
+  }
+
+  void drive(HarnessTop* top) {
+    top->go_0Ready = (state == Start) ? 1 : 0;
+    top->done_0Stop = 0;
+    top->paramaddr_0Stop = 0;
+    top->paramdata_0Ready = paramReqs.empty() ? 0 : 1;
+    top->paramdata_0Data = paramReqs.empty() ? 0 : registers[paramReqs.front()];
+    top->s_axi_araddr = 0;
+    top->s_axi_arcache = 0;
+    top->s_axi_arprot = 0;
+    top->s_axi_arvalid = (state == ReadStatusAddr) ? 1 : 0;
+    top->s_axi_rready = 1;
+    top->s_axi_awaddr = 0;
+    top->s_axi_awcache = 0;
+    top->s_axi_awprot = 0;
+    top->s_axi_awvalid = 0;
+    top->s_axi_wdata = 0;
+    top->s_axi_wstrb = 0;
+    top->s_axi_wvalid = 0;
+    top->s_axi_bready = 1;
+  }
+
+  void clock(HarnessTop* top) {
+    if (top->go_0Ready && !top->go_0Stop) {
+      state = Running;
+    }
+    if (top->paramaddr_0Ready && !top->paramaddr_0Stop) {
+      paramReqs.push_back(top->paramaddr_0Data);
+    }
+    if (top->paramdata_0Ready && !top->paramdata_0Stop) {
+      paramReqs.pop_front();
+    }
+    if (top->done_0Ready && !top->done_0Stop) {
+      state = ReadStatusAddr;
+    }
+    if ((state == ReadStatusAddr) && top->s_axi_arready) {
+      state = ReadStatusData;
+    }
+    if ((state == ReadStatusData) && top->s_axi_rvalid) {
+      status = top->s_axi_rdata;
+      state = Finished;
+    }
+  }
+
+  bool finished() const { return state == Finished; }
+
+  void report() const {
+    std::printf("Kernel status register: 0x%08X\n", status);
+  }
+
+ private:
+  State state;
+  uint32_t status;
+  std::map<uint32_t, uint32_t> registers;
+  std::deque<uint32_t> paramReqs;
+};
+{{else}}
+//
+// Implements the kernel control sequence. The kernel arguments are passed to
+// the adaptor as a single packed argument word, with the first argument in
+// the least significant bits.
+//
+class HarnessControl {
+ public:
+  enum State { Start, Running, Finished };
+
+  explicit HarnessControl(const std::vector<uint32_t>& args)
+      : state(Start), args(args) {}
+
+  void drive(HarnessTop* top) {
+    top->argsReady = (state == Start) ? 1 : 0;
+{{- if .ArgsWideData}}
+    for (size_t i = 0; i < KERNEL_ARGS_WIDTH; i++) {
+      top->argsData[i] = args[i];
+    }
+{{- else}}
+    uint64_t argsData = 0;
+    for (int i = KERNEL_ARGS_WIDTH - 1; i >= 0; i--) {
+      argsData = (argsData << 32) | args[i];
+    }
+    top->argsData = argsData;
+{{- end}}
+    top->retValStop = 0;
+  }
+
+  void clock(HarnessTop* top) {
+    if (top->argsReady && !top->argsStop) {
+      state = Running;
+    }
+    if (top->retValReady && !top->retValStop) {
+      state = Finished;
+    }
+  }
+
+  bool finished() const { return state == Finished; }
+
+  void report() const {}
+
+ private:
+  State state;
+  std::vector<uint32_t> args;
+};
+{{end}}
+//
+// Parses an unsigned integer value, exiting on failure.
+//
+static uint64_t parseValue(const std::string& text) {
+  char* end = NULL;
+  uint64_t value = std::strtoull(text.c_str(), &end, 0);
+  if (text.empty() || (*end != '\0')) {
+    std::fprintf(stderr, "Invalid numeric value (%s)\n", text.c_str());
+    std::exit(1);
+  }
+  return value;
+}
+
+//
+// Splits an option value of the form '<left>=<right>', exiting on failure.
+//
+static void splitOption(const std::string& text, std::string& left, std::string& right) {
+  size_t split = text.find('=');
+  if (split == std::string::npos) {
+    std::fprintf(stderr, "Invalid option value (%s)\n", text.c_str());
+    std::exit(1);
+  }
+  left = text.substr(0, split);
+  right = text.substr(split + 1);
+}
+
+int main(int argc, char** argv) {
+  Verilated::commandArgs(argc, argv);
+
+  uint64_t maxCycles = 1000000;
+  unsigned latency = 20;
+  std::vector<uint32_t> args(KERNEL_ARGS_WIDTH, 0);
+  std::vector<uint64_t> printAddrs;
+  std::vector<std::pair<uint64_t, uint64_t> > expectValues;
+  HarnessMemory memory;
+
+  // Parse the command line, skipping Verilator '+' arguments.
+  unsigned argCount = 0;
+  for (int i = 1; i < argc; i++) {
+    std::string arg(argv[i]);
+    std::string left, right;
+    if (arg[0] == '+') {
+      continue;
+    }
+    if ((arg[0] == '-') && (i + 1 < argc)) {
+      std::string value(argv[++i]);
+      if (arg == "-cycles") {
+        maxCycles = parseValue(value);
+      } else if (arg == "-latency") {
+        latency = unsigned(parseValue(value));
+      } else if (arg == "-load") {
+        splitOption(value, left, right);
+        std::ifstream file(right.c_str(), std::ios::binary);
+        if (!file) {
+          std::fprintf(stderr, "Failed to open memory file (%s)\n", right.c_str());
+          return 1;
+        }
+        std::vector<uint8_t> data((std::istreambuf_iterator<char>(file)),
+            std::istreambuf_iterator<char>());
+        memory.write(parseValue(left), data.data(), data.size());
+      } else if (arg == "-print") {
+        printAddrs.push_back(parseValue(value));
+      } else if (arg == "-expect") {
+        splitOption(value, left, right);
+        expectValues.push_back(std::make_pair(parseValue(left), parseValue(right)));
+      } else {
+        std::fprintf(stderr, "Unknown option (%s)\n", arg.c_str());
+        return 1;
+      }
+    } else if (argCount < KERNEL_ARGS_WIDTH) {
+      args[argCount++] = uint32_t(parseValue(arg));
+    } else {
+      std::fprintf(stderr, "Too many kernel arguments (%u expected)\n", KERNEL_ARGS_WIDTH);
+      return 1;
+    }
+  }
+
+  HarnessTop* top = new HarnessTop;
+  HarnessAxiMemory axiMemory(memory, latency);
+  HarnessControl control(args);
+
+  // Run the simulation until the kernel completes, holding the kernel
+  // control inputs idle during reset.
+  uint64_t cycle = 0;
+  bool timeout = false;
+  while (!control.finished()) {
+    bool reset = cycle < RESET_CYCLES;
+    if (cycle == maxCycles) {
+      timeout = true;
+      break;
+    }
+    top->reset = reset ? 1 : 0;
+    axiMemory.drive(top, cycle);
+    control.drive(top);
+    top->clk = 0;
+    top->eval();
+    if (!reset) {
+      axiMemory.clock(top, cycle);
+      control.clock(top);
+    }
+    top->clk = 1;
+    top->eval();
+    cycle++;
+  }
+  top->final();
+  delete top;
+
+  // Report the simulation results.
+  int status = 0;
+  if (timeout) {
+    std::fprintf(stderr, "Kernel did not complete after %llu clock cycles\n",
+        (unsigned long long) maxCycles);
+    status = 1;
+  } else {
+    std::printf("Kernel completed after %llu clock cycles\n",
+        (unsigned long long) (cycle - RESET_CYCLES));
+    control.report();
+  }
+  for (size_t i = 0; i < printAddrs.size(); i++) {
+    std::printf("Memory word at 0x%016llX: 0x%016llX\n",
+        (unsigned long long) printAddrs[i],
+        (unsigned long long) memory.readWord(printAddrs[i]));
+  }
+  for (size_t i = 0; i < expectValues.size(); i++) {
+    uint64_t value = memory.readWord(expectValues[i].first);
+    if (value != expectValues[i].second) {
+      std::fprintf(stderr, "Memory word at 0x%016llX is 0x%016llX (expected 0x%016llX)\n",
+          (unsigned long long) expectValues[i].first, (unsigned long long) value,
+          (unsigned long long) expectValues[i].second);
+      status = 1;
+    }
+  }
+  if (axiMemory.errors() != 0) {
+    std::fprintf(stderr, "Detected %u AXI protocol errors\n", axiMemory.errors());
+    status = 1;
+  }
+  std::printf("%s\n", (status == 0) ? "PASSED" : "FAILED");
+  return status;
+}
+{{end}}`
+
+//
+// Defines the template for the Makefile fragment which builds and runs the
+// Verilator simulation harness. The fragment may be included from another
+// Makefile, with the generated files being located relative to the fragment.
+//
+var smiVerilatorMakefileTemplate = `
+{{define "smiVerilatorMakefile"}}#
+# Copyright 2018 ReconfigureIO
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+#
+
+#
+# Created {{makeFileTimestamp}}
+# Machine generated file - DO NOT EDIT
+#
+# Builds and runs the Verilator smoke test simulation for {{.ModuleName}}.
+# Run using:
+#   make -f {{.HarnessName}}.mk {{.ModuleName}}-sim [SMI_LIB_DIR=<dir>]
+#{{if not .UseTestFiles}}
+# The kernel source files must be specified using SMI_KERNEL_SOURCES.
+#{{end}}
+
+VERILATOR          ?= verilator
+SMI_LIB_DIR        ?= verilog{{if .UseTestFiles}}
+SMI_TEST_DIR       ?= $(SMI_LIB_DIR)/../test/verilog{{end}}
+SMI_KERNEL_SOURCES ?=
+
+{{.ModuleName}}_DIR      := $(dir $(lastword $(MAKEFILE_LIST)))
+{{.ModuleName}}_OBJ_DIR  := $({{.ModuleName}}_DIR)obj_dir_{{.ModuleName}}
+{{.ModuleName}}_SOURCES  := $(addprefix $({{.ModuleName}}_DIR),{{range .SourceFiles}} {{.}}{{end}})
+{{.ModuleName}}_SIM_ARGS ?= {{.SimArgs}}
+
+.PHONY: {{.ModuleName}}-harness {{.ModuleName}}-sim {{.ModuleName}}-clean
+
+{{.ModuleName}}-harness: $({{.ModuleName}}_OBJ_DIR)/{{.ModelPrefix}}
+
+$({{.ModuleName}}_OBJ_DIR)/{{.ModelPrefix}}: $({{.ModuleName}}_DIR){{.HarnessName}}.cpp \
+		$({{.ModuleName}}_SOURCES) $(SMI_KERNEL_SOURCES)
+	$(VERILATOR) --cc --exe -Wno-fatal --top-module {{.ModuleName}} \
+		--prefix {{.ModelPrefix}} -Mdir $({{.ModuleName}}_OBJ_DIR) \
+		-y $(SMI_LIB_DIR){{if .UseTestFiles}} -y $(SMI_TEST_DIR){{end}} \
+		$({{.ModuleName}}_SOURCES) $(SMI_KERNEL_SOURCES) \
+		$(abspath $({{.ModuleName}}_DIR){{.HarnessName}}.cpp)
+	$(MAKE) -C $({{.ModuleName}}_OBJ_DIR) -f {{.ModelPrefix}}.mk {{.ModelPrefix}}
+
+{{.ModuleName}}-sim: {{.ModuleName}}-harness
+	$({{.ModuleName}}_OBJ_DIR)/{{.ModelPrefix}} $({{.ModuleName}}_SIM_ARGS)
+
+{{.ModuleName}}-clean:
+	rm -rf $({{.ModuleName}}_OBJ_DIR)
+{{end}}`
+
+//
+// Cache the parsed Verilator harness templates.
+//
+var smiVerilatorHarnessCache *template.Template = nil
+
+//
+// Implement lazy construction of the Verilator harness templates.
+//
+func getSmiVerilatorHarnessTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
+	if smiVerilatorHarnessCache == nil {
+		templGroup := template.New("").Funcs(smiTemplateFunctions)
+		templGroup = template.Must(templGroup.Parse(smiVerilatorHarnessTemplate))
+		templGroup = template.Must(templGroup.Parse(smiVerilatorMakefileTemplate))
+		smiVerilatorHarnessCache = templGroup
+	}
+	return smiVerilatorHarnessCache
+}
+
+//
+// Derives the default simulation arguments for the fuzz test kernel. The
+// fuzz testers use contiguous windows from address zero, with the result
+// words following the last window. The expected data count is derived from
+// the fuzz test parameter generator model.
+//
+func makeFuzzTestKernelSimArgs(numPorts uint) (string, error) {
+	resultAddr := uint64(numPorts) * smiHarnessFuzzBlockSize
+	dataCount := uint64(0)
+	for i := uint(0); i < numPorts; i++ {
+		gen, err := smiFuzzModel.NewFuzzParamGen(smiFuzzModel.PortRandSeed(i))
+		if err != nil {
+			return "", err
+		}
+		params, err := gen.Generate(smiFuzzModel.PortFuzzTestConfig(
+			i, 0, smiHarnessFuzzBlockSize, smiHarnessFuzzNumTests))
+		if err != nil {
+			return "", err
+		}
+		dataCount += smiFuzzModel.ExpectedDataCount(params)
+	}
+	args := []string{
+		fmt.Sprintf("-expect 0x%X=0", resultAddr),
+		fmt.Sprintf("-expect 0x%X=%d", resultAddr+8, dataCount),
+		"0 0", fmt.Sprintf("%d %d", smiHarnessFuzzBlockSize, smiHarnessFuzzNumTests),
+		fmt.Sprintf("0x%X 0", resultAddr), fmt.Sprintf("0x%X 0", resultAddr+8)}
+	return strings.Join(args, " "), nil
+}
+
+//
+// Generates a Verilator harness configuration given the supplied parameters.
+// Only the AXI based platforms with a standard kernel start interface are
+// supported.
+//
+func configureSmiVerilatorHarness(moduleName string, platform string,
+	sourceFiles []string, numClients uint, scalingFactor uint,
+	kernelArgsWidth uint, fuzzTestKernel bool) (smiVerilatorHarnessConfig, error) {
+
+	var smiVerilatorHarness = smiVerilatorHarnessConfig{}
+
+	// Check for valid scaling factor.
+	if (scalingFactor != 1) && (scalingFactor != 2) &&
+		(scalingFactor != 4) && (scalingFactor != 8) {
+		return smiVerilatorHarness, errors.New(fmt.Sprintf(
+			"Invalid bus scaling (%d) for Verilator harness", scalingFactor))
+	}
+	if (platform != "sdaccel") && (platform != "llvm") {
+		return smiVerilatorHarness, errors.New(fmt.Sprintf(
+			"Verilator harness not supported for %s platform", platform))
+	}
+	if kernelArgsWidth == 0 {
+		return smiVerilatorHarness, errors.New(
+			"Verilator harness requires at least one kernel argument word")
+	}
+	if fuzzTestKernel && (platform != "sdaccel") {
+		return smiVerilatorHarness, errors.New(
+			"Fuzz test kernel only supported for sdaccel platform")
+	}
+
+	smiVerilatorHarness.ModuleName = moduleName
+	smiVerilatorHarness.HarnessName = moduleName + "_harness"
+	smiVerilatorHarness.ModelPrefix = smiVerilatorHarnessPrefix
+	smiVerilatorHarness.Platform = platform
+	smiVerilatorHarness.AxiDataBytes = scalingFactor * 8
+	smiVerilatorHarness.AxiWideData = (scalingFactor > 1)
+	smiVerilatorHarness.KernelArgsWidth = kernelArgsWidth
+	smiVerilatorHarness.KernelArgsOffset = smiSdaKernelArgsOffset
+	smiVerilatorHarness.ArgsWideData = (kernelArgsWidth > 2)
+	smiVerilatorHarness.SourceFiles = sourceFiles
+	smiVerilatorHarness.UseTestFiles = fuzzTestKernel
+
+	if fuzzTestKernel {
+		simArgs, err := makeFuzzTestKernelSimArgs(numClients)
+		if err != nil {
+			return smiVerilatorHarness, err
+		}
+		smiVerilatorHarness.SimArgs = simArgs
+	}
+	return smiVerilatorHarness, nil
+}
+
+//
+// Execute the harness template using the supplied output file handle and
+// configuration.
+//
+func executeSmiVerilatorHarnessTemplate(outFile *os.File,
+	config smiVerilatorHarnessConfig) error {
+
+	return getSmiVerilatorHarnessTemplate().ExecuteTemplate(
+		outFile, "smiVerilatorHarness", config)
+}
+
+//
+// Execute the Makefile fragment template using the supplied output file
+// handle and configuration.
+//
+func executeSmiVerilatorMakefileTemplate(outFile *os.File,
+	config smiVerilatorHarnessConfig) error {
+
+	return getSmiVerilatorHarnessTemplate().ExecuteTemplate(
+		outFile, "smiVerilatorMakefile", config)
+}
@@ -0,0 +1,974 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+//
+// Defines the template configuration options for a single VHDL generic.
+//
+type smiVhdlGenericConfig struct {
+	Name  string // Name of the generic.
+	Type  string // VHDL type of the generic.
+	Value string // Default value of the generic.
+}
+
+//
+// Defines the template configuration options for a single VHDL port or
+// signal. The width is specified as an integer or generic expression, where
+// an empty width denotes a single std_logic bit.
+//
+type smiVhdlPortConfig struct {
+	Name      string // Name of the port or signal.
+	Direction string // Port direction ('in' or 'out'). Not used for signals.
+	Width     string // Width of the port or signal in bits.
+}
+
+//
+// Defines the template configuration options for a VHDL component
+// declaration, corresponding to a Verilog library or kernel module.
+//
+type smiVhdlComponentConfig struct {
+	Name     string                 // Name of the component.
+	Generics []smiVhdlGenericConfig // List of component generics.
+	Ports    []smiVhdlPortConfig    // List of component ports.
+}
+
+//
+// Defines the template configuration options for a single named port
+// association.
+//
+type smiVhdlPortMapConfig struct {
+	Port   string // Name of the component port.
+	Signal string // Name of the connected signal or expression.
+}
+
+//
+// Defines the template configuration options for a kernel instance. Kernel
+// instances with a condition are wrapped in a generate statement which also
+// contains the kernel component declaration.
+//
+type smiVhdlKernelInstanceConfig struct {
+	Label     string                 // Label for the generate statement.
+	Condition string                 // Generate condition, or empty if unconditional.
+	Component smiVhdlComponentConfig // Kernel component declaration.
+	PortMap   []smiVhdlPortMapConfig // Kernel port associations.
+}
+
+//
+// Defines the template configuration options for a VHDL SMI memory bus
+// arbitration tree entity. The arbitration tree structure is taken directly
+// from the standard arbitration tree configuration.
+//
+type smiVhdlArbitrationTreeConfig struct {
+	arbitrationTreeConfig                          // Underlying arbitration tree.
+	EntityPorts           []smiVhdlPortConfig      // List of entity ports.
+	Signals               []smiVhdlPortConfig      // List of internal signals.
+	Components            []smiVhdlComponentConfig // Library component declarations.
+}
+
+//
+// Defines the template configuration options for a VHDL SMI kernel adaptor
+// entity.
+//
+type smiVhdlKernelAdaptorConfig struct {
+	ModuleName            string                        // Name of the kernel adaptor entity.
+	ArbitrationModuleName string                        // Name of the arbitration tree entity.
+	AxiByteIndexSize      uint                          // Size of AXI data byte index values.
+	AxiBusIdWidth         uint                          // Width of AXI ID signal.
+	EntityGenerics        []smiVhdlGenericConfig        // List of entity generics.
+	EntityPorts           []smiVhdlPortConfig           // List of entity ports.
+	Signals               []smiVhdlPortConfig           // List of internal signals.
+	Components            []smiVhdlComponentConfig      // Library component declarations.
+	KernelInstances       []smiVhdlKernelInstanceConfig // Kernel instances.
+	SmiMemBusClientConns  []smiMemBusConnectionConfig   // List of client side connections.
+	SmiMemBusServerConn   []smiMemBusConnectionConfig   // Single server side connection.
+	ClockDomains          smiMemClockDomainConfig       // Kernel and memory clock domains.
+}
+
+//
+// Defines the file header template to be used on generated VHDL files.
+//
+var smiVhdlFileHeaderTemplate = `
+{{define "smiVhdlFileHeader"}}--
+-- Copyright 2018 ReconfigureIO
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+
+--
+-- Created {{makeFileTimestamp}}
+-- Machine generated file - DO NOT EDIT
+--
+
+library ieee;
+use ieee.std_logic_1164.all;
+{{end}}`
+
+//
+// Defines the templates for VHDL generic, port and signal lists and for
+// component declarations.
+//
+var smiVhdlDeclarationTemplate = `` +
+	`{{define "smiVhdlGenericList"}}{{range $i, $g := .}}{{if $i}};{{end}}
+    {{printf "%-24s" $g.Name}} : {{$g.Type}} := {{$g.Value}}{{end}}{{end}}` +
+	`{{define "smiVhdlPortList"}}{{range $i, $p := .}}{{if $i}};{{end}}
+    {{printf "%-24s" $p.Name}} : {{printf "%-3s" $p.Direction}} {{makeVhdlType $p.Width}}{{end}}{{end}}` +
+	`{{define "smiVhdlSignalList"}}{{range .}}
+  signal {{printf "%-24s" .Name}} : {{makeVhdlType .Width}};{{end}}{{end}}` +
+	`{{define "smiVhdlComponent"}}
+  component {{makeVhdlIdentifier .Name}}{{if .Generics}}
+  generic ({{template "smiVhdlGenericList" .Generics}});{{end}}
+  port ({{template "smiVhdlPortList" .Ports}});
+  end component;
+{{end}}`
+
+//
+// Defines the template for implementing direct SMI memory bus assignments.
+//
+var smiVhdlMemBusAssignmentTemplate = `
+{{define "smiVhdlMemBusAssignment"}}{{$client := .SmiMemBusClientConn}}{{$server := .SmiMemBusServerConn}}
+  -- Directly map {{$client.SmiNetReqName}} -> {{$server.SmiNetReqName}}
+  {{$server.SmiNetReqName}}Ready <= {{$client.SmiNetReqName}}Ready;
+  {{$server.SmiNetReqName}}Eofc <= {{$client.SmiNetReqName}}Eofc;
+  {{$server.SmiNetReqName}}Data <= {{$client.SmiNetReqName}}Data;
+  {{$client.SmiNetReqName}}Stop <= {{$server.SmiNetReqName}}Stop;
+
+  -- Directly map {{$server.SmiNetRespName}} -> {{$client.SmiNetRespName}}
+  {{$client.SmiNetRespName}}Ready <= {{$server.SmiNetRespName}}Ready;
+  {{$client.SmiNetRespName}}Eofc <= {{$server.SmiNetRespName}}Eofc;
+  {{$client.SmiNetRespName}}Data <= {{$server.SmiNetRespName}}Data;
+  {{$server.SmiNetRespName}}Stop <= {{$client.SmiNetRespName}}Stop;
+{{end}}`
+
+//
+// Defines the template for instantiating SMI memory bus width scaling
+// components.
+//
+var smiVhdlMemBusWidthScalerTemplate = `
+{{define "smiVhdlMemBusWidthScaler"}}{{$client := .SmiMemBusClientConn}}{{$server := .SmiMemBusServerConn}}
+  -- Instantiate SMI request scaler {{.InstanceName}}Req
+  {{.InstanceName}}Req : {{printf "smiFlitScaleX%d" .SmiMemBusScaleFactor}}
+  generic map (FlitWidth => {{.SmiMemBusFlitWidth}})
+  port map (
+    smiInReady  => {{$client.SmiNetReqName}}Ready,
+    smiInEofc   => {{$client.SmiNetReqName}}Eofc,
+    smiInData   => {{$client.SmiNetReqName}}Data,
+    smiInStop   => {{$client.SmiNetReqName}}Stop,
+    smiOutReady => {{$server.SmiNetReqName}}Ready,
+    smiOutEofc  => {{$server.SmiNetReqName}}Eofc,
+    smiOutData  => {{$server.SmiNetReqName}}Data,
+    smiOutStop  => {{$server.SmiNetReqName}}Stop,
+    clk         => clk,
+    srst        => srst);
+
+  -- Instantiate SMI response scaler {{.InstanceName}}Resp
+  {{.InstanceName}}Resp : {{printf "smiFlitScaleD%d" .SmiMemBusScaleFactor}}
+  generic map (FlitWidth => {{.SmiMemBusFlitWidth}}*{{.SmiMemBusScaleFactor}})
+  port map (
+    smiInReady  => {{$server.SmiNetRespName}}Ready,
+    smiInEofc   => {{$server.SmiNetRespName}}Eofc,
+    smiInData   => {{$server.SmiNetRespName}}Data,
+    smiInStop   => {{$server.SmiNetRespName}}Stop,
+    smiOutReady => {{$client.SmiNetRespName}}Ready,
+    smiOutEofc  => {{$client.SmiNetRespName}}Eofc,
+    smiOutData  => {{$client.SmiNetRespName}}Data,
+    smiOutStop  => {{$client.SmiNetRespName}}Stop,
+    clk         => clk,
+    srst        => srst);
+{{end}}`
+
+//
+// Defines the template for instantiating a single SMI memory bus arbitration
+// component.
+//
+var smiVhdlMemBusArbiterTemplate = `
+{{define "smiVhdlMemBusArbiter"}}{{$server := .SmiMemBusServerConn}}
+  -- Instantiate transaction arbiter {{.InstanceName}}
+  {{.InstanceName}} : {{if .SmiMemBusScaleWidth}}` +
+	`{{len .SmiMemBusClientConns | printf "smiTransactionScaledArbiterX%d"}}` +
+	`{{else}}` +
+	`{{len .SmiMemBusClientConns | printf "smiTransactionArbiterX%d"}}` +
+	`{{end}}
+  generic map (
+    FlitWidth  => {{.SmiMemBusFlitWidth}},
+    TagIdWidth => {{.SmiMemBusTagIdWidth}},
+    FifoSize   => {{.SmiFifoFlitDepth}},
+    {{if .SmiMemBusScaleWidth}}MaxAssembledFrames{{else}}MaxFrameCount{{end}} => {{.SmiFifoFrameDepth}})
+  port map ({{range $index, $element := .SmiMemBusClientConns}}
+    {{makePortIdCharName "smiReq%cInReady" $index}}   => {{$element.SmiNetReqName}}Ready,
+    {{makePortIdCharName "smiReq%cInEofc" $index}}    => {{$element.SmiNetReqName}}Eofc,
+    {{makePortIdCharName "smiReq%cInData" $index}}    => {{$element.SmiNetReqName}}Data,
+    {{makePortIdCharName "smiReq%cInStop" $index}}    => {{$element.SmiNetReqName}}Stop,
+    {{makePortIdCharName "smiResp%cOutReady" $index}} => {{$element.SmiNetRespName}}Ready,
+    {{makePortIdCharName "smiResp%cOutEofc" $index}}  => {{$element.SmiNetRespName}}Eofc,
+    {{makePortIdCharName "smiResp%cOutData" $index}}  => {{$element.SmiNetRespName}}Data,
+    {{makePortIdCharName "smiResp%cOutStop" $index}}  => {{$element.SmiNetRespName}}Stop,{{end}}
+    smiReqOutReady => {{$server.SmiNetReqName}}Ready,
+    smiReqOutEofc  => {{$server.SmiNetReqName}}Eofc,
+    smiReqOutData  => {{$server.SmiNetReqName}}Data,
+    smiReqOutStop  => {{$server.SmiNetReqName}}Stop,
+    smiRespInReady => {{$server.SmiNetRespName}}Ready,
+    smiRespInEofc  => {{$server.SmiNetRespName}}Eofc,
+    smiRespInData  => {{$server.SmiNetRespName}}Data,
+    smiRespInStop  => {{$server.SmiNetRespName}}Stop,
+    clk            => clk,
+    srst           => srst);
+{{end}}`
+
+//
+// Defines the template for instantiating an SMI memory bus pipeline stage.
+//
+var smiVhdlMemBusPipelineTemplate = `
+{{define "smiVhdlMemBusPipeline"}}{{$client := .SmiMemBusClientConn}}{{$server := .SmiMemBusServerConn}}
+  -- Instantiate SMI pipeline stage {{.InstanceName}}
+  {{.InstanceName}} : smiMemBusPipelineStage
+  generic map (FlitWidth => {{.SmiMemBusFlitWidth}})
+  port map (
+    smiReqInReady   => {{$client.SmiNetReqName}}Ready,
+    smiReqInEofc    => {{$client.SmiNetReqName}}Eofc,
+    smiReqInData    => {{$client.SmiNetReqName}}Data,
+    smiReqInStop    => {{$client.SmiNetReqName}}Stop,
+    smiRespOutReady => {{$client.SmiNetRespName}}Ready,
+    smiRespOutEofc  => {{$client.SmiNetRespName}}Eofc,
+    smiRespOutData  => {{$client.SmiNetRespName}}Data,
+    smiRespOutStop  => {{$client.SmiNetRespName}}Stop,
+    smiReqOutReady  => {{$server.SmiNetReqName}}Ready,
+    smiReqOutEofc   => {{$server.SmiNetReqName}}Eofc,
+    smiReqOutData   => {{$server.SmiNetReqName}}Data,
+    smiReqOutStop   => {{$server.SmiNetReqName}}Stop,
+    smiRespInReady  => {{$server.SmiNetRespName}}Ready,
+    smiRespInEofc   => {{$server.SmiNetRespName}}Eofc,
+    smiRespInData   => {{$server.SmiNetRespName}}Data,
+    smiRespInStop   => {{$server.SmiNetRespName}}Stop,
+    clk             => clk,
+    srst            => srst);
+{{end}}`
+
+//
+// Defines the template for a VHDL SMI memory bus arbitration tree entity.
+//
+var smiVhdlArbitrationTreeTemplate = `
+{{define "smiVhdlArbitrationTree"}}{{template "smiVhdlFileHeader" .}}
+entity {{makeVhdlIdentifier .ModuleName}} is
+  port ({{template "smiVhdlPortList" .EntityPorts}});
+end {{makeVhdlIdentifier .ModuleName}};
+
+architecture rtl of {{makeVhdlIdentifier .ModuleName}} is
+{{range .Components}}{{template "smiVhdlComponent" .}}{{end}}{{template "smiVhdlSignalList" .Signals}}
+
+begin
+{{range .SmiMemBusAssignments}}{{template "smiVhdlMemBusAssignment" .}}{{end}}` +
+	`{{range .SmiMemBusWidthScalers}}{{template "smiVhdlMemBusWidthScaler" .}}{{end}}` +
+	`{{range .SmiMemBusArbiters}}{{template "smiVhdlMemBusArbiter" .}}{{end}}` +
+	`{{range .SmiMemBusPipelines}}{{template "smiVhdlMemBusPipeline" .}}{{end}}
+end rtl;
+{{end}}`
+
+//
+// Defines the template for a VHDL SMI kernel adaptor entity. This is common to
+// all the supported AXI based platforms, with the kernel specific ports and
+// port associations being supplied by the configuration.
+//
+var smiVhdlKernelAdaptorTemplate = `
+{{define "smiVhdlKernelPortMap"}}
+  port map ({{range $i, $m := .}}{{if $i}},{{end}}
+    {{printf "%-24s" $m.Port}} => {{$m.Signal}}{{end}});{{end}}` +
+	`{{define "smiVhdlKernelAdaptor"}}{{template "smiVhdlFileHeader" .}}
+entity {{makeVhdlIdentifier .ModuleName}} is{{if .EntityGenerics}}
+  generic ({{template "smiVhdlGenericList" .EntityGenerics}});{{end}}
+  port ({{template "smiVhdlPortList" .EntityPorts}});
+end {{makeVhdlIdentifier .ModuleName}};
+
+architecture rtl of {{makeVhdlIdentifier .ModuleName}} is
+{{range .Components}}{{template "smiVhdlComponent" .}}{{end}}` +
+	`{{range .KernelInstances}}{{if not .Condition}}{{template "smiVhdlComponent" .Component}}{{end}}{{end}}` +
+	`{{template "smiVhdlSignalList" .Signals}}
+
+begin
+
+  --
+  -- Instantiate the SMI/AXI memory controller adaptor.
+  --
+  axiBusAdaptor : smiAxiMemBusAdaptor
+  generic map (
+    DataIndexSize => {{.AxiByteIndexSize}},
+    AxiIdWidth    => {{.AxiBusIdWidth}},
+    FifoSize      => 33)
+  port map ({{with $wire := index .SmiMemBusServerConn 0}}
+    smiReqReady  => {{$wire.SmiNetReqName}}Ready,
+    smiReqEofc   => {{$wire.SmiNetReqName}}Eofc,
+    smiReqData   => {{$wire.SmiNetReqName}}Data,
+    smiReqStop   => {{$wire.SmiNetReqName}}Stop,
+    smiRespReady => {{$wire.SmiNetRespName}}Ready,
+    smiRespEofc  => {{$wire.SmiNetRespName}}Eofc,
+    smiRespData  => {{$wire.SmiNetRespName}}Data,
+    smiRespStop  => {{$wire.SmiNetRespName}}Stop,{{end}}
+    axiARValid   => m_axi_gmem_arvalid,
+    axiARReady   => m_axi_gmem_arready,
+    axiARId      => m_axi_gmem_arid,
+    axiARAddr    => m_axi_gmem_araddr,
+    axiARLen     => m_axi_gmem_arlen,
+    axiARSize    => m_axi_gmem_arsize,
+    axiARCache   => m_axi_gmem_arcache,
+    axiRValid    => m_axi_gmem_rvalid,
+    axiRReady    => m_axi_gmem_rready,
+    axiRId       => m_axi_gmem_rid,
+    axiRData     => m_axi_gmem_rdata,
+    axiRResp     => m_axi_gmem_rresp,
+    axiRLast     => m_axi_gmem_rlast,
+    axiAWValid   => m_axi_gmem_awvalid,
+    axiAWReady   => m_axi_gmem_awready,
+    axiAWId      => m_axi_gmem_awid,
+    axiAWAddr    => m_axi_gmem_awaddr,
+    axiAWLen     => m_axi_gmem_awlen,
+    axiAWSize    => m_axi_gmem_awsize,
+    axiAWCache   => m_axi_gmem_awcache,
+    axiWValid    => m_axi_gmem_wvalid,
+    axiWReady    => m_axi_gmem_wready,
+    axiWId       => m_axi_gmem_wid,
+    axiWData     => m_axi_gmem_wdata,
+    axiWStrb     => m_axi_gmem_wstrb,
+    axiWLast     => m_axi_gmem_wlast,
+    axiBValid    => m_axi_gmem_bvalid,
+    axiBReady    => m_axi_gmem_bready,
+    axiBId       => m_axi_gmem_bid,
+    axiBResp     => m_axi_gmem_bresp,
+    axiReset     => {{.ClockDomains.MemResetName}},
+    clk          => {{.ClockDomains.MemClockName}},
+    srst         => {{.ClockDomains.MemResetName}});
+
+  --
+  -- Tie off static AXI signals.
+  --
+  m_axi_gmem_arburst  <= "01";
+  m_axi_gmem_arlock   <= '0';
+  m_axi_gmem_arprot   <= "000";
+  m_axi_gmem_arqos    <= "0000";
+  m_axi_gmem_arregion <= "0000";
+  m_axi_gmem_aruser   <= "0";
+
+  m_axi_gmem_awburst  <= "01";
+  m_axi_gmem_awlock   <= '0';
+  m_axi_gmem_awprot   <= "000";
+  m_axi_gmem_awqos    <= "0000";
+  m_axi_gmem_awregion <= "0000";
+  m_axi_gmem_awuser   <= "0";
+  m_axi_gmem_wuser    <= "0";
+
+  --
+  -- Instantiate the memory access arbitration logic.
+  --
+  memArbitrationTree : entity work.{{makeVhdlIdentifier .ArbitrationModuleName}}
+  port map ({{range .SmiMemBusClientConns}}
+    {{.SmiNetReqName}}Ready => {{.SmiNetReqName}}Ready,
+    {{.SmiNetReqName}}Eofc => {{.SmiNetReqName}}Eofc,
+    {{.SmiNetReqName}}Data => {{.SmiNetReqName}}Data,
+    {{.SmiNetReqName}}Stop => {{.SmiNetReqName}}Stop,
+    {{.SmiNetRespName}}Ready => {{.SmiNetRespName}}Ready,
+    {{.SmiNetRespName}}Eofc => {{.SmiNetRespName}}Eofc,
+    {{.SmiNetRespName}}Data => {{.SmiNetRespName}}Data,
+    {{.SmiNetRespName}}Stop => {{.SmiNetRespName}}Stop,{{end}}{{range .SmiMemBusServerConn}}
+    {{.SmiNetReqName}}Ready => {{.SmiNetReqName}}Ready,
+    {{.SmiNetReqName}}Eofc => {{.SmiNetReqName}}Eofc,
+    {{.SmiNetReqName}}Data => {{.SmiNetReqName}}Data,
+    {{.SmiNetReqName}}Stop => {{.SmiNetReqName}}Stop,
+    {{.SmiNetRespName}}Ready => {{.SmiNetRespName}}Ready,
+    {{.SmiNetRespName}}Eofc => {{.SmiNetRespName}}Eofc,
+    {{.SmiNetRespName}}Data => {{.SmiNetRespName}}Data,
+    {{.SmiNetRespName}}Stop => {{.SmiNetRespName}}Stop,{{end}}
+    clk  => clk,
+    srst => reset);
+
+  --
+  -- Map SMI flit vector signals.
+  --{{range .SmiMemBusClientConns}}
+  {{.SmiNetReqName}}Data  <= {{.SmiNetReqName}}Flit(63 downto 0);
+  {{.SmiNetReqName}}Eofc  <= {{.SmiNetReqName}}Flit(71 downto 64);
+  {{.SmiNetRespName}}Flit <= {{.SmiNetRespName}}Eofc & {{.SmiNetRespName}}Data;
+{{end}}
+  --
+  -- Instantiate the SMI kernel logic.
+  --{{range .KernelInstances}}{{if .Condition}}
+  {{.Label}} : if {{.Condition}} generate
+{{template "smiVhdlComponent" .Component}}
+  begin
+  smiKernel : {{makeVhdlIdentifier .Component.Name}}{{template "smiVhdlKernelPortMap" .PortMap}}
+  end generate;
+{{else}}
+  smiKernel : {{makeVhdlIdentifier .Component.Name}}{{template "smiVhdlKernelPortMap" .PortMap}}
+{{end}}{{end}}
+end rtl;
+{{end}}`
+
+//
+// Cache the parsed VHDL templates.
+//
+var smiVhdlTemplateCache *template.Template = nil
+
+//
+// Implement lazy construction of the VHDL templates.
+//
+func getSmiVhdlTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
+	if smiVhdlTemplateCache == nil {
+		templGroup := template.New("").Funcs(smiTemplateFunctions)
+		templGroup = template.Must(templGroup.Parse(smiVhdlFileHeaderTemplate))
+		templGroup = template.Must(templGroup.Parse(smiVhdlDeclarationTemplate))
+		templGroup = template.Must(templGroup.Parse(smiVhdlMemBusAssignmentTemplate))
+		templGroup = template.Must(templGroup.Parse(smiVhdlMemBusWidthScalerTemplate))
+		templGroup = template.Must(templGroup.Parse(smiVhdlMemBusArbiterTemplate))
+		templGroup = template.Must(templGroup.Parse(smiVhdlMemBusPipelineTemplate))
+		templGroup = template.Must(templGroup.Parse(smiVhdlArbitrationTreeTemplate))
+		templGroup = template.Must(templGroup.Parse(smiVhdlKernelAdaptorTemplate))
+		smiVhdlTemplateCache = templGroup
+	}
+	return smiVhdlTemplateCache
+}
+
+//
+// Builds the list of VHDL ports or signals for an array of SMI memory bus
+// connections. The directions are specified for the request connection, with
+// the response connection directions being reversed. Empty directions may be
+// used when generating signal lists.
+//
+func makeVhdlMemBusPorts(conns []smiMemBusConnectionConfig,
+	reqIn string, reqOut string) []smiVhdlPortConfig {
+
+	ports := make([]smiVhdlPortConfig, 0, 8*len(conns))
+	for _, conn := range conns {
+		dataWidth := strconv.Itoa(int(conn.SmiMemBusFlitWidth * 8))
+		ports = append(ports,
+			smiVhdlPortConfig{conn.SmiNetReqName + "Ready", reqIn, ""},
+			smiVhdlPortConfig{conn.SmiNetReqName + "Eofc", reqIn, "8"},
+			smiVhdlPortConfig{conn.SmiNetReqName + "Data", reqIn, dataWidth},
+			smiVhdlPortConfig{conn.SmiNetReqName + "Stop", reqOut, ""},
+			smiVhdlPortConfig{conn.SmiNetRespName + "Ready", reqOut, ""},
+			smiVhdlPortConfig{conn.SmiNetRespName + "Eofc", reqOut, "8"},
+			smiVhdlPortConfig{conn.SmiNetRespName + "Data", reqOut, dataWidth},
+			smiVhdlPortConfig{conn.SmiNetRespName + "Stop", reqIn, ""})
+	}
+	return ports
+}
+
+//
+// Builds the list of VHDL ports for a single SMI link, using the naming
+// conventions of the Verilog library components.
+//
+func makeVhdlSmiLinkPorts(prefix string, input bool, dataWidth string) []smiVhdlPortConfig {
+	forward, reverse := "out", "in"
+	if input {
+		forward, reverse = "in", "out"
+	}
+	return []smiVhdlPortConfig{
+		{prefix + "Ready", forward, ""},
+		{prefix + "Eofc", forward, "8"},
+		{prefix + "Data", forward, dataWidth},
+		{prefix + "Stop", reverse, ""}}
+}
+
+//
+// Builds the list of VHDL ports for the AXI memory master interface.
+//
+func makeVhdlAxiMasterPorts(dataWidth uint, idWidth uint) []smiVhdlPortConfig {
+	data := strconv.Itoa(int(dataWidth))
+	strb := strconv.Itoa(int(dataWidth / 8))
+	id := strconv.Itoa(int(idWidth))
+	return []smiVhdlPortConfig{
+		{"m_axi_gmem_awaddr", "out", "64"},
+		{"m_axi_gmem_awlen", "out", "8"},
+		{"m_axi_gmem_awsize", "out", "3"},
+		{"m_axi_gmem_awburst", "out", "2"},
+		{"m_axi_gmem_awlock", "out", ""},
+		{"m_axi_gmem_awcache", "out", "4"},
+		{"m_axi_gmem_awprot", "out", "3"},
+		{"m_axi_gmem_awqos", "out", "4"},
+		{"m_axi_gmem_awregion", "out", "4"},
+		{"m_axi_gmem_awuser", "out", "1"},
+		{"m_axi_gmem_awid", "out", id},
+		{"m_axi_gmem_awvalid", "out", ""},
+		{"m_axi_gmem_awready", "in", ""},
+		{"m_axi_gmem_wdata", "out", data},
+		{"m_axi_gmem_wstrb", "out", strb},
+		{"m_axi_gmem_wid", "out", id},
+		{"m_axi_gmem_wlast", "out", ""},
+		{"m_axi_gmem_wuser", "out", "1"},
+		{"m_axi_gmem_wvalid", "out", ""},
+		{"m_axi_gmem_wready", "in", ""},
+		{"m_axi_gmem_bresp", "in", "2"},
+		{"m_axi_gmem_buser", "in", "1"},
+		{"m_axi_gmem_bid", "in", id},
+		{"m_axi_gmem_bvalid", "in", ""},
+		{"m_axi_gmem_bready", "out", ""},
+		{"m_axi_gmem_araddr", "out", "64"},
+		{"m_axi_gmem_arlen", "out", "8"},
+		{"m_axi_gmem_arsize", "out", "3"},
+		{"m_axi_gmem_arburst", "out", "2"},
+		{"m_axi_gmem_arlock", "out", ""},
+		{"m_axi_gmem_arcache", "out", "4"},
+		{"m_axi_gmem_arprot", "out", "3"},
+		{"m_axi_gmem_arqos", "out", "4"},
+		{"m_axi_gmem_arregion", "out", "4"},
+		{"m_axi_gmem_aruser", "out", "1"},
+		{"m_axi_gmem_arid", "out", id},
+		{"m_axi_gmem_arvalid", "out", ""},
+		{"m_axi_gmem_arready", "in", ""},
+		{"m_axi_gmem_rdata", "in", data},
+		{"m_axi_gmem_rresp", "in", "2"},
+		{"m_axi_gmem_rlast", "in", ""},
+		{"m_axi_gmem_ruser", "in", "1"},
+		{"m_axi_gmem_rid", "in", id},
+		{"m_axi_gmem_rvalid", "in", ""},
+		{"m_axi_gmem_rready", "out", ""}}
+}
+
+//
+// Builds the VHDL component declaration for one of the Verilog library
+// components which may be instantiated by the generated code. The generics
+// and port widths follow the parameters and port lists of the corresponding
+// module in the verilog/ directory.
+//
+func makeVhdlLibraryComponent(moduleName string) (smiVhdlComponentConfig, error) {
+	component := smiVhdlComponentConfig{Name: moduleName}
+	clockPorts := []smiVhdlPortConfig{{"clk", "in", ""}, {"srst", "in", ""}}
+
+	// Flit width upscaling components.
+	if strings.HasPrefix(moduleName, "smiFlitScaleX") {
+		scale, err := strconv.Atoi(strings.TrimPrefix(moduleName, "smiFlitScaleX"))
+		if err == nil {
+			component.Generics = []smiVhdlGenericConfig{{"FlitWidth", "integer", "4"}}
+			component.Ports = append(component.Ports,
+				makeVhdlSmiLinkPorts("smiIn", true, "FlitWidth*8")...)
+			component.Ports = append(component.Ports,
+				makeVhdlSmiLinkPorts("smiOut", false, fmt.Sprintf("FlitWidth*%d", 8*scale))...)
+			component.Ports = append(component.Ports, clockPorts...)
+			return component, nil
+		}
+	}
+
+	// Flit width downscaling components. The default flit width of the
+	// divide by 8 component is 8 bytes, so that the output flit is 1 byte.
+	if strings.HasPrefix(moduleName, "smiFlitScaleD") {
+		scale, err := strconv.Atoi(strings.TrimPrefix(moduleName, "smiFlitScaleD"))
+		if err == nil {
+			flitWidth := "4"
+			if scale == 8 {
+				flitWidth = "8"
+			}
+			component.Generics = []smiVhdlGenericConfig{{"FlitWidth", "integer", flitWidth}}
+			component.Ports = append(component.Ports,
+				makeVhdlSmiLinkPorts("smiIn", true, "FlitWidth*8")...)
+			component.Ports = append(component.Ports,
+				makeVhdlSmiLinkPorts("smiOut", false, fmt.Sprintf("FlitWidth*8/%d", scale))...)
+			component.Ports = append(component.Ports, clockPorts...)
+			return component, nil
+		}
+	}
+
+	// Transaction arbitration components, with optional flit width scaling.
+	for _, prefix := range []string{"smiTransactionArbiterX", "smiTransactionScaledArbiterX"} {
+		if !strings.HasPrefix(moduleName, prefix) {
+			continue
+		}
+		numClients, err := strconv.Atoi(strings.TrimPrefix(moduleName, prefix))
+		if err != nil {
+			break
+		}
+		scaled := (prefix == "smiTransactionScaledArbiterX")
+		frameParam := smiVhdlGenericConfig{"MaxFrameCount", "integer", "7"}
+		serverWidth := "FlitWidth*8"
+		if scaled {
+			frameParam = smiVhdlGenericConfig{"MaxAssembledFrames", "integer", "15"}
+			serverWidth = "FlitWidth*16"
+		}
+		component.Generics = []smiVhdlGenericConfig{
+			{"FlitWidth", "integer", "4"},
+			{"TagIdWidth", "integer", "2"},
+			{"FifoSize", "integer", "16"},
+			frameParam}
+		for i := 0; i < numClients; i++ {
+			component.Ports = append(component.Ports, makeVhdlSmiLinkPorts(
+				makePortIdCharName("smiReq%cIn", i), true, "FlitWidth*8")...)
+			component.Ports = append(component.Ports, makeVhdlSmiLinkPorts(
+				makePortIdCharName("smiResp%cOut", i), false, "FlitWidth*8")...)
+		}
+		component.Ports = append(component.Ports,
+			makeVhdlSmiLinkPorts("smiReqOut", false, serverWidth)...)
+		component.Ports = append(component.Ports,
+			makeVhdlSmiLinkPorts("smiRespIn", true, serverWidth)...)
+		component.Ports = append(component.Ports, clockPorts...)
+		return component, nil
+	}
+
+	// SMI memory bus pipeline stage component.
+	if moduleName == "smiMemBusPipelineStage" {
+		component.Generics = []smiVhdlGenericConfig{{"FlitWidth", "integer", "8"}}
+		component.Ports = append(component.Ports,
+			makeVhdlSmiLinkPorts("smiReqIn", true, "FlitWidth*8")...)
+		component.Ports = append(component.Ports,
+			makeVhdlSmiLinkPorts("smiRespOut", false, "FlitWidth*8")...)
+		component.Ports = append(component.Ports,
+			makeVhdlSmiLinkPorts("smiReqOut", false, "FlitWidth*8")...)
+		component.Ports = append(component.Ports,
+			makeVhdlSmiLinkPorts("smiRespIn", true, "FlitWidth*8")...)
+		component.Ports = append(component.Ports, clockPorts...)
+		return component, nil
+	}
+
+	// SMI to AXI memory bus adaptor component.
+	if moduleName == "smiAxiMemBusAdaptor" {
+		dataWidth := "(2**DataIndexSize)*8"
+		component.Generics = []smiVhdlGenericConfig{
+			{"DataIndexSize", "integer", "3"},
+			{"AxiIdWidth", "integer", "1"},
+			{"FifoSize", "integer", "16"}}
+		component.Ports = append(component.Ports,
+			makeVhdlSmiLinkPorts("smiReq", true, dataWidth)...)
+		component.Ports = append(component.Ports,
+			makeVhdlSmiLinkPorts("smiResp", false, dataWidth)...)
+		component.Ports = append(component.Ports, []smiVhdlPortConfig{
+			{"axiARValid", "out", ""},
+			{"axiARReady", "in", ""},
+			{"axiARId", "out", "AxiIdWidth"},
+			{"axiARAddr", "out", "64"},
+			{"axiARLen", "out", "8"},
+			{"axiARSize", "out", "3"},
+			{"axiARCache", "out", "4"},
+			{"axiRValid", "in", ""},
+			{"axiRReady", "out", ""},
+			{"axiRId", "in", "AxiIdWidth"},
+			{"axiRData", "in", dataWidth},
+			{"axiRResp", "in", "2"},
+			{"axiRLast", "in", ""},
+			{"axiAWValid", "out", ""},
+			{"axiAWReady", "in", ""},
+			{"axiAWId", "out", "AxiIdWidth"},
+			{"axiAWAddr", "out", "64"},
+			{"axiAWLen", "out", "8"},
+			{"axiAWSize", "out", "3"},
+			{"axiAWCache", "out", "4"},
+			{"axiWValid", "out", ""},
+			{"axiWReady", "in", ""},
+			{"axiWId", "out", "AxiIdWidth"},
+			{"axiWData", "out", dataWidth},
+			{"axiWStrb", "out", "2**DataIndexSize"},
+			{"axiWLast", "out", ""},
+			{"axiBValid", "in", ""},
+			{"axiBReady", "out", ""},
+			{"axiBId", "in", "AxiIdWidth"},
+			{"axiBResp", "in", "2"},
+			{"axiReset", "in", ""}}...)
+		component.Ports = append(component.Ports, clockPorts...)
+		return component, nil
+	}
+
+	return component, errors.New(fmt.Sprintf(
+		"No VHDL component declaration for SMI library module (%s)", moduleName))
+}
+
+//
+// Builds the sorted list of unique VHDL component declarations for the
+// specified library modules.
+//
+func makeVhdlLibraryComponents(moduleNames []string) ([]smiVhdlComponentConfig, error) {
+	moduleSet := make(map[string]bool)
+	uniqueNames := make([]string, 0, len(moduleNames))
+	for _, moduleName := range moduleNames {
+		if !moduleSet[moduleName] {
+			moduleSet[moduleName] = true
+			uniqueNames = append(uniqueNames, moduleName)
+		}
+	}
+	sort.Strings(uniqueNames)
+	components := make([]smiVhdlComponentConfig, len(uniqueNames))
+	for i, moduleName := range uniqueNames {
+		component, err := makeVhdlLibraryComponent(moduleName)
+		if err != nil {
+			return nil, err
+		}
+		components[i] = component
+	}
+	return components, nil
+}
+
+//
+// Generates a VHDL arbitration tree configuration from the standard
+// arbitration tree configuration for the supplied parameters and options.
+//
+func configureVhdlArbitrationTree(moduleName string, numClients uint,
+	scalingFactor uint, options ArbitrationTreeOptions) (smiVhdlArbitrationTreeConfig, error) {
+
+	var vhdlConfig = smiVhdlArbitrationTreeConfig{}
+	config, err := configureArbitrationTreeWithOptions(
+		moduleName, numClients, scalingFactor, options)
+	if err != nil {
+		return vhdlConfig, err
+	}
+	vhdlConfig.arbitrationTreeConfig = config
+	vhdlConfig.EntityPorts = makeVhdlMemBusPorts(config.SmiMemBusClientConns, "in", "out")
+	vhdlConfig.EntityPorts = append(vhdlConfig.EntityPorts,
+		makeVhdlMemBusPorts(config.SmiMemBusServerConn, "out", "in")...)
+	vhdlConfig.EntityPorts = append(vhdlConfig.EntityPorts,
+		smiVhdlPortConfig{"clk", "in", ""}, smiVhdlPortConfig{"srst", "in", ""})
+	vhdlConfig.Signals = makeVhdlMemBusPorts(config.SmiMemBusWireConns, "", "")
+	vhdlConfig.Components, err = makeVhdlLibraryComponents(
+		getArbitrationTreeLibraryModules(config))
+	return vhdlConfig, err
+}
+
+//
+// Fills in the parts of a VHDL kernel adaptor configuration which are common
+// to all the supported AXI based platforms. The kernel specific entity ports
+// are placed before the AXI memory master ports.
+//
+func configureVhdlKernelAdaptorCommon(vhdlConfig *smiVhdlKernelAdaptorConfig,
+	kernelPorts []smiVhdlPortConfig, axiBusDataWidth uint,
+	wireConns []smiMemBusConnectionConfig) error {
+
+	var err error
+	vhdlConfig.EntityPorts = append(kernelPorts,
+		makeVhdlAxiMasterPorts(axiBusDataWidth*8, vhdlConfig.AxiBusIdWidth)...)
+	vhdlConfig.EntityPorts = append(vhdlConfig.EntityPorts,
+		smiVhdlPortConfig{"clk", "in", ""}, smiVhdlPortConfig{"reset", "in", ""})
+	vhdlConfig.Signals = makeVhdlMemBusPorts(wireConns, "", "")
+	for _, conn := range vhdlConfig.SmiMemBusClientConns {
+		vhdlConfig.Signals = append(vhdlConfig.Signals,
+			smiVhdlPortConfig{conn.SmiNetReqName + "Flit", "", "72"},
+			smiVhdlPortConfig{conn.SmiNetRespName + "Flit", "", "72"})
+	}
+	vhdlConfig.Components, err = makeVhdlLibraryComponents(
+		[]string{"smiAxiMemBusAdaptor"})
+	return err
+}
+
+//
+// Builds the kernel component port and port map entries for a single SMI
+// client connection, given the kernel request and response port name
+// prefixes.
+//
+func appendVhdlKernelSmiPorts(instance *smiVhdlKernelInstanceConfig,
+	conn smiMemBusConnectionConfig, reqPrefix string, respPrefix string) {
+
+	instance.Component.Ports = append(instance.Component.Ports,
+		smiVhdlPortConfig{reqPrefix + "Ready", "out", ""},
+		smiVhdlPortConfig{reqPrefix + "Data", "out", "72"},
+		smiVhdlPortConfig{reqPrefix + "Stop", "in", ""},
+		smiVhdlPortConfig{respPrefix + "Ready", "in", ""},
+		smiVhdlPortConfig{respPrefix + "Data", "in", "72"},
+		smiVhdlPortConfig{respPrefix + "Stop", "out", ""})
+	instance.PortMap = append(instance.PortMap,
+		smiVhdlPortMapConfig{reqPrefix + "Ready", conn.SmiNetReqName + "Ready"},
+		smiVhdlPortMapConfig{reqPrefix + "Data", conn.SmiNetReqName + "Flit"},
+		smiVhdlPortMapConfig{reqPrefix + "Stop", conn.SmiNetReqName + "Stop"},
+		smiVhdlPortMapConfig{respPrefix + "Ready", conn.SmiNetRespName + "Ready"},
+		smiVhdlPortMapConfig{respPrefix + "Data", conn.SmiNetRespName + "Flit"},
+		smiVhdlPortMapConfig{respPrefix + "Stop", conn.SmiNetRespName + "Stop"})
+}
+
+//
+// Builds the kernel component ports and port map entries for ports which are
+// passed directly through from the kernel adaptor entity.
+//
+func appendVhdlKernelDirectPorts(instance *smiVhdlKernelInstanceConfig,
+	ports []smiVhdlPortConfig) {
+
+	for _, port := range ports {
+		instance.Component.Ports = append(instance.Component.Ports, port)
+		instance.PortMap = append(instance.PortMap,
+			smiVhdlPortMapConfig{port.Name, port.Name})
+	}
+}
+
+//
+// Generates a VHDL SDAccel kernel adaptor configuration given the supplied
+// parameters.
+//
+func configureVhdlSdaKernelAdaptor(moduleName string, kernelName string,
+	numClients uint, scalingFactor uint) (smiVhdlKernelAdaptorConfig, error) {
+
+	var vhdlConfig = smiVhdlKernelAdaptorConfig{}
+	config, err := configureSmiSdaKernelAdaptor(
+		moduleName, kernelName, numClients, scalingFactor, ClockCrossingNone)
+	if err != nil {
+		return vhdlConfig, err
+	}
+	vhdlConfig.ModuleName = config.ModuleName
+	vhdlConfig.ArbitrationModuleName = config.ArbitrationModuleName
+	vhdlConfig.AxiByteIndexSize = config.AxiByteIndexSize
+	vhdlConfig.AxiBusIdWidth = config.AxiBusIdWidth
+	vhdlConfig.SmiMemBusClientConns = config.SmiMemBusClientConns
+	vhdlConfig.SmiMemBusServerConn = config.SmiMemBusServerConn
+	vhdlConfig.ClockDomains = config.ClockDomains
+
+	controlPorts := []smiVhdlPortConfig{
+		{"go_0Ready", "in", ""},
+		{"go_0Stop", "out", ""},
+		{"done_0Ready", "out", ""},
+		{"done_0Stop", "in", ""}}
+	axiSlavePorts := []smiVhdlPortConfig{
+		{"s_axi_araddr", "in", "32"},
+		{"s_axi_arcache", "in", "4"},
+		{"s_axi_arprot", "in", "3"},
+		{"s_axi_arvalid", "in", ""},
+		{"s_axi_arready", "out", ""},
+		{"s_axi_rdata", "out", "32"},
+		{"s_axi_rresp", "out", "2"},
+		{"s_axi_rvalid", "out", ""},
+		{"s_axi_rready", "in", ""},
+		{"s_axi_awaddr", "in", "32"},
+		{"s_axi_awcache", "in", "4"},
+		{"s_axi_awprot", "in", "3"},
+		{"s_axi_awvalid", "in", ""},
+		{"s_axi_awready", "out", ""},
+		{"s_axi_wdata", "in", "32"},
+		{"s_axi_wstrb", "in", "4"},
+		{"s_axi_wvalid", "in", ""},
+		{"s_axi_wready", "out", ""},
+		{"s_axi_bresp", "out", "2"},
+		{"s_axi_bvalid", "out", ""},
+		{"s_axi_bready", "in", ""}}
+	paramPorts := []smiVhdlPortConfig{
+		{"paramaddr_0Ready", "out", ""},
+		{"paramaddr_0Data", "out", "32"},
+		{"paramaddr_0Stop", "in", ""},
+		{"paramdata_0Ready", "in", ""},
+		{"paramdata_0Data", "in", "32"},
+		{"paramdata_0Stop", "out", ""}}
+
+	// Build the kernel component and port associations.
+	kernel := smiVhdlKernelInstanceConfig{}
+	kernel.Component.Name = config.KernelModuleName
+	appendVhdlKernelDirectPorts(&kernel, controlPorts)
+	appendVhdlKernelDirectPorts(&kernel, paramPorts)
+	for i, conn := range config.SmiMemBusClientConns {
+		appendVhdlKernelSmiPorts(&kernel, conn,
+			fmt.Sprintf("smiport%dreq_0", i), fmt.Sprintf("smiport%dresp_0", i))
+	}
+	appendVhdlKernelDirectPorts(&kernel, axiSlavePorts)
+	appendVhdlKernelDirectPorts(&kernel, []smiVhdlPortConfig{
+		{"clk", "in", ""}, {"reset", "in", ""}})
+	vhdlConfig.KernelInstances = []smiVhdlKernelInstanceConfig{kernel}
+
+	kernelPorts := append(append(controlPorts, axiSlavePorts...), paramPorts...)
+	err = configureVhdlKernelAdaptorCommon(&vhdlConfig, kernelPorts,
+		config.AxiBusDataWidth, config.SmiMemBusWireConns)
+	return vhdlConfig, err
+}
+
+//
+// Generates a VHDL common LLVM kernel adaptor configuration given the
+// supplied parameters. The Verilog adaptor only connects the kernel argument
+// data when KERNEL_ARGS_DATA is defined, so the VHDL equivalent is selected
+// using the KernelArgsData generic.
+//
+func configureVhdlLlvmKernelAdaptor(moduleName string, kernelName string,
+	numClients uint, scalingFactor uint, axiBusIdWidth uint,
+	kernelArgsWidth uint) (smiVhdlKernelAdaptorConfig, error) {
+
+	var vhdlConfig = smiVhdlKernelAdaptorConfig{}
+	config, err := configureSmiLlvmKernelAdaptor(moduleName, kernelName,
+		numClients, scalingFactor, axiBusIdWidth, kernelArgsWidth, ClockCrossingNone)
+	if err != nil {
+		return vhdlConfig, err
+	}
+	vhdlConfig.ModuleName = config.ModuleName
+	vhdlConfig.ArbitrationModuleName = config.ArbitrationModuleName
+	vhdlConfig.AxiByteIndexSize = config.AxiByteIndexSize
+	vhdlConfig.AxiBusIdWidth = config.AxiBusIdWidth
+	vhdlConfig.SmiMemBusClientConns = config.SmiMemBusClientConns
+	vhdlConfig.SmiMemBusServerConn = config.SmiMemBusServerConn
+	vhdlConfig.ClockDomains = config.ClockDomains
+	vhdlConfig.EntityGenerics = []smiVhdlGenericConfig{
+		{"KernelArgsData", "boolean", "false"}}
+
+	argsWidth := strconv.Itoa(int(config.KernelArgsWidth * 32))
+	controlPorts := []smiVhdlPortConfig{
+		{"argsReady", "in", ""},
+		{"argsData", "in", argsWidth},
+		{"argsStop", "out", ""},
+		{"retValReady", "out", ""},
+		{"retValStop", "in", ""}}
+
+	// Build the kernel component variants with and without argument data.
+	kernelInstances := make([]smiVhdlKernelInstanceConfig, 2)
+	for k := range kernelInstances {
+		kernel := &kernelInstances[k]
+		kernel.Component.Name = config.KernelModuleName
+		kernel.Component.Ports = []smiVhdlPortConfig{{"args0_0Ready", "in", ""}}
+		kernel.PortMap = []smiVhdlPortMapConfig{{"args0_0Ready", "argsReady"}}
+		if k == 0 {
+			kernel.Label = "kernelArgsDataGen"
+			kernel.Condition = "KernelArgsData"
+			kernel.Component.Ports = append(kernel.Component.Ports,
+				smiVhdlPortConfig{"args0_0Data", "in", argsWidth})
+			kernel.PortMap = append(kernel.PortMap,
+				smiVhdlPortMapConfig{"args0_0Data", "argsData"})
+		} else {
+			kernel.Label = "kernelNoArgsDataGen"
+			kernel.Condition = "not KernelArgsData"
+		}
+		kernel.Component.Ports = append(kernel.Component.Ports,
+			smiVhdlPortConfig{"args0_0Stop", "out", ""},
+			smiVhdlPortConfig{"retVal1_0Ready", "out", ""},
+			smiVhdlPortConfig{"retVal1_0Stop", "in", ""})
+		kernel.PortMap = append(kernel.PortMap,
+			smiVhdlPortMapConfig{"args0_0Stop", "argsStop"},
+			smiVhdlPortMapConfig{"retVal1_0Ready", "retValReady"},
+			smiVhdlPortMapConfig{"retVal1_0Stop", "retValStop"})
+		for i, conn := range config.SmiMemBusClientConns {
+			appendVhdlKernelSmiPorts(kernel, conn,
+				makePortIdIndexName("request%d_0", i, 2, 2),
+				makePortIdIndexName("response%d_0", i, 3, 2))
+		}
+		appendVhdlKernelDirectPorts(kernel, []smiVhdlPortConfig{
+			{"clk", "in", ""}, {"reset", "in", ""}})
+	}
+	vhdlConfig.KernelInstances = kernelInstances
+
+	err = configureVhdlKernelAdaptorCommon(&vhdlConfig, controlPorts,
+		config.AxiBusDataWidth, config.SmiMemBusWireConns)
+	return vhdlConfig, err
+}
+
+//
+// Execute the named VHDL template using the supplied output file handle and
+// configuration.
+//
+func executeSmiVhdlTemplate(outFile *os.File, templateName string,
+	config interface{}) error {
+
+	return getSmiVhdlTemplate().ExecuteTemplate(outFile, templateName, config)
+}
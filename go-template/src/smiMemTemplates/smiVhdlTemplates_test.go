@@ -0,0 +1,409 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+//
+// Matches the Verilog comments, module header, parameter and port
+// declarations used by the library modules.
+//
+var (
+	verilogCommentRegexp   = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+	verilogModuleRegexp    = regexp.MustCompile(`module\s+(\w+)\s*\(([^)]*)\)\s*;`)
+	verilogParameterRegexp = regexp.MustCompile(`\b(parameter|localparam)\s+(\w+)\s*=\s*([^;]+);`)
+	verilogPortRegexp      = regexp.MustCompile(
+		`\b(input|output)\s+(?:(?:reg|wire)\s+)?(?:\[([^:\]]+):([^\]]+)\]\s*)?([\w\s,]+);`)
+)
+
+//
+// Specifies a single Verilog parameter, in declaration order.
+//
+type verilogParameter struct {
+	name  string // Name of the parameter.
+	local bool   // Set for local parameters, which can not be overridden.
+	value string // Default value expression.
+}
+
+//
+// Specifies a single Verilog port declaration.
+//
+type verilogPort struct {
+	direction string // Port direction ('input' or 'output').
+	msb       string // Most significant bit expression, or empty for a single bit.
+	lsb       string // Least significant bit expression, or empty for a single bit.
+}
+
+//
+// Specifies the interface of a Verilog module, as declared in the module
+// header and the associated parameter and port declarations.
+//
+type verilogModule struct {
+	name       string                 // Name of the module.
+	portNames  []string               // Port names in module header order.
+	parameters []verilogParameter     // Parameters in declaration order.
+	ports      map[string]verilogPort // Port declarations indexed by name.
+}
+
+//
+// Parses the interface of a Verilog library module from its source file.
+//
+func parseVerilogModule(fileName string) (verilogModule, error) {
+	module := verilogModule{ports: make(map[string]verilogPort)}
+	source, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return module, err
+	}
+	text := verilogCommentRegexp.ReplaceAllString(string(source), "")
+	header := verilogModuleRegexp.FindStringSubmatch(text)
+	if header == nil {
+		return module, errors.New(fmt.Sprintf("%s: no module header", fileName))
+	}
+	module.name = header[1]
+	for _, portName := range strings.Split(header[2], ",") {
+		module.portNames = append(module.portNames, strings.TrimSpace(portName))
+	}
+	for _, match := range verilogParameterRegexp.FindAllStringSubmatch(text, -1) {
+		module.parameters = append(module.parameters, verilogParameter{
+			match[2], match[1] == "localparam", strings.TrimSpace(match[3])})
+	}
+	for _, match := range verilogPortRegexp.FindAllStringSubmatch(text, -1) {
+		for _, portName := range strings.Split(match[4], ",") {
+			module.ports[strings.TrimSpace(portName)] = verilogPort{
+				match[1], strings.TrimSpace(match[2]), strings.TrimSpace(match[3])}
+		}
+	}
+	return module, nil
+}
+
+//
+// Evaluates the integer constant expressions used for Verilog parameter
+// values and port ranges, and for VHDL generic based port widths. Supports
+// the '+', '-', '*', '/', '**', '<<' and '>>' operators with parentheses.
+//
+type constExprParser struct {
+	tokens []string         // Remaining expression tokens.
+	values map[string]int64 // Values of named constants.
+}
+
+func evalConstExpr(expr string, values map[string]int64) (int64, error) {
+	parser := constExprParser{values: values}
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsLetter(c) || unicode.IsDigit(c) || (c == '_'):
+			j := i
+			for (j < len(expr)) && (unicode.IsLetter(rune(expr[j])) ||
+				unicode.IsDigit(rune(expr[j])) || (expr[j] == '_')) {
+				j++
+			}
+			parser.tokens = append(parser.tokens, expr[i:j])
+			i = j
+		case (i+1 < len(expr)) && ((expr[i:i+2] == "**") ||
+			(expr[i:i+2] == "<<") || (expr[i:i+2] == ">>")):
+			parser.tokens = append(parser.tokens, expr[i:i+2])
+			i += 2
+		case strings.ContainsRune("+-*/()", c):
+			parser.tokens = append(parser.tokens, expr[i:i+1])
+			i++
+		default:
+			return 0, errors.New(fmt.Sprintf("unsupported character in '%s'", expr))
+		}
+	}
+	value, err := parser.parseShift()
+	if (err == nil) && (len(parser.tokens) != 0) {
+		err = errors.New(fmt.Sprintf("unexpected token '%s' in '%s'", parser.tokens[0], expr))
+	}
+	return value, err
+}
+
+func (parser *constExprParser) next(ops ...string) string {
+	if len(parser.tokens) != 0 {
+		for _, op := range ops {
+			if parser.tokens[0] == op {
+				parser.tokens = parser.tokens[1:]
+				return op
+			}
+		}
+	}
+	return ""
+}
+
+func (parser *constExprParser) parseShift() (int64, error) {
+	value, err := parser.parseSum()
+	for op := parser.next("<<", ">>"); (err == nil) && (op != ""); op = parser.next("<<", ">>") {
+		var rhs int64
+		if rhs, err = parser.parseSum(); op == "<<" {
+			value <<= uint(rhs)
+		} else {
+			value >>= uint(rhs)
+		}
+	}
+	return value, err
+}
+
+func (parser *constExprParser) parseSum() (int64, error) {
+	value, err := parser.parseProduct()
+	for op := parser.next("+", "-"); (err == nil) && (op != ""); op = parser.next("+", "-") {
+		var rhs int64
+		if rhs, err = parser.parseProduct(); op == "+" {
+			value += rhs
+		} else {
+			value -= rhs
+		}
+	}
+	return value, err
+}
+
+func (parser *constExprParser) parseProduct() (int64, error) {
+	value, err := parser.parsePower()
+	for op := parser.next("*", "/"); (err == nil) && (op != ""); op = parser.next("*", "/") {
+		var rhs int64
+		if rhs, err = parser.parsePower(); op == "*" {
+			value *= rhs
+		} else if rhs != 0 {
+			value /= rhs
+		} else {
+			err = errors.New("division by zero")
+		}
+	}
+	return value, err
+}
+
+func (parser *constExprParser) parsePower() (int64, error) {
+	value, err := parser.parseTerm()
+	if (err == nil) && (parser.next("**") != "") {
+		var exponent int64
+		exponent, err = parser.parsePower()
+		result := int64(1)
+		for ; exponent > 0; exponent-- {
+			result *= value
+		}
+		value = result
+	}
+	return value, err
+}
+
+func (parser *constExprParser) parseTerm() (int64, error) {
+	if parser.next("-") != "" {
+		value, err := parser.parseTerm()
+		return -value, err
+	}
+	if parser.next("(") != "" {
+		value, err := parser.parseShift()
+		if (err == nil) && (parser.next(")") == "") {
+			err = errors.New("missing closing parenthesis")
+		}
+		return value, err
+	}
+	if len(parser.tokens) == 0 {
+		return 0, errors.New("unexpected end of expression")
+	}
+	token := parser.tokens[0]
+	parser.tokens = parser.tokens[1:]
+	if value, err := strconv.ParseInt(token, 10, 64); err == nil {
+		return value, nil
+	}
+	if value, ok := parser.values[token]; ok {
+		return value, nil
+	}
+	return 0, errors.New(fmt.Sprintf("unknown identifier '%s'", token))
+}
+
+//
+// Evaluates the Verilog module parameters in declaration order, with the
+// specified overridden values.
+//
+func (module verilogModule) parameterValues(overrides map[string]int64) (map[string]int64, error) {
+	values := make(map[string]int64)
+	for _, param := range module.parameters {
+		if value, ok := overrides[param.name]; ok && !param.local {
+			values[param.name] = value
+			continue
+		}
+		value, err := evalConstExpr(param.value, values)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("parameter %s: %v", param.name, err))
+		}
+		values[param.name] = value
+	}
+	return values, nil
+}
+
+//
+// Lists the library modules for which VHDL component declarations may be
+// generated, covering all the supported arbitration tree configurations with
+// and without link pipelining, together with the kernel adaptors.
+//
+func vhdlLibraryModuleNames(t *testing.T) []string {
+	moduleSet := make(map[string]bool)
+	pipelinedOptions := DefaultArbitrationTreeOptions()
+	pipelinedOptions.PipelineFanIn = 1
+	for _, options := range []ArbitrationTreeOptions{
+		DefaultArbitrationTreeOptions(), pipelinedOptions} {
+		for _, scalingFactor := range testScalingFactors {
+			for numClients := uint(1); numClients <= testMaxClients; numClients++ {
+				config, err := configureVhdlArbitrationTree(
+					"testTree", numClients, scalingFactor, options)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, component := range config.Components {
+					moduleSet[component.Name] = true
+				}
+			}
+		}
+	}
+	adaptor, err := configureVhdlSdaKernelAdaptor("testAdaptor", "testKernel", 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, component := range adaptor.Components {
+		moduleSet[component.Name] = true
+	}
+	moduleNames := make([]string, 0, len(moduleSet))
+	for moduleName := range moduleSet {
+		moduleNames = append(moduleNames, moduleName)
+	}
+	sort.Strings(moduleNames)
+	return moduleNames
+}
+
+//
+// Tests that the VHDL component declarations for the library modules match
+// the corresponding Verilog module headers. The port names, order and
+// directions must match, each generic must be a Verilog parameter with the
+// same default value, and the port widths must agree both for the default
+// generic values and for a set of alternative values.
+//
+func TestVhdlLibraryComponents(t *testing.T) {
+	moduleNames := vhdlLibraryModuleNames(t)
+	for _, moduleName := range []string{"smiAxiMemBusAdaptor", "smiMemBusPipelineStage"} {
+		if sort.SearchStrings(moduleNames, moduleName) == len(moduleNames) {
+			t.Errorf("library module %s not included in the checked components", moduleName)
+		}
+	}
+	for _, moduleName := range moduleNames {
+		component, err := makeVhdlLibraryComponent(moduleName)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		module, err := parseVerilogModule(filepath.Join(testLibraryDir, moduleName+".v"))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if module.name != moduleName {
+			t.Errorf("%s: module name is %s", moduleName, module.name)
+			continue
+		}
+
+		// Check the port names and order against the module header.
+		vhdlPortNames := make([]string, len(component.Ports))
+		for i, port := range component.Ports {
+			vhdlPortNames[i] = port.Name
+		}
+		if strings.Join(vhdlPortNames, ",") != strings.Join(module.portNames, ",") {
+			t.Errorf("%s: VHDL ports %v, Verilog ports %v",
+				moduleName, vhdlPortNames, module.portNames)
+			continue
+		}
+
+		// Check the generic defaults and build the alternative values.
+		defaults, err := module.parameterValues(nil)
+		if err != nil {
+			t.Errorf("%s: %v", moduleName, err)
+			continue
+		}
+		generics := []map[string]int64{make(map[string]int64), make(map[string]int64)}
+		for _, generic := range component.Generics {
+			value, err := strconv.ParseInt(generic.Value, 10, 64)
+			if (generic.Type != "integer") || (err != nil) {
+				t.Errorf("%s: unsupported generic %+v", moduleName, generic)
+				continue
+			}
+			if defaultValue, ok := defaults[generic.Name]; !ok {
+				t.Errorf("%s: generic %s is not a Verilog parameter", moduleName, generic.Name)
+			} else if defaultValue != value {
+				t.Errorf("%s: generic %s default %d, Verilog default %d",
+					moduleName, generic.Name, value, defaultValue)
+			}
+			generics[0][generic.Name] = value
+			generics[1][generic.Name] = 2*value + 1
+		}
+
+		// Check the port directions and widths for each set of generics.
+		for _, genericValues := range generics {
+			paramValues, err := module.parameterValues(genericValues)
+			if err != nil {
+				t.Errorf("%s: %v", moduleName, err)
+				break
+			}
+			for _, port := range component.Ports {
+				verilogPort, ok := module.ports[port.Name]
+				if !ok {
+					t.Errorf("%s: port %s is not declared", moduleName, port.Name)
+					continue
+				}
+				if verilogPort.direction != map[string]string{
+					"in": "input", "out": "output"}[port.Direction] {
+					t.Errorf("%s: port %s direction %s, Verilog direction %s",
+						moduleName, port.Name, port.Direction, verilogPort.direction)
+					continue
+				}
+				if (port.Width == "") || (verilogPort.msb == "") {
+					if port.Width != verilogPort.msb {
+						t.Errorf("%s: port %s width '%s', Verilog range [%s:%s]", moduleName,
+							port.Name, port.Width, verilogPort.msb, verilogPort.lsb)
+					}
+					continue
+				}
+				vhdlWidth, err := evalConstExpr(port.Width, genericValues)
+				if err != nil {
+					t.Errorf("%s: port %s: %v", moduleName, port.Name, err)
+					continue
+				}
+				msb, err := evalConstExpr(verilogPort.msb, paramValues)
+				if err == nil {
+					var lsb int64
+					lsb, err = evalConstExpr(verilogPort.lsb, paramValues)
+					if (err == nil) && (msb-lsb+1 != vhdlWidth) {
+						t.Errorf("%s: port %s width %d with generics %v, Verilog width %d",
+							moduleName, port.Name, vhdlWidth, genericValues, msb-lsb+1)
+					}
+				}
+				if err != nil {
+					t.Errorf("%s: port %s: %v", moduleName, port.Name, err)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,260 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiMemTemplates
+
+import (
+	"fmt"
+	"os"
+	"text/template"
+)
+
+//
+// Defines the template configuration options for a Vivado IP packaging script.
+//
+type smiVivadoPackageConfig struct {
+	ModuleName    string                        // Name of the top level module.
+	Vendor        string                        // IP vendor name.
+	Library       string                        // IP library name.
+	Version       string                        // IP version string.
+	SourceFiles   []string                      // List of generated source files.
+	LibraryFiles  []string                      // List of required library files.
+	TestFiles     []string                      // List of required test files.
+	BusInterfaces []smiKernelBusInterfaceConfig // List of AXI bus interfaces.
+	ClockName     string                        // Name of the clock port.
+	ResetName     string                        // Name of the active high reset port.
+	MemClockName  string                        // Name of the memory clock port, if any.
+	MemResetName  string                        // Name of the memory reset port, if any.
+	MemBusifName  string                        // Bus interface using the memory clock, if any.
+}
+
+//
+// Defines the template for the Vivado IP packaging script. The library file
+// directory may be overridden using the SMI_LIB_DIR environment variable, the
+// test file directory may be overridden using the SMI_TEST_DIR environment
+// variable and the target part may be specified as the first script argument.
+//
+var smiVivadoPackageTemplate = `
+{{define "smiVivadoPackage"}}#
+# Copyright 2018 ReconfigureIO
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+#
+
+#
+# Created {{makeFileTimestamp}}
+# Machine generated file - DO NOT EDIT
+#
+# Packages {{.ModuleName}} as a Vivado IP. Run using:
+#   vivado -mode batch -source <script> [-tclargs <part>]
+#
+
+set ip_name {{.ModuleName}}
+set ip_dir [file normalize "./${ip_name}_ip"]
+set src_dir [file normalize [file dirname [info script]]]
+if {[info exists ::env(SMI_LIB_DIR)]} {
+  set smi_lib_dir [file normalize $::env(SMI_LIB_DIR)]
+} else {
+  set smi_lib_dir [file normalize [file join $src_dir verilog]]
+}{{if .TestFiles}}
+if {[info exists ::env(SMI_TEST_DIR)]} {
+  set smi_test_dir [file normalize $::env(SMI_TEST_DIR)]
+} else {
+  set smi_test_dir [file normalize [file join $smi_lib_dir .. test verilog]]
+}{{end}}
+
+# Create a temporary project for the packaging step.
+if {$argc > 0} {
+  create_project -force ${ip_name}_pkg ./${ip_name}_pkg -part [lindex $argv 0]
+} else {
+  create_project -force ${ip_name}_pkg ./${ip_name}_pkg
+}
+
+# Add the generated source files.{{range .SourceFiles}}
+add_files -norecurse [file join $src_dir {{.}}]{{end}}
+
+# Add the required SMI library files.{{range .LibraryFiles}}
+add_files -norecurse [file join $smi_lib_dir {{.}}]{{end}}
+{{if .TestFiles}}
+# Add the required SMI test component files.{{range .TestFiles}}
+add_files -norecurse [file join $smi_test_dir {{.}}]{{end}}
+{{end}}
+set_property top $ip_name [current_fileset]
+update_compile_order -fileset sources_1
+
+# Package the project and infer the standard bus interfaces.
+ipx::package_project -root_dir $ip_dir -vendor {{.Vendor}} -library {{.Library}} \
+  -taxonomy /UserIP -import_files -set_current true
+set core [ipx::current_core]
+set_property version {{.Version}} $core
+set_property name $ip_name $core
+set_property display_name $ip_name $core
+
+ipx::infer_bus_interfaces xilinx.com:interface:aximm_rtl:1.0 $core
+ipx::infer_bus_interfaces xilinx.com:signal:clock_rtl:1.0 $core
+ipx::infer_bus_interfaces xilinx.com:signal:reset_rtl:1.0 $core
+
+# Set a bus interface parameter, adding it if it has not been inferred.
+proc smi_set_bus_param {core busif name value} {
+  set bif [ipx::get_bus_interfaces $busif -of_objects $core]
+  set param [ipx::get_bus_parameters $name -of_objects $bif]
+  if {$param eq ""} {
+    set param [ipx::add_bus_parameter $name $bif]
+  }
+  set_property value $value $param
+}
+{{range $busif := .BusInterfaces}}
+# Configure the {{$busif.Name}} {{$busif.Mode}} interface.{{if ne $busif.Name (makeInferredBusName $busif.Ports)}}
+set_property name {{$busif.Name}} [ipx::get_bus_interfaces {{makeInferredBusName $busif.Ports}} -of_objects $core]{{end}}
+ipx::associate_bus_interfaces -busif {{$busif.Name}} -clock {{if eq $busif.Name $.MemBusifName}}{{$.MemClockName}}{{else}}{{$.ClockName}}{{end}} $core{{range $busif.Parameters}}
+smi_set_bus_param $core {{$busif.Name}} {{.Name}} {{.Value}}{{end}}
+{{end}}
+# Associate the clock and reset signals.
+ipx::associate_bus_interfaces -clock {{.ClockName}} -reset {{.ResetName}} $core
+smi_set_bus_param $core {{.ResetName}} POLARITY ACTIVE_HIGH{{if .MemClockName}}
+ipx::associate_bus_interfaces -clock {{.MemClockName}} -reset {{.MemResetName}} $core
+smi_set_bus_param $core {{.MemResetName}} POLARITY ACTIVE_HIGH{{end}}
+
+# Save the packaged IP.
+ipx::create_xgui_files $core
+ipx::update_checksums $core
+ipx::check_integrity $core
+ipx::save_core $core
+close_project -delete
+{{end}}`
+
+//
+// Cache the parsed Vivado IP packaging template.
+//
+var smiVivadoPackageCache *template.Template = nil
+
+//
+// Implement lazy construction of the Vivado IP packaging template.
+//
+func getSmiVivadoPackageTemplate() *template.Template {
+	templateCacheLock.Lock()
+	defer templateCacheLock.Unlock()
+	if smiVivadoPackageCache == nil {
+		templGroup := template.New("").Funcs(smiTemplateFunctions)
+		templGroup = template.Must(templGroup.Parse(smiVivadoPackageTemplate))
+		smiVivadoPackageCache = templGroup
+	}
+	return smiVivadoPackageCache
+}
+
+//
+// Generates a Vivado IP packaging script configuration given the supplied
+// parameters. The AXI memory master interface is always present and the AXI
+// control slave interface is optional. The arbitration tree library
+// components are selected using the arbitration tree options, and the library
+// and test components used by the fuzz test kernel are included if required.
+// If a clock domain crossing is used, the clock domain bridge components are
+// included and the AXI memory master interface is associated with the memory
+// clock.
+//
+func configureSmiVivadoPackage(moduleName string, sourceFiles []string,
+	numClients uint, scalingFactor uint, axiBusIdWidth uint,
+	axiControlSlave bool, fuzzTestKernel bool,
+	clockCrossing string, options ArbitrationTreeOptions) (smiVivadoPackageConfig, error) {
+
+	var smiVivadoPackage = smiVivadoPackageConfig{}
+	smiVivadoPackage.ModuleName = moduleName
+	smiVivadoPackage.Vendor = "reconfigure.io"
+	smiVivadoPackage.Library = "smi"
+	smiVivadoPackage.Version = "1.0"
+	smiVivadoPackage.SourceFiles = sourceFiles
+	smiVivadoPackage.ClockName = "clk"
+	smiVivadoPackage.ResetName = "reset"
+
+	libraryFiles, err := RequiredLibraryFilesWithOptions(
+		numClients, scalingFactor, "smiAxiMemBusAdaptor", options)
+	if err != nil {
+		return smiVivadoPackage, err
+	}
+	smiVivadoPackage.LibraryFiles = libraryFiles
+
+	// Merge in the fuzz test kernel components.
+	if fuzzTestKernel {
+		fuzzLibraryFiles, fuzzTestFiles, err := FuzzTestKernelFiles()
+		if err != nil {
+			return smiVivadoPackage, err
+		}
+		smiVivadoPackage.LibraryFiles = MergeLibraryFiles(
+			smiVivadoPackage.LibraryFiles, fuzzLibraryFiles)
+		smiVivadoPackage.TestFiles = fuzzTestFiles
+	}
+
+	// Merge in the clock domain bridge components. The bridge connections are
+	// not used when packaging, so only the clock and reset names are required.
+	clockDomains, _, err := configureSmiMemClockDomains(
+		clockCrossing, nil, smiMemBusConnectionConfig{})
+	if err != nil {
+		return smiVivadoPackage, err
+	}
+	if clockDomains.MemClockSeparate {
+		bridgeLibraryFiles, err := ClockBridgeFiles()
+		if err != nil {
+			return smiVivadoPackage, err
+		}
+		smiVivadoPackage.LibraryFiles = MergeLibraryFiles(
+			smiVivadoPackage.LibraryFiles, bridgeLibraryFiles)
+		smiVivadoPackage.MemClockName = clockDomains.MemClockName
+		smiVivadoPackage.MemResetName = clockDomains.MemResetName
+	}
+
+	// The port lists are only used to identify the inferred interface names.
+	smiVivadoPackage.BusInterfaces = []smiKernelBusInterfaceConfig{
+		{"m_axi_gmem", "master", "aximm", "aximm_rtl",
+			[]smiKernelPortConfig{{"m_axi_gmem_rdata", "RDATA", "in", scalingFactor * 64}},
+			[]smiKernelParamConfig{
+				{"PROTOCOL", "AXI4"},
+				{"DATA_WIDTH", fmt.Sprintf("%d", scalingFactor*64)},
+				{"ID_WIDTH", fmt.Sprintf("%d", axiBusIdWidth)},
+				{"ADDR_WIDTH", "64"}}}}
+	if clockDomains.MemClockSeparate {
+		smiVivadoPackage.MemBusifName = smiVivadoPackage.BusInterfaces[0].Name
+	}
+	if axiControlSlave {
+		smiVivadoPackage.BusInterfaces = append(smiVivadoPackage.BusInterfaces,
+			smiKernelBusInterfaceConfig{"s_axi_control", "slave", "aximm", "aximm_rtl",
+				[]smiKernelPortConfig{{"s_axi_rdata", "RDATA", "out", 32}},
+				[]smiKernelParamConfig{
+					{"PROTOCOL", "AXI4LITE"},
+					{"DATA_WIDTH", "32"},
+					{"ADDR_WIDTH", "32"}}})
+	}
+	return smiVivadoPackage, nil
+}
+
+//
+// Execute the template using the supplied output file handle and configuration.
+//
+func executeSmiVivadoPackageTemplate(outFile *os.File,
+	config smiVivadoPackageConfig) error {
+
+	return getSmiVivadoPackageTemplate().ExecuteTemplate(
+		outFile, "smiVivadoPackage", config)
+}
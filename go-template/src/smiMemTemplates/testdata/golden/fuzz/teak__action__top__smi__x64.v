@@ -0,0 +1,4259 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Created Mon, 01 Jan 2018 00:00:00 UTC
+// Machine generated file - DO NOT EDIT
+//
+
+`timescale 1ns/1ps
+
+module teak__action__top__smi__x64 (
+
+  // Action control signals.
+  input          go_0Ready,
+  output         go_0Stop,
+  output         done_0Ready,
+  input          done_0Stop,
+
+  // Specifies the parameter register file data access signals.
+  output         paramaddr_0Ready,
+  output [ 31:0] paramaddr_0Data,
+  input          paramaddr_0Stop,
+  input          paramdata_0Ready,
+  input  [ 31:0] paramdata_0Data,
+  output         paramdata_0Stop,
+
+  // Specifies the SMI memory port 0 signals.
+  output         smiport0req_0Ready,
+  output [ 71:0] smiport0req_0Data,
+  input          smiport0req_0Stop,
+  input          smiport0resp_0Ready,
+  input  [ 71:0] smiport0resp_0Data,
+  output         smiport0resp_0Stop,
+
+  // Specifies the SMI memory port 1 signals.
+  output         smiport1req_0Ready,
+  output [ 71:0] smiport1req_0Data,
+  input          smiport1req_0Stop,
+  input          smiport1resp_0Ready,
+  input  [ 71:0] smiport1resp_0Data,
+  output         smiport1resp_0Stop,
+
+  // Specifies the SMI memory port 2 signals.
+  output         smiport2req_0Ready,
+  output [ 71:0] smiport2req_0Data,
+  input          smiport2req_0Stop,
+  input          smiport2resp_0Ready,
+  input  [ 71:0] smiport2resp_0Data,
+  output         smiport2resp_0Stop,
+
+  // Specifies the SMI memory port 3 signals.
+  output         smiport3req_0Ready,
+  output [ 71:0] smiport3req_0Data,
+  input          smiport3req_0Stop,
+  input          smiport3resp_0Ready,
+  input  [ 71:0] smiport3resp_0Data,
+  output         smiport3resp_0Stop,
+
+  // Specifies the SMI memory port 4 signals.
+  output         smiport4req_0Ready,
+  output [ 71:0] smiport4req_0Data,
+  input          smiport4req_0Stop,
+  input          smiport4resp_0Ready,
+  input  [ 71:0] smiport4resp_0Data,
+  output         smiport4resp_0Stop,
+
+  // Specifies the SMI memory port 5 signals.
+  output         smiport5req_0Ready,
+  output [ 71:0] smiport5req_0Data,
+  input          smiport5req_0Stop,
+  input          smiport5resp_0Ready,
+  input  [ 71:0] smiport5resp_0Data,
+  output         smiport5resp_0Stop,
+
+  // Specifies the SMI memory port 6 signals.
+  output         smiport6req_0Ready,
+  output [ 71:0] smiport6req_0Data,
+  input          smiport6req_0Stop,
+  input          smiport6resp_0Ready,
+  input  [ 71:0] smiport6resp_0Data,
+  output         smiport6resp_0Stop,
+
+  // Specifies the SMI memory port 7 signals.
+  output         smiport7req_0Ready,
+  output [ 71:0] smiport7req_0Data,
+  input          smiport7req_0Stop,
+  input          smiport7resp_0Ready,
+  input  [ 71:0] smiport7resp_0Data,
+  output         smiport7resp_0Stop,
+
+  // Specifies the SMI memory port 8 signals.
+  output         smiport8req_0Ready,
+  output [ 71:0] smiport8req_0Data,
+  input          smiport8req_0Stop,
+  input          smiport8resp_0Ready,
+  input  [ 71:0] smiport8resp_0Data,
+  output         smiport8resp_0Stop,
+
+  // Specifies the SMI memory port 9 signals.
+  output         smiport9req_0Ready,
+  output [ 71:0] smiport9req_0Data,
+  input          smiport9req_0Stop,
+  input          smiport9resp_0Ready,
+  input  [ 71:0] smiport9resp_0Data,
+  output         smiport9resp_0Stop,
+
+  // Specifies the SMI memory port 10 signals.
+  output         smiport10req_0Ready,
+  output [ 71:0] smiport10req_0Data,
+  input          smiport10req_0Stop,
+  input          smiport10resp_0Ready,
+  input  [ 71:0] smiport10resp_0Data,
+  output         smiport10resp_0Stop,
+
+  // Specifies the SMI memory port 11 signals.
+  output         smiport11req_0Ready,
+  output [ 71:0] smiport11req_0Data,
+  input          smiport11req_0Stop,
+  input          smiport11resp_0Ready,
+  input  [ 71:0] smiport11resp_0Data,
+  output         smiport11resp_0Stop,
+
+  // Specifies the SMI memory port 12 signals.
+  output         smiport12req_0Ready,
+  output [ 71:0] smiport12req_0Data,
+  input          smiport12req_0Stop,
+  input          smiport12resp_0Ready,
+  input  [ 71:0] smiport12resp_0Data,
+  output         smiport12resp_0Stop,
+
+  // Specifies the SMI memory port 13 signals.
+  output         smiport13req_0Ready,
+  output [ 71:0] smiport13req_0Data,
+  input          smiport13req_0Stop,
+  input          smiport13resp_0Ready,
+  input  [ 71:0] smiport13resp_0Data,
+  output         smiport13resp_0Stop,
+
+  // Specifies the SMI memory port 14 signals.
+  output         smiport14req_0Ready,
+  output [ 71:0] smiport14req_0Data,
+  input          smiport14req_0Stop,
+  input          smiport14resp_0Ready,
+  input  [ 71:0] smiport14resp_0Data,
+  output         smiport14resp_0Stop,
+
+  // Specifies the SMI memory port 15 signals.
+  output         smiport15req_0Ready,
+  output [ 71:0] smiport15req_0Data,
+  input          smiport15req_0Stop,
+  input          smiport15resp_0Ready,
+  input  [ 71:0] smiport15resp_0Data,
+  output         smiport15resp_0Stop,
+
+  // Specifies the SMI memory port 16 signals.
+  output         smiport16req_0Ready,
+  output [ 71:0] smiport16req_0Data,
+  input          smiport16req_0Stop,
+  input          smiport16resp_0Ready,
+  input  [ 71:0] smiport16resp_0Data,
+  output         smiport16resp_0Stop,
+
+  // Specifies the SMI memory port 17 signals.
+  output         smiport17req_0Ready,
+  output [ 71:0] smiport17req_0Data,
+  input          smiport17req_0Stop,
+  input          smiport17resp_0Ready,
+  input  [ 71:0] smiport17resp_0Data,
+  output         smiport17resp_0Stop,
+
+  // Specifies the SMI memory port 18 signals.
+  output         smiport18req_0Ready,
+  output [ 71:0] smiport18req_0Data,
+  input          smiport18req_0Stop,
+  input          smiport18resp_0Ready,
+  input  [ 71:0] smiport18resp_0Data,
+  output         smiport18resp_0Stop,
+
+  // Specifies the SMI memory port 19 signals.
+  output         smiport19req_0Ready,
+  output [ 71:0] smiport19req_0Data,
+  input          smiport19req_0Stop,
+  input          smiport19resp_0Ready,
+  input  [ 71:0] smiport19resp_0Data,
+  output         smiport19resp_0Stop,
+
+  // Specifies the SMI memory port 20 signals.
+  output         smiport20req_0Ready,
+  output [ 71:0] smiport20req_0Data,
+  input          smiport20req_0Stop,
+  input          smiport20resp_0Ready,
+  input  [ 71:0] smiport20resp_0Data,
+  output         smiport20resp_0Stop,
+
+  // Specifies the SMI memory port 21 signals.
+  output         smiport21req_0Ready,
+  output [ 71:0] smiport21req_0Data,
+  input          smiport21req_0Stop,
+  input          smiport21resp_0Ready,
+  input  [ 71:0] smiport21resp_0Data,
+  output         smiport21resp_0Stop,
+
+  // Specifies the SMI memory port 22 signals.
+  output         smiport22req_0Ready,
+  output [ 71:0] smiport22req_0Data,
+  input          smiport22req_0Stop,
+  input          smiport22resp_0Ready,
+  input  [ 71:0] smiport22resp_0Data,
+  output         smiport22resp_0Stop,
+
+  // Specifies the SMI memory port 23 signals.
+  output         smiport23req_0Ready,
+  output [ 71:0] smiport23req_0Data,
+  input          smiport23req_0Stop,
+  input          smiport23resp_0Ready,
+  input  [ 71:0] smiport23resp_0Data,
+  output         smiport23resp_0Stop,
+
+  // Specifies the SMI memory port 24 signals.
+  output         smiport24req_0Ready,
+  output [ 71:0] smiport24req_0Data,
+  input          smiport24req_0Stop,
+  input          smiport24resp_0Ready,
+  input  [ 71:0] smiport24resp_0Data,
+  output         smiport24resp_0Stop,
+
+  // Specifies the SMI memory port 25 signals.
+  output         smiport25req_0Ready,
+  output [ 71:0] smiport25req_0Data,
+  input          smiport25req_0Stop,
+  input          smiport25resp_0Ready,
+  input  [ 71:0] smiport25resp_0Data,
+  output         smiport25resp_0Stop,
+
+  // Specifies the SMI memory port 26 signals.
+  output         smiport26req_0Ready,
+  output [ 71:0] smiport26req_0Data,
+  input          smiport26req_0Stop,
+  input          smiport26resp_0Ready,
+  input  [ 71:0] smiport26resp_0Data,
+  output         smiport26resp_0Stop,
+
+  // Specifies the SMI memory port 27 signals.
+  output         smiport27req_0Ready,
+  output [ 71:0] smiport27req_0Data,
+  input          smiport27req_0Stop,
+  input          smiport27resp_0Ready,
+  input  [ 71:0] smiport27resp_0Data,
+  output         smiport27resp_0Stop,
+
+  // Specifies the SMI memory port 28 signals.
+  output         smiport28req_0Ready,
+  output [ 71:0] smiport28req_0Data,
+  input          smiport28req_0Stop,
+  input          smiport28resp_0Ready,
+  input  [ 71:0] smiport28resp_0Data,
+  output         smiport28resp_0Stop,
+
+  // Specifies the SMI memory port 29 signals.
+  output         smiport29req_0Ready,
+  output [ 71:0] smiport29req_0Data,
+  input          smiport29req_0Stop,
+  input          smiport29resp_0Ready,
+  input  [ 71:0] smiport29resp_0Data,
+  output         smiport29resp_0Stop,
+
+  // Specifies the SMI memory port 30 signals.
+  output         smiport30req_0Ready,
+  output [ 71:0] smiport30req_0Data,
+  input          smiport30req_0Stop,
+  input          smiport30resp_0Ready,
+  input  [ 71:0] smiport30resp_0Data,
+  output         smiport30resp_0Stop,
+
+  // Specifies the SMI memory port 31 signals.
+  output         smiport31req_0Ready,
+  output [ 71:0] smiport31req_0Data,
+  input          smiport31req_0Stop,
+  input          smiport31resp_0Ready,
+  input  [ 71:0] smiport31resp_0Data,
+  output         smiport31resp_0Stop,
+
+  // Specifies the SMI memory port 32 signals.
+  output         smiport32req_0Ready,
+  output [ 71:0] smiport32req_0Data,
+  input          smiport32req_0Stop,
+  input          smiport32resp_0Ready,
+  input  [ 71:0] smiport32resp_0Data,
+  output         smiport32resp_0Stop,
+
+  // Specifies the SMI memory port 33 signals.
+  output         smiport33req_0Ready,
+  output [ 71:0] smiport33req_0Data,
+  input          smiport33req_0Stop,
+  input          smiport33resp_0Ready,
+  input  [ 71:0] smiport33resp_0Data,
+  output         smiport33resp_0Stop,
+
+  // Specifies the SMI memory port 34 signals.
+  output         smiport34req_0Ready,
+  output [ 71:0] smiport34req_0Data,
+  input          smiport34req_0Stop,
+  input          smiport34resp_0Ready,
+  input  [ 71:0] smiport34resp_0Data,
+  output         smiport34resp_0Stop,
+
+  // Specifies the SMI memory port 35 signals.
+  output         smiport35req_0Ready,
+  output [ 71:0] smiport35req_0Data,
+  input          smiport35req_0Stop,
+  input          smiport35resp_0Ready,
+  input  [ 71:0] smiport35resp_0Data,
+  output         smiport35resp_0Stop,
+
+  // Specifies the SMI memory port 36 signals.
+  output         smiport36req_0Ready,
+  output [ 71:0] smiport36req_0Data,
+  input          smiport36req_0Stop,
+  input          smiport36resp_0Ready,
+  input  [ 71:0] smiport36resp_0Data,
+  output         smiport36resp_0Stop,
+
+  // Specifies the SMI memory port 37 signals.
+  output         smiport37req_0Ready,
+  output [ 71:0] smiport37req_0Data,
+  input          smiport37req_0Stop,
+  input          smiport37resp_0Ready,
+  input  [ 71:0] smiport37resp_0Data,
+  output         smiport37resp_0Stop,
+
+  // Specifies the SMI memory port 38 signals.
+  output         smiport38req_0Ready,
+  output [ 71:0] smiport38req_0Data,
+  input          smiport38req_0Stop,
+  input          smiport38resp_0Ready,
+  input  [ 71:0] smiport38resp_0Data,
+  output         smiport38resp_0Stop,
+
+  // Specifies the SMI memory port 39 signals.
+  output         smiport39req_0Ready,
+  output [ 71:0] smiport39req_0Data,
+  input          smiport39req_0Stop,
+  input          smiport39resp_0Ready,
+  input  [ 71:0] smiport39resp_0Data,
+  output         smiport39resp_0Stop,
+
+  // Specifies the SMI memory port 40 signals.
+  output         smiport40req_0Ready,
+  output [ 71:0] smiport40req_0Data,
+  input          smiport40req_0Stop,
+  input          smiport40resp_0Ready,
+  input  [ 71:0] smiport40resp_0Data,
+  output         smiport40resp_0Stop,
+
+  // Specifies the SMI memory port 41 signals.
+  output         smiport41req_0Ready,
+  output [ 71:0] smiport41req_0Data,
+  input          smiport41req_0Stop,
+  input          smiport41resp_0Ready,
+  input  [ 71:0] smiport41resp_0Data,
+  output         smiport41resp_0Stop,
+
+  // Specifies the SMI memory port 42 signals.
+  output         smiport42req_0Ready,
+  output [ 71:0] smiport42req_0Data,
+  input          smiport42req_0Stop,
+  input          smiport42resp_0Ready,
+  input  [ 71:0] smiport42resp_0Data,
+  output         smiport42resp_0Stop,
+
+  // Specifies the SMI memory port 43 signals.
+  output         smiport43req_0Ready,
+  output [ 71:0] smiport43req_0Data,
+  input          smiport43req_0Stop,
+  input          smiport43resp_0Ready,
+  input  [ 71:0] smiport43resp_0Data,
+  output         smiport43resp_0Stop,
+
+  // Specifies the SMI memory port 44 signals.
+  output         smiport44req_0Ready,
+  output [ 71:0] smiport44req_0Data,
+  input          smiport44req_0Stop,
+  input          smiport44resp_0Ready,
+  input  [ 71:0] smiport44resp_0Data,
+  output         smiport44resp_0Stop,
+
+  // Specifies the SMI memory port 45 signals.
+  output         smiport45req_0Ready,
+  output [ 71:0] smiport45req_0Data,
+  input          smiport45req_0Stop,
+  input          smiport45resp_0Ready,
+  input  [ 71:0] smiport45resp_0Data,
+  output         smiport45resp_0Stop,
+
+  // Specifies the SMI memory port 46 signals.
+  output         smiport46req_0Ready,
+  output [ 71:0] smiport46req_0Data,
+  input          smiport46req_0Stop,
+  input          smiport46resp_0Ready,
+  input  [ 71:0] smiport46resp_0Data,
+  output         smiport46resp_0Stop,
+
+  // Specifies the SMI memory port 47 signals.
+  output         smiport47req_0Ready,
+  output [ 71:0] smiport47req_0Data,
+  input          smiport47req_0Stop,
+  input          smiport47resp_0Ready,
+  input  [ 71:0] smiport47resp_0Data,
+  output         smiport47resp_0Stop,
+
+  // Specifies the SMI memory port 48 signals.
+  output         smiport48req_0Ready,
+  output [ 71:0] smiport48req_0Data,
+  input          smiport48req_0Stop,
+  input          smiport48resp_0Ready,
+  input  [ 71:0] smiport48resp_0Data,
+  output         smiport48resp_0Stop,
+
+  // Specifies the SMI memory port 49 signals.
+  output         smiport49req_0Ready,
+  output [ 71:0] smiport49req_0Data,
+  input          smiport49req_0Stop,
+  input          smiport49resp_0Ready,
+  input  [ 71:0] smiport49resp_0Data,
+  output         smiport49resp_0Stop,
+
+  // Specifies the SMI memory port 50 signals.
+  output         smiport50req_0Ready,
+  output [ 71:0] smiport50req_0Data,
+  input          smiport50req_0Stop,
+  input          smiport50resp_0Ready,
+  input  [ 71:0] smiport50resp_0Data,
+  output         smiport50resp_0Stop,
+
+  // Specifies the SMI memory port 51 signals.
+  output         smiport51req_0Ready,
+  output [ 71:0] smiport51req_0Data,
+  input          smiport51req_0Stop,
+  input          smiport51resp_0Ready,
+  input  [ 71:0] smiport51resp_0Data,
+  output         smiport51resp_0Stop,
+
+  // Specifies the SMI memory port 52 signals.
+  output         smiport52req_0Ready,
+  output [ 71:0] smiport52req_0Data,
+  input          smiport52req_0Stop,
+  input          smiport52resp_0Ready,
+  input  [ 71:0] smiport52resp_0Data,
+  output         smiport52resp_0Stop,
+
+  // Specifies the SMI memory port 53 signals.
+  output         smiport53req_0Ready,
+  output [ 71:0] smiport53req_0Data,
+  input          smiport53req_0Stop,
+  input          smiport53resp_0Ready,
+  input  [ 71:0] smiport53resp_0Data,
+  output         smiport53resp_0Stop,
+
+  // Specifies the SMI memory port 54 signals.
+  output         smiport54req_0Ready,
+  output [ 71:0] smiport54req_0Data,
+  input          smiport54req_0Stop,
+  input          smiport54resp_0Ready,
+  input  [ 71:0] smiport54resp_0Data,
+  output         smiport54resp_0Stop,
+
+  // Specifies the SMI memory port 55 signals.
+  output         smiport55req_0Ready,
+  output [ 71:0] smiport55req_0Data,
+  input          smiport55req_0Stop,
+  input          smiport55resp_0Ready,
+  input  [ 71:0] smiport55resp_0Data,
+  output         smiport55resp_0Stop,
+
+  // Specifies the SMI memory port 56 signals.
+  output         smiport56req_0Ready,
+  output [ 71:0] smiport56req_0Data,
+  input          smiport56req_0Stop,
+  input          smiport56resp_0Ready,
+  input  [ 71:0] smiport56resp_0Data,
+  output         smiport56resp_0Stop,
+
+  // Specifies the SMI memory port 57 signals.
+  output         smiport57req_0Ready,
+  output [ 71:0] smiport57req_0Data,
+  input          smiport57req_0Stop,
+  input          smiport57resp_0Ready,
+  input  [ 71:0] smiport57resp_0Data,
+  output         smiport57resp_0Stop,
+
+  // Specifies the SMI memory port 58 signals.
+  output         smiport58req_0Ready,
+  output [ 71:0] smiport58req_0Data,
+  input          smiport58req_0Stop,
+  input          smiport58resp_0Ready,
+  input  [ 71:0] smiport58resp_0Data,
+  output         smiport58resp_0Stop,
+
+  // Specifies the SMI memory port 59 signals.
+  output         smiport59req_0Ready,
+  output [ 71:0] smiport59req_0Data,
+  input          smiport59req_0Stop,
+  input          smiport59resp_0Ready,
+  input  [ 71:0] smiport59resp_0Data,
+  output         smiport59resp_0Stop,
+
+  // Specifies the SMI memory port 60 signals.
+  output         smiport60req_0Ready,
+  output [ 71:0] smiport60req_0Data,
+  input          smiport60req_0Stop,
+  input          smiport60resp_0Ready,
+  input  [ 71:0] smiport60resp_0Data,
+  output         smiport60resp_0Stop,
+
+  // Specifies the SMI memory port 61 signals.
+  output         smiport61req_0Ready,
+  output [ 71:0] smiport61req_0Data,
+  input          smiport61req_0Stop,
+  input          smiport61resp_0Ready,
+  input  [ 71:0] smiport61resp_0Data,
+  output         smiport61resp_0Stop,
+
+  // Specifies the SMI memory port 62 signals.
+  output         smiport62req_0Ready,
+  output [ 71:0] smiport62req_0Data,
+  input          smiport62req_0Stop,
+  input          smiport62resp_0Ready,
+  input  [ 71:0] smiport62resp_0Data,
+  output         smiport62resp_0Stop,
+
+  // Specifies the SMI memory port 63 signals.
+  output         smiport63req_0Ready,
+  output [ 71:0] smiport63req_0Data,
+  input          smiport63req_0Stop,
+  input          smiport63resp_0Ready,
+  input  [ 71:0] smiport63resp_0Data,
+  output         smiport63resp_0Stop,
+
+  // Specifies the AXI slave read bus signals.
+  input  [ 31:0] s_axi_araddr,
+  input  [  3:0] s_axi_arcache,
+  input  [  2:0] s_axi_arprot,
+  input          s_axi_arvalid,
+  output         s_axi_arready,
+  output [ 31:0] s_axi_rdata,
+  output [  1:0] s_axi_rresp,
+  output         s_axi_rvalid,
+  input          s_axi_rready,
+
+  // Specifies the AXI slave write bus signals.
+  input  [ 31:0] s_axi_awaddr,
+  input  [  3:0] s_axi_awcache,
+  input  [  2:0] s_axi_awprot,
+  input          s_axi_awvalid,
+  output         s_axi_awready,
+  input  [ 31:0] s_axi_wdata,
+  input  [  3:0] s_axi_wstrb,
+  input          s_axi_wvalid,
+  output         s_axi_wready,
+  output [  1:0] s_axi_bresp,
+  output         s_axi_bvalid,
+  input          s_axi_bready,
+
+  // Specify system level signals.
+  input          clk,
+  input          reset
+);
+
+// Specify state space for test runner state machine.
+parameter [3:0]
+  TestStateReset = 0,
+  TestStateIdle = 1,
+  TestStateGetParams = 2,
+  TestStateSetConfig = 3,
+  TestStateGetStatus = 4,
+  TestStateWriteErrCountReq = 5,
+  TestStateWriteErrCountDone = 6,
+  TestStateWriteDataCountReq = 7,
+  TestStateWriteDataCountDone = 8,
+  TestStateReportResult = 9;
+
+// Parameter request state machine signals.
+reg [3:0] paramReqCount_d;
+reg [3:0] paramReqCount_q;
+reg       paramReq;
+reg       paramAddrReady;
+reg [31:0] paramAddrData;
+
+// Action execution state machine signals. The kernel arguments are shifted
+// into a single parameter register.
+reg [3:0]   testState_d;
+reg [3:0]   paramCount_d;
+reg [255:0] params_d;
+reg [31:0]  errorCount_d;
+reg [63:0]  dataCount_d;
+
+reg [3:0]   testState_q;
+reg [3:0]   paramCount_q;
+reg [255:0] params_q;
+reg [31:0]  errorCount_q;
+reg [63:0]  dataCount_q;
+
+reg goHalt;
+reg doneReady;
+reg paramReadHalt;
+
+// Kernel argument values.
+wire [63:0] memBaseAddr = params_q [63:0];
+wire [31:0] memBlockSize = params_q [95:64];
+wire [31:0] fuzzTestCount = params_q [127:96];
+wire [63:0] errResultAddr = params_q [191:128];
+wire [63:0] dcountResultAddr = params_q [255:192];
+
+// Per-port fuzz tester handshake signals.
+reg  [63:0] fuzzConfigDone_q;
+reg  [63:0] fuzzStatusDone_q;
+wire [63:0] fuzzConfigValid;
+wire [63:0] fuzzConfigStop;
+wire [63:0] fuzzConfigAccept;
+wire [63:0] fuzzStatusValid;
+wire [63:0] fuzzStatusStop;
+wire [63:0] fuzzStatusAccept;
+
+// Fuzz tester signals for SMI port 0.
+wire [63:0] fuzzMemAddrBase0;
+wire [31:0] fuzzStatusErrorCount0;
+wire [63:0] fuzzStatusDataCount0;
+wire        smiFuzzReqReady0;
+wire [7:0]  smiFuzzReqEofc0;
+wire [63:0] smiFuzzReqData0;
+wire        smiFuzzReqStop0;
+wire        smiFuzzRespReady0;
+wire [7:0]  smiFuzzRespEofc0;
+wire [63:0] smiFuzzRespData0;
+wire        smiFuzzRespStop0;
+
+// Fuzz tester signals for SMI port 1.
+wire [63:0] fuzzMemAddrBase1;
+wire [31:0] fuzzStatusErrorCount1;
+wire [63:0] fuzzStatusDataCount1;
+wire        smiFuzzReqReady1;
+wire [7:0]  smiFuzzReqEofc1;
+wire [63:0] smiFuzzReqData1;
+wire        smiFuzzReqStop1;
+wire        smiFuzzRespReady1;
+wire [7:0]  smiFuzzRespEofc1;
+wire [63:0] smiFuzzRespData1;
+wire        smiFuzzRespStop1;
+
+// Fuzz tester signals for SMI port 2.
+wire [63:0] fuzzMemAddrBase2;
+wire [31:0] fuzzStatusErrorCount2;
+wire [63:0] fuzzStatusDataCount2;
+wire        smiFuzzReqReady2;
+wire [7:0]  smiFuzzReqEofc2;
+wire [63:0] smiFuzzReqData2;
+wire        smiFuzzReqStop2;
+wire        smiFuzzRespReady2;
+wire [7:0]  smiFuzzRespEofc2;
+wire [63:0] smiFuzzRespData2;
+wire        smiFuzzRespStop2;
+
+// Fuzz tester signals for SMI port 3.
+wire [63:0] fuzzMemAddrBase3;
+wire [31:0] fuzzStatusErrorCount3;
+wire [63:0] fuzzStatusDataCount3;
+wire        smiFuzzReqReady3;
+wire [7:0]  smiFuzzReqEofc3;
+wire [63:0] smiFuzzReqData3;
+wire        smiFuzzReqStop3;
+wire        smiFuzzRespReady3;
+wire [7:0]  smiFuzzRespEofc3;
+wire [63:0] smiFuzzRespData3;
+wire        smiFuzzRespStop3;
+
+// Fuzz tester signals for SMI port 4.
+wire [63:0] fuzzMemAddrBase4;
+wire [31:0] fuzzStatusErrorCount4;
+wire [63:0] fuzzStatusDataCount4;
+wire        smiFuzzReqReady4;
+wire [7:0]  smiFuzzReqEofc4;
+wire [63:0] smiFuzzReqData4;
+wire        smiFuzzReqStop4;
+wire        smiFuzzRespReady4;
+wire [7:0]  smiFuzzRespEofc4;
+wire [63:0] smiFuzzRespData4;
+wire        smiFuzzRespStop4;
+
+// Fuzz tester signals for SMI port 5.
+wire [63:0] fuzzMemAddrBase5;
+wire [31:0] fuzzStatusErrorCount5;
+wire [63:0] fuzzStatusDataCount5;
+wire        smiFuzzReqReady5;
+wire [7:0]  smiFuzzReqEofc5;
+wire [63:0] smiFuzzReqData5;
+wire        smiFuzzReqStop5;
+wire        smiFuzzRespReady5;
+wire [7:0]  smiFuzzRespEofc5;
+wire [63:0] smiFuzzRespData5;
+wire        smiFuzzRespStop5;
+
+// Fuzz tester signals for SMI port 6.
+wire [63:0] fuzzMemAddrBase6;
+wire [31:0] fuzzStatusErrorCount6;
+wire [63:0] fuzzStatusDataCount6;
+wire        smiFuzzReqReady6;
+wire [7:0]  smiFuzzReqEofc6;
+wire [63:0] smiFuzzReqData6;
+wire        smiFuzzReqStop6;
+wire        smiFuzzRespReady6;
+wire [7:0]  smiFuzzRespEofc6;
+wire [63:0] smiFuzzRespData6;
+wire        smiFuzzRespStop6;
+
+// Fuzz tester signals for SMI port 7.
+wire [63:0] fuzzMemAddrBase7;
+wire [31:0] fuzzStatusErrorCount7;
+wire [63:0] fuzzStatusDataCount7;
+wire        smiFuzzReqReady7;
+wire [7:0]  smiFuzzReqEofc7;
+wire [63:0] smiFuzzReqData7;
+wire        smiFuzzReqStop7;
+wire        smiFuzzRespReady7;
+wire [7:0]  smiFuzzRespEofc7;
+wire [63:0] smiFuzzRespData7;
+wire        smiFuzzRespStop7;
+
+// Fuzz tester signals for SMI port 8.
+wire [63:0] fuzzMemAddrBase8;
+wire [31:0] fuzzStatusErrorCount8;
+wire [63:0] fuzzStatusDataCount8;
+wire        smiFuzzReqReady8;
+wire [7:0]  smiFuzzReqEofc8;
+wire [63:0] smiFuzzReqData8;
+wire        smiFuzzReqStop8;
+wire        smiFuzzRespReady8;
+wire [7:0]  smiFuzzRespEofc8;
+wire [63:0] smiFuzzRespData8;
+wire        smiFuzzRespStop8;
+
+// Fuzz tester signals for SMI port 9.
+wire [63:0] fuzzMemAddrBase9;
+wire [31:0] fuzzStatusErrorCount9;
+wire [63:0] fuzzStatusDataCount9;
+wire        smiFuzzReqReady9;
+wire [7:0]  smiFuzzReqEofc9;
+wire [63:0] smiFuzzReqData9;
+wire        smiFuzzReqStop9;
+wire        smiFuzzRespReady9;
+wire [7:0]  smiFuzzRespEofc9;
+wire [63:0] smiFuzzRespData9;
+wire        smiFuzzRespStop9;
+
+// Fuzz tester signals for SMI port 10.
+wire [63:0] fuzzMemAddrBase10;
+wire [31:0] fuzzStatusErrorCount10;
+wire [63:0] fuzzStatusDataCount10;
+wire        smiFuzzReqReady10;
+wire [7:0]  smiFuzzReqEofc10;
+wire [63:0] smiFuzzReqData10;
+wire        smiFuzzReqStop10;
+wire        smiFuzzRespReady10;
+wire [7:0]  smiFuzzRespEofc10;
+wire [63:0] smiFuzzRespData10;
+wire        smiFuzzRespStop10;
+
+// Fuzz tester signals for SMI port 11.
+wire [63:0] fuzzMemAddrBase11;
+wire [31:0] fuzzStatusErrorCount11;
+wire [63:0] fuzzStatusDataCount11;
+wire        smiFuzzReqReady11;
+wire [7:0]  smiFuzzReqEofc11;
+wire [63:0] smiFuzzReqData11;
+wire        smiFuzzReqStop11;
+wire        smiFuzzRespReady11;
+wire [7:0]  smiFuzzRespEofc11;
+wire [63:0] smiFuzzRespData11;
+wire        smiFuzzRespStop11;
+
+// Fuzz tester signals for SMI port 12.
+wire [63:0] fuzzMemAddrBase12;
+wire [31:0] fuzzStatusErrorCount12;
+wire [63:0] fuzzStatusDataCount12;
+wire        smiFuzzReqReady12;
+wire [7:0]  smiFuzzReqEofc12;
+wire [63:0] smiFuzzReqData12;
+wire        smiFuzzReqStop12;
+wire        smiFuzzRespReady12;
+wire [7:0]  smiFuzzRespEofc12;
+wire [63:0] smiFuzzRespData12;
+wire        smiFuzzRespStop12;
+
+// Fuzz tester signals for SMI port 13.
+wire [63:0] fuzzMemAddrBase13;
+wire [31:0] fuzzStatusErrorCount13;
+wire [63:0] fuzzStatusDataCount13;
+wire        smiFuzzReqReady13;
+wire [7:0]  smiFuzzReqEofc13;
+wire [63:0] smiFuzzReqData13;
+wire        smiFuzzReqStop13;
+wire        smiFuzzRespReady13;
+wire [7:0]  smiFuzzRespEofc13;
+wire [63:0] smiFuzzRespData13;
+wire        smiFuzzRespStop13;
+
+// Fuzz tester signals for SMI port 14.
+wire [63:0] fuzzMemAddrBase14;
+wire [31:0] fuzzStatusErrorCount14;
+wire [63:0] fuzzStatusDataCount14;
+wire        smiFuzzReqReady14;
+wire [7:0]  smiFuzzReqEofc14;
+wire [63:0] smiFuzzReqData14;
+wire        smiFuzzReqStop14;
+wire        smiFuzzRespReady14;
+wire [7:0]  smiFuzzRespEofc14;
+wire [63:0] smiFuzzRespData14;
+wire        smiFuzzRespStop14;
+
+// Fuzz tester signals for SMI port 15.
+wire [63:0] fuzzMemAddrBase15;
+wire [31:0] fuzzStatusErrorCount15;
+wire [63:0] fuzzStatusDataCount15;
+wire        smiFuzzReqReady15;
+wire [7:0]  smiFuzzReqEofc15;
+wire [63:0] smiFuzzReqData15;
+wire        smiFuzzReqStop15;
+wire        smiFuzzRespReady15;
+wire [7:0]  smiFuzzRespEofc15;
+wire [63:0] smiFuzzRespData15;
+wire        smiFuzzRespStop15;
+
+// Fuzz tester signals for SMI port 16.
+wire [63:0] fuzzMemAddrBase16;
+wire [31:0] fuzzStatusErrorCount16;
+wire [63:0] fuzzStatusDataCount16;
+wire        smiFuzzReqReady16;
+wire [7:0]  smiFuzzReqEofc16;
+wire [63:0] smiFuzzReqData16;
+wire        smiFuzzReqStop16;
+wire        smiFuzzRespReady16;
+wire [7:0]  smiFuzzRespEofc16;
+wire [63:0] smiFuzzRespData16;
+wire        smiFuzzRespStop16;
+
+// Fuzz tester signals for SMI port 17.
+wire [63:0] fuzzMemAddrBase17;
+wire [31:0] fuzzStatusErrorCount17;
+wire [63:0] fuzzStatusDataCount17;
+wire        smiFuzzReqReady17;
+wire [7:0]  smiFuzzReqEofc17;
+wire [63:0] smiFuzzReqData17;
+wire        smiFuzzReqStop17;
+wire        smiFuzzRespReady17;
+wire [7:0]  smiFuzzRespEofc17;
+wire [63:0] smiFuzzRespData17;
+wire        smiFuzzRespStop17;
+
+// Fuzz tester signals for SMI port 18.
+wire [63:0] fuzzMemAddrBase18;
+wire [31:0] fuzzStatusErrorCount18;
+wire [63:0] fuzzStatusDataCount18;
+wire        smiFuzzReqReady18;
+wire [7:0]  smiFuzzReqEofc18;
+wire [63:0] smiFuzzReqData18;
+wire        smiFuzzReqStop18;
+wire        smiFuzzRespReady18;
+wire [7:0]  smiFuzzRespEofc18;
+wire [63:0] smiFuzzRespData18;
+wire        smiFuzzRespStop18;
+
+// Fuzz tester signals for SMI port 19.
+wire [63:0] fuzzMemAddrBase19;
+wire [31:0] fuzzStatusErrorCount19;
+wire [63:0] fuzzStatusDataCount19;
+wire        smiFuzzReqReady19;
+wire [7:0]  smiFuzzReqEofc19;
+wire [63:0] smiFuzzReqData19;
+wire        smiFuzzReqStop19;
+wire        smiFuzzRespReady19;
+wire [7:0]  smiFuzzRespEofc19;
+wire [63:0] smiFuzzRespData19;
+wire        smiFuzzRespStop19;
+
+// Fuzz tester signals for SMI port 20.
+wire [63:0] fuzzMemAddrBase20;
+wire [31:0] fuzzStatusErrorCount20;
+wire [63:0] fuzzStatusDataCount20;
+wire        smiFuzzReqReady20;
+wire [7:0]  smiFuzzReqEofc20;
+wire [63:0] smiFuzzReqData20;
+wire        smiFuzzReqStop20;
+wire        smiFuzzRespReady20;
+wire [7:0]  smiFuzzRespEofc20;
+wire [63:0] smiFuzzRespData20;
+wire        smiFuzzRespStop20;
+
+// Fuzz tester signals for SMI port 21.
+wire [63:0] fuzzMemAddrBase21;
+wire [31:0] fuzzStatusErrorCount21;
+wire [63:0] fuzzStatusDataCount21;
+wire        smiFuzzReqReady21;
+wire [7:0]  smiFuzzReqEofc21;
+wire [63:0] smiFuzzReqData21;
+wire        smiFuzzReqStop21;
+wire        smiFuzzRespReady21;
+wire [7:0]  smiFuzzRespEofc21;
+wire [63:0] smiFuzzRespData21;
+wire        smiFuzzRespStop21;
+
+// Fuzz tester signals for SMI port 22.
+wire [63:0] fuzzMemAddrBase22;
+wire [31:0] fuzzStatusErrorCount22;
+wire [63:0] fuzzStatusDataCount22;
+wire        smiFuzzReqReady22;
+wire [7:0]  smiFuzzReqEofc22;
+wire [63:0] smiFuzzReqData22;
+wire        smiFuzzReqStop22;
+wire        smiFuzzRespReady22;
+wire [7:0]  smiFuzzRespEofc22;
+wire [63:0] smiFuzzRespData22;
+wire        smiFuzzRespStop22;
+
+// Fuzz tester signals for SMI port 23.
+wire [63:0] fuzzMemAddrBase23;
+wire [31:0] fuzzStatusErrorCount23;
+wire [63:0] fuzzStatusDataCount23;
+wire        smiFuzzReqReady23;
+wire [7:0]  smiFuzzReqEofc23;
+wire [63:0] smiFuzzReqData23;
+wire        smiFuzzReqStop23;
+wire        smiFuzzRespReady23;
+wire [7:0]  smiFuzzRespEofc23;
+wire [63:0] smiFuzzRespData23;
+wire        smiFuzzRespStop23;
+
+// Fuzz tester signals for SMI port 24.
+wire [63:0] fuzzMemAddrBase24;
+wire [31:0] fuzzStatusErrorCount24;
+wire [63:0] fuzzStatusDataCount24;
+wire        smiFuzzReqReady24;
+wire [7:0]  smiFuzzReqEofc24;
+wire [63:0] smiFuzzReqData24;
+wire        smiFuzzReqStop24;
+wire        smiFuzzRespReady24;
+wire [7:0]  smiFuzzRespEofc24;
+wire [63:0] smiFuzzRespData24;
+wire        smiFuzzRespStop24;
+
+// Fuzz tester signals for SMI port 25.
+wire [63:0] fuzzMemAddrBase25;
+wire [31:0] fuzzStatusErrorCount25;
+wire [63:0] fuzzStatusDataCount25;
+wire        smiFuzzReqReady25;
+wire [7:0]  smiFuzzReqEofc25;
+wire [63:0] smiFuzzReqData25;
+wire        smiFuzzReqStop25;
+wire        smiFuzzRespReady25;
+wire [7:0]  smiFuzzRespEofc25;
+wire [63:0] smiFuzzRespData25;
+wire        smiFuzzRespStop25;
+
+// Fuzz tester signals for SMI port 26.
+wire [63:0] fuzzMemAddrBase26;
+wire [31:0] fuzzStatusErrorCount26;
+wire [63:0] fuzzStatusDataCount26;
+wire        smiFuzzReqReady26;
+wire [7:0]  smiFuzzReqEofc26;
+wire [63:0] smiFuzzReqData26;
+wire        smiFuzzReqStop26;
+wire        smiFuzzRespReady26;
+wire [7:0]  smiFuzzRespEofc26;
+wire [63:0] smiFuzzRespData26;
+wire        smiFuzzRespStop26;
+
+// Fuzz tester signals for SMI port 27.
+wire [63:0] fuzzMemAddrBase27;
+wire [31:0] fuzzStatusErrorCount27;
+wire [63:0] fuzzStatusDataCount27;
+wire        smiFuzzReqReady27;
+wire [7:0]  smiFuzzReqEofc27;
+wire [63:0] smiFuzzReqData27;
+wire        smiFuzzReqStop27;
+wire        smiFuzzRespReady27;
+wire [7:0]  smiFuzzRespEofc27;
+wire [63:0] smiFuzzRespData27;
+wire        smiFuzzRespStop27;
+
+// Fuzz tester signals for SMI port 28.
+wire [63:0] fuzzMemAddrBase28;
+wire [31:0] fuzzStatusErrorCount28;
+wire [63:0] fuzzStatusDataCount28;
+wire        smiFuzzReqReady28;
+wire [7:0]  smiFuzzReqEofc28;
+wire [63:0] smiFuzzReqData28;
+wire        smiFuzzReqStop28;
+wire        smiFuzzRespReady28;
+wire [7:0]  smiFuzzRespEofc28;
+wire [63:0] smiFuzzRespData28;
+wire        smiFuzzRespStop28;
+
+// Fuzz tester signals for SMI port 29.
+wire [63:0] fuzzMemAddrBase29;
+wire [31:0] fuzzStatusErrorCount29;
+wire [63:0] fuzzStatusDataCount29;
+wire        smiFuzzReqReady29;
+wire [7:0]  smiFuzzReqEofc29;
+wire [63:0] smiFuzzReqData29;
+wire        smiFuzzReqStop29;
+wire        smiFuzzRespReady29;
+wire [7:0]  smiFuzzRespEofc29;
+wire [63:0] smiFuzzRespData29;
+wire        smiFuzzRespStop29;
+
+// Fuzz tester signals for SMI port 30.
+wire [63:0] fuzzMemAddrBase30;
+wire [31:0] fuzzStatusErrorCount30;
+wire [63:0] fuzzStatusDataCount30;
+wire        smiFuzzReqReady30;
+wire [7:0]  smiFuzzReqEofc30;
+wire [63:0] smiFuzzReqData30;
+wire        smiFuzzReqStop30;
+wire        smiFuzzRespReady30;
+wire [7:0]  smiFuzzRespEofc30;
+wire [63:0] smiFuzzRespData30;
+wire        smiFuzzRespStop30;
+
+// Fuzz tester signals for SMI port 31.
+wire [63:0] fuzzMemAddrBase31;
+wire [31:0] fuzzStatusErrorCount31;
+wire [63:0] fuzzStatusDataCount31;
+wire        smiFuzzReqReady31;
+wire [7:0]  smiFuzzReqEofc31;
+wire [63:0] smiFuzzReqData31;
+wire        smiFuzzReqStop31;
+wire        smiFuzzRespReady31;
+wire [7:0]  smiFuzzRespEofc31;
+wire [63:0] smiFuzzRespData31;
+wire        smiFuzzRespStop31;
+
+// Fuzz tester signals for SMI port 32.
+wire [63:0] fuzzMemAddrBase32;
+wire [31:0] fuzzStatusErrorCount32;
+wire [63:0] fuzzStatusDataCount32;
+wire        smiFuzzReqReady32;
+wire [7:0]  smiFuzzReqEofc32;
+wire [63:0] smiFuzzReqData32;
+wire        smiFuzzReqStop32;
+wire        smiFuzzRespReady32;
+wire [7:0]  smiFuzzRespEofc32;
+wire [63:0] smiFuzzRespData32;
+wire        smiFuzzRespStop32;
+
+// Fuzz tester signals for SMI port 33.
+wire [63:0] fuzzMemAddrBase33;
+wire [31:0] fuzzStatusErrorCount33;
+wire [63:0] fuzzStatusDataCount33;
+wire        smiFuzzReqReady33;
+wire [7:0]  smiFuzzReqEofc33;
+wire [63:0] smiFuzzReqData33;
+wire        smiFuzzReqStop33;
+wire        smiFuzzRespReady33;
+wire [7:0]  smiFuzzRespEofc33;
+wire [63:0] smiFuzzRespData33;
+wire        smiFuzzRespStop33;
+
+// Fuzz tester signals for SMI port 34.
+wire [63:0] fuzzMemAddrBase34;
+wire [31:0] fuzzStatusErrorCount34;
+wire [63:0] fuzzStatusDataCount34;
+wire        smiFuzzReqReady34;
+wire [7:0]  smiFuzzReqEofc34;
+wire [63:0] smiFuzzReqData34;
+wire        smiFuzzReqStop34;
+wire        smiFuzzRespReady34;
+wire [7:0]  smiFuzzRespEofc34;
+wire [63:0] smiFuzzRespData34;
+wire        smiFuzzRespStop34;
+
+// Fuzz tester signals for SMI port 35.
+wire [63:0] fuzzMemAddrBase35;
+wire [31:0] fuzzStatusErrorCount35;
+wire [63:0] fuzzStatusDataCount35;
+wire        smiFuzzReqReady35;
+wire [7:0]  smiFuzzReqEofc35;
+wire [63:0] smiFuzzReqData35;
+wire        smiFuzzReqStop35;
+wire        smiFuzzRespReady35;
+wire [7:0]  smiFuzzRespEofc35;
+wire [63:0] smiFuzzRespData35;
+wire        smiFuzzRespStop35;
+
+// Fuzz tester signals for SMI port 36.
+wire [63:0] fuzzMemAddrBase36;
+wire [31:0] fuzzStatusErrorCount36;
+wire [63:0] fuzzStatusDataCount36;
+wire        smiFuzzReqReady36;
+wire [7:0]  smiFuzzReqEofc36;
+wire [63:0] smiFuzzReqData36;
+wire        smiFuzzReqStop36;
+wire        smiFuzzRespReady36;
+wire [7:0]  smiFuzzRespEofc36;
+wire [63:0] smiFuzzRespData36;
+wire        smiFuzzRespStop36;
+
+// Fuzz tester signals for SMI port 37.
+wire [63:0] fuzzMemAddrBase37;
+wire [31:0] fuzzStatusErrorCount37;
+wire [63:0] fuzzStatusDataCount37;
+wire        smiFuzzReqReady37;
+wire [7:0]  smiFuzzReqEofc37;
+wire [63:0] smiFuzzReqData37;
+wire        smiFuzzReqStop37;
+wire        smiFuzzRespReady37;
+wire [7:0]  smiFuzzRespEofc37;
+wire [63:0] smiFuzzRespData37;
+wire        smiFuzzRespStop37;
+
+// Fuzz tester signals for SMI port 38.
+wire [63:0] fuzzMemAddrBase38;
+wire [31:0] fuzzStatusErrorCount38;
+wire [63:0] fuzzStatusDataCount38;
+wire        smiFuzzReqReady38;
+wire [7:0]  smiFuzzReqEofc38;
+wire [63:0] smiFuzzReqData38;
+wire        smiFuzzReqStop38;
+wire        smiFuzzRespReady38;
+wire [7:0]  smiFuzzRespEofc38;
+wire [63:0] smiFuzzRespData38;
+wire        smiFuzzRespStop38;
+
+// Fuzz tester signals for SMI port 39.
+wire [63:0] fuzzMemAddrBase39;
+wire [31:0] fuzzStatusErrorCount39;
+wire [63:0] fuzzStatusDataCount39;
+wire        smiFuzzReqReady39;
+wire [7:0]  smiFuzzReqEofc39;
+wire [63:0] smiFuzzReqData39;
+wire        smiFuzzReqStop39;
+wire        smiFuzzRespReady39;
+wire [7:0]  smiFuzzRespEofc39;
+wire [63:0] smiFuzzRespData39;
+wire        smiFuzzRespStop39;
+
+// Fuzz tester signals for SMI port 40.
+wire [63:0] fuzzMemAddrBase40;
+wire [31:0] fuzzStatusErrorCount40;
+wire [63:0] fuzzStatusDataCount40;
+wire        smiFuzzReqReady40;
+wire [7:0]  smiFuzzReqEofc40;
+wire [63:0] smiFuzzReqData40;
+wire        smiFuzzReqStop40;
+wire        smiFuzzRespReady40;
+wire [7:0]  smiFuzzRespEofc40;
+wire [63:0] smiFuzzRespData40;
+wire        smiFuzzRespStop40;
+
+// Fuzz tester signals for SMI port 41.
+wire [63:0] fuzzMemAddrBase41;
+wire [31:0] fuzzStatusErrorCount41;
+wire [63:0] fuzzStatusDataCount41;
+wire        smiFuzzReqReady41;
+wire [7:0]  smiFuzzReqEofc41;
+wire [63:0] smiFuzzReqData41;
+wire        smiFuzzReqStop41;
+wire        smiFuzzRespReady41;
+wire [7:0]  smiFuzzRespEofc41;
+wire [63:0] smiFuzzRespData41;
+wire        smiFuzzRespStop41;
+
+// Fuzz tester signals for SMI port 42.
+wire [63:0] fuzzMemAddrBase42;
+wire [31:0] fuzzStatusErrorCount42;
+wire [63:0] fuzzStatusDataCount42;
+wire        smiFuzzReqReady42;
+wire [7:0]  smiFuzzReqEofc42;
+wire [63:0] smiFuzzReqData42;
+wire        smiFuzzReqStop42;
+wire        smiFuzzRespReady42;
+wire [7:0]  smiFuzzRespEofc42;
+wire [63:0] smiFuzzRespData42;
+wire        smiFuzzRespStop42;
+
+// Fuzz tester signals for SMI port 43.
+wire [63:0] fuzzMemAddrBase43;
+wire [31:0] fuzzStatusErrorCount43;
+wire [63:0] fuzzStatusDataCount43;
+wire        smiFuzzReqReady43;
+wire [7:0]  smiFuzzReqEofc43;
+wire [63:0] smiFuzzReqData43;
+wire        smiFuzzReqStop43;
+wire        smiFuzzRespReady43;
+wire [7:0]  smiFuzzRespEofc43;
+wire [63:0] smiFuzzRespData43;
+wire        smiFuzzRespStop43;
+
+// Fuzz tester signals for SMI port 44.
+wire [63:0] fuzzMemAddrBase44;
+wire [31:0] fuzzStatusErrorCount44;
+wire [63:0] fuzzStatusDataCount44;
+wire        smiFuzzReqReady44;
+wire [7:0]  smiFuzzReqEofc44;
+wire [63:0] smiFuzzReqData44;
+wire        smiFuzzReqStop44;
+wire        smiFuzzRespReady44;
+wire [7:0]  smiFuzzRespEofc44;
+wire [63:0] smiFuzzRespData44;
+wire        smiFuzzRespStop44;
+
+// Fuzz tester signals for SMI port 45.
+wire [63:0] fuzzMemAddrBase45;
+wire [31:0] fuzzStatusErrorCount45;
+wire [63:0] fuzzStatusDataCount45;
+wire        smiFuzzReqReady45;
+wire [7:0]  smiFuzzReqEofc45;
+wire [63:0] smiFuzzReqData45;
+wire        smiFuzzReqStop45;
+wire        smiFuzzRespReady45;
+wire [7:0]  smiFuzzRespEofc45;
+wire [63:0] smiFuzzRespData45;
+wire        smiFuzzRespStop45;
+
+// Fuzz tester signals for SMI port 46.
+wire [63:0] fuzzMemAddrBase46;
+wire [31:0] fuzzStatusErrorCount46;
+wire [63:0] fuzzStatusDataCount46;
+wire        smiFuzzReqReady46;
+wire [7:0]  smiFuzzReqEofc46;
+wire [63:0] smiFuzzReqData46;
+wire        smiFuzzReqStop46;
+wire        smiFuzzRespReady46;
+wire [7:0]  smiFuzzRespEofc46;
+wire [63:0] smiFuzzRespData46;
+wire        smiFuzzRespStop46;
+
+// Fuzz tester signals for SMI port 47.
+wire [63:0] fuzzMemAddrBase47;
+wire [31:0] fuzzStatusErrorCount47;
+wire [63:0] fuzzStatusDataCount47;
+wire        smiFuzzReqReady47;
+wire [7:0]  smiFuzzReqEofc47;
+wire [63:0] smiFuzzReqData47;
+wire        smiFuzzReqStop47;
+wire        smiFuzzRespReady47;
+wire [7:0]  smiFuzzRespEofc47;
+wire [63:0] smiFuzzRespData47;
+wire        smiFuzzRespStop47;
+
+// Fuzz tester signals for SMI port 48.
+wire [63:0] fuzzMemAddrBase48;
+wire [31:0] fuzzStatusErrorCount48;
+wire [63:0] fuzzStatusDataCount48;
+wire        smiFuzzReqReady48;
+wire [7:0]  smiFuzzReqEofc48;
+wire [63:0] smiFuzzReqData48;
+wire        smiFuzzReqStop48;
+wire        smiFuzzRespReady48;
+wire [7:0]  smiFuzzRespEofc48;
+wire [63:0] smiFuzzRespData48;
+wire        smiFuzzRespStop48;
+
+// Fuzz tester signals for SMI port 49.
+wire [63:0] fuzzMemAddrBase49;
+wire [31:0] fuzzStatusErrorCount49;
+wire [63:0] fuzzStatusDataCount49;
+wire        smiFuzzReqReady49;
+wire [7:0]  smiFuzzReqEofc49;
+wire [63:0] smiFuzzReqData49;
+wire        smiFuzzReqStop49;
+wire        smiFuzzRespReady49;
+wire [7:0]  smiFuzzRespEofc49;
+wire [63:0] smiFuzzRespData49;
+wire        smiFuzzRespStop49;
+
+// Fuzz tester signals for SMI port 50.
+wire [63:0] fuzzMemAddrBase50;
+wire [31:0] fuzzStatusErrorCount50;
+wire [63:0] fuzzStatusDataCount50;
+wire        smiFuzzReqReady50;
+wire [7:0]  smiFuzzReqEofc50;
+wire [63:0] smiFuzzReqData50;
+wire        smiFuzzReqStop50;
+wire        smiFuzzRespReady50;
+wire [7:0]  smiFuzzRespEofc50;
+wire [63:0] smiFuzzRespData50;
+wire        smiFuzzRespStop50;
+
+// Fuzz tester signals for SMI port 51.
+wire [63:0] fuzzMemAddrBase51;
+wire [31:0] fuzzStatusErrorCount51;
+wire [63:0] fuzzStatusDataCount51;
+wire        smiFuzzReqReady51;
+wire [7:0]  smiFuzzReqEofc51;
+wire [63:0] smiFuzzReqData51;
+wire        smiFuzzReqStop51;
+wire        smiFuzzRespReady51;
+wire [7:0]  smiFuzzRespEofc51;
+wire [63:0] smiFuzzRespData51;
+wire        smiFuzzRespStop51;
+
+// Fuzz tester signals for SMI port 52.
+wire [63:0] fuzzMemAddrBase52;
+wire [31:0] fuzzStatusErrorCount52;
+wire [63:0] fuzzStatusDataCount52;
+wire        smiFuzzReqReady52;
+wire [7:0]  smiFuzzReqEofc52;
+wire [63:0] smiFuzzReqData52;
+wire        smiFuzzReqStop52;
+wire        smiFuzzRespReady52;
+wire [7:0]  smiFuzzRespEofc52;
+wire [63:0] smiFuzzRespData52;
+wire        smiFuzzRespStop52;
+
+// Fuzz tester signals for SMI port 53.
+wire [63:0] fuzzMemAddrBase53;
+wire [31:0] fuzzStatusErrorCount53;
+wire [63:0] fuzzStatusDataCount53;
+wire        smiFuzzReqReady53;
+wire [7:0]  smiFuzzReqEofc53;
+wire [63:0] smiFuzzReqData53;
+wire        smiFuzzReqStop53;
+wire        smiFuzzRespReady53;
+wire [7:0]  smiFuzzRespEofc53;
+wire [63:0] smiFuzzRespData53;
+wire        smiFuzzRespStop53;
+
+// Fuzz tester signals for SMI port 54.
+wire [63:0] fuzzMemAddrBase54;
+wire [31:0] fuzzStatusErrorCount54;
+wire [63:0] fuzzStatusDataCount54;
+wire        smiFuzzReqReady54;
+wire [7:0]  smiFuzzReqEofc54;
+wire [63:0] smiFuzzReqData54;
+wire        smiFuzzReqStop54;
+wire        smiFuzzRespReady54;
+wire [7:0]  smiFuzzRespEofc54;
+wire [63:0] smiFuzzRespData54;
+wire        smiFuzzRespStop54;
+
+// Fuzz tester signals for SMI port 55.
+wire [63:0] fuzzMemAddrBase55;
+wire [31:0] fuzzStatusErrorCount55;
+wire [63:0] fuzzStatusDataCount55;
+wire        smiFuzzReqReady55;
+wire [7:0]  smiFuzzReqEofc55;
+wire [63:0] smiFuzzReqData55;
+wire        smiFuzzReqStop55;
+wire        smiFuzzRespReady55;
+wire [7:0]  smiFuzzRespEofc55;
+wire [63:0] smiFuzzRespData55;
+wire        smiFuzzRespStop55;
+
+// Fuzz tester signals for SMI port 56.
+wire [63:0] fuzzMemAddrBase56;
+wire [31:0] fuzzStatusErrorCount56;
+wire [63:0] fuzzStatusDataCount56;
+wire        smiFuzzReqReady56;
+wire [7:0]  smiFuzzReqEofc56;
+wire [63:0] smiFuzzReqData56;
+wire        smiFuzzReqStop56;
+wire        smiFuzzRespReady56;
+wire [7:0]  smiFuzzRespEofc56;
+wire [63:0] smiFuzzRespData56;
+wire        smiFuzzRespStop56;
+
+// Fuzz tester signals for SMI port 57.
+wire [63:0] fuzzMemAddrBase57;
+wire [31:0] fuzzStatusErrorCount57;
+wire [63:0] fuzzStatusDataCount57;
+wire        smiFuzzReqReady57;
+wire [7:0]  smiFuzzReqEofc57;
+wire [63:0] smiFuzzReqData57;
+wire        smiFuzzReqStop57;
+wire        smiFuzzRespReady57;
+wire [7:0]  smiFuzzRespEofc57;
+wire [63:0] smiFuzzRespData57;
+wire        smiFuzzRespStop57;
+
+// Fuzz tester signals for SMI port 58.
+wire [63:0] fuzzMemAddrBase58;
+wire [31:0] fuzzStatusErrorCount58;
+wire [63:0] fuzzStatusDataCount58;
+wire        smiFuzzReqReady58;
+wire [7:0]  smiFuzzReqEofc58;
+wire [63:0] smiFuzzReqData58;
+wire        smiFuzzReqStop58;
+wire        smiFuzzRespReady58;
+wire [7:0]  smiFuzzRespEofc58;
+wire [63:0] smiFuzzRespData58;
+wire        smiFuzzRespStop58;
+
+// Fuzz tester signals for SMI port 59.
+wire [63:0] fuzzMemAddrBase59;
+wire [31:0] fuzzStatusErrorCount59;
+wire [63:0] fuzzStatusDataCount59;
+wire        smiFuzzReqReady59;
+wire [7:0]  smiFuzzReqEofc59;
+wire [63:0] smiFuzzReqData59;
+wire        smiFuzzReqStop59;
+wire        smiFuzzRespReady59;
+wire [7:0]  smiFuzzRespEofc59;
+wire [63:0] smiFuzzRespData59;
+wire        smiFuzzRespStop59;
+
+// Fuzz tester signals for SMI port 60.
+wire [63:0] fuzzMemAddrBase60;
+wire [31:0] fuzzStatusErrorCount60;
+wire [63:0] fuzzStatusDataCount60;
+wire        smiFuzzReqReady60;
+wire [7:0]  smiFuzzReqEofc60;
+wire [63:0] smiFuzzReqData60;
+wire        smiFuzzReqStop60;
+wire        smiFuzzRespReady60;
+wire [7:0]  smiFuzzRespEofc60;
+wire [63:0] smiFuzzRespData60;
+wire        smiFuzzRespStop60;
+
+// Fuzz tester signals for SMI port 61.
+wire [63:0] fuzzMemAddrBase61;
+wire [31:0] fuzzStatusErrorCount61;
+wire [63:0] fuzzStatusDataCount61;
+wire        smiFuzzReqReady61;
+wire [7:0]  smiFuzzReqEofc61;
+wire [63:0] smiFuzzReqData61;
+wire        smiFuzzReqStop61;
+wire        smiFuzzRespReady61;
+wire [7:0]  smiFuzzRespEofc61;
+wire [63:0] smiFuzzRespData61;
+wire        smiFuzzRespStop61;
+
+// Fuzz tester signals for SMI port 62.
+wire [63:0] fuzzMemAddrBase62;
+wire [31:0] fuzzStatusErrorCount62;
+wire [63:0] fuzzStatusDataCount62;
+wire        smiFuzzReqReady62;
+wire [7:0]  smiFuzzReqEofc62;
+wire [63:0] smiFuzzReqData62;
+wire        smiFuzzReqStop62;
+wire        smiFuzzRespReady62;
+wire [7:0]  smiFuzzRespEofc62;
+wire [63:0] smiFuzzRespData62;
+wire        smiFuzzRespStop62;
+
+// Fuzz tester signals for SMI port 63.
+wire [63:0] fuzzMemAddrBase63;
+wire [31:0] fuzzStatusErrorCount63;
+wire [63:0] fuzzStatusDataCount63;
+wire        smiFuzzReqReady63;
+wire [7:0]  smiFuzzReqEofc63;
+wire [63:0] smiFuzzReqData63;
+wire        smiFuzzReqStop63;
+wire        smiFuzzRespReady63;
+wire [7:0]  smiFuzzRespEofc63;
+wire [63:0] smiFuzzRespData63;
+wire        smiFuzzRespStop63;
+
+// Status writer signals, which share SMI port 0.
+reg         statusWriteValid;
+reg  [63:0] statusWriteData;
+reg  [63:0] statusWriteAddr;
+wire        statusWriteStop;
+wire        statusWriteDoneValid;
+wire        statusWriteDoneStatusOk;
+reg         statusWriteDoneStop;
+
+wire        smiStatReqReady;
+wire [7:0]  smiStatReqEofc;
+wire [63:0] smiStatReqData;
+wire        smiStatReqStop;
+wire        smiStatRespReady;
+wire [7:0]  smiStatRespEofc;
+wire [63:0] smiStatRespData;
+wire        smiStatRespStop;
+
+wire        smiPortReqReady0;
+wire [7:0]  smiPortReqEofc0;
+wire [63:0] smiPortReqData0;
+wire        smiPortReqStop0;
+wire        smiPortRespReady0;
+wire [7:0]  smiPortRespEofc0;
+wire [63:0] smiPortRespData0;
+wire        smiPortRespStop0;
+
+// AXI slave loopback signals. Initialised to zero to avoid locking the slave
+// AXI bus on reset.
+reg s_axi_read_ready_q = 1'b0;
+reg s_axi_read_complete_q = 1'b0;
+reg s_axi_write_ready_q = 1'b0;
+reg s_axi_write_complete_q = 1'b0;
+
+// Implement combinatorial logic for parameter request state machine.
+always @(paramReqCount_q, paramReq, paramaddr_0Stop)
+begin
+
+  // Hold current state by default.
+  paramReqCount_d = paramReqCount_q;
+  paramAddrReady = 1'b0;
+  paramAddrData = 32'd0;
+
+  // From the idle state, wait for parameter request to be initiated.
+  if (paramReqCount_q == 4'd0)
+  begin
+    if (paramReq)
+      paramReqCount_d = 4'd1;
+  end
+
+  // Issue parameter requests.
+  else if (paramReqCount_q <= 4'd8)
+  begin
+    paramAddrReady = 1'b1;
+    case (paramReqCount_q)
+      4'd1 : paramAddrData = 32'h10;
+      4'd2 : paramAddrData = 32'h14;
+      4'd3 : paramAddrData = 32'h18;
+      4'd4 : paramAddrData = 32'h1C;
+      4'd5 : paramAddrData = 32'h20;
+      4'd6 : paramAddrData = 32'h24;
+      4'd7 : paramAddrData = 32'h28;
+      4'd8 : paramAddrData = 32'h2C;
+      default : paramAddrData = 32'd0;
+    endcase
+    if (~paramaddr_0Stop)
+      paramReqCount_d = paramReqCount_q + 4'd1;
+  end
+
+  // Revert to idle state.
+  else
+  begin
+    paramReqCount_d = 4'd0;
+  end
+
+end
+
+// Derive the per-port fuzz tester handshakes.
+assign fuzzConfigValid = (testState_q == TestStateSetConfig) ?
+  ~fuzzConfigDone_q : 64'd0;
+assign fuzzConfigAccept = fuzzConfigValid & ~fuzzConfigStop;
+assign fuzzStatusStop = (testState_q == TestStateGetStatus) ?
+  fuzzStatusDone_q : ~64'd0;
+assign fuzzStatusAccept = fuzzStatusValid & ~fuzzStatusStop;
+
+// Implement combinatorial logic for action execution state machine.
+always @(testState_q, paramCount_q, params_q, errorCount_q, dataCount_q,
+  go_0Ready, done_0Stop, paramdata_0Ready, paramdata_0Data, fuzzConfigDone_q,
+  fuzzConfigAccept, fuzzStatusDone_q, fuzzStatusAccept,
+  fuzzStatusErrorCount0, fuzzStatusDataCount0,
+  fuzzStatusErrorCount1, fuzzStatusDataCount1,
+  fuzzStatusErrorCount2, fuzzStatusDataCount2,
+  fuzzStatusErrorCount3, fuzzStatusDataCount3,
+  fuzzStatusErrorCount4, fuzzStatusDataCount4,
+  fuzzStatusErrorCount5, fuzzStatusDataCount5,
+  fuzzStatusErrorCount6, fuzzStatusDataCount6,
+  fuzzStatusErrorCount7, fuzzStatusDataCount7,
+  fuzzStatusErrorCount8, fuzzStatusDataCount8,
+  fuzzStatusErrorCount9, fuzzStatusDataCount9,
+  fuzzStatusErrorCount10, fuzzStatusDataCount10,
+  fuzzStatusErrorCount11, fuzzStatusDataCount11,
+  fuzzStatusErrorCount12, fuzzStatusDataCount12,
+  fuzzStatusErrorCount13, fuzzStatusDataCount13,
+  fuzzStatusErrorCount14, fuzzStatusDataCount14,
+  fuzzStatusErrorCount15, fuzzStatusDataCount15,
+  fuzzStatusErrorCount16, fuzzStatusDataCount16,
+  fuzzStatusErrorCount17, fuzzStatusDataCount17,
+  fuzzStatusErrorCount18, fuzzStatusDataCount18,
+  fuzzStatusErrorCount19, fuzzStatusDataCount19,
+  fuzzStatusErrorCount20, fuzzStatusDataCount20,
+  fuzzStatusErrorCount21, fuzzStatusDataCount21,
+  fuzzStatusErrorCount22, fuzzStatusDataCount22,
+  fuzzStatusErrorCount23, fuzzStatusDataCount23,
+  fuzzStatusErrorCount24, fuzzStatusDataCount24,
+  fuzzStatusErrorCount25, fuzzStatusDataCount25,
+  fuzzStatusErrorCount26, fuzzStatusDataCount26,
+  fuzzStatusErrorCount27, fuzzStatusDataCount27,
+  fuzzStatusErrorCount28, fuzzStatusDataCount28,
+  fuzzStatusErrorCount29, fuzzStatusDataCount29,
+  fuzzStatusErrorCount30, fuzzStatusDataCount30,
+  fuzzStatusErrorCount31, fuzzStatusDataCount31,
+  fuzzStatusErrorCount32, fuzzStatusDataCount32,
+  fuzzStatusErrorCount33, fuzzStatusDataCount33,
+  fuzzStatusErrorCount34, fuzzStatusDataCount34,
+  fuzzStatusErrorCount35, fuzzStatusDataCount35,
+  fuzzStatusErrorCount36, fuzzStatusDataCount36,
+  fuzzStatusErrorCount37, fuzzStatusDataCount37,
+  fuzzStatusErrorCount38, fuzzStatusDataCount38,
+  fuzzStatusErrorCount39, fuzzStatusDataCount39,
+  fuzzStatusErrorCount40, fuzzStatusDataCount40,
+  fuzzStatusErrorCount41, fuzzStatusDataCount41,
+  fuzzStatusErrorCount42, fuzzStatusDataCount42,
+  fuzzStatusErrorCount43, fuzzStatusDataCount43,
+  fuzzStatusErrorCount44, fuzzStatusDataCount44,
+  fuzzStatusErrorCount45, fuzzStatusDataCount45,
+  fuzzStatusErrorCount46, fuzzStatusDataCount46,
+  fuzzStatusErrorCount47, fuzzStatusDataCount47,
+  fuzzStatusErrorCount48, fuzzStatusDataCount48,
+  fuzzStatusErrorCount49, fuzzStatusDataCount49,
+  fuzzStatusErrorCount50, fuzzStatusDataCount50,
+  fuzzStatusErrorCount51, fuzzStatusDataCount51,
+  fuzzStatusErrorCount52, fuzzStatusDataCount52,
+  fuzzStatusErrorCount53, fuzzStatusDataCount53,
+  fuzzStatusErrorCount54, fuzzStatusDataCount54,
+  fuzzStatusErrorCount55, fuzzStatusDataCount55,
+  fuzzStatusErrorCount56, fuzzStatusDataCount56,
+  fuzzStatusErrorCount57, fuzzStatusDataCount57,
+  fuzzStatusErrorCount58, fuzzStatusDataCount58,
+  fuzzStatusErrorCount59, fuzzStatusDataCount59,
+  fuzzStatusErrorCount60, fuzzStatusDataCount60,
+  fuzzStatusErrorCount61, fuzzStatusDataCount61,
+  fuzzStatusErrorCount62, fuzzStatusDataCount62,
+  fuzzStatusErrorCount63, fuzzStatusDataCount63,
+  errResultAddr, dcountResultAddr, statusWriteStop, statusWriteDoneValid)
+begin
+
+  // Hold current state by default.
+  testState_d = testState_q;
+  paramCount_d = paramCount_q;
+  params_d = params_q;
+  errorCount_d = errorCount_q;
+  dataCount_d = dataCount_q;
+
+  goHalt = 1'b1;
+  doneReady = 1'b0;
+  paramReq = 1'b0;
+  paramReadHalt = 1'b1;
+  statusWriteValid = 1'b0;
+  statusWriteData = 64'd0;
+  statusWriteAddr = 64'd0;
+  statusWriteDoneStop = 1'b1;
+
+  // Implement state machine.
+  case (testState_q)
+
+    // In the idle state, wait for the 'go' request.
+    TestStateIdle :
+    begin
+      goHalt = 1'b0;
+      paramCount_d = 4'd0;
+      errorCount_d = 32'd0;
+      dataCount_d = 64'd0;
+      if (go_0Ready)
+      begin
+        testState_d = TestStateGetParams;
+        paramReq = 1'b1;
+      end
+    end
+
+    // Shift the kernel argument words into the parameter register.
+    TestStateGetParams :
+    begin
+      paramReadHalt = 1'b0;
+      if (paramdata_0Ready)
+      begin
+        params_d = { paramdata_0Data, params_q [255:32] };
+        paramCount_d = paramCount_q + 4'd1;
+        if (paramCount_q == 4'd8 - 4'd1)
+          testState_d = TestStateSetConfig;
+      end
+    end
+
+    // Set the configuration parameters for all fuzz testers, initiating the
+    // fuzz testing.
+    TestStateSetConfig :
+    begin
+      if ((fuzzConfigDone_q | fuzzConfigAccept) == ~64'd0)
+        testState_d = TestStateGetStatus;
+    end
+
+    // Accumulate the fuzz testing status values from all fuzz testers.
+    TestStateGetStatus :
+    begin
+      errorCount_d = errorCount_q +
+        (fuzzStatusAccept [0] ? fuzzStatusErrorCount0 : 32'd0) +
+        (fuzzStatusAccept [1] ? fuzzStatusErrorCount1 : 32'd0) +
+        (fuzzStatusAccept [2] ? fuzzStatusErrorCount2 : 32'd0) +
+        (fuzzStatusAccept [3] ? fuzzStatusErrorCount3 : 32'd0) +
+        (fuzzStatusAccept [4] ? fuzzStatusErrorCount4 : 32'd0) +
+        (fuzzStatusAccept [5] ? fuzzStatusErrorCount5 : 32'd0) +
+        (fuzzStatusAccept [6] ? fuzzStatusErrorCount6 : 32'd0) +
+        (fuzzStatusAccept [7] ? fuzzStatusErrorCount7 : 32'd0) +
+        (fuzzStatusAccept [8] ? fuzzStatusErrorCount8 : 32'd0) +
+        (fuzzStatusAccept [9] ? fuzzStatusErrorCount9 : 32'd0) +
+        (fuzzStatusAccept [10] ? fuzzStatusErrorCount10 : 32'd0) +
+        (fuzzStatusAccept [11] ? fuzzStatusErrorCount11 : 32'd0) +
+        (fuzzStatusAccept [12] ? fuzzStatusErrorCount12 : 32'd0) +
+        (fuzzStatusAccept [13] ? fuzzStatusErrorCount13 : 32'd0) +
+        (fuzzStatusAccept [14] ? fuzzStatusErrorCount14 : 32'd0) +
+        (fuzzStatusAccept [15] ? fuzzStatusErrorCount15 : 32'd0) +
+        (fuzzStatusAccept [16] ? fuzzStatusErrorCount16 : 32'd0) +
+        (fuzzStatusAccept [17] ? fuzzStatusErrorCount17 : 32'd0) +
+        (fuzzStatusAccept [18] ? fuzzStatusErrorCount18 : 32'd0) +
+        (fuzzStatusAccept [19] ? fuzzStatusErrorCount19 : 32'd0) +
+        (fuzzStatusAccept [20] ? fuzzStatusErrorCount20 : 32'd0) +
+        (fuzzStatusAccept [21] ? fuzzStatusErrorCount21 : 32'd0) +
+        (fuzzStatusAccept [22] ? fuzzStatusErrorCount22 : 32'd0) +
+        (fuzzStatusAccept [23] ? fuzzStatusErrorCount23 : 32'd0) +
+        (fuzzStatusAccept [24] ? fuzzStatusErrorCount24 : 32'd0) +
+        (fuzzStatusAccept [25] ? fuzzStatusErrorCount25 : 32'd0) +
+        (fuzzStatusAccept [26] ? fuzzStatusErrorCount26 : 32'd0) +
+        (fuzzStatusAccept [27] ? fuzzStatusErrorCount27 : 32'd0) +
+        (fuzzStatusAccept [28] ? fuzzStatusErrorCount28 : 32'd0) +
+        (fuzzStatusAccept [29] ? fuzzStatusErrorCount29 : 32'd0) +
+        (fuzzStatusAccept [30] ? fuzzStatusErrorCount30 : 32'd0) +
+        (fuzzStatusAccept [31] ? fuzzStatusErrorCount31 : 32'd0) +
+        (fuzzStatusAccept [32] ? fuzzStatusErrorCount32 : 32'd0) +
+        (fuzzStatusAccept [33] ? fuzzStatusErrorCount33 : 32'd0) +
+        (fuzzStatusAccept [34] ? fuzzStatusErrorCount34 : 32'd0) +
+        (fuzzStatusAccept [35] ? fuzzStatusErrorCount35 : 32'd0) +
+        (fuzzStatusAccept [36] ? fuzzStatusErrorCount36 : 32'd0) +
+        (fuzzStatusAccept [37] ? fuzzStatusErrorCount37 : 32'd0) +
+        (fuzzStatusAccept [38] ? fuzzStatusErrorCount38 : 32'd0) +
+        (fuzzStatusAccept [39] ? fuzzStatusErrorCount39 : 32'd0) +
+        (fuzzStatusAccept [40] ? fuzzStatusErrorCount40 : 32'd0) +
+        (fuzzStatusAccept [41] ? fuzzStatusErrorCount41 : 32'd0) +
+        (fuzzStatusAccept [42] ? fuzzStatusErrorCount42 : 32'd0) +
+        (fuzzStatusAccept [43] ? fuzzStatusErrorCount43 : 32'd0) +
+        (fuzzStatusAccept [44] ? fuzzStatusErrorCount44 : 32'd0) +
+        (fuzzStatusAccept [45] ? fuzzStatusErrorCount45 : 32'd0) +
+        (fuzzStatusAccept [46] ? fuzzStatusErrorCount46 : 32'd0) +
+        (fuzzStatusAccept [47] ? fuzzStatusErrorCount47 : 32'd0) +
+        (fuzzStatusAccept [48] ? fuzzStatusErrorCount48 : 32'd0) +
+        (fuzzStatusAccept [49] ? fuzzStatusErrorCount49 : 32'd0) +
+        (fuzzStatusAccept [50] ? fuzzStatusErrorCount50 : 32'd0) +
+        (fuzzStatusAccept [51] ? fuzzStatusErrorCount51 : 32'd0) +
+        (fuzzStatusAccept [52] ? fuzzStatusErrorCount52 : 32'd0) +
+        (fuzzStatusAccept [53] ? fuzzStatusErrorCount53 : 32'd0) +
+        (fuzzStatusAccept [54] ? fuzzStatusErrorCount54 : 32'd0) +
+        (fuzzStatusAccept [55] ? fuzzStatusErrorCount55 : 32'd0) +
+        (fuzzStatusAccept [56] ? fuzzStatusErrorCount56 : 32'd0) +
+        (fuzzStatusAccept [57] ? fuzzStatusErrorCount57 : 32'd0) +
+        (fuzzStatusAccept [58] ? fuzzStatusErrorCount58 : 32'd0) +
+        (fuzzStatusAccept [59] ? fuzzStatusErrorCount59 : 32'd0) +
+        (fuzzStatusAccept [60] ? fuzzStatusErrorCount60 : 32'd0) +
+        (fuzzStatusAccept [61] ? fuzzStatusErrorCount61 : 32'd0) +
+        (fuzzStatusAccept [62] ? fuzzStatusErrorCount62 : 32'd0) +
+        (fuzzStatusAccept [63] ? fuzzStatusErrorCount63 : 32'd0);
+      dataCount_d = dataCount_q +
+        (fuzzStatusAccept [0] ? fuzzStatusDataCount0 : 64'd0) +
+        (fuzzStatusAccept [1] ? fuzzStatusDataCount1 : 64'd0) +
+        (fuzzStatusAccept [2] ? fuzzStatusDataCount2 : 64'd0) +
+        (fuzzStatusAccept [3] ? fuzzStatusDataCount3 : 64'd0) +
+        (fuzzStatusAccept [4] ? fuzzStatusDataCount4 : 64'd0) +
+        (fuzzStatusAccept [5] ? fuzzStatusDataCount5 : 64'd0) +
+        (fuzzStatusAccept [6] ? fuzzStatusDataCount6 : 64'd0) +
+        (fuzzStatusAccept [7] ? fuzzStatusDataCount7 : 64'd0) +
+        (fuzzStatusAccept [8] ? fuzzStatusDataCount8 : 64'd0) +
+        (fuzzStatusAccept [9] ? fuzzStatusDataCount9 : 64'd0) +
+        (fuzzStatusAccept [10] ? fuzzStatusDataCount10 : 64'd0) +
+        (fuzzStatusAccept [11] ? fuzzStatusDataCount11 : 64'd0) +
+        (fuzzStatusAccept [12] ? fuzzStatusDataCount12 : 64'd0) +
+        (fuzzStatusAccept [13] ? fuzzStatusDataCount13 : 64'd0) +
+        (fuzzStatusAccept [14] ? fuzzStatusDataCount14 : 64'd0) +
+        (fuzzStatusAccept [15] ? fuzzStatusDataCount15 : 64'd0) +
+        (fuzzStatusAccept [16] ? fuzzStatusDataCount16 : 64'd0) +
+        (fuzzStatusAccept [17] ? fuzzStatusDataCount17 : 64'd0) +
+        (fuzzStatusAccept [18] ? fuzzStatusDataCount18 : 64'd0) +
+        (fuzzStatusAccept [19] ? fuzzStatusDataCount19 : 64'd0) +
+        (fuzzStatusAccept [20] ? fuzzStatusDataCount20 : 64'd0) +
+        (fuzzStatusAccept [21] ? fuzzStatusDataCount21 : 64'd0) +
+        (fuzzStatusAccept [22] ? fuzzStatusDataCount22 : 64'd0) +
+        (fuzzStatusAccept [23] ? fuzzStatusDataCount23 : 64'd0) +
+        (fuzzStatusAccept [24] ? fuzzStatusDataCount24 : 64'd0) +
+        (fuzzStatusAccept [25] ? fuzzStatusDataCount25 : 64'd0) +
+        (fuzzStatusAccept [26] ? fuzzStatusDataCount26 : 64'd0) +
+        (fuzzStatusAccept [27] ? fuzzStatusDataCount27 : 64'd0) +
+        (fuzzStatusAccept [28] ? fuzzStatusDataCount28 : 64'd0) +
+        (fuzzStatusAccept [29] ? fuzzStatusDataCount29 : 64'd0) +
+        (fuzzStatusAccept [30] ? fuzzStatusDataCount30 : 64'd0) +
+        (fuzzStatusAccept [31] ? fuzzStatusDataCount31 : 64'd0) +
+        (fuzzStatusAccept [32] ? fuzzStatusDataCount32 : 64'd0) +
+        (fuzzStatusAccept [33] ? fuzzStatusDataCount33 : 64'd0) +
+        (fuzzStatusAccept [34] ? fuzzStatusDataCount34 : 64'd0) +
+        (fuzzStatusAccept [35] ? fuzzStatusDataCount35 : 64'd0) +
+        (fuzzStatusAccept [36] ? fuzzStatusDataCount36 : 64'd0) +
+        (fuzzStatusAccept [37] ? fuzzStatusDataCount37 : 64'd0) +
+        (fuzzStatusAccept [38] ? fuzzStatusDataCount38 : 64'd0) +
+        (fuzzStatusAccept [39] ? fuzzStatusDataCount39 : 64'd0) +
+        (fuzzStatusAccept [40] ? fuzzStatusDataCount40 : 64'd0) +
+        (fuzzStatusAccept [41] ? fuzzStatusDataCount41 : 64'd0) +
+        (fuzzStatusAccept [42] ? fuzzStatusDataCount42 : 64'd0) +
+        (fuzzStatusAccept [43] ? fuzzStatusDataCount43 : 64'd0) +
+        (fuzzStatusAccept [44] ? fuzzStatusDataCount44 : 64'd0) +
+        (fuzzStatusAccept [45] ? fuzzStatusDataCount45 : 64'd0) +
+        (fuzzStatusAccept [46] ? fuzzStatusDataCount46 : 64'd0) +
+        (fuzzStatusAccept [47] ? fuzzStatusDataCount47 : 64'd0) +
+        (fuzzStatusAccept [48] ? fuzzStatusDataCount48 : 64'd0) +
+        (fuzzStatusAccept [49] ? fuzzStatusDataCount49 : 64'd0) +
+        (fuzzStatusAccept [50] ? fuzzStatusDataCount50 : 64'd0) +
+        (fuzzStatusAccept [51] ? fuzzStatusDataCount51 : 64'd0) +
+        (fuzzStatusAccept [52] ? fuzzStatusDataCount52 : 64'd0) +
+        (fuzzStatusAccept [53] ? fuzzStatusDataCount53 : 64'd0) +
+        (fuzzStatusAccept [54] ? fuzzStatusDataCount54 : 64'd0) +
+        (fuzzStatusAccept [55] ? fuzzStatusDataCount55 : 64'd0) +
+        (fuzzStatusAccept [56] ? fuzzStatusDataCount56 : 64'd0) +
+        (fuzzStatusAccept [57] ? fuzzStatusDataCount57 : 64'd0) +
+        (fuzzStatusAccept [58] ? fuzzStatusDataCount58 : 64'd0) +
+        (fuzzStatusAccept [59] ? fuzzStatusDataCount59 : 64'd0) +
+        (fuzzStatusAccept [60] ? fuzzStatusDataCount60 : 64'd0) +
+        (fuzzStatusAccept [61] ? fuzzStatusDataCount61 : 64'd0) +
+        (fuzzStatusAccept [62] ? fuzzStatusDataCount62 : 64'd0) +
+        (fuzzStatusAccept [63] ? fuzzStatusDataCount63 : 64'd0);
+      if ((fuzzStatusDone_q | fuzzStatusAccept) == ~64'd0)
+        testState_d = TestStateWriteErrCountReq;
+    end
+
+    // Write the status error count value to the return location in shared memory.
+    TestStateWriteErrCountReq :
+    begin
+      statusWriteValid = 1'b1;
+      statusWriteAddr = errResultAddr;
+      statusWriteData = { 32'd0, errorCount_q };
+      if (~statusWriteStop)
+        testState_d = TestStateWriteErrCountDone;
+    end
+
+    TestStateWriteErrCountDone :
+    begin
+      statusWriteDoneStop = 1'b0;
+      if (statusWriteDoneValid)
+        testState_d = TestStateWriteDataCountReq;
+    end
+
+    // Write the status data count value to the return location in shared memory.
+    TestStateWriteDataCountReq :
+    begin
+      statusWriteValid = 1'b1;
+      statusWriteAddr = dcountResultAddr;
+      statusWriteData = dataCount_q;
+      if (~statusWriteStop)
+        testState_d = TestStateWriteDataCountDone;
+    end
+
+    TestStateWriteDataCountDone :
+    begin
+      statusWriteDoneStop = 1'b0;
+      if (statusWriteDoneValid)
+        testState_d = TestStateReportResult;
+    end
+
+    // Indicate completion to the SDAccel framework.
+    TestStateReportResult :
+    begin
+      doneReady = 1'b1;
+      if (~done_0Stop)
+        testState_d = TestStateIdle;
+    end
+
+    // From the reset state, transition to the idle state.
+    default :
+    begin
+      testState_d = TestStateIdle;
+    end
+  endcase
+
+end
+
+// Implement resettable state registers for test control state machine.
+always @(posedge clk)
+begin
+  if (reset)
+  begin
+    testState_q <= TestStateReset;
+    paramReqCount_q <= 4'd0;
+  end
+  else
+  begin
+    testState_q <= testState_d;
+    paramReqCount_q <= paramReqCount_d;
+  end
+end
+
+// Implement non-resettable data registers for test control state machine.
+always @(posedge clk)
+begin
+  paramCount_q <= paramCount_d;
+  params_q <= params_d;
+  errorCount_q <= errorCount_d;
+  dataCount_q <= dataCount_d;
+end
+
+// Track the per-port fuzz tester handshakes. These are cleared in the idle
+// state.
+always @(posedge clk)
+begin
+  if (testState_q == TestStateIdle)
+  begin
+    fuzzConfigDone_q <= 64'd0;
+    fuzzStatusDone_q <= 64'd0;
+  end
+  else
+  begin
+    fuzzConfigDone_q <= fuzzConfigDone_q | fuzzConfigAccept;
+    fuzzStatusDone_q <= fuzzStatusDone_q | fuzzStatusAccept;
+  end
+end
+
+// Connect external handshake signals.
+assign go_0Stop = goHalt;
+assign done_0Ready = doneReady;
+
+assign paramaddr_0Ready = paramAddrReady;
+assign paramaddr_0Data = paramAddrData;
+assign paramdata_0Stop = paramReadHalt;
+
+//
+// Instantiate the fuzz tester for SMI port 0.
+//
+assign fuzzMemAddrBase0 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd0);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h373E7B7D27C69FA4)) fuzzTester0 (
+  .configValid        (fuzzConfigValid [0]),
+  .configMemAddrBase  (fuzzMemAddrBase0),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [0]),
+  .statusValid        (fuzzStatusValid [0]),
+  .statusErrorCount   (fuzzStatusErrorCount0),
+  .statusDataCount    (fuzzStatusDataCount0),
+  .statusStop         (fuzzStatusStop [0]),
+  .smiReqValid        (smiFuzzReqReady0),
+  .smiReqEofc         (smiFuzzReqEofc0),
+  .smiReqData         (smiFuzzReqData0),
+  .smiReqStop         (smiFuzzReqStop0),
+  .smiRespValid       (smiFuzzRespReady0),
+  .smiRespEofc        (smiFuzzRespEofc0),
+  .smiRespData        (smiFuzzRespData0),
+  .smiRespStop        (smiFuzzRespStop0),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+//
+// Instantiate the status memory write module and arbitrate with the port 0
+// fuzz tester.
+//
+smiMemLibWriteWord64 statusWriter (
+  .paramsValid  (statusWriteValid),
+  .paramAddr    (statusWriteAddr),
+  .paramOpts    (8'h01),
+  .paramData    (statusWriteData),
+  .paramsStop   (statusWriteStop),
+  .doneValid    (statusWriteDoneValid),
+  .doneStatusOk (statusWriteDoneStatusOk),
+  .doneStop     (statusWriteDoneStop),
+  .smiReqValid  (smiStatReqReady),
+  .smiReqEofc   (smiStatReqEofc),
+  .smiReqData   (smiStatReqData),
+  .smiReqStop   (smiStatReqStop),
+  .smiRespValid (smiStatRespReady),
+  .smiRespEofc  (smiStatRespEofc),
+  .smiRespData  (smiStatRespData),
+  .smiRespStop  (smiStatRespStop),
+  .clk          (clk),
+  .srst         (reset)
+);
+
+smiTransactionArbiterX2 #(8, 2, 64, 4) statusArbiter (
+  .smiReqAInReady   (smiFuzzReqReady0),
+  .smiReqAInEofc    (smiFuzzReqEofc0),
+  .smiReqAInData    (smiFuzzReqData0),
+  .smiReqAInStop    (smiFuzzReqStop0),
+  .smiRespAOutReady (smiFuzzRespReady0),
+  .smiRespAOutEofc  (smiFuzzRespEofc0),
+  .smiRespAOutData  (smiFuzzRespData0),
+  .smiRespAOutStop  (smiFuzzRespStop0),
+  .smiReqBInReady   (smiStatReqReady),
+  .smiReqBInEofc    (smiStatReqEofc),
+  .smiReqBInData    (smiStatReqData),
+  .smiReqBInStop    (smiStatReqStop),
+  .smiRespBOutReady (smiStatRespReady),
+  .smiRespBOutEofc  (smiStatRespEofc),
+  .smiRespBOutData  (smiStatRespData),
+  .smiRespBOutStop  (smiStatRespStop),
+  .smiReqOutReady   (smiPortReqReady0),
+  .smiReqOutEofc    (smiPortReqEofc0),
+  .smiReqOutData    (smiPortReqData0),
+  .smiReqOutStop    (smiPortReqStop0),
+  .smiRespInReady   (smiPortRespReady0),
+  .smiRespInEofc    (smiPortRespEofc0),
+  .smiRespInData    (smiPortRespData0),
+  .smiRespInStop    (smiPortRespStop0),
+  .clk              (clk),
+  .srst             (reset)
+);
+
+assign smiport0req_0Ready = smiPortReqReady0;
+assign smiport0req_0Data  = { smiPortReqEofc0, smiPortReqData0 };
+assign smiPortReqStop0    = smiport0req_0Stop;
+assign smiPortRespReady0  = smiport0resp_0Ready;
+assign smiPortRespEofc0   = smiport0resp_0Data [71:64];
+assign smiPortRespData0   = smiport0resp_0Data [63:0];
+assign smiport0resp_0Stop = smiPortRespStop0;
+
+//
+// Instantiate the fuzz tester for SMI port 1.
+//
+assign fuzzMemAddrBase1 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd1);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h89ADBA5993CA22D5)) fuzzTester1 (
+  .configValid        (fuzzConfigValid [1]),
+  .configMemAddrBase  (fuzzMemAddrBase1),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [1]),
+  .statusValid        (fuzzStatusValid [1]),
+  .statusErrorCount   (fuzzStatusErrorCount1),
+  .statusDataCount    (fuzzStatusDataCount1),
+  .statusStop         (fuzzStatusStop [1]),
+  .smiReqValid        (smiFuzzReqReady1),
+  .smiReqEofc         (smiFuzzReqEofc1),
+  .smiReqData         (smiFuzzReqData1),
+  .smiReqStop         (smiFuzzReqStop1),
+  .smiRespValid       (smiFuzzRespReady1),
+  .smiRespEofc        (smiFuzzRespEofc1),
+  .smiRespData        (smiFuzzRespData1),
+  .smiRespStop        (smiFuzzRespStop1),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport1req_0Ready = smiFuzzReqReady1;
+assign smiport1req_0Data  = { smiFuzzReqEofc1, smiFuzzReqData1 };
+assign smiFuzzReqStop1    = smiport1req_0Stop;
+assign smiFuzzRespReady1  = smiport1resp_0Ready;
+assign smiFuzzRespEofc1   = smiport1resp_0Data [71:64];
+assign smiFuzzRespData1   = smiport1resp_0Data [63:0];
+assign smiport1resp_0Stop = smiFuzzRespStop1;
+
+//
+// Instantiate the fuzz tester for SMI port 2.
+//
+assign fuzzMemAddrBase2 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd2);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h178E91E4F31E9698)) fuzzTester2 (
+  .configValid        (fuzzConfigValid [2]),
+  .configMemAddrBase  (fuzzMemAddrBase2),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [2]),
+  .statusValid        (fuzzStatusValid [2]),
+  .statusErrorCount   (fuzzStatusErrorCount2),
+  .statusDataCount    (fuzzStatusDataCount2),
+  .statusStop         (fuzzStatusStop [2]),
+  .smiReqValid        (smiFuzzReqReady2),
+  .smiReqEofc         (smiFuzzReqEofc2),
+  .smiReqData         (smiFuzzReqData2),
+  .smiReqStop         (smiFuzzReqStop2),
+  .smiRespValid       (smiFuzzRespReady2),
+  .smiRespEofc        (smiFuzzRespEofc2),
+  .smiRespData        (smiFuzzRespData2),
+  .smiRespStop        (smiFuzzRespStop2),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport2req_0Ready = smiFuzzReqReady2;
+assign smiport2req_0Data  = { smiFuzzReqEofc2, smiFuzzReqData2 };
+assign smiFuzzReqStop2    = smiport2req_0Stop;
+assign smiFuzzRespReady2  = smiport2resp_0Ready;
+assign smiFuzzRespEofc2   = smiport2resp_0Data [71:64];
+assign smiFuzzRespData2   = smiport2resp_0Data [63:0];
+assign smiport2resp_0Stop = smiFuzzRespStop2;
+
+//
+// Instantiate the fuzz tester for SMI port 3.
+//
+assign fuzzMemAddrBase3 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd3);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hBACC7EB24A8B409D)) fuzzTester3 (
+  .configValid        (fuzzConfigValid [3]),
+  .configMemAddrBase  (fuzzMemAddrBase3),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [3]),
+  .statusValid        (fuzzStatusValid [3]),
+  .statusErrorCount   (fuzzStatusErrorCount3),
+  .statusDataCount    (fuzzStatusDataCount3),
+  .statusStop         (fuzzStatusStop [3]),
+  .smiReqValid        (smiFuzzReqReady3),
+  .smiReqEofc         (smiFuzzReqEofc3),
+  .smiReqData         (smiFuzzReqData3),
+  .smiReqStop         (smiFuzzReqStop3),
+  .smiRespValid       (smiFuzzRespReady3),
+  .smiRespEofc        (smiFuzzRespEofc3),
+  .smiRespData        (smiFuzzRespData3),
+  .smiRespStop        (smiFuzzRespStop3),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport3req_0Ready = smiFuzzReqReady3;
+assign smiport3req_0Data  = { smiFuzzReqEofc3, smiFuzzReqData3 };
+assign smiFuzzReqStop3    = smiport3req_0Stop;
+assign smiFuzzRespReady3  = smiport3resp_0Ready;
+assign smiFuzzRespEofc3   = smiport3resp_0Data [71:64];
+assign smiFuzzRespData3   = smiport3resp_0Data [63:0];
+assign smiport3resp_0Stop = smiFuzzRespStop3;
+
+//
+// Instantiate the fuzz tester for SMI port 4.
+//
+assign fuzzMemAddrBase4 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd4);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h92AF3CE5A90E73A4)) fuzzTester4 (
+  .configValid        (fuzzConfigValid [4]),
+  .configMemAddrBase  (fuzzMemAddrBase4),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [4]),
+  .statusValid        (fuzzStatusValid [4]),
+  .statusErrorCount   (fuzzStatusErrorCount4),
+  .statusDataCount    (fuzzStatusDataCount4),
+  .statusStop         (fuzzStatusStop [4]),
+  .smiReqValid        (smiFuzzReqReady4),
+  .smiReqEofc         (smiFuzzReqEofc4),
+  .smiReqData         (smiFuzzReqData4),
+  .smiReqStop         (smiFuzzReqStop4),
+  .smiRespValid       (smiFuzzRespReady4),
+  .smiRespEofc        (smiFuzzRespEofc4),
+  .smiRespData        (smiFuzzRespData4),
+  .smiRespStop        (smiFuzzRespStop4),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport4req_0Ready = smiFuzzReqReady4;
+assign smiport4req_0Data  = { smiFuzzReqEofc4, smiFuzzReqData4 };
+assign smiFuzzReqStop4    = smiport4req_0Stop;
+assign smiFuzzRespReady4  = smiport4resp_0Ready;
+assign smiFuzzRespEofc4   = smiport4resp_0Data [71:64];
+assign smiFuzzRespData4   = smiport4resp_0Data [63:0];
+assign smiport4resp_0Stop = smiFuzzRespStop4;
+
+//
+// Instantiate the fuzz tester for SMI port 5.
+//
+assign fuzzMemAddrBase5 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd5);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h3F373072FEF79D75)) fuzzTester5 (
+  .configValid        (fuzzConfigValid [5]),
+  .configMemAddrBase  (fuzzMemAddrBase5),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [5]),
+  .statusValid        (fuzzStatusValid [5]),
+  .statusErrorCount   (fuzzStatusErrorCount5),
+  .statusDataCount    (fuzzStatusDataCount5),
+  .statusStop         (fuzzStatusStop [5]),
+  .smiReqValid        (smiFuzzReqReady5),
+  .smiReqEofc         (smiFuzzReqEofc5),
+  .smiReqData         (smiFuzzReqData5),
+  .smiReqStop         (smiFuzzReqStop5),
+  .smiRespValid       (smiFuzzRespReady5),
+  .smiRespEofc        (smiFuzzRespEofc5),
+  .smiRespData        (smiFuzzRespData5),
+  .smiRespStop        (smiFuzzRespStop5),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport5req_0Ready = smiFuzzReqReady5;
+assign smiport5req_0Data  = { smiFuzzReqEofc5, smiFuzzReqData5 };
+assign smiFuzzReqStop5    = smiport5req_0Stop;
+assign smiFuzzRespReady5  = smiport5resp_0Ready;
+assign smiFuzzRespEofc5   = smiport5resp_0Data [71:64];
+assign smiFuzzRespData5   = smiport5resp_0Data [63:0];
+assign smiport5resp_0Stop = smiFuzzRespStop5;
+
+//
+// Instantiate the fuzz tester for SMI port 6.
+//
+assign fuzzMemAddrBase6 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd6);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h3E98F15FA57BD08C)) fuzzTester6 (
+  .configValid        (fuzzConfigValid [6]),
+  .configMemAddrBase  (fuzzMemAddrBase6),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [6]),
+  .statusValid        (fuzzStatusValid [6]),
+  .statusErrorCount   (fuzzStatusErrorCount6),
+  .statusDataCount    (fuzzStatusDataCount6),
+  .statusStop         (fuzzStatusStop [6]),
+  .smiReqValid        (smiFuzzReqReady6),
+  .smiReqEofc         (smiFuzzReqEofc6),
+  .smiReqData         (smiFuzzReqData6),
+  .smiReqStop         (smiFuzzReqStop6),
+  .smiRespValid       (smiFuzzRespReady6),
+  .smiRespEofc        (smiFuzzRespEofc6),
+  .smiRespData        (smiFuzzRespData6),
+  .smiRespStop        (smiFuzzRespStop6),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport6req_0Ready = smiFuzzReqReady6;
+assign smiport6req_0Data  = { smiFuzzReqEofc6, smiFuzzReqData6 };
+assign smiFuzzReqStop6    = smiport6req_0Stop;
+assign smiFuzzRespReady6  = smiport6resp_0Ready;
+assign smiFuzzRespEofc6   = smiport6resp_0Data [71:64];
+assign smiFuzzRespData6   = smiport6resp_0Data [63:0];
+assign smiport6resp_0Stop = smiFuzzRespStop6;
+
+//
+// Instantiate the fuzz tester for SMI port 7.
+//
+assign fuzzMemAddrBase7 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd7);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h4A84A03153110E83)) fuzzTester7 (
+  .configValid        (fuzzConfigValid [7]),
+  .configMemAddrBase  (fuzzMemAddrBase7),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [7]),
+  .statusValid        (fuzzStatusValid [7]),
+  .statusErrorCount   (fuzzStatusErrorCount7),
+  .statusDataCount    (fuzzStatusDataCount7),
+  .statusStop         (fuzzStatusStop [7]),
+  .smiReqValid        (smiFuzzReqReady7),
+  .smiReqEofc         (smiFuzzReqEofc7),
+  .smiReqData         (smiFuzzReqData7),
+  .smiReqStop         (smiFuzzReqStop7),
+  .smiRespValid       (smiFuzzRespReady7),
+  .smiRespEofc        (smiFuzzRespEofc7),
+  .smiRespData        (smiFuzzRespData7),
+  .smiRespStop        (smiFuzzRespStop7),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport7req_0Ready = smiFuzzReqReady7;
+assign smiport7req_0Data  = { smiFuzzReqEofc7, smiFuzzReqData7 };
+assign smiFuzzReqStop7    = smiport7req_0Stop;
+assign smiFuzzRespReady7  = smiport7resp_0Ready;
+assign smiFuzzRespEofc7   = smiport7resp_0Data [71:64];
+assign smiFuzzRespData7   = smiport7resp_0Data [63:0];
+assign smiport7resp_0Stop = smiFuzzRespStop7;
+
+//
+// Instantiate the fuzz tester for SMI port 8.
+//
+assign fuzzMemAddrBase8 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd8);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hB8420E317A7FD672)) fuzzTester8 (
+  .configValid        (fuzzConfigValid [8]),
+  .configMemAddrBase  (fuzzMemAddrBase8),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [8]),
+  .statusValid        (fuzzStatusValid [8]),
+  .statusErrorCount   (fuzzStatusErrorCount8),
+  .statusDataCount    (fuzzStatusDataCount8),
+  .statusStop         (fuzzStatusStop [8]),
+  .smiReqValid        (smiFuzzReqReady8),
+  .smiReqEofc         (smiFuzzReqEofc8),
+  .smiReqData         (smiFuzzReqData8),
+  .smiReqStop         (smiFuzzReqStop8),
+  .smiRespValid       (smiFuzzRespReady8),
+  .smiRespEofc        (smiFuzzRespEofc8),
+  .smiRespData        (smiFuzzRespData8),
+  .smiRespStop        (smiFuzzRespStop8),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport8req_0Ready = smiFuzzReqReady8;
+assign smiport8req_0Data  = { smiFuzzReqEofc8, smiFuzzReqData8 };
+assign smiFuzzReqStop8    = smiport8req_0Stop;
+assign smiFuzzRespReady8  = smiport8resp_0Ready;
+assign smiFuzzRespEofc8   = smiport8resp_0Data [71:64];
+assign smiFuzzRespData8   = smiport8resp_0Data [63:0];
+assign smiport8resp_0Stop = smiFuzzRespStop8;
+
+//
+// Instantiate the fuzz tester for SMI port 9.
+//
+assign fuzzMemAddrBase9 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd9);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hC6C08D26F8B0D161)) fuzzTester9 (
+  .configValid        (fuzzConfigValid [9]),
+  .configMemAddrBase  (fuzzMemAddrBase9),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [9]),
+  .statusValid        (fuzzStatusValid [9]),
+  .statusErrorCount   (fuzzStatusErrorCount9),
+  .statusDataCount    (fuzzStatusDataCount9),
+  .statusStop         (fuzzStatusStop [9]),
+  .smiReqValid        (smiFuzzReqReady9),
+  .smiReqEofc         (smiFuzzReqEofc9),
+  .smiReqData         (smiFuzzReqData9),
+  .smiReqStop         (smiFuzzReqStop9),
+  .smiRespValid       (smiFuzzRespReady9),
+  .smiRespEofc        (smiFuzzRespEofc9),
+  .smiRespData        (smiFuzzRespData9),
+  .smiRespStop        (smiFuzzRespStop9),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport9req_0Ready = smiFuzzReqReady9;
+assign smiport9req_0Data  = { smiFuzzReqEofc9, smiFuzzReqData9 };
+assign smiFuzzReqStop9    = smiport9req_0Stop;
+assign smiFuzzRespReady9  = smiport9resp_0Ready;
+assign smiFuzzRespEofc9   = smiport9resp_0Data [71:64];
+assign smiFuzzRespData9   = smiport9resp_0Data [63:0];
+assign smiport9resp_0Stop = smiFuzzRespStop9;
+
+//
+// Instantiate the fuzz tester for SMI port 10.
+//
+assign fuzzMemAddrBase10 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd10);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h6741EE85891B9760)) fuzzTester10 (
+  .configValid        (fuzzConfigValid [10]),
+  .configMemAddrBase  (fuzzMemAddrBase10),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [10]),
+  .statusValid        (fuzzStatusValid [10]),
+  .statusErrorCount   (fuzzStatusErrorCount10),
+  .statusDataCount    (fuzzStatusDataCount10),
+  .statusStop         (fuzzStatusStop [10]),
+  .smiReqValid        (smiFuzzReqReady10),
+  .smiReqEofc         (smiFuzzReqEofc10),
+  .smiReqData         (smiFuzzReqData10),
+  .smiReqStop         (smiFuzzReqStop10),
+  .smiRespValid       (smiFuzzRespReady10),
+  .smiRespEofc        (smiFuzzRespEofc10),
+  .smiRespData        (smiFuzzRespData10),
+  .smiRespStop        (smiFuzzRespStop10),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport10req_0Ready = smiFuzzReqReady10;
+assign smiport10req_0Data  = { smiFuzzReqEofc10, smiFuzzReqData10 };
+assign smiFuzzReqStop10    = smiport10req_0Stop;
+assign smiFuzzRespReady10  = smiport10resp_0Ready;
+assign smiFuzzRespEofc10   = smiport10resp_0Data [71:64];
+assign smiFuzzRespData10   = smiport10resp_0Data [63:0];
+assign smiport10resp_0Stop = smiFuzzRespStop10;
+
+//
+// Instantiate the fuzz tester for SMI port 11.
+//
+assign fuzzMemAddrBase11 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd11);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h31F8B5D6229F3248)) fuzzTester11 (
+  .configValid        (fuzzConfigValid [11]),
+  .configMemAddrBase  (fuzzMemAddrBase11),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [11]),
+  .statusValid        (fuzzStatusValid [11]),
+  .statusErrorCount   (fuzzStatusErrorCount11),
+  .statusDataCount    (fuzzStatusDataCount11),
+  .statusStop         (fuzzStatusStop [11]),
+  .smiReqValid        (smiFuzzReqReady11),
+  .smiReqEofc         (smiFuzzReqEofc11),
+  .smiReqData         (smiFuzzReqData11),
+  .smiReqStop         (smiFuzzReqStop11),
+  .smiRespValid       (smiFuzzRespReady11),
+  .smiRespEofc        (smiFuzzRespEofc11),
+  .smiRespData        (smiFuzzRespData11),
+  .smiRespStop        (smiFuzzRespStop11),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport11req_0Ready = smiFuzzReqReady11;
+assign smiport11req_0Data  = { smiFuzzReqEofc11, smiFuzzReqData11 };
+assign smiFuzzReqStop11    = smiport11req_0Stop;
+assign smiFuzzRespReady11  = smiport11resp_0Ready;
+assign smiFuzzRespEofc11   = smiport11resp_0Data [71:64];
+assign smiFuzzRespData11   = smiport11resp_0Data [63:0];
+assign smiport11resp_0Stop = smiFuzzRespStop11;
+
+//
+// Instantiate the fuzz tester for SMI port 12.
+//
+assign fuzzMemAddrBase12 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd12);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h8B9E9A5D192B6781)) fuzzTester12 (
+  .configValid        (fuzzConfigValid [12]),
+  .configMemAddrBase  (fuzzMemAddrBase12),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [12]),
+  .statusValid        (fuzzStatusValid [12]),
+  .statusErrorCount   (fuzzStatusErrorCount12),
+  .statusDataCount    (fuzzStatusDataCount12),
+  .statusStop         (fuzzStatusStop [12]),
+  .smiReqValid        (smiFuzzReqReady12),
+  .smiReqEofc         (smiFuzzReqEofc12),
+  .smiReqData         (smiFuzzReqData12),
+  .smiReqStop         (smiFuzzReqStop12),
+  .smiRespValid       (smiFuzzRespReady12),
+  .smiRespEofc        (smiFuzzRespEofc12),
+  .smiRespData        (smiFuzzRespData12),
+  .smiRespStop        (smiFuzzRespStop12),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport12req_0Ready = smiFuzzReqReady12;
+assign smiport12req_0Data  = { smiFuzzReqEofc12, smiFuzzReqData12 };
+assign smiFuzzReqStop12    = smiport12req_0Stop;
+assign smiFuzzRespReady12  = smiport12resp_0Ready;
+assign smiFuzzRespEofc12   = smiport12resp_0Data [71:64];
+assign smiFuzzRespData12   = smiport12resp_0Data [63:0];
+assign smiport12resp_0Stop = smiFuzzRespStop12;
+
+//
+// Instantiate the fuzz tester for SMI port 13.
+//
+assign fuzzMemAddrBase13 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd13);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h6100025A6135B22B)) fuzzTester13 (
+  .configValid        (fuzzConfigValid [13]),
+  .configMemAddrBase  (fuzzMemAddrBase13),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [13]),
+  .statusValid        (fuzzStatusValid [13]),
+  .statusErrorCount   (fuzzStatusErrorCount13),
+  .statusDataCount    (fuzzStatusDataCount13),
+  .statusStop         (fuzzStatusStop [13]),
+  .smiReqValid        (smiFuzzReqReady13),
+  .smiReqEofc         (smiFuzzReqEofc13),
+  .smiReqData         (smiFuzzReqData13),
+  .smiReqStop         (smiFuzzReqStop13),
+  .smiRespValid       (smiFuzzRespReady13),
+  .smiRespEofc        (smiFuzzRespEofc13),
+  .smiRespData        (smiFuzzRespData13),
+  .smiRespStop        (smiFuzzRespStop13),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport13req_0Ready = smiFuzzReqReady13;
+assign smiport13req_0Data  = { smiFuzzReqEofc13, smiFuzzReqData13 };
+assign smiFuzzReqStop13    = smiport13req_0Stop;
+assign smiFuzzRespReady13  = smiport13resp_0Ready;
+assign smiFuzzRespEofc13   = smiport13resp_0Data [71:64];
+assign smiFuzzRespData13   = smiport13resp_0Data [63:0];
+assign smiport13resp_0Stop = smiFuzzRespStop13;
+
+//
+// Instantiate the fuzz tester for SMI port 14.
+//
+assign fuzzMemAddrBase14 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd14);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hA6DF0B1196C5E255)) fuzzTester14 (
+  .configValid        (fuzzConfigValid [14]),
+  .configMemAddrBase  (fuzzMemAddrBase14),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [14]),
+  .statusValid        (fuzzStatusValid [14]),
+  .statusErrorCount   (fuzzStatusErrorCount14),
+  .statusDataCount    (fuzzStatusDataCount14),
+  .statusStop         (fuzzStatusStop [14]),
+  .smiReqValid        (smiFuzzReqReady14),
+  .smiReqEofc         (smiFuzzReqEofc14),
+  .smiReqData         (smiFuzzReqData14),
+  .smiReqStop         (smiFuzzReqStop14),
+  .smiRespValid       (smiFuzzRespReady14),
+  .smiRespEofc        (smiFuzzRespEofc14),
+  .smiRespData        (smiFuzzRespData14),
+  .smiRespStop        (smiFuzzRespStop14),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport14req_0Ready = smiFuzzReqReady14;
+assign smiport14req_0Data  = { smiFuzzReqEofc14, smiFuzzReqData14 };
+assign smiFuzzReqStop14    = smiport14req_0Stop;
+assign smiFuzzRespReady14  = smiport14resp_0Ready;
+assign smiFuzzRespEofc14   = smiport14resp_0Data [71:64];
+assign smiFuzzRespData14   = smiport14resp_0Data [63:0];
+assign smiport14resp_0Stop = smiFuzzRespStop14;
+
+//
+// Instantiate the fuzz tester for SMI port 15.
+//
+assign fuzzMemAddrBase15 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd15);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h78159F0DAEE96EB7)) fuzzTester15 (
+  .configValid        (fuzzConfigValid [15]),
+  .configMemAddrBase  (fuzzMemAddrBase15),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [15]),
+  .statusValid        (fuzzStatusValid [15]),
+  .statusErrorCount   (fuzzStatusErrorCount15),
+  .statusDataCount    (fuzzStatusDataCount15),
+  .statusStop         (fuzzStatusStop [15]),
+  .smiReqValid        (smiFuzzReqReady15),
+  .smiReqEofc         (smiFuzzReqEofc15),
+  .smiReqData         (smiFuzzReqData15),
+  .smiReqStop         (smiFuzzReqStop15),
+  .smiRespValid       (smiFuzzRespReady15),
+  .smiRespEofc        (smiFuzzRespEofc15),
+  .smiRespData        (smiFuzzRespData15),
+  .smiRespStop        (smiFuzzRespStop15),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport15req_0Ready = smiFuzzReqReady15;
+assign smiport15req_0Data  = { smiFuzzReqEofc15, smiFuzzReqData15 };
+assign smiFuzzReqStop15    = smiport15req_0Stop;
+assign smiFuzzRespReady15  = smiport15resp_0Ready;
+assign smiFuzzRespEofc15   = smiport15resp_0Data [71:64];
+assign smiFuzzRespData15   = smiport15resp_0Data [63:0];
+assign smiport15resp_0Stop = smiFuzzRespStop15;
+
+//
+// Instantiate the fuzz tester for SMI port 16.
+//
+assign fuzzMemAddrBase16 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd16);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h35F5854C38B10774)) fuzzTester16 (
+  .configValid        (fuzzConfigValid [16]),
+  .configMemAddrBase  (fuzzMemAddrBase16),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [16]),
+  .statusValid        (fuzzStatusValid [16]),
+  .statusErrorCount   (fuzzStatusErrorCount16),
+  .statusDataCount    (fuzzStatusDataCount16),
+  .statusStop         (fuzzStatusStop [16]),
+  .smiReqValid        (smiFuzzReqReady16),
+  .smiReqEofc         (smiFuzzReqEofc16),
+  .smiReqData         (smiFuzzReqData16),
+  .smiReqStop         (smiFuzzReqStop16),
+  .smiRespValid       (smiFuzzRespReady16),
+  .smiRespEofc        (smiFuzzRespEofc16),
+  .smiRespData        (smiFuzzRespData16),
+  .smiRespStop        (smiFuzzRespStop16),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport16req_0Ready = smiFuzzReqReady16;
+assign smiport16req_0Data  = { smiFuzzReqEofc16, smiFuzzReqData16 };
+assign smiFuzzReqStop16    = smiport16req_0Stop;
+assign smiFuzzRespReady16  = smiport16resp_0Ready;
+assign smiFuzzRespEofc16   = smiport16resp_0Data [71:64];
+assign smiFuzzRespData16   = smiport16resp_0Data [63:0];
+assign smiport16resp_0Stop = smiFuzzRespStop16;
+
+//
+// Instantiate the fuzz tester for SMI port 17.
+//
+assign fuzzMemAddrBase17 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd17);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h229A593F82B4CC2F)) fuzzTester17 (
+  .configValid        (fuzzConfigValid [17]),
+  .configMemAddrBase  (fuzzMemAddrBase17),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [17]),
+  .statusValid        (fuzzStatusValid [17]),
+  .statusErrorCount   (fuzzStatusErrorCount17),
+  .statusDataCount    (fuzzStatusDataCount17),
+  .statusStop         (fuzzStatusStop [17]),
+  .smiReqValid        (smiFuzzReqReady17),
+  .smiReqEofc         (smiFuzzReqEofc17),
+  .smiReqData         (smiFuzzReqData17),
+  .smiReqStop         (smiFuzzReqStop17),
+  .smiRespValid       (smiFuzzRespReady17),
+  .smiRespEofc        (smiFuzzRespEofc17),
+  .smiRespData        (smiFuzzRespData17),
+  .smiRespStop        (smiFuzzRespStop17),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport17req_0Ready = smiFuzzReqReady17;
+assign smiport17req_0Data  = { smiFuzzReqEofc17, smiFuzzReqData17 };
+assign smiFuzzReqStop17    = smiport17req_0Stop;
+assign smiFuzzRespReady17  = smiport17resp_0Ready;
+assign smiFuzzRespEofc17   = smiport17resp_0Data [71:64];
+assign smiFuzzRespData17   = smiport17resp_0Data [63:0];
+assign smiport17resp_0Stop = smiFuzzRespStop17;
+
+//
+// Instantiate the fuzz tester for SMI port 18.
+//
+assign fuzzMemAddrBase18 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd18);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h92CF8451DE67383B)) fuzzTester18 (
+  .configValid        (fuzzConfigValid [18]),
+  .configMemAddrBase  (fuzzMemAddrBase18),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [18]),
+  .statusValid        (fuzzStatusValid [18]),
+  .statusErrorCount   (fuzzStatusErrorCount18),
+  .statusDataCount    (fuzzStatusDataCount18),
+  .statusStop         (fuzzStatusStop [18]),
+  .smiReqValid        (smiFuzzReqReady18),
+  .smiReqEofc         (smiFuzzReqEofc18),
+  .smiReqData         (smiFuzzReqData18),
+  .smiReqStop         (smiFuzzReqStop18),
+  .smiRespValid       (smiFuzzRespReady18),
+  .smiRespEofc        (smiFuzzRespEofc18),
+  .smiRespData        (smiFuzzRespData18),
+  .smiRespStop        (smiFuzzRespStop18),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport18req_0Ready = smiFuzzReqReady18;
+assign smiport18req_0Data  = { smiFuzzReqEofc18, smiFuzzReqData18 };
+assign smiFuzzReqStop18    = smiport18req_0Stop;
+assign smiFuzzRespReady18  = smiport18resp_0Ready;
+assign smiFuzzRespEofc18   = smiport18resp_0Data [71:64];
+assign smiFuzzRespData18   = smiport18resp_0Data [63:0];
+assign smiport18resp_0Stop = smiFuzzRespStop18;
+
+//
+// Instantiate the fuzz tester for SMI port 19.
+//
+assign fuzzMemAddrBase19 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd19);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h106F096D5E87DF0D)) fuzzTester19 (
+  .configValid        (fuzzConfigValid [19]),
+  .configMemAddrBase  (fuzzMemAddrBase19),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [19]),
+  .statusValid        (fuzzStatusValid [19]),
+  .statusErrorCount   (fuzzStatusErrorCount19),
+  .statusDataCount    (fuzzStatusDataCount19),
+  .statusStop         (fuzzStatusStop [19]),
+  .smiReqValid        (smiFuzzReqReady19),
+  .smiReqEofc         (smiFuzzReqEofc19),
+  .smiReqData         (smiFuzzReqData19),
+  .smiReqStop         (smiFuzzReqStop19),
+  .smiRespValid       (smiFuzzRespReady19),
+  .smiRespEofc        (smiFuzzRespEofc19),
+  .smiRespData        (smiFuzzRespData19),
+  .smiRespStop        (smiFuzzRespStop19),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport19req_0Ready = smiFuzzReqReady19;
+assign smiport19req_0Data  = { smiFuzzReqEofc19, smiFuzzReqData19 };
+assign smiFuzzReqStop19    = smiport19req_0Stop;
+assign smiFuzzRespReady19  = smiport19resp_0Ready;
+assign smiFuzzRespEofc19   = smiport19resp_0Data [71:64];
+assign smiFuzzRespData19   = smiport19resp_0Data [63:0];
+assign smiport19resp_0Stop = smiFuzzRespStop19;
+
+//
+// Instantiate the fuzz tester for SMI port 20.
+//
+assign fuzzMemAddrBase20 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd20);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h94D4866DA1EA55A5)) fuzzTester20 (
+  .configValid        (fuzzConfigValid [20]),
+  .configMemAddrBase  (fuzzMemAddrBase20),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [20]),
+  .statusValid        (fuzzStatusValid [20]),
+  .statusErrorCount   (fuzzStatusErrorCount20),
+  .statusDataCount    (fuzzStatusDataCount20),
+  .statusStop         (fuzzStatusStop [20]),
+  .smiReqValid        (smiFuzzReqReady20),
+  .smiReqEofc         (smiFuzzReqEofc20),
+  .smiReqData         (smiFuzzReqData20),
+  .smiReqStop         (smiFuzzReqStop20),
+  .smiRespValid       (smiFuzzRespReady20),
+  .smiRespEofc        (smiFuzzRespEofc20),
+  .smiRespData        (smiFuzzRespData20),
+  .smiRespStop        (smiFuzzRespStop20),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport20req_0Ready = smiFuzzReqReady20;
+assign smiport20req_0Data  = { smiFuzzReqEofc20, smiFuzzReqData20 };
+assign smiFuzzReqStop20    = smiport20req_0Stop;
+assign smiFuzzRespReady20  = smiport20resp_0Ready;
+assign smiFuzzRespEofc20   = smiport20resp_0Data [71:64];
+assign smiFuzzRespData20   = smiport20resp_0Data [63:0];
+assign smiport20resp_0Stop = smiFuzzRespStop20;
+
+//
+// Instantiate the fuzz tester for SMI port 21.
+//
+assign fuzzMemAddrBase21 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd21);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h2B83D0B69EDDE23D)) fuzzTester21 (
+  .configValid        (fuzzConfigValid [21]),
+  .configMemAddrBase  (fuzzMemAddrBase21),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [21]),
+  .statusValid        (fuzzStatusValid [21]),
+  .statusErrorCount   (fuzzStatusErrorCount21),
+  .statusDataCount    (fuzzStatusDataCount21),
+  .statusStop         (fuzzStatusStop [21]),
+  .smiReqValid        (smiFuzzReqReady21),
+  .smiReqEofc         (smiFuzzReqEofc21),
+  .smiReqData         (smiFuzzReqData21),
+  .smiReqStop         (smiFuzzReqStop21),
+  .smiRespValid       (smiFuzzRespReady21),
+  .smiRespEofc        (smiFuzzRespEofc21),
+  .smiRespData        (smiFuzzRespData21),
+  .smiRespStop        (smiFuzzRespStop21),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport21req_0Ready = smiFuzzReqReady21;
+assign smiport21req_0Data  = { smiFuzzReqEofc21, smiFuzzReqData21 };
+assign smiFuzzReqStop21    = smiport21req_0Stop;
+assign smiFuzzRespReady21  = smiport21resp_0Ready;
+assign smiFuzzRespEofc21   = smiport21resp_0Data [71:64];
+assign smiFuzzRespData21   = smiport21resp_0Data [63:0];
+assign smiport21resp_0Stop = smiFuzzRespStop21;
+
+//
+// Instantiate the fuzz tester for SMI port 22.
+//
+assign fuzzMemAddrBase22 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd22);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hD2EA49CB5F737D89)) fuzzTester22 (
+  .configValid        (fuzzConfigValid [22]),
+  .configMemAddrBase  (fuzzMemAddrBase22),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [22]),
+  .statusValid        (fuzzStatusValid [22]),
+  .statusErrorCount   (fuzzStatusErrorCount22),
+  .statusDataCount    (fuzzStatusDataCount22),
+  .statusStop         (fuzzStatusStop [22]),
+  .smiReqValid        (smiFuzzReqReady22),
+  .smiReqEofc         (smiFuzzReqEofc22),
+  .smiReqData         (smiFuzzReqData22),
+  .smiReqStop         (smiFuzzReqStop22),
+  .smiRespValid       (smiFuzzRespReady22),
+  .smiRespEofc        (smiFuzzRespEofc22),
+  .smiRespData        (smiFuzzRespData22),
+  .smiRespStop        (smiFuzzRespStop22),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport22req_0Ready = smiFuzzReqReady22;
+assign smiport22req_0Data  = { smiFuzzReqEofc22, smiFuzzReqData22 };
+assign smiFuzzReqStop22    = smiport22req_0Stop;
+assign smiFuzzRespReady22  = smiport22resp_0Ready;
+assign smiFuzzRespEofc22   = smiport22resp_0Data [71:64];
+assign smiFuzzRespData22   = smiport22resp_0Data [63:0];
+assign smiport22resp_0Stop = smiFuzzRespStop22;
+
+//
+// Instantiate the fuzz tester for SMI port 23.
+//
+assign fuzzMemAddrBase23 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd23);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hF3E4A3353899FF1F)) fuzzTester23 (
+  .configValid        (fuzzConfigValid [23]),
+  .configMemAddrBase  (fuzzMemAddrBase23),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [23]),
+  .statusValid        (fuzzStatusValid [23]),
+  .statusErrorCount   (fuzzStatusErrorCount23),
+  .statusDataCount    (fuzzStatusDataCount23),
+  .statusStop         (fuzzStatusStop [23]),
+  .smiReqValid        (smiFuzzReqReady23),
+  .smiReqEofc         (smiFuzzReqEofc23),
+  .smiReqData         (smiFuzzReqData23),
+  .smiReqStop         (smiFuzzReqStop23),
+  .smiRespValid       (smiFuzzRespReady23),
+  .smiRespEofc        (smiFuzzRespEofc23),
+  .smiRespData        (smiFuzzRespData23),
+  .smiRespStop        (smiFuzzRespStop23),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport23req_0Ready = smiFuzzReqReady23;
+assign smiport23req_0Data  = { smiFuzzReqEofc23, smiFuzzReqData23 };
+assign smiFuzzReqStop23    = smiport23req_0Stop;
+assign smiFuzzRespReady23  = smiport23resp_0Ready;
+assign smiFuzzRespEofc23   = smiport23resp_0Data [71:64];
+assign smiFuzzRespData23   = smiport23resp_0Data [63:0];
+assign smiport23resp_0Stop = smiFuzzRespStop23;
+
+//
+// Instantiate the fuzz tester for SMI port 24.
+//
+assign fuzzMemAddrBase24 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd24);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h400B31EF654ADB15)) fuzzTester24 (
+  .configValid        (fuzzConfigValid [24]),
+  .configMemAddrBase  (fuzzMemAddrBase24),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [24]),
+  .statusValid        (fuzzStatusValid [24]),
+  .statusErrorCount   (fuzzStatusErrorCount24),
+  .statusDataCount    (fuzzStatusDataCount24),
+  .statusStop         (fuzzStatusStop [24]),
+  .smiReqValid        (smiFuzzReqReady24),
+  .smiReqEofc         (smiFuzzReqEofc24),
+  .smiReqData         (smiFuzzReqData24),
+  .smiReqStop         (smiFuzzReqStop24),
+  .smiRespValid       (smiFuzzRespReady24),
+  .smiRespEofc        (smiFuzzRespEofc24),
+  .smiRespData        (smiFuzzRespData24),
+  .smiRespStop        (smiFuzzRespStop24),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport24req_0Ready = smiFuzzReqReady24;
+assign smiport24req_0Data  = { smiFuzzReqEofc24, smiFuzzReqData24 };
+assign smiFuzzReqStop24    = smiport24req_0Stop;
+assign smiFuzzRespReady24  = smiport24resp_0Ready;
+assign smiFuzzRespEofc24   = smiport24resp_0Data [71:64];
+assign smiFuzzRespData24   = smiport24resp_0Data [63:0];
+assign smiport24resp_0Stop = smiFuzzRespStop24;
+
+//
+// Instantiate the fuzz tester for SMI port 25.
+//
+assign fuzzMemAddrBase25 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd25);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h329FA7F8212868B3)) fuzzTester25 (
+  .configValid        (fuzzConfigValid [25]),
+  .configMemAddrBase  (fuzzMemAddrBase25),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [25]),
+  .statusValid        (fuzzStatusValid [25]),
+  .statusErrorCount   (fuzzStatusErrorCount25),
+  .statusDataCount    (fuzzStatusDataCount25),
+  .statusStop         (fuzzStatusStop [25]),
+  .smiReqValid        (smiFuzzReqReady25),
+  .smiReqEofc         (smiFuzzReqEofc25),
+  .smiReqData         (smiFuzzReqData25),
+  .smiReqStop         (smiFuzzReqStop25),
+  .smiRespValid       (smiFuzzRespReady25),
+  .smiRespEofc        (smiFuzzRespEofc25),
+  .smiRespData        (smiFuzzRespData25),
+  .smiRespStop        (smiFuzzRespStop25),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport25req_0Ready = smiFuzzReqReady25;
+assign smiport25req_0Data  = { smiFuzzReqEofc25, smiFuzzReqData25 };
+assign smiFuzzReqStop25    = smiport25req_0Stop;
+assign smiFuzzRespReady25  = smiport25resp_0Ready;
+assign smiFuzzRespEofc25   = smiport25resp_0Data [71:64];
+assign smiFuzzRespData25   = smiport25resp_0Data [63:0];
+assign smiport25resp_0Stop = smiFuzzRespStop25;
+
+//
+// Instantiate the fuzz tester for SMI port 26.
+//
+assign fuzzMemAddrBase26 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd26);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hF06B180BAF53104E)) fuzzTester26 (
+  .configValid        (fuzzConfigValid [26]),
+  .configMemAddrBase  (fuzzMemAddrBase26),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [26]),
+  .statusValid        (fuzzStatusValid [26]),
+  .statusErrorCount   (fuzzStatusErrorCount26),
+  .statusDataCount    (fuzzStatusDataCount26),
+  .statusStop         (fuzzStatusStop [26]),
+  .smiReqValid        (smiFuzzReqReady26),
+  .smiReqEofc         (smiFuzzReqEofc26),
+  .smiReqData         (smiFuzzReqData26),
+  .smiReqStop         (smiFuzzReqStop26),
+  .smiRespValid       (smiFuzzRespReady26),
+  .smiRespEofc        (smiFuzzRespEofc26),
+  .smiRespData        (smiFuzzRespData26),
+  .smiRespStop        (smiFuzzRespStop26),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport26req_0Ready = smiFuzzReqReady26;
+assign smiport26req_0Data  = { smiFuzzReqEofc26, smiFuzzReqData26 };
+assign smiFuzzReqStop26    = smiport26req_0Stop;
+assign smiFuzzRespReady26  = smiport26resp_0Ready;
+assign smiFuzzRespEofc26   = smiport26resp_0Data [71:64];
+assign smiFuzzRespData26   = smiport26resp_0Data [63:0];
+assign smiport26resp_0Stop = smiFuzzRespStop26;
+
+//
+// Instantiate the fuzz tester for SMI port 27.
+//
+assign fuzzMemAddrBase27 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd27);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hC2026AF4643E4769)) fuzzTester27 (
+  .configValid        (fuzzConfigValid [27]),
+  .configMemAddrBase  (fuzzMemAddrBase27),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [27]),
+  .statusValid        (fuzzStatusValid [27]),
+  .statusErrorCount   (fuzzStatusErrorCount27),
+  .statusDataCount    (fuzzStatusDataCount27),
+  .statusStop         (fuzzStatusStop [27]),
+  .smiReqValid        (smiFuzzReqReady27),
+  .smiReqEofc         (smiFuzzReqEofc27),
+  .smiReqData         (smiFuzzReqData27),
+  .smiReqStop         (smiFuzzReqStop27),
+  .smiRespValid       (smiFuzzRespReady27),
+  .smiRespEofc        (smiFuzzRespEofc27),
+  .smiRespData        (smiFuzzRespData27),
+  .smiRespStop        (smiFuzzRespStop27),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport27req_0Ready = smiFuzzReqReady27;
+assign smiport27req_0Data  = { smiFuzzReqEofc27, smiFuzzReqData27 };
+assign smiFuzzReqStop27    = smiport27req_0Stop;
+assign smiFuzzRespReady27  = smiport27resp_0Ready;
+assign smiFuzzRespEofc27   = smiport27resp_0Data [71:64];
+assign smiFuzzRespData27   = smiport27resp_0Data [63:0];
+assign smiport27resp_0Stop = smiFuzzRespStop27;
+
+//
+// Instantiate the fuzz tester for SMI port 28.
+//
+assign fuzzMemAddrBase28 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd28);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hCD9C5961ECAE3B4A)) fuzzTester28 (
+  .configValid        (fuzzConfigValid [28]),
+  .configMemAddrBase  (fuzzMemAddrBase28),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [28]),
+  .statusValid        (fuzzStatusValid [28]),
+  .statusErrorCount   (fuzzStatusErrorCount28),
+  .statusDataCount    (fuzzStatusDataCount28),
+  .statusStop         (fuzzStatusStop [28]),
+  .smiReqValid        (smiFuzzReqReady28),
+  .smiReqEofc         (smiFuzzReqEofc28),
+  .smiReqData         (smiFuzzReqData28),
+  .smiReqStop         (smiFuzzReqStop28),
+  .smiRespValid       (smiFuzzRespReady28),
+  .smiRespEofc        (smiFuzzRespEofc28),
+  .smiRespData        (smiFuzzRespData28),
+  .smiRespStop        (smiFuzzRespStop28),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport28req_0Ready = smiFuzzReqReady28;
+assign smiport28req_0Data  = { smiFuzzReqEofc28, smiFuzzReqData28 };
+assign smiFuzzReqStop28    = smiport28req_0Stop;
+assign smiFuzzRespReady28  = smiport28resp_0Ready;
+assign smiFuzzRespEofc28   = smiport28resp_0Data [71:64];
+assign smiFuzzRespData28   = smiport28resp_0Data [63:0];
+assign smiport28resp_0Stop = smiFuzzRespStop28;
+
+//
+// Instantiate the fuzz tester for SMI port 29.
+//
+assign fuzzMemAddrBase29 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd29);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hF38F1C08474B7806)) fuzzTester29 (
+  .configValid        (fuzzConfigValid [29]),
+  .configMemAddrBase  (fuzzMemAddrBase29),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [29]),
+  .statusValid        (fuzzStatusValid [29]),
+  .statusErrorCount   (fuzzStatusErrorCount29),
+  .statusDataCount    (fuzzStatusDataCount29),
+  .statusStop         (fuzzStatusStop [29]),
+  .smiReqValid        (smiFuzzReqReady29),
+  .smiReqEofc         (smiFuzzReqEofc29),
+  .smiReqData         (smiFuzzReqData29),
+  .smiReqStop         (smiFuzzReqStop29),
+  .smiRespValid       (smiFuzzRespReady29),
+  .smiRespEofc        (smiFuzzRespEofc29),
+  .smiRespData        (smiFuzzRespData29),
+  .smiRespStop        (smiFuzzRespStop29),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport29req_0Ready = smiFuzzReqReady29;
+assign smiport29req_0Data  = { smiFuzzReqEofc29, smiFuzzReqData29 };
+assign smiFuzzReqStop29    = smiport29req_0Stop;
+assign smiFuzzRespReady29  = smiport29resp_0Ready;
+assign smiFuzzRespEofc29   = smiport29resp_0Data [71:64];
+assign smiFuzzRespData29   = smiport29resp_0Data [63:0];
+assign smiport29resp_0Stop = smiFuzzRespStop29;
+
+//
+// Instantiate the fuzz tester for SMI port 30.
+//
+assign fuzzMemAddrBase30 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd30);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h75955560AB6FAD17)) fuzzTester30 (
+  .configValid        (fuzzConfigValid [30]),
+  .configMemAddrBase  (fuzzMemAddrBase30),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [30]),
+  .statusValid        (fuzzStatusValid [30]),
+  .statusErrorCount   (fuzzStatusErrorCount30),
+  .statusDataCount    (fuzzStatusDataCount30),
+  .statusStop         (fuzzStatusStop [30]),
+  .smiReqValid        (smiFuzzReqReady30),
+  .smiReqEofc         (smiFuzzReqEofc30),
+  .smiReqData         (smiFuzzReqData30),
+  .smiReqStop         (smiFuzzReqStop30),
+  .smiRespValid       (smiFuzzRespReady30),
+  .smiRespEofc        (smiFuzzRespEofc30),
+  .smiRespData        (smiFuzzRespData30),
+  .smiRespStop        (smiFuzzRespStop30),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport30req_0Ready = smiFuzzReqReady30;
+assign smiport30req_0Data  = { smiFuzzReqEofc30, smiFuzzReqData30 };
+assign smiFuzzReqStop30    = smiport30req_0Stop;
+assign smiFuzzRespReady30  = smiport30resp_0Ready;
+assign smiFuzzRespEofc30   = smiport30resp_0Data [71:64];
+assign smiFuzzRespData30   = smiport30resp_0Data [63:0];
+assign smiport30resp_0Stop = smiFuzzRespStop30;
+
+//
+// Instantiate the fuzz tester for SMI port 31.
+//
+assign fuzzMemAddrBase31 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd31);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h7E8AF81136F5C2DD)) fuzzTester31 (
+  .configValid        (fuzzConfigValid [31]),
+  .configMemAddrBase  (fuzzMemAddrBase31),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [31]),
+  .statusValid        (fuzzStatusValid [31]),
+  .statusErrorCount   (fuzzStatusErrorCount31),
+  .statusDataCount    (fuzzStatusDataCount31),
+  .statusStop         (fuzzStatusStop [31]),
+  .smiReqValid        (smiFuzzReqReady31),
+  .smiReqEofc         (smiFuzzReqEofc31),
+  .smiReqData         (smiFuzzReqData31),
+  .smiReqStop         (smiFuzzReqStop31),
+  .smiRespValid       (smiFuzzRespReady31),
+  .smiRespEofc        (smiFuzzRespEofc31),
+  .smiRespData        (smiFuzzRespData31),
+  .smiRespStop        (smiFuzzRespStop31),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport31req_0Ready = smiFuzzReqReady31;
+assign smiport31req_0Data  = { smiFuzzReqEofc31, smiFuzzReqData31 };
+assign smiFuzzReqStop31    = smiport31req_0Stop;
+assign smiFuzzRespReady31  = smiport31resp_0Ready;
+assign smiFuzzRespEofc31   = smiport31resp_0Data [71:64];
+assign smiFuzzRespData31   = smiport31resp_0Data [63:0];
+assign smiport31resp_0Stop = smiFuzzRespStop31;
+
+//
+// Instantiate the fuzz tester for SMI port 32.
+//
+assign fuzzMemAddrBase32 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd32);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h577DE9AC56FFFC01)) fuzzTester32 (
+  .configValid        (fuzzConfigValid [32]),
+  .configMemAddrBase  (fuzzMemAddrBase32),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [32]),
+  .statusValid        (fuzzStatusValid [32]),
+  .statusErrorCount   (fuzzStatusErrorCount32),
+  .statusDataCount    (fuzzStatusDataCount32),
+  .statusStop         (fuzzStatusStop [32]),
+  .smiReqValid        (smiFuzzReqReady32),
+  .smiReqEofc         (smiFuzzReqEofc32),
+  .smiReqData         (smiFuzzReqData32),
+  .smiReqStop         (smiFuzzReqStop32),
+  .smiRespValid       (smiFuzzRespReady32),
+  .smiRespEofc        (smiFuzzRespEofc32),
+  .smiRespData        (smiFuzzRespData32),
+  .smiRespStop        (smiFuzzRespStop32),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport32req_0Ready = smiFuzzReqReady32;
+assign smiport32req_0Data  = { smiFuzzReqEofc32, smiFuzzReqData32 };
+assign smiFuzzReqStop32    = smiport32req_0Stop;
+assign smiFuzzRespReady32  = smiport32resp_0Ready;
+assign smiFuzzRespEofc32   = smiport32resp_0Data [71:64];
+assign smiFuzzRespData32   = smiport32resp_0Data [63:0];
+assign smiport32resp_0Stop = smiFuzzRespStop32;
+
+//
+// Instantiate the fuzz tester for SMI port 33.
+//
+assign fuzzMemAddrBase33 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd33);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hA3744ED964815727)) fuzzTester33 (
+  .configValid        (fuzzConfigValid [33]),
+  .configMemAddrBase  (fuzzMemAddrBase33),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [33]),
+  .statusValid        (fuzzStatusValid [33]),
+  .statusErrorCount   (fuzzStatusErrorCount33),
+  .statusDataCount    (fuzzStatusDataCount33),
+  .statusStop         (fuzzStatusStop [33]),
+  .smiReqValid        (smiFuzzReqReady33),
+  .smiReqEofc         (smiFuzzReqEofc33),
+  .smiReqData         (smiFuzzReqData33),
+  .smiReqStop         (smiFuzzReqStop33),
+  .smiRespValid       (smiFuzzRespReady33),
+  .smiRespEofc        (smiFuzzRespEofc33),
+  .smiRespData        (smiFuzzRespData33),
+  .smiRespStop        (smiFuzzRespStop33),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport33req_0Ready = smiFuzzReqReady33;
+assign smiport33req_0Data  = { smiFuzzReqEofc33, smiFuzzReqData33 };
+assign smiFuzzReqStop33    = smiport33req_0Stop;
+assign smiFuzzRespReady33  = smiport33resp_0Ready;
+assign smiFuzzRespEofc33   = smiport33resp_0Data [71:64];
+assign smiFuzzRespData33   = smiport33resp_0Data [63:0];
+assign smiport33resp_0Stop = smiFuzzRespStop33;
+
+//
+// Instantiate the fuzz tester for SMI port 34.
+//
+assign fuzzMemAddrBase34 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd34);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h394CCFD3C46DE466)) fuzzTester34 (
+  .configValid        (fuzzConfigValid [34]),
+  .configMemAddrBase  (fuzzMemAddrBase34),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [34]),
+  .statusValid        (fuzzStatusValid [34]),
+  .statusErrorCount   (fuzzStatusErrorCount34),
+  .statusDataCount    (fuzzStatusDataCount34),
+  .statusStop         (fuzzStatusStop [34]),
+  .smiReqValid        (smiFuzzReqReady34),
+  .smiReqEofc         (smiFuzzReqEofc34),
+  .smiReqData         (smiFuzzReqData34),
+  .smiReqStop         (smiFuzzReqStop34),
+  .smiRespValid       (smiFuzzRespReady34),
+  .smiRespEofc        (smiFuzzRespEofc34),
+  .smiRespData        (smiFuzzRespData34),
+  .smiRespStop        (smiFuzzRespStop34),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport34req_0Ready = smiFuzzReqReady34;
+assign smiport34req_0Data  = { smiFuzzReqEofc34, smiFuzzReqData34 };
+assign smiFuzzReqStop34    = smiport34req_0Stop;
+assign smiFuzzRespReady34  = smiport34resp_0Ready;
+assign smiFuzzRespEofc34   = smiport34resp_0Data [71:64];
+assign smiFuzzRespData34   = smiport34resp_0Data [63:0];
+assign smiport34resp_0Stop = smiFuzzRespStop34;
+
+//
+// Instantiate the fuzz tester for SMI port 35.
+//
+assign fuzzMemAddrBase35 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd35);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hBBD5EE4ECFD87DE7)) fuzzTester35 (
+  .configValid        (fuzzConfigValid [35]),
+  .configMemAddrBase  (fuzzMemAddrBase35),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [35]),
+  .statusValid        (fuzzStatusValid [35]),
+  .statusErrorCount   (fuzzStatusErrorCount35),
+  .statusDataCount    (fuzzStatusDataCount35),
+  .statusStop         (fuzzStatusStop [35]),
+  .smiReqValid        (smiFuzzReqReady35),
+  .smiReqEofc         (smiFuzzReqEofc35),
+  .smiReqData         (smiFuzzReqData35),
+  .smiReqStop         (smiFuzzReqStop35),
+  .smiRespValid       (smiFuzzRespReady35),
+  .smiRespEofc        (smiFuzzRespEofc35),
+  .smiRespData        (smiFuzzRespData35),
+  .smiRespStop        (smiFuzzRespStop35),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport35req_0Ready = smiFuzzReqReady35;
+assign smiport35req_0Data  = { smiFuzzReqEofc35, smiFuzzReqData35 };
+assign smiFuzzReqStop35    = smiport35req_0Stop;
+assign smiFuzzRespReady35  = smiport35resp_0Ready;
+assign smiFuzzRespEofc35   = smiport35resp_0Data [71:64];
+assign smiFuzzRespData35   = smiport35resp_0Data [63:0];
+assign smiport35resp_0Stop = smiFuzzRespStop35;
+
+//
+// Instantiate the fuzz tester for SMI port 36.
+//
+assign fuzzMemAddrBase36 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd36);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h1B0E85F1B5BC3556)) fuzzTester36 (
+  .configValid        (fuzzConfigValid [36]),
+  .configMemAddrBase  (fuzzMemAddrBase36),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [36]),
+  .statusValid        (fuzzStatusValid [36]),
+  .statusErrorCount   (fuzzStatusErrorCount36),
+  .statusDataCount    (fuzzStatusDataCount36),
+  .statusStop         (fuzzStatusStop [36]),
+  .smiReqValid        (smiFuzzReqReady36),
+  .smiReqEofc         (smiFuzzReqEofc36),
+  .smiReqData         (smiFuzzReqData36),
+  .smiReqStop         (smiFuzzReqStop36),
+  .smiRespValid       (smiFuzzRespReady36),
+  .smiRespEofc        (smiFuzzRespEofc36),
+  .smiRespData        (smiFuzzRespData36),
+  .smiRespStop        (smiFuzzRespStop36),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport36req_0Ready = smiFuzzReqReady36;
+assign smiport36req_0Data  = { smiFuzzReqEofc36, smiFuzzReqData36 };
+assign smiFuzzReqStop36    = smiport36req_0Stop;
+assign smiFuzzRespReady36  = smiport36resp_0Ready;
+assign smiFuzzRespEofc36   = smiport36resp_0Data [71:64];
+assign smiFuzzRespData36   = smiport36resp_0Data [63:0];
+assign smiport36resp_0Stop = smiFuzzRespStop36;
+
+//
+// Instantiate the fuzz tester for SMI port 37.
+//
+assign fuzzMemAddrBase37 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd37);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h7A4683FA910F6EC0)) fuzzTester37 (
+  .configValid        (fuzzConfigValid [37]),
+  .configMemAddrBase  (fuzzMemAddrBase37),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [37]),
+  .statusValid        (fuzzStatusValid [37]),
+  .statusErrorCount   (fuzzStatusErrorCount37),
+  .statusDataCount    (fuzzStatusDataCount37),
+  .statusStop         (fuzzStatusStop [37]),
+  .smiReqValid        (smiFuzzReqReady37),
+  .smiReqEofc         (smiFuzzReqEofc37),
+  .smiReqData         (smiFuzzReqData37),
+  .smiReqStop         (smiFuzzReqStop37),
+  .smiRespValid       (smiFuzzRespReady37),
+  .smiRespEofc        (smiFuzzRespEofc37),
+  .smiRespData        (smiFuzzRespData37),
+  .smiRespStop        (smiFuzzRespStop37),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport37req_0Ready = smiFuzzReqReady37;
+assign smiport37req_0Data  = { smiFuzzReqEofc37, smiFuzzReqData37 };
+assign smiFuzzReqStop37    = smiport37req_0Stop;
+assign smiFuzzRespReady37  = smiport37resp_0Ready;
+assign smiFuzzRespEofc37   = smiport37resp_0Data [71:64];
+assign smiFuzzRespData37   = smiport37resp_0Data [63:0];
+assign smiport37resp_0Stop = smiFuzzRespStop37;
+
+//
+// Instantiate the fuzz tester for SMI port 38.
+//
+assign fuzzMemAddrBase38 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd38);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h758D1766D8818D8B)) fuzzTester38 (
+  .configValid        (fuzzConfigValid [38]),
+  .configMemAddrBase  (fuzzMemAddrBase38),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [38]),
+  .statusValid        (fuzzStatusValid [38]),
+  .statusErrorCount   (fuzzStatusErrorCount38),
+  .statusDataCount    (fuzzStatusDataCount38),
+  .statusStop         (fuzzStatusStop [38]),
+  .smiReqValid        (smiFuzzReqReady38),
+  .smiReqEofc         (smiFuzzReqEofc38),
+  .smiReqData         (smiFuzzReqData38),
+  .smiReqStop         (smiFuzzReqStop38),
+  .smiRespValid       (smiFuzzRespReady38),
+  .smiRespEofc        (smiFuzzRespEofc38),
+  .smiRespData        (smiFuzzRespData38),
+  .smiRespStop        (smiFuzzRespStop38),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport38req_0Ready = smiFuzzReqReady38;
+assign smiport38req_0Data  = { smiFuzzReqEofc38, smiFuzzReqData38 };
+assign smiFuzzReqStop38    = smiport38req_0Stop;
+assign smiFuzzRespReady38  = smiport38resp_0Ready;
+assign smiFuzzRespEofc38   = smiport38resp_0Data [71:64];
+assign smiFuzzRespData38   = smiport38resp_0Data [63:0];
+assign smiport38resp_0Stop = smiFuzzRespStop38;
+
+//
+// Instantiate the fuzz tester for SMI port 39.
+//
+assign fuzzMemAddrBase39 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd39);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hF9ABC8B8EF397513)) fuzzTester39 (
+  .configValid        (fuzzConfigValid [39]),
+  .configMemAddrBase  (fuzzMemAddrBase39),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [39]),
+  .statusValid        (fuzzStatusValid [39]),
+  .statusErrorCount   (fuzzStatusErrorCount39),
+  .statusDataCount    (fuzzStatusDataCount39),
+  .statusStop         (fuzzStatusStop [39]),
+  .smiReqValid        (smiFuzzReqReady39),
+  .smiReqEofc         (smiFuzzReqEofc39),
+  .smiReqData         (smiFuzzReqData39),
+  .smiReqStop         (smiFuzzReqStop39),
+  .smiRespValid       (smiFuzzRespReady39),
+  .smiRespEofc        (smiFuzzRespEofc39),
+  .smiRespData        (smiFuzzRespData39),
+  .smiRespStop        (smiFuzzRespStop39),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport39req_0Ready = smiFuzzReqReady39;
+assign smiport39req_0Data  = { smiFuzzReqEofc39, smiFuzzReqData39 };
+assign smiFuzzReqStop39    = smiport39req_0Stop;
+assign smiFuzzRespReady39  = smiport39resp_0Ready;
+assign smiFuzzRespEofc39   = smiport39resp_0Data [71:64];
+assign smiFuzzRespData39   = smiport39resp_0Data [63:0];
+assign smiport39resp_0Stop = smiFuzzRespStop39;
+
+//
+// Instantiate the fuzz tester for SMI port 40.
+//
+assign fuzzMemAddrBase40 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd40);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hBF8669A7CDF9D8FF)) fuzzTester40 (
+  .configValid        (fuzzConfigValid [40]),
+  .configMemAddrBase  (fuzzMemAddrBase40),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [40]),
+  .statusValid        (fuzzStatusValid [40]),
+  .statusErrorCount   (fuzzStatusErrorCount40),
+  .statusDataCount    (fuzzStatusDataCount40),
+  .statusStop         (fuzzStatusStop [40]),
+  .smiReqValid        (smiFuzzReqReady40),
+  .smiReqEofc         (smiFuzzReqEofc40),
+  .smiReqData         (smiFuzzReqData40),
+  .smiReqStop         (smiFuzzReqStop40),
+  .smiRespValid       (smiFuzzRespReady40),
+  .smiRespEofc        (smiFuzzRespEofc40),
+  .smiRespData        (smiFuzzRespData40),
+  .smiRespStop        (smiFuzzRespStop40),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport40req_0Ready = smiFuzzReqReady40;
+assign smiport40req_0Data  = { smiFuzzReqEofc40, smiFuzzReqData40 };
+assign smiFuzzReqStop40    = smiport40req_0Stop;
+assign smiFuzzRespReady40  = smiport40resp_0Ready;
+assign smiFuzzRespEofc40   = smiport40resp_0Data [71:64];
+assign smiFuzzRespData40   = smiport40resp_0Data [63:0];
+assign smiport40resp_0Stop = smiFuzzRespStop40;
+
+//
+// Instantiate the fuzz tester for SMI port 41.
+//
+assign fuzzMemAddrBase41 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd41);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hE5AF6FE6E6CED5C1)) fuzzTester41 (
+  .configValid        (fuzzConfigValid [41]),
+  .configMemAddrBase  (fuzzMemAddrBase41),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [41]),
+  .statusValid        (fuzzStatusValid [41]),
+  .statusErrorCount   (fuzzStatusErrorCount41),
+  .statusDataCount    (fuzzStatusDataCount41),
+  .statusStop         (fuzzStatusStop [41]),
+  .smiReqValid        (smiFuzzReqReady41),
+  .smiReqEofc         (smiFuzzReqEofc41),
+  .smiReqData         (smiFuzzReqData41),
+  .smiReqStop         (smiFuzzReqStop41),
+  .smiRespValid       (smiFuzzRespReady41),
+  .smiRespEofc        (smiFuzzRespEofc41),
+  .smiRespData        (smiFuzzRespData41),
+  .smiRespStop        (smiFuzzRespStop41),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport41req_0Ready = smiFuzzReqReady41;
+assign smiport41req_0Data  = { smiFuzzReqEofc41, smiFuzzReqData41 };
+assign smiFuzzReqStop41    = smiport41req_0Stop;
+assign smiFuzzRespReady41  = smiport41resp_0Ready;
+assign smiFuzzRespEofc41   = smiport41resp_0Data [71:64];
+assign smiFuzzRespData41   = smiport41resp_0Data [63:0];
+assign smiport41resp_0Stop = smiFuzzRespStop41;
+
+//
+// Instantiate the fuzz tester for SMI port 42.
+//
+assign fuzzMemAddrBase42 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd42);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h7BBD4E526C342701)) fuzzTester42 (
+  .configValid        (fuzzConfigValid [42]),
+  .configMemAddrBase  (fuzzMemAddrBase42),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [42]),
+  .statusValid        (fuzzStatusValid [42]),
+  .statusErrorCount   (fuzzStatusErrorCount42),
+  .statusDataCount    (fuzzStatusDataCount42),
+  .statusStop         (fuzzStatusStop [42]),
+  .smiReqValid        (smiFuzzReqReady42),
+  .smiReqEofc         (smiFuzzReqEofc42),
+  .smiReqData         (smiFuzzReqData42),
+  .smiReqStop         (smiFuzzReqStop42),
+  .smiRespValid       (smiFuzzRespReady42),
+  .smiRespEofc        (smiFuzzRespEofc42),
+  .smiRespData        (smiFuzzRespData42),
+  .smiRespStop        (smiFuzzRespStop42),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport42req_0Ready = smiFuzzReqReady42;
+assign smiport42req_0Data  = { smiFuzzReqEofc42, smiFuzzReqData42 };
+assign smiFuzzReqStop42    = smiport42req_0Stop;
+assign smiFuzzRespReady42  = smiport42resp_0Ready;
+assign smiFuzzRespEofc42   = smiport42resp_0Data [71:64];
+assign smiFuzzRespData42   = smiport42resp_0Data [63:0];
+assign smiport42resp_0Stop = smiFuzzRespStop42;
+
+//
+// Instantiate the fuzz tester for SMI port 43.
+//
+assign fuzzMemAddrBase43 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd43);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h9BBD637B3558557A)) fuzzTester43 (
+  .configValid        (fuzzConfigValid [43]),
+  .configMemAddrBase  (fuzzMemAddrBase43),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [43]),
+  .statusValid        (fuzzStatusValid [43]),
+  .statusErrorCount   (fuzzStatusErrorCount43),
+  .statusDataCount    (fuzzStatusDataCount43),
+  .statusStop         (fuzzStatusStop [43]),
+  .smiReqValid        (smiFuzzReqReady43),
+  .smiReqEofc         (smiFuzzReqEofc43),
+  .smiReqData         (smiFuzzReqData43),
+  .smiReqStop         (smiFuzzReqStop43),
+  .smiRespValid       (smiFuzzRespReady43),
+  .smiRespEofc        (smiFuzzRespEofc43),
+  .smiRespData        (smiFuzzRespData43),
+  .smiRespStop        (smiFuzzRespStop43),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport43req_0Ready = smiFuzzReqReady43;
+assign smiport43req_0Data  = { smiFuzzReqEofc43, smiFuzzReqData43 };
+assign smiFuzzReqStop43    = smiport43req_0Stop;
+assign smiFuzzRespReady43  = smiport43resp_0Ready;
+assign smiFuzzRespEofc43   = smiport43resp_0Data [71:64];
+assign smiFuzzRespData43   = smiport43resp_0Data [63:0];
+assign smiport43resp_0Stop = smiFuzzRespStop43;
+
+//
+// Instantiate the fuzz tester for SMI port 44.
+//
+assign fuzzMemAddrBase44 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd44);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hEA7381172FA0F086)) fuzzTester44 (
+  .configValid        (fuzzConfigValid [44]),
+  .configMemAddrBase  (fuzzMemAddrBase44),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [44]),
+  .statusValid        (fuzzStatusValid [44]),
+  .statusErrorCount   (fuzzStatusErrorCount44),
+  .statusDataCount    (fuzzStatusDataCount44),
+  .statusStop         (fuzzStatusStop [44]),
+  .smiReqValid        (smiFuzzReqReady44),
+  .smiReqEofc         (smiFuzzReqEofc44),
+  .smiReqData         (smiFuzzReqData44),
+  .smiReqStop         (smiFuzzReqStop44),
+  .smiRespValid       (smiFuzzRespReady44),
+  .smiRespEofc        (smiFuzzRespEofc44),
+  .smiRespData        (smiFuzzRespData44),
+  .smiRespStop        (smiFuzzRespStop44),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport44req_0Ready = smiFuzzReqReady44;
+assign smiport44req_0Data  = { smiFuzzReqEofc44, smiFuzzReqData44 };
+assign smiFuzzReqStop44    = smiport44req_0Stop;
+assign smiFuzzRespReady44  = smiport44resp_0Ready;
+assign smiFuzzRespEofc44   = smiport44resp_0Data [71:64];
+assign smiFuzzRespData44   = smiport44resp_0Data [63:0];
+assign smiport44resp_0Stop = smiFuzzRespStop44;
+
+//
+// Instantiate the fuzz tester for SMI port 45.
+//
+assign fuzzMemAddrBase45 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd45);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h0CD1B3A01EEA0414)) fuzzTester45 (
+  .configValid        (fuzzConfigValid [45]),
+  .configMemAddrBase  (fuzzMemAddrBase45),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [45]),
+  .statusValid        (fuzzStatusValid [45]),
+  .statusErrorCount   (fuzzStatusErrorCount45),
+  .statusDataCount    (fuzzStatusDataCount45),
+  .statusStop         (fuzzStatusStop [45]),
+  .smiReqValid        (smiFuzzReqReady45),
+  .smiReqEofc         (smiFuzzReqEofc45),
+  .smiReqData         (smiFuzzReqData45),
+  .smiReqStop         (smiFuzzReqStop45),
+  .smiRespValid       (smiFuzzRespReady45),
+  .smiRespEofc        (smiFuzzRespEofc45),
+  .smiRespData        (smiFuzzRespData45),
+  .smiRespStop        (smiFuzzRespStop45),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport45req_0Ready = smiFuzzReqReady45;
+assign smiport45req_0Data  = { smiFuzzReqEofc45, smiFuzzReqData45 };
+assign smiFuzzReqStop45    = smiport45req_0Stop;
+assign smiFuzzRespReady45  = smiport45resp_0Ready;
+assign smiFuzzRespEofc45   = smiport45resp_0Data [71:64];
+assign smiFuzzRespData45   = smiport45resp_0Data [63:0];
+assign smiport45resp_0Stop = smiFuzzRespStop45;
+
+//
+// Instantiate the fuzz tester for SMI port 46.
+//
+assign fuzzMemAddrBase46 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd46);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h9AABB6D5D65E3EE4)) fuzzTester46 (
+  .configValid        (fuzzConfigValid [46]),
+  .configMemAddrBase  (fuzzMemAddrBase46),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [46]),
+  .statusValid        (fuzzStatusValid [46]),
+  .statusErrorCount   (fuzzStatusErrorCount46),
+  .statusDataCount    (fuzzStatusDataCount46),
+  .statusStop         (fuzzStatusStop [46]),
+  .smiReqValid        (smiFuzzReqReady46),
+  .smiReqEofc         (smiFuzzReqEofc46),
+  .smiReqData         (smiFuzzReqData46),
+  .smiReqStop         (smiFuzzReqStop46),
+  .smiRespValid       (smiFuzzRespReady46),
+  .smiRespEofc        (smiFuzzRespEofc46),
+  .smiRespData        (smiFuzzRespData46),
+  .smiRespStop        (smiFuzzRespStop46),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport46req_0Ready = smiFuzzReqReady46;
+assign smiport46req_0Data  = { smiFuzzReqEofc46, smiFuzzReqData46 };
+assign smiFuzzReqStop46    = smiport46req_0Stop;
+assign smiFuzzRespReady46  = smiport46resp_0Ready;
+assign smiFuzzRespEofc46   = smiport46resp_0Data [71:64];
+assign smiFuzzRespData46   = smiport46resp_0Data [63:0];
+assign smiport46resp_0Stop = smiFuzzRespStop46;
+
+//
+// Instantiate the fuzz tester for SMI port 47.
+//
+assign fuzzMemAddrBase47 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd47);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h795ED2C109DCF6CB)) fuzzTester47 (
+  .configValid        (fuzzConfigValid [47]),
+  .configMemAddrBase  (fuzzMemAddrBase47),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [47]),
+  .statusValid        (fuzzStatusValid [47]),
+  .statusErrorCount   (fuzzStatusErrorCount47),
+  .statusDataCount    (fuzzStatusDataCount47),
+  .statusStop         (fuzzStatusStop [47]),
+  .smiReqValid        (smiFuzzReqReady47),
+  .smiReqEofc         (smiFuzzReqEofc47),
+  .smiReqData         (smiFuzzReqData47),
+  .smiReqStop         (smiFuzzReqStop47),
+  .smiRespValid       (smiFuzzRespReady47),
+  .smiRespEofc        (smiFuzzRespEofc47),
+  .smiRespData        (smiFuzzRespData47),
+  .smiRespStop        (smiFuzzRespStop47),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport47req_0Ready = smiFuzzReqReady47;
+assign smiport47req_0Data  = { smiFuzzReqEofc47, smiFuzzReqData47 };
+assign smiFuzzReqStop47    = smiport47req_0Stop;
+assign smiFuzzRespReady47  = smiport47resp_0Ready;
+assign smiFuzzRespEofc47   = smiport47resp_0Data [71:64];
+assign smiFuzzRespData47   = smiport47resp_0Data [63:0];
+assign smiport47resp_0Stop = smiFuzzRespStop47;
+
+//
+// Instantiate the fuzz tester for SMI port 48.
+//
+assign fuzzMemAddrBase48 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd48);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h733718583FDB2F91)) fuzzTester48 (
+  .configValid        (fuzzConfigValid [48]),
+  .configMemAddrBase  (fuzzMemAddrBase48),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [48]),
+  .statusValid        (fuzzStatusValid [48]),
+  .statusErrorCount   (fuzzStatusErrorCount48),
+  .statusDataCount    (fuzzStatusDataCount48),
+  .statusStop         (fuzzStatusStop [48]),
+  .smiReqValid        (smiFuzzReqReady48),
+  .smiReqEofc         (smiFuzzReqEofc48),
+  .smiReqData         (smiFuzzReqData48),
+  .smiReqStop         (smiFuzzReqStop48),
+  .smiRespValid       (smiFuzzRespReady48),
+  .smiRespEofc        (smiFuzzRespEofc48),
+  .smiRespData        (smiFuzzRespData48),
+  .smiRespStop        (smiFuzzRespStop48),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport48req_0Ready = smiFuzzReqReady48;
+assign smiport48req_0Data  = { smiFuzzReqEofc48, smiFuzzReqData48 };
+assign smiFuzzReqStop48    = smiport48req_0Stop;
+assign smiFuzzRespReady48  = smiport48resp_0Ready;
+assign smiFuzzRespEofc48   = smiport48resp_0Data [71:64];
+assign smiFuzzRespData48   = smiport48resp_0Data [63:0];
+assign smiport48resp_0Stop = smiFuzzRespStop48;
+
+//
+// Instantiate the fuzz tester for SMI port 49.
+//
+assign fuzzMemAddrBase49 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd49);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h5BF981E9BA867B1E)) fuzzTester49 (
+  .configValid        (fuzzConfigValid [49]),
+  .configMemAddrBase  (fuzzMemAddrBase49),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [49]),
+  .statusValid        (fuzzStatusValid [49]),
+  .statusErrorCount   (fuzzStatusErrorCount49),
+  .statusDataCount    (fuzzStatusDataCount49),
+  .statusStop         (fuzzStatusStop [49]),
+  .smiReqValid        (smiFuzzReqReady49),
+  .smiReqEofc         (smiFuzzReqEofc49),
+  .smiReqData         (smiFuzzReqData49),
+  .smiReqStop         (smiFuzzReqStop49),
+  .smiRespValid       (smiFuzzRespReady49),
+  .smiRespEofc        (smiFuzzRespEofc49),
+  .smiRespData        (smiFuzzRespData49),
+  .smiRespStop        (smiFuzzRespStop49),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport49req_0Ready = smiFuzzReqReady49;
+assign smiport49req_0Data  = { smiFuzzReqEofc49, smiFuzzReqData49 };
+assign smiFuzzReqStop49    = smiport49req_0Stop;
+assign smiFuzzRespReady49  = smiport49resp_0Ready;
+assign smiFuzzRespEofc49   = smiport49resp_0Data [71:64];
+assign smiFuzzRespData49   = smiport49resp_0Data [63:0];
+assign smiport49resp_0Stop = smiFuzzRespStop49;
+
+//
+// Instantiate the fuzz tester for SMI port 50.
+//
+assign fuzzMemAddrBase50 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd50);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h52012C0006D5C647)) fuzzTester50 (
+  .configValid        (fuzzConfigValid [50]),
+  .configMemAddrBase  (fuzzMemAddrBase50),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [50]),
+  .statusValid        (fuzzStatusValid [50]),
+  .statusErrorCount   (fuzzStatusErrorCount50),
+  .statusDataCount    (fuzzStatusDataCount50),
+  .statusStop         (fuzzStatusStop [50]),
+  .smiReqValid        (smiFuzzReqReady50),
+  .smiReqEofc         (smiFuzzReqEofc50),
+  .smiReqData         (smiFuzzReqData50),
+  .smiReqStop         (smiFuzzReqStop50),
+  .smiRespValid       (smiFuzzRespReady50),
+  .smiRespEofc        (smiFuzzRespEofc50),
+  .smiRespData        (smiFuzzRespData50),
+  .smiRespStop        (smiFuzzRespStop50),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport50req_0Ready = smiFuzzReqReady50;
+assign smiport50req_0Data  = { smiFuzzReqEofc50, smiFuzzReqData50 };
+assign smiFuzzReqStop50    = smiport50req_0Stop;
+assign smiFuzzRespReady50  = smiport50resp_0Ready;
+assign smiFuzzRespEofc50   = smiport50resp_0Data [71:64];
+assign smiFuzzRespData50   = smiport50resp_0Data [63:0];
+assign smiport50resp_0Stop = smiFuzzRespStop50;
+
+//
+// Instantiate the fuzz tester for SMI port 51.
+//
+assign fuzzMemAddrBase51 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd51);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h27A47971D3CEBC47)) fuzzTester51 (
+  .configValid        (fuzzConfigValid [51]),
+  .configMemAddrBase  (fuzzMemAddrBase51),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [51]),
+  .statusValid        (fuzzStatusValid [51]),
+  .statusErrorCount   (fuzzStatusErrorCount51),
+  .statusDataCount    (fuzzStatusDataCount51),
+  .statusStop         (fuzzStatusStop [51]),
+  .smiReqValid        (smiFuzzReqReady51),
+  .smiReqEofc         (smiFuzzReqEofc51),
+  .smiReqData         (smiFuzzReqData51),
+  .smiReqStop         (smiFuzzReqStop51),
+  .smiRespValid       (smiFuzzRespReady51),
+  .smiRespEofc        (smiFuzzRespEofc51),
+  .smiRespData        (smiFuzzRespData51),
+  .smiRespStop        (smiFuzzRespStop51),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport51req_0Ready = smiFuzzReqReady51;
+assign smiport51req_0Data  = { smiFuzzReqEofc51, smiFuzzReqData51 };
+assign smiFuzzReqStop51    = smiport51req_0Stop;
+assign smiFuzzRespReady51  = smiport51resp_0Ready;
+assign smiFuzzRespEofc51   = smiport51resp_0Data [71:64];
+assign smiFuzzRespData51   = smiport51resp_0Data [63:0];
+assign smiport51resp_0Stop = smiFuzzRespStop51;
+
+//
+// Instantiate the fuzz tester for SMI port 52.
+//
+assign fuzzMemAddrBase52 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd52);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hD77D3BF785CFB71A)) fuzzTester52 (
+  .configValid        (fuzzConfigValid [52]),
+  .configMemAddrBase  (fuzzMemAddrBase52),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [52]),
+  .statusValid        (fuzzStatusValid [52]),
+  .statusErrorCount   (fuzzStatusErrorCount52),
+  .statusDataCount    (fuzzStatusDataCount52),
+  .statusStop         (fuzzStatusStop [52]),
+  .smiReqValid        (smiFuzzReqReady52),
+  .smiReqEofc         (smiFuzzReqEofc52),
+  .smiReqData         (smiFuzzReqData52),
+  .smiReqStop         (smiFuzzReqStop52),
+  .smiRespValid       (smiFuzzRespReady52),
+  .smiRespEofc        (smiFuzzRespEofc52),
+  .smiRespData        (smiFuzzRespData52),
+  .smiRespStop        (smiFuzzRespStop52),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport52req_0Ready = smiFuzzReqReady52;
+assign smiport52req_0Data  = { smiFuzzReqEofc52, smiFuzzReqData52 };
+assign smiFuzzReqStop52    = smiport52req_0Stop;
+assign smiFuzzRespReady52  = smiport52resp_0Ready;
+assign smiFuzzRespEofc52   = smiport52resp_0Data [71:64];
+assign smiFuzzRespData52   = smiport52resp_0Data [63:0];
+assign smiport52resp_0Stop = smiFuzzRespStop52;
+
+//
+// Instantiate the fuzz tester for SMI port 53.
+//
+assign fuzzMemAddrBase53 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd53);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h6F61A0A753878F12)) fuzzTester53 (
+  .configValid        (fuzzConfigValid [53]),
+  .configMemAddrBase  (fuzzMemAddrBase53),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [53]),
+  .statusValid        (fuzzStatusValid [53]),
+  .statusErrorCount   (fuzzStatusErrorCount53),
+  .statusDataCount    (fuzzStatusDataCount53),
+  .statusStop         (fuzzStatusStop [53]),
+  .smiReqValid        (smiFuzzReqReady53),
+  .smiReqEofc         (smiFuzzReqEofc53),
+  .smiReqData         (smiFuzzReqData53),
+  .smiReqStop         (smiFuzzReqStop53),
+  .smiRespValid       (smiFuzzRespReady53),
+  .smiRespEofc        (smiFuzzRespEofc53),
+  .smiRespData        (smiFuzzRespData53),
+  .smiRespStop        (smiFuzzRespStop53),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport53req_0Ready = smiFuzzReqReady53;
+assign smiport53req_0Data  = { smiFuzzReqEofc53, smiFuzzReqData53 };
+assign smiFuzzReqStop53    = smiport53req_0Stop;
+assign smiFuzzRespReady53  = smiport53resp_0Ready;
+assign smiFuzzRespEofc53   = smiport53resp_0Data [71:64];
+assign smiFuzzRespData53   = smiport53resp_0Data [63:0];
+assign smiport53resp_0Stop = smiFuzzRespStop53;
+
+//
+// Instantiate the fuzz tester for SMI port 54.
+//
+assign fuzzMemAddrBase54 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd54);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h927EBB69A35CC997)) fuzzTester54 (
+  .configValid        (fuzzConfigValid [54]),
+  .configMemAddrBase  (fuzzMemAddrBase54),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [54]),
+  .statusValid        (fuzzStatusValid [54]),
+  .statusErrorCount   (fuzzStatusErrorCount54),
+  .statusDataCount    (fuzzStatusDataCount54),
+  .statusStop         (fuzzStatusStop [54]),
+  .smiReqValid        (smiFuzzReqReady54),
+  .smiReqEofc         (smiFuzzReqEofc54),
+  .smiReqData         (smiFuzzReqData54),
+  .smiReqStop         (smiFuzzReqStop54),
+  .smiRespValid       (smiFuzzRespReady54),
+  .smiRespEofc        (smiFuzzRespEofc54),
+  .smiRespData        (smiFuzzRespData54),
+  .smiRespStop        (smiFuzzRespStop54),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport54req_0Ready = smiFuzzReqReady54;
+assign smiport54req_0Data  = { smiFuzzReqEofc54, smiFuzzReqData54 };
+assign smiFuzzReqStop54    = smiport54req_0Stop;
+assign smiFuzzRespReady54  = smiport54resp_0Ready;
+assign smiFuzzRespEofc54   = smiport54resp_0Data [71:64];
+assign smiFuzzRespData54   = smiport54resp_0Data [63:0];
+assign smiport54resp_0Stop = smiFuzzRespStop54;
+
+//
+// Instantiate the fuzz tester for SMI port 55.
+//
+assign fuzzMemAddrBase55 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd55);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h80F0487FE74DE466)) fuzzTester55 (
+  .configValid        (fuzzConfigValid [55]),
+  .configMemAddrBase  (fuzzMemAddrBase55),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [55]),
+  .statusValid        (fuzzStatusValid [55]),
+  .statusErrorCount   (fuzzStatusErrorCount55),
+  .statusDataCount    (fuzzStatusDataCount55),
+  .statusStop         (fuzzStatusStop [55]),
+  .smiReqValid        (smiFuzzReqReady55),
+  .smiReqEofc         (smiFuzzReqEofc55),
+  .smiReqData         (smiFuzzReqData55),
+  .smiReqStop         (smiFuzzReqStop55),
+  .smiRespValid       (smiFuzzRespReady55),
+  .smiRespEofc        (smiFuzzRespEofc55),
+  .smiRespData        (smiFuzzRespData55),
+  .smiRespStop        (smiFuzzRespStop55),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport55req_0Ready = smiFuzzReqReady55;
+assign smiport55req_0Data  = { smiFuzzReqEofc55, smiFuzzReqData55 };
+assign smiFuzzReqStop55    = smiport55req_0Stop;
+assign smiFuzzRespReady55  = smiport55resp_0Ready;
+assign smiFuzzRespEofc55   = smiport55resp_0Data [71:64];
+assign smiFuzzRespData55   = smiport55resp_0Data [63:0];
+assign smiport55resp_0Stop = smiFuzzRespStop55;
+
+//
+// Instantiate the fuzz tester for SMI port 56.
+//
+assign fuzzMemAddrBase56 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd56);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h369B141583828B47)) fuzzTester56 (
+  .configValid        (fuzzConfigValid [56]),
+  .configMemAddrBase  (fuzzMemAddrBase56),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [56]),
+  .statusValid        (fuzzStatusValid [56]),
+  .statusErrorCount   (fuzzStatusErrorCount56),
+  .statusDataCount    (fuzzStatusDataCount56),
+  .statusStop         (fuzzStatusStop [56]),
+  .smiReqValid        (smiFuzzReqReady56),
+  .smiReqEofc         (smiFuzzReqEofc56),
+  .smiReqData         (smiFuzzReqData56),
+  .smiReqStop         (smiFuzzReqStop56),
+  .smiRespValid       (smiFuzzRespReady56),
+  .smiRespEofc        (smiFuzzRespEofc56),
+  .smiRespData        (smiFuzzRespData56),
+  .smiRespStop        (smiFuzzRespStop56),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport56req_0Ready = smiFuzzReqReady56;
+assign smiport56req_0Data  = { smiFuzzReqEofc56, smiFuzzReqData56 };
+assign smiFuzzReqStop56    = smiport56req_0Stop;
+assign smiFuzzRespReady56  = smiport56resp_0Ready;
+assign smiFuzzRespEofc56   = smiport56resp_0Data [71:64];
+assign smiFuzzRespData56   = smiport56resp_0Data [63:0];
+assign smiport56resp_0Stop = smiFuzzRespStop56;
+
+//
+// Instantiate the fuzz tester for SMI port 57.
+//
+assign fuzzMemAddrBase57 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd57);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h6FECC38739253976)) fuzzTester57 (
+  .configValid        (fuzzConfigValid [57]),
+  .configMemAddrBase  (fuzzMemAddrBase57),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [57]),
+  .statusValid        (fuzzStatusValid [57]),
+  .statusErrorCount   (fuzzStatusErrorCount57),
+  .statusDataCount    (fuzzStatusDataCount57),
+  .statusStop         (fuzzStatusStop [57]),
+  .smiReqValid        (smiFuzzReqReady57),
+  .smiReqEofc         (smiFuzzReqEofc57),
+  .smiReqData         (smiFuzzReqData57),
+  .smiReqStop         (smiFuzzReqStop57),
+  .smiRespValid       (smiFuzzRespReady57),
+  .smiRespEofc        (smiFuzzRespEofc57),
+  .smiRespData        (smiFuzzRespData57),
+  .smiRespStop        (smiFuzzRespStop57),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport57req_0Ready = smiFuzzReqReady57;
+assign smiport57req_0Data  = { smiFuzzReqEofc57, smiFuzzReqData57 };
+assign smiFuzzReqStop57    = smiport57req_0Stop;
+assign smiFuzzRespReady57  = smiport57resp_0Ready;
+assign smiFuzzRespEofc57   = smiport57resp_0Data [71:64];
+assign smiFuzzRespData57   = smiport57resp_0Data [63:0];
+assign smiport57resp_0Stop = smiFuzzRespStop57;
+
+//
+// Instantiate the fuzz tester for SMI port 58.
+//
+assign fuzzMemAddrBase58 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd58);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h380554A18AA023F3)) fuzzTester58 (
+  .configValid        (fuzzConfigValid [58]),
+  .configMemAddrBase  (fuzzMemAddrBase58),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [58]),
+  .statusValid        (fuzzStatusValid [58]),
+  .statusErrorCount   (fuzzStatusErrorCount58),
+  .statusDataCount    (fuzzStatusDataCount58),
+  .statusStop         (fuzzStatusStop [58]),
+  .smiReqValid        (smiFuzzReqReady58),
+  .smiReqEofc         (smiFuzzReqEofc58),
+  .smiReqData         (smiFuzzReqData58),
+  .smiReqStop         (smiFuzzReqStop58),
+  .smiRespValid       (smiFuzzRespReady58),
+  .smiRespEofc        (smiFuzzRespEofc58),
+  .smiRespData        (smiFuzzRespData58),
+  .smiRespStop        (smiFuzzRespStop58),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport58req_0Ready = smiFuzzReqReady58;
+assign smiport58req_0Data  = { smiFuzzReqEofc58, smiFuzzReqData58 };
+assign smiFuzzReqStop58    = smiport58req_0Stop;
+assign smiFuzzRespReady58  = smiport58resp_0Ready;
+assign smiFuzzRespEofc58   = smiport58resp_0Data [71:64];
+assign smiFuzzRespData58   = smiport58resp_0Data [63:0];
+assign smiport58resp_0Stop = smiFuzzRespStop58;
+
+//
+// Instantiate the fuzz tester for SMI port 59.
+//
+assign fuzzMemAddrBase59 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd59);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h88389B30C449E6B0)) fuzzTester59 (
+  .configValid        (fuzzConfigValid [59]),
+  .configMemAddrBase  (fuzzMemAddrBase59),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [59]),
+  .statusValid        (fuzzStatusValid [59]),
+  .statusErrorCount   (fuzzStatusErrorCount59),
+  .statusDataCount    (fuzzStatusDataCount59),
+  .statusStop         (fuzzStatusStop [59]),
+  .smiReqValid        (smiFuzzReqReady59),
+  .smiReqEofc         (smiFuzzReqEofc59),
+  .smiReqData         (smiFuzzReqData59),
+  .smiReqStop         (smiFuzzReqStop59),
+  .smiRespValid       (smiFuzzRespReady59),
+  .smiRespEofc        (smiFuzzRespEofc59),
+  .smiRespData        (smiFuzzRespData59),
+  .smiRespStop        (smiFuzzRespStop59),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport59req_0Ready = smiFuzzReqReady59;
+assign smiport59req_0Data  = { smiFuzzReqEofc59, smiFuzzReqData59 };
+assign smiFuzzReqStop59    = smiport59req_0Stop;
+assign smiFuzzRespReady59  = smiport59resp_0Ready;
+assign smiFuzzRespEofc59   = smiport59resp_0Data [71:64];
+assign smiFuzzRespData59   = smiport59resp_0Data [63:0];
+assign smiport59resp_0Stop = smiFuzzRespStop59;
+
+//
+// Instantiate the fuzz tester for SMI port 60.
+//
+assign fuzzMemAddrBase60 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd60);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h9B4BAB767D8F1389)) fuzzTester60 (
+  .configValid        (fuzzConfigValid [60]),
+  .configMemAddrBase  (fuzzMemAddrBase60),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [60]),
+  .statusValid        (fuzzStatusValid [60]),
+  .statusErrorCount   (fuzzStatusErrorCount60),
+  .statusDataCount    (fuzzStatusDataCount60),
+  .statusStop         (fuzzStatusStop [60]),
+  .smiReqValid        (smiFuzzReqReady60),
+  .smiReqEofc         (smiFuzzReqEofc60),
+  .smiReqData         (smiFuzzReqData60),
+  .smiReqStop         (smiFuzzReqStop60),
+  .smiRespValid       (smiFuzzRespReady60),
+  .smiRespEofc        (smiFuzzRespEofc60),
+  .smiRespData        (smiFuzzRespData60),
+  .smiRespStop        (smiFuzzRespStop60),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport60req_0Ready = smiFuzzReqReady60;
+assign smiport60req_0Data  = { smiFuzzReqEofc60, smiFuzzReqData60 };
+assign smiFuzzReqStop60    = smiport60req_0Stop;
+assign smiFuzzRespReady60  = smiport60resp_0Ready;
+assign smiFuzzRespEofc60   = smiport60resp_0Data [71:64];
+assign smiFuzzRespData60   = smiport60resp_0Data [63:0];
+assign smiport60resp_0Stop = smiFuzzRespStop60;
+
+//
+// Instantiate the fuzz tester for SMI port 61.
+//
+assign fuzzMemAddrBase61 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd61);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h7FECBAF76B2CEF7E)) fuzzTester61 (
+  .configValid        (fuzzConfigValid [61]),
+  .configMemAddrBase  (fuzzMemAddrBase61),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [61]),
+  .statusValid        (fuzzStatusValid [61]),
+  .statusErrorCount   (fuzzStatusErrorCount61),
+  .statusDataCount    (fuzzStatusDataCount61),
+  .statusStop         (fuzzStatusStop [61]),
+  .smiReqValid        (smiFuzzReqReady61),
+  .smiReqEofc         (smiFuzzReqEofc61),
+  .smiReqData         (smiFuzzReqData61),
+  .smiReqStop         (smiFuzzReqStop61),
+  .smiRespValid       (smiFuzzRespReady61),
+  .smiRespEofc        (smiFuzzRespEofc61),
+  .smiRespData        (smiFuzzRespData61),
+  .smiRespStop        (smiFuzzRespStop61),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport61req_0Ready = smiFuzzReqReady61;
+assign smiport61req_0Data  = { smiFuzzReqEofc61, smiFuzzReqData61 };
+assign smiFuzzReqStop61    = smiport61req_0Stop;
+assign smiFuzzRespReady61  = smiport61resp_0Ready;
+assign smiFuzzRespEofc61   = smiport61resp_0Data [71:64];
+assign smiFuzzRespData61   = smiport61resp_0Data [63:0];
+assign smiport61resp_0Stop = smiFuzzRespStop61;
+
+//
+// Instantiate the fuzz tester for SMI port 62.
+//
+assign fuzzMemAddrBase62 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd62);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'h265907500DBF5CF6)) fuzzTester62 (
+  .configValid        (fuzzConfigValid [62]),
+  .configMemAddrBase  (fuzzMemAddrBase62),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [62]),
+  .statusValid        (fuzzStatusValid [62]),
+  .statusErrorCount   (fuzzStatusErrorCount62),
+  .statusDataCount    (fuzzStatusDataCount62),
+  .statusStop         (fuzzStatusStop [62]),
+  .smiReqValid        (smiFuzzReqReady62),
+  .smiReqEofc         (smiFuzzReqEofc62),
+  .smiReqData         (smiFuzzReqData62),
+  .smiReqStop         (smiFuzzReqStop62),
+  .smiRespValid       (smiFuzzRespReady62),
+  .smiRespEofc        (smiFuzzRespEofc62),
+  .smiRespData        (smiFuzzRespData62),
+  .smiRespStop        (smiFuzzRespStop62),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport62req_0Ready = smiFuzzReqReady62;
+assign smiport62req_0Data  = { smiFuzzReqEofc62, smiFuzzReqData62 };
+assign smiFuzzReqStop62    = smiport62req_0Stop;
+assign smiFuzzRespReady62  = smiport62resp_0Ready;
+assign smiFuzzRespEofc62   = smiport62resp_0Data [71:64];
+assign smiFuzzRespData62   = smiport62resp_0Data [63:0];
+assign smiport62resp_0Stop = smiFuzzRespStop62;
+
+//
+// Instantiate the fuzz tester for SMI port 63.
+//
+assign fuzzMemAddrBase63 = memBaseAddr + ({ 32'd0, memBlockSize } * 64'd63);
+
+smiMemLibFuzzTestBurst64 #(.RandSeed (64'hDB01303D1A33752C)) fuzzTester63 (
+  .configValid        (fuzzConfigValid [63]),
+  .configMemAddrBase  (fuzzMemAddrBase63),
+  .configMemBlockSize (memBlockSize),
+  .configNumTests     (fuzzTestCount),
+  .configStop         (fuzzConfigStop [63]),
+  .statusValid        (fuzzStatusValid [63]),
+  .statusErrorCount   (fuzzStatusErrorCount63),
+  .statusDataCount    (fuzzStatusDataCount63),
+  .statusStop         (fuzzStatusStop [63]),
+  .smiReqValid        (smiFuzzReqReady63),
+  .smiReqEofc         (smiFuzzReqEofc63),
+  .smiReqData         (smiFuzzReqData63),
+  .smiReqStop         (smiFuzzReqStop63),
+  .smiRespValid       (smiFuzzRespReady63),
+  .smiRespEofc        (smiFuzzRespEofc63),
+  .smiRespData        (smiFuzzRespData63),
+  .smiRespStop        (smiFuzzRespStop63),
+  .clk                (clk),
+  .srst               (reset)
+);
+
+assign smiport63req_0Ready = smiFuzzReqReady63;
+assign smiport63req_0Data  = { smiFuzzReqEofc63, smiFuzzReqData63 };
+assign smiFuzzReqStop63    = smiport63req_0Stop;
+assign smiFuzzRespReady63  = smiport63resp_0Ready;
+assign smiFuzzRespEofc63   = smiport63resp_0Data [71:64];
+assign smiFuzzRespData63   = smiport63resp_0Data [63:0];
+assign smiport63resp_0Stop = smiFuzzRespStop63;
+
+//
+// Implement AXI read control loopback, returning the error count.
+//
+always @(posedge clk)
+begin
+  if (s_axi_read_complete_q)
+  begin
+    s_axi_read_complete_q <= ~s_axi_rready;
+  end
+  else if (s_axi_read_ready_q)
+  begin
+    s_axi_read_ready_q <= 1'b0;
+    s_axi_read_complete_q <= 1'b1;
+  end
+  else
+  begin
+    s_axi_read_ready_q <= s_axi_arvalid;
+  end
+end
+
+assign s_axi_arready = s_axi_read_ready_q;
+assign s_axi_rdata = errorCount_q;
+assign s_axi_rresp = 2'b0;
+assign s_axi_rvalid = s_axi_read_complete_q;
+
+//
+// Implement AXI write control loopback.
+//
+always @(posedge clk)
+begin
+  if (s_axi_write_complete_q)
+  begin
+    s_axi_write_complete_q <= ~s_axi_bready;
+  end
+  else if (s_axi_write_ready_q)
+  begin
+    s_axi_write_ready_q <= 1'b0;
+    s_axi_write_complete_q <= 1'b1;
+  end
+  else
+  begin
+    s_axi_write_ready_q <= s_axi_awvalid & s_axi_wvalid;
+  end
+end
+
+assign s_axi_awready = s_axi_write_ready_q;
+assign s_axi_wready = s_axi_write_ready_q;
+assign s_axi_bresp = 2'b0;
+assign s_axi_bvalid = s_axi_write_complete_q;
+
+endmodule
@@ -0,0 +1,2409 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Created Mon, 01 Jan 2018 00:00:00 UTC
+// Machine generated file - DO NOT EDIT
+//
+
+`timescale 1ns/1ps
+
+module fp1_teak_action_top_gmem (
+
+  // Action control signals.
+  input          go_ready,
+  output         go_stop,
+  output         done_ready,
+  input          done_stop,
+
+  // Configuration register file access signals.
+  output         config_req_valid,
+  output [ 31:0] config_req_data,
+  input          config_req_stop,
+  input          config_resp_valid,
+  input  [ 31:0] config_resp_data,
+  output         config_resp_stop,
+
+  // Kernel internal register access signals.
+  input          control_req_valid,
+  input  [ 64:0] control_req_data,
+  output         control_req_stop,
+  output         control_resp_valid,
+  output [ 33:0] control_resp_data,
+  input          control_resp_stop,
+
+  // Kernel interrupt queue signals.
+  output         interrupt_valid,
+  output [ 31:0] interrupt_data,
+  input          interrupt_stop,
+
+  // Specifies the AXI master write address signals.
+  output [ 63:0] m_axi_gmem_awaddr,
+  output [  7:0] m_axi_gmem_awlen,
+  output [  2:0] m_axi_gmem_awsize,
+  output [  0:0] m_axi_gmem_awid,
+  output         m_axi_gmem_awvalid,
+  input          m_axi_gmem_awready,
+
+  // Specifies the AXI master write data signals.
+  output [511:0] m_axi_gmem_wdata,
+  output [ 63:0] m_axi_gmem_wstrb,
+  output         m_axi_gmem_wlast,
+  output [  0:0] m_axi_gmem_wid,
+  output         m_axi_gmem_wvalid,
+  input          m_axi_gmem_wready,
+
+  // Specifies the AXI master write response signals.
+  input  [  1:0] m_axi_gmem_bresp,
+  input  [  0:0] m_axi_gmem_bid,
+  input          m_axi_gmem_bvalid,
+  output         m_axi_gmem_bready,
+
+  // Specifies the AXI master read address signals.
+  output [ 63:0] m_axi_gmem_araddr,
+  output [  7:0] m_axi_gmem_arlen,
+  output [  2:0] m_axi_gmem_arsize,
+  output [  0:0] m_axi_gmem_arid,
+  output         m_axi_gmem_arvalid,
+  input          m_axi_gmem_arready,
+
+  // Specifies the AXI master read data signals.
+  input  [511:0] m_axi_gmem_rdata,
+  input  [  1:0] m_axi_gmem_rresp,
+  input          m_axi_gmem_rlast,
+  input  [  0:0] m_axi_gmem_rid,
+  input          m_axi_gmem_rvalid,
+  output         m_axi_gmem_rready,
+
+  // Specify system level signals.
+  input          clk,
+  input          reset
+);
+
+// SMI connections for smiMemServerReq/smiMemServerResp
+wire         smiMemServerReqReady;
+wire [  7:0] smiMemServerReqEofc;
+wire [511:0] smiMemServerReqData;
+wire         smiMemServerReqStop;
+wire         smiMemServerRespReady;
+wire [  7:0] smiMemServerRespEofc;
+wire [511:0] smiMemServerRespData;
+wire         smiMemServerRespStop;
+
+// SMI connections for smiMemClientReq0/smiMemClientResp0
+wire         smiMemClientReq0Ready;
+wire [  7:0] smiMemClientReq0Eofc;
+wire [ 63:0] smiMemClientReq0Data;
+wire         smiMemClientReq0Stop;
+wire         smiMemClientResp0Ready;
+wire [  7:0] smiMemClientResp0Eofc;
+wire [ 63:0] smiMemClientResp0Data;
+wire         smiMemClientResp0Stop;
+
+// SMI connections for smiMemClientReq1/smiMemClientResp1
+wire         smiMemClientReq1Ready;
+wire [  7:0] smiMemClientReq1Eofc;
+wire [ 63:0] smiMemClientReq1Data;
+wire         smiMemClientReq1Stop;
+wire         smiMemClientResp1Ready;
+wire [  7:0] smiMemClientResp1Eofc;
+wire [ 63:0] smiMemClientResp1Data;
+wire         smiMemClientResp1Stop;
+
+// SMI connections for smiMemClientReq2/smiMemClientResp2
+wire         smiMemClientReq2Ready;
+wire [  7:0] smiMemClientReq2Eofc;
+wire [ 63:0] smiMemClientReq2Data;
+wire         smiMemClientReq2Stop;
+wire         smiMemClientResp2Ready;
+wire [  7:0] smiMemClientResp2Eofc;
+wire [ 63:0] smiMemClientResp2Data;
+wire         smiMemClientResp2Stop;
+
+// SMI connections for smiMemClientReq3/smiMemClientResp3
+wire         smiMemClientReq3Ready;
+wire [  7:0] smiMemClientReq3Eofc;
+wire [ 63:0] smiMemClientReq3Data;
+wire         smiMemClientReq3Stop;
+wire         smiMemClientResp3Ready;
+wire [  7:0] smiMemClientResp3Eofc;
+wire [ 63:0] smiMemClientResp3Data;
+wire         smiMemClientResp3Stop;
+
+// SMI connections for smiMemClientReq4/smiMemClientResp4
+wire         smiMemClientReq4Ready;
+wire [  7:0] smiMemClientReq4Eofc;
+wire [ 63:0] smiMemClientReq4Data;
+wire         smiMemClientReq4Stop;
+wire         smiMemClientResp4Ready;
+wire [  7:0] smiMemClientResp4Eofc;
+wire [ 63:0] smiMemClientResp4Data;
+wire         smiMemClientResp4Stop;
+
+// SMI connections for smiMemClientReq5/smiMemClientResp5
+wire         smiMemClientReq5Ready;
+wire [  7:0] smiMemClientReq5Eofc;
+wire [ 63:0] smiMemClientReq5Data;
+wire         smiMemClientReq5Stop;
+wire         smiMemClientResp5Ready;
+wire [  7:0] smiMemClientResp5Eofc;
+wire [ 63:0] smiMemClientResp5Data;
+wire         smiMemClientResp5Stop;
+
+// SMI connections for smiMemClientReq6/smiMemClientResp6
+wire         smiMemClientReq6Ready;
+wire [  7:0] smiMemClientReq6Eofc;
+wire [ 63:0] smiMemClientReq6Data;
+wire         smiMemClientReq6Stop;
+wire         smiMemClientResp6Ready;
+wire [  7:0] smiMemClientResp6Eofc;
+wire [ 63:0] smiMemClientResp6Data;
+wire         smiMemClientResp6Stop;
+
+// SMI connections for smiMemClientReq7/smiMemClientResp7
+wire         smiMemClientReq7Ready;
+wire [  7:0] smiMemClientReq7Eofc;
+wire [ 63:0] smiMemClientReq7Data;
+wire         smiMemClientReq7Stop;
+wire         smiMemClientResp7Ready;
+wire [  7:0] smiMemClientResp7Eofc;
+wire [ 63:0] smiMemClientResp7Data;
+wire         smiMemClientResp7Stop;
+
+// SMI connections for smiMemClientReq8/smiMemClientResp8
+wire         smiMemClientReq8Ready;
+wire [  7:0] smiMemClientReq8Eofc;
+wire [ 63:0] smiMemClientReq8Data;
+wire         smiMemClientReq8Stop;
+wire         smiMemClientResp8Ready;
+wire [  7:0] smiMemClientResp8Eofc;
+wire [ 63:0] smiMemClientResp8Data;
+wire         smiMemClientResp8Stop;
+
+// SMI connections for smiMemClientReq9/smiMemClientResp9
+wire         smiMemClientReq9Ready;
+wire [  7:0] smiMemClientReq9Eofc;
+wire [ 63:0] smiMemClientReq9Data;
+wire         smiMemClientReq9Stop;
+wire         smiMemClientResp9Ready;
+wire [  7:0] smiMemClientResp9Eofc;
+wire [ 63:0] smiMemClientResp9Data;
+wire         smiMemClientResp9Stop;
+
+// SMI connections for smiMemClientReq10/smiMemClientResp10
+wire         smiMemClientReq10Ready;
+wire [  7:0] smiMemClientReq10Eofc;
+wire [ 63:0] smiMemClientReq10Data;
+wire         smiMemClientReq10Stop;
+wire         smiMemClientResp10Ready;
+wire [  7:0] smiMemClientResp10Eofc;
+wire [ 63:0] smiMemClientResp10Data;
+wire         smiMemClientResp10Stop;
+
+// SMI connections for smiMemClientReq11/smiMemClientResp11
+wire         smiMemClientReq11Ready;
+wire [  7:0] smiMemClientReq11Eofc;
+wire [ 63:0] smiMemClientReq11Data;
+wire         smiMemClientReq11Stop;
+wire         smiMemClientResp11Ready;
+wire [  7:0] smiMemClientResp11Eofc;
+wire [ 63:0] smiMemClientResp11Data;
+wire         smiMemClientResp11Stop;
+
+// SMI connections for smiMemClientReq12/smiMemClientResp12
+wire         smiMemClientReq12Ready;
+wire [  7:0] smiMemClientReq12Eofc;
+wire [ 63:0] smiMemClientReq12Data;
+wire         smiMemClientReq12Stop;
+wire         smiMemClientResp12Ready;
+wire [  7:0] smiMemClientResp12Eofc;
+wire [ 63:0] smiMemClientResp12Data;
+wire         smiMemClientResp12Stop;
+
+// SMI connections for smiMemClientReq13/smiMemClientResp13
+wire         smiMemClientReq13Ready;
+wire [  7:0] smiMemClientReq13Eofc;
+wire [ 63:0] smiMemClientReq13Data;
+wire         smiMemClientReq13Stop;
+wire         smiMemClientResp13Ready;
+wire [  7:0] smiMemClientResp13Eofc;
+wire [ 63:0] smiMemClientResp13Data;
+wire         smiMemClientResp13Stop;
+
+// SMI connections for smiMemClientReq14/smiMemClientResp14
+wire         smiMemClientReq14Ready;
+wire [  7:0] smiMemClientReq14Eofc;
+wire [ 63:0] smiMemClientReq14Data;
+wire         smiMemClientReq14Stop;
+wire         smiMemClientResp14Ready;
+wire [  7:0] smiMemClientResp14Eofc;
+wire [ 63:0] smiMemClientResp14Data;
+wire         smiMemClientResp14Stop;
+
+// SMI connections for smiMemClientReq15/smiMemClientResp15
+wire         smiMemClientReq15Ready;
+wire [  7:0] smiMemClientReq15Eofc;
+wire [ 63:0] smiMemClientReq15Data;
+wire         smiMemClientReq15Stop;
+wire         smiMemClientResp15Ready;
+wire [  7:0] smiMemClientResp15Eofc;
+wire [ 63:0] smiMemClientResp15Data;
+wire         smiMemClientResp15Stop;
+
+// SMI connections for smiMemClientReq16/smiMemClientResp16
+wire         smiMemClientReq16Ready;
+wire [  7:0] smiMemClientReq16Eofc;
+wire [ 63:0] smiMemClientReq16Data;
+wire         smiMemClientReq16Stop;
+wire         smiMemClientResp16Ready;
+wire [  7:0] smiMemClientResp16Eofc;
+wire [ 63:0] smiMemClientResp16Data;
+wire         smiMemClientResp16Stop;
+
+// SMI connections for smiMemClientReq17/smiMemClientResp17
+wire         smiMemClientReq17Ready;
+wire [  7:0] smiMemClientReq17Eofc;
+wire [ 63:0] smiMemClientReq17Data;
+wire         smiMemClientReq17Stop;
+wire         smiMemClientResp17Ready;
+wire [  7:0] smiMemClientResp17Eofc;
+wire [ 63:0] smiMemClientResp17Data;
+wire         smiMemClientResp17Stop;
+
+// SMI connections for smiMemClientReq18/smiMemClientResp18
+wire         smiMemClientReq18Ready;
+wire [  7:0] smiMemClientReq18Eofc;
+wire [ 63:0] smiMemClientReq18Data;
+wire         smiMemClientReq18Stop;
+wire         smiMemClientResp18Ready;
+wire [  7:0] smiMemClientResp18Eofc;
+wire [ 63:0] smiMemClientResp18Data;
+wire         smiMemClientResp18Stop;
+
+// SMI connections for smiMemClientReq19/smiMemClientResp19
+wire         smiMemClientReq19Ready;
+wire [  7:0] smiMemClientReq19Eofc;
+wire [ 63:0] smiMemClientReq19Data;
+wire         smiMemClientReq19Stop;
+wire         smiMemClientResp19Ready;
+wire [  7:0] smiMemClientResp19Eofc;
+wire [ 63:0] smiMemClientResp19Data;
+wire         smiMemClientResp19Stop;
+
+// SMI connections for smiMemClientReq20/smiMemClientResp20
+wire         smiMemClientReq20Ready;
+wire [  7:0] smiMemClientReq20Eofc;
+wire [ 63:0] smiMemClientReq20Data;
+wire         smiMemClientReq20Stop;
+wire         smiMemClientResp20Ready;
+wire [  7:0] smiMemClientResp20Eofc;
+wire [ 63:0] smiMemClientResp20Data;
+wire         smiMemClientResp20Stop;
+
+// SMI connections for smiMemClientReq21/smiMemClientResp21
+wire         smiMemClientReq21Ready;
+wire [  7:0] smiMemClientReq21Eofc;
+wire [ 63:0] smiMemClientReq21Data;
+wire         smiMemClientReq21Stop;
+wire         smiMemClientResp21Ready;
+wire [  7:0] smiMemClientResp21Eofc;
+wire [ 63:0] smiMemClientResp21Data;
+wire         smiMemClientResp21Stop;
+
+// SMI connections for smiMemClientReq22/smiMemClientResp22
+wire         smiMemClientReq22Ready;
+wire [  7:0] smiMemClientReq22Eofc;
+wire [ 63:0] smiMemClientReq22Data;
+wire         smiMemClientReq22Stop;
+wire         smiMemClientResp22Ready;
+wire [  7:0] smiMemClientResp22Eofc;
+wire [ 63:0] smiMemClientResp22Data;
+wire         smiMemClientResp22Stop;
+
+// SMI connections for smiMemClientReq23/smiMemClientResp23
+wire         smiMemClientReq23Ready;
+wire [  7:0] smiMemClientReq23Eofc;
+wire [ 63:0] smiMemClientReq23Data;
+wire         smiMemClientReq23Stop;
+wire         smiMemClientResp23Ready;
+wire [  7:0] smiMemClientResp23Eofc;
+wire [ 63:0] smiMemClientResp23Data;
+wire         smiMemClientResp23Stop;
+
+// SMI connections for smiMemClientReq24/smiMemClientResp24
+wire         smiMemClientReq24Ready;
+wire [  7:0] smiMemClientReq24Eofc;
+wire [ 63:0] smiMemClientReq24Data;
+wire         smiMemClientReq24Stop;
+wire         smiMemClientResp24Ready;
+wire [  7:0] smiMemClientResp24Eofc;
+wire [ 63:0] smiMemClientResp24Data;
+wire         smiMemClientResp24Stop;
+
+// SMI connections for smiMemClientReq25/smiMemClientResp25
+wire         smiMemClientReq25Ready;
+wire [  7:0] smiMemClientReq25Eofc;
+wire [ 63:0] smiMemClientReq25Data;
+wire         smiMemClientReq25Stop;
+wire         smiMemClientResp25Ready;
+wire [  7:0] smiMemClientResp25Eofc;
+wire [ 63:0] smiMemClientResp25Data;
+wire         smiMemClientResp25Stop;
+
+// SMI connections for smiMemClientReq26/smiMemClientResp26
+wire         smiMemClientReq26Ready;
+wire [  7:0] smiMemClientReq26Eofc;
+wire [ 63:0] smiMemClientReq26Data;
+wire         smiMemClientReq26Stop;
+wire         smiMemClientResp26Ready;
+wire [  7:0] smiMemClientResp26Eofc;
+wire [ 63:0] smiMemClientResp26Data;
+wire         smiMemClientResp26Stop;
+
+// SMI connections for smiMemClientReq27/smiMemClientResp27
+wire         smiMemClientReq27Ready;
+wire [  7:0] smiMemClientReq27Eofc;
+wire [ 63:0] smiMemClientReq27Data;
+wire         smiMemClientReq27Stop;
+wire         smiMemClientResp27Ready;
+wire [  7:0] smiMemClientResp27Eofc;
+wire [ 63:0] smiMemClientResp27Data;
+wire         smiMemClientResp27Stop;
+
+// SMI connections for smiMemClientReq28/smiMemClientResp28
+wire         smiMemClientReq28Ready;
+wire [  7:0] smiMemClientReq28Eofc;
+wire [ 63:0] smiMemClientReq28Data;
+wire         smiMemClientReq28Stop;
+wire         smiMemClientResp28Ready;
+wire [  7:0] smiMemClientResp28Eofc;
+wire [ 63:0] smiMemClientResp28Data;
+wire         smiMemClientResp28Stop;
+
+// SMI connections for smiMemClientReq29/smiMemClientResp29
+wire         smiMemClientReq29Ready;
+wire [  7:0] smiMemClientReq29Eofc;
+wire [ 63:0] smiMemClientReq29Data;
+wire         smiMemClientReq29Stop;
+wire         smiMemClientResp29Ready;
+wire [  7:0] smiMemClientResp29Eofc;
+wire [ 63:0] smiMemClientResp29Data;
+wire         smiMemClientResp29Stop;
+
+// SMI connections for smiMemClientReq30/smiMemClientResp30
+wire         smiMemClientReq30Ready;
+wire [  7:0] smiMemClientReq30Eofc;
+wire [ 63:0] smiMemClientReq30Data;
+wire         smiMemClientReq30Stop;
+wire         smiMemClientResp30Ready;
+wire [  7:0] smiMemClientResp30Eofc;
+wire [ 63:0] smiMemClientResp30Data;
+wire         smiMemClientResp30Stop;
+
+// SMI connections for smiMemClientReq31/smiMemClientResp31
+wire         smiMemClientReq31Ready;
+wire [  7:0] smiMemClientReq31Eofc;
+wire [ 63:0] smiMemClientReq31Data;
+wire         smiMemClientReq31Stop;
+wire         smiMemClientResp31Ready;
+wire [  7:0] smiMemClientResp31Eofc;
+wire [ 63:0] smiMemClientResp31Data;
+wire         smiMemClientResp31Stop;
+
+// SMI connections for smiMemClientReq32/smiMemClientResp32
+wire         smiMemClientReq32Ready;
+wire [  7:0] smiMemClientReq32Eofc;
+wire [ 63:0] smiMemClientReq32Data;
+wire         smiMemClientReq32Stop;
+wire         smiMemClientResp32Ready;
+wire [  7:0] smiMemClientResp32Eofc;
+wire [ 63:0] smiMemClientResp32Data;
+wire         smiMemClientResp32Stop;
+
+// SMI connections for smiMemClientReq33/smiMemClientResp33
+wire         smiMemClientReq33Ready;
+wire [  7:0] smiMemClientReq33Eofc;
+wire [ 63:0] smiMemClientReq33Data;
+wire         smiMemClientReq33Stop;
+wire         smiMemClientResp33Ready;
+wire [  7:0] smiMemClientResp33Eofc;
+wire [ 63:0] smiMemClientResp33Data;
+wire         smiMemClientResp33Stop;
+
+// SMI connections for smiMemClientReq34/smiMemClientResp34
+wire         smiMemClientReq34Ready;
+wire [  7:0] smiMemClientReq34Eofc;
+wire [ 63:0] smiMemClientReq34Data;
+wire         smiMemClientReq34Stop;
+wire         smiMemClientResp34Ready;
+wire [  7:0] smiMemClientResp34Eofc;
+wire [ 63:0] smiMemClientResp34Data;
+wire         smiMemClientResp34Stop;
+
+// SMI connections for smiMemClientReq35/smiMemClientResp35
+wire         smiMemClientReq35Ready;
+wire [  7:0] smiMemClientReq35Eofc;
+wire [ 63:0] smiMemClientReq35Data;
+wire         smiMemClientReq35Stop;
+wire         smiMemClientResp35Ready;
+wire [  7:0] smiMemClientResp35Eofc;
+wire [ 63:0] smiMemClientResp35Data;
+wire         smiMemClientResp35Stop;
+
+// SMI connections for smiMemClientReq36/smiMemClientResp36
+wire         smiMemClientReq36Ready;
+wire [  7:0] smiMemClientReq36Eofc;
+wire [ 63:0] smiMemClientReq36Data;
+wire         smiMemClientReq36Stop;
+wire         smiMemClientResp36Ready;
+wire [  7:0] smiMemClientResp36Eofc;
+wire [ 63:0] smiMemClientResp36Data;
+wire         smiMemClientResp36Stop;
+
+// SMI connections for smiMemClientReq37/smiMemClientResp37
+wire         smiMemClientReq37Ready;
+wire [  7:0] smiMemClientReq37Eofc;
+wire [ 63:0] smiMemClientReq37Data;
+wire         smiMemClientReq37Stop;
+wire         smiMemClientResp37Ready;
+wire [  7:0] smiMemClientResp37Eofc;
+wire [ 63:0] smiMemClientResp37Data;
+wire         smiMemClientResp37Stop;
+
+// SMI connections for smiMemClientReq38/smiMemClientResp38
+wire         smiMemClientReq38Ready;
+wire [  7:0] smiMemClientReq38Eofc;
+wire [ 63:0] smiMemClientReq38Data;
+wire         smiMemClientReq38Stop;
+wire         smiMemClientResp38Ready;
+wire [  7:0] smiMemClientResp38Eofc;
+wire [ 63:0] smiMemClientResp38Data;
+wire         smiMemClientResp38Stop;
+
+// SMI connections for smiMemClientReq39/smiMemClientResp39
+wire         smiMemClientReq39Ready;
+wire [  7:0] smiMemClientReq39Eofc;
+wire [ 63:0] smiMemClientReq39Data;
+wire         smiMemClientReq39Stop;
+wire         smiMemClientResp39Ready;
+wire [  7:0] smiMemClientResp39Eofc;
+wire [ 63:0] smiMemClientResp39Data;
+wire         smiMemClientResp39Stop;
+
+// SMI connections for smiMemClientReq40/smiMemClientResp40
+wire         smiMemClientReq40Ready;
+wire [  7:0] smiMemClientReq40Eofc;
+wire [ 63:0] smiMemClientReq40Data;
+wire         smiMemClientReq40Stop;
+wire         smiMemClientResp40Ready;
+wire [  7:0] smiMemClientResp40Eofc;
+wire [ 63:0] smiMemClientResp40Data;
+wire         smiMemClientResp40Stop;
+
+// SMI connections for smiMemClientReq41/smiMemClientResp41
+wire         smiMemClientReq41Ready;
+wire [  7:0] smiMemClientReq41Eofc;
+wire [ 63:0] smiMemClientReq41Data;
+wire         smiMemClientReq41Stop;
+wire         smiMemClientResp41Ready;
+wire [  7:0] smiMemClientResp41Eofc;
+wire [ 63:0] smiMemClientResp41Data;
+wire         smiMemClientResp41Stop;
+
+// SMI connections for smiMemClientReq42/smiMemClientResp42
+wire         smiMemClientReq42Ready;
+wire [  7:0] smiMemClientReq42Eofc;
+wire [ 63:0] smiMemClientReq42Data;
+wire         smiMemClientReq42Stop;
+wire         smiMemClientResp42Ready;
+wire [  7:0] smiMemClientResp42Eofc;
+wire [ 63:0] smiMemClientResp42Data;
+wire         smiMemClientResp42Stop;
+
+// SMI connections for smiMemClientReq43/smiMemClientResp43
+wire         smiMemClientReq43Ready;
+wire [  7:0] smiMemClientReq43Eofc;
+wire [ 63:0] smiMemClientReq43Data;
+wire         smiMemClientReq43Stop;
+wire         smiMemClientResp43Ready;
+wire [  7:0] smiMemClientResp43Eofc;
+wire [ 63:0] smiMemClientResp43Data;
+wire         smiMemClientResp43Stop;
+
+// SMI connections for smiMemClientReq44/smiMemClientResp44
+wire         smiMemClientReq44Ready;
+wire [  7:0] smiMemClientReq44Eofc;
+wire [ 63:0] smiMemClientReq44Data;
+wire         smiMemClientReq44Stop;
+wire         smiMemClientResp44Ready;
+wire [  7:0] smiMemClientResp44Eofc;
+wire [ 63:0] smiMemClientResp44Data;
+wire         smiMemClientResp44Stop;
+
+// SMI connections for smiMemClientReq45/smiMemClientResp45
+wire         smiMemClientReq45Ready;
+wire [  7:0] smiMemClientReq45Eofc;
+wire [ 63:0] smiMemClientReq45Data;
+wire         smiMemClientReq45Stop;
+wire         smiMemClientResp45Ready;
+wire [  7:0] smiMemClientResp45Eofc;
+wire [ 63:0] smiMemClientResp45Data;
+wire         smiMemClientResp45Stop;
+
+// SMI connections for smiMemClientReq46/smiMemClientResp46
+wire         smiMemClientReq46Ready;
+wire [  7:0] smiMemClientReq46Eofc;
+wire [ 63:0] smiMemClientReq46Data;
+wire         smiMemClientReq46Stop;
+wire         smiMemClientResp46Ready;
+wire [  7:0] smiMemClientResp46Eofc;
+wire [ 63:0] smiMemClientResp46Data;
+wire         smiMemClientResp46Stop;
+
+// SMI connections for smiMemClientReq47/smiMemClientResp47
+wire         smiMemClientReq47Ready;
+wire [  7:0] smiMemClientReq47Eofc;
+wire [ 63:0] smiMemClientReq47Data;
+wire         smiMemClientReq47Stop;
+wire         smiMemClientResp47Ready;
+wire [  7:0] smiMemClientResp47Eofc;
+wire [ 63:0] smiMemClientResp47Data;
+wire         smiMemClientResp47Stop;
+
+// SMI connections for smiMemClientReq48/smiMemClientResp48
+wire         smiMemClientReq48Ready;
+wire [  7:0] smiMemClientReq48Eofc;
+wire [ 63:0] smiMemClientReq48Data;
+wire         smiMemClientReq48Stop;
+wire         smiMemClientResp48Ready;
+wire [  7:0] smiMemClientResp48Eofc;
+wire [ 63:0] smiMemClientResp48Data;
+wire         smiMemClientResp48Stop;
+
+// SMI connections for smiMemClientReq49/smiMemClientResp49
+wire         smiMemClientReq49Ready;
+wire [  7:0] smiMemClientReq49Eofc;
+wire [ 63:0] smiMemClientReq49Data;
+wire         smiMemClientReq49Stop;
+wire         smiMemClientResp49Ready;
+wire [  7:0] smiMemClientResp49Eofc;
+wire [ 63:0] smiMemClientResp49Data;
+wire         smiMemClientResp49Stop;
+
+// SMI connections for smiMemClientReq50/smiMemClientResp50
+wire         smiMemClientReq50Ready;
+wire [  7:0] smiMemClientReq50Eofc;
+wire [ 63:0] smiMemClientReq50Data;
+wire         smiMemClientReq50Stop;
+wire         smiMemClientResp50Ready;
+wire [  7:0] smiMemClientResp50Eofc;
+wire [ 63:0] smiMemClientResp50Data;
+wire         smiMemClientResp50Stop;
+
+// SMI connections for smiMemClientReq51/smiMemClientResp51
+wire         smiMemClientReq51Ready;
+wire [  7:0] smiMemClientReq51Eofc;
+wire [ 63:0] smiMemClientReq51Data;
+wire         smiMemClientReq51Stop;
+wire         smiMemClientResp51Ready;
+wire [  7:0] smiMemClientResp51Eofc;
+wire [ 63:0] smiMemClientResp51Data;
+wire         smiMemClientResp51Stop;
+
+// SMI connections for smiMemClientReq52/smiMemClientResp52
+wire         smiMemClientReq52Ready;
+wire [  7:0] smiMemClientReq52Eofc;
+wire [ 63:0] smiMemClientReq52Data;
+wire         smiMemClientReq52Stop;
+wire         smiMemClientResp52Ready;
+wire [  7:0] smiMemClientResp52Eofc;
+wire [ 63:0] smiMemClientResp52Data;
+wire         smiMemClientResp52Stop;
+
+// SMI connections for smiMemClientReq53/smiMemClientResp53
+wire         smiMemClientReq53Ready;
+wire [  7:0] smiMemClientReq53Eofc;
+wire [ 63:0] smiMemClientReq53Data;
+wire         smiMemClientReq53Stop;
+wire         smiMemClientResp53Ready;
+wire [  7:0] smiMemClientResp53Eofc;
+wire [ 63:0] smiMemClientResp53Data;
+wire         smiMemClientResp53Stop;
+
+// SMI connections for smiMemClientReq54/smiMemClientResp54
+wire         smiMemClientReq54Ready;
+wire [  7:0] smiMemClientReq54Eofc;
+wire [ 63:0] smiMemClientReq54Data;
+wire         smiMemClientReq54Stop;
+wire         smiMemClientResp54Ready;
+wire [  7:0] smiMemClientResp54Eofc;
+wire [ 63:0] smiMemClientResp54Data;
+wire         smiMemClientResp54Stop;
+
+// SMI connections for smiMemClientReq55/smiMemClientResp55
+wire         smiMemClientReq55Ready;
+wire [  7:0] smiMemClientReq55Eofc;
+wire [ 63:0] smiMemClientReq55Data;
+wire         smiMemClientReq55Stop;
+wire         smiMemClientResp55Ready;
+wire [  7:0] smiMemClientResp55Eofc;
+wire [ 63:0] smiMemClientResp55Data;
+wire         smiMemClientResp55Stop;
+
+// SMI connections for smiMemClientReq56/smiMemClientResp56
+wire         smiMemClientReq56Ready;
+wire [  7:0] smiMemClientReq56Eofc;
+wire [ 63:0] smiMemClientReq56Data;
+wire         smiMemClientReq56Stop;
+wire         smiMemClientResp56Ready;
+wire [  7:0] smiMemClientResp56Eofc;
+wire [ 63:0] smiMemClientResp56Data;
+wire         smiMemClientResp56Stop;
+
+// SMI connections for smiMemClientReq57/smiMemClientResp57
+wire         smiMemClientReq57Ready;
+wire [  7:0] smiMemClientReq57Eofc;
+wire [ 63:0] smiMemClientReq57Data;
+wire         smiMemClientReq57Stop;
+wire         smiMemClientResp57Ready;
+wire [  7:0] smiMemClientResp57Eofc;
+wire [ 63:0] smiMemClientResp57Data;
+wire         smiMemClientResp57Stop;
+
+// SMI connections for smiMemClientReq58/smiMemClientResp58
+wire         smiMemClientReq58Ready;
+wire [  7:0] smiMemClientReq58Eofc;
+wire [ 63:0] smiMemClientReq58Data;
+wire         smiMemClientReq58Stop;
+wire         smiMemClientResp58Ready;
+wire [  7:0] smiMemClientResp58Eofc;
+wire [ 63:0] smiMemClientResp58Data;
+wire         smiMemClientResp58Stop;
+
+// SMI connections for smiMemClientReq59/smiMemClientResp59
+wire         smiMemClientReq59Ready;
+wire [  7:0] smiMemClientReq59Eofc;
+wire [ 63:0] smiMemClientReq59Data;
+wire         smiMemClientReq59Stop;
+wire         smiMemClientResp59Ready;
+wire [  7:0] smiMemClientResp59Eofc;
+wire [ 63:0] smiMemClientResp59Data;
+wire         smiMemClientResp59Stop;
+
+// SMI connections for smiMemClientReq60/smiMemClientResp60
+wire         smiMemClientReq60Ready;
+wire [  7:0] smiMemClientReq60Eofc;
+wire [ 63:0] smiMemClientReq60Data;
+wire         smiMemClientReq60Stop;
+wire         smiMemClientResp60Ready;
+wire [  7:0] smiMemClientResp60Eofc;
+wire [ 63:0] smiMemClientResp60Data;
+wire         smiMemClientResp60Stop;
+
+// SMI connections for smiMemClientReq61/smiMemClientResp61
+wire         smiMemClientReq61Ready;
+wire [  7:0] smiMemClientReq61Eofc;
+wire [ 63:0] smiMemClientReq61Data;
+wire         smiMemClientReq61Stop;
+wire         smiMemClientResp61Ready;
+wire [  7:0] smiMemClientResp61Eofc;
+wire [ 63:0] smiMemClientResp61Data;
+wire         smiMemClientResp61Stop;
+
+// SMI connections for smiMemClientReq62/smiMemClientResp62
+wire         smiMemClientReq62Ready;
+wire [  7:0] smiMemClientReq62Eofc;
+wire [ 63:0] smiMemClientReq62Data;
+wire         smiMemClientReq62Stop;
+wire         smiMemClientResp62Ready;
+wire [  7:0] smiMemClientResp62Eofc;
+wire [ 63:0] smiMemClientResp62Data;
+wire         smiMemClientResp62Stop;
+
+// SMI connections for smiMemClientReq63/smiMemClientResp63
+wire         smiMemClientReq63Ready;
+wire [  7:0] smiMemClientReq63Eofc;
+wire [ 63:0] smiMemClientReq63Data;
+wire         smiMemClientReq63Stop;
+wire         smiMemClientResp63Ready;
+wire [  7:0] smiMemClientResp63Eofc;
+wire [ 63:0] smiMemClientResp63Data;
+wire         smiMemClientResp63Stop;
+
+// Concatenated SMI flit vectors. 
+wire [ 71:0] smiMemClientReq0Flit;
+wire [ 71:0] smiMemClientResp0Flit;
+wire [ 71:0] smiMemClientReq1Flit;
+wire [ 71:0] smiMemClientResp1Flit;
+wire [ 71:0] smiMemClientReq2Flit;
+wire [ 71:0] smiMemClientResp2Flit;
+wire [ 71:0] smiMemClientReq3Flit;
+wire [ 71:0] smiMemClientResp3Flit;
+wire [ 71:0] smiMemClientReq4Flit;
+wire [ 71:0] smiMemClientResp4Flit;
+wire [ 71:0] smiMemClientReq5Flit;
+wire [ 71:0] smiMemClientResp5Flit;
+wire [ 71:0] smiMemClientReq6Flit;
+wire [ 71:0] smiMemClientResp6Flit;
+wire [ 71:0] smiMemClientReq7Flit;
+wire [ 71:0] smiMemClientResp7Flit;
+wire [ 71:0] smiMemClientReq8Flit;
+wire [ 71:0] smiMemClientResp8Flit;
+wire [ 71:0] smiMemClientReq9Flit;
+wire [ 71:0] smiMemClientResp9Flit;
+wire [ 71:0] smiMemClientReq10Flit;
+wire [ 71:0] smiMemClientResp10Flit;
+wire [ 71:0] smiMemClientReq11Flit;
+wire [ 71:0] smiMemClientResp11Flit;
+wire [ 71:0] smiMemClientReq12Flit;
+wire [ 71:0] smiMemClientResp12Flit;
+wire [ 71:0] smiMemClientReq13Flit;
+wire [ 71:0] smiMemClientResp13Flit;
+wire [ 71:0] smiMemClientReq14Flit;
+wire [ 71:0] smiMemClientResp14Flit;
+wire [ 71:0] smiMemClientReq15Flit;
+wire [ 71:0] smiMemClientResp15Flit;
+wire [ 71:0] smiMemClientReq16Flit;
+wire [ 71:0] smiMemClientResp16Flit;
+wire [ 71:0] smiMemClientReq17Flit;
+wire [ 71:0] smiMemClientResp17Flit;
+wire [ 71:0] smiMemClientReq18Flit;
+wire [ 71:0] smiMemClientResp18Flit;
+wire [ 71:0] smiMemClientReq19Flit;
+wire [ 71:0] smiMemClientResp19Flit;
+wire [ 71:0] smiMemClientReq20Flit;
+wire [ 71:0] smiMemClientResp20Flit;
+wire [ 71:0] smiMemClientReq21Flit;
+wire [ 71:0] smiMemClientResp21Flit;
+wire [ 71:0] smiMemClientReq22Flit;
+wire [ 71:0] smiMemClientResp22Flit;
+wire [ 71:0] smiMemClientReq23Flit;
+wire [ 71:0] smiMemClientResp23Flit;
+wire [ 71:0] smiMemClientReq24Flit;
+wire [ 71:0] smiMemClientResp24Flit;
+wire [ 71:0] smiMemClientReq25Flit;
+wire [ 71:0] smiMemClientResp25Flit;
+wire [ 71:0] smiMemClientReq26Flit;
+wire [ 71:0] smiMemClientResp26Flit;
+wire [ 71:0] smiMemClientReq27Flit;
+wire [ 71:0] smiMemClientResp27Flit;
+wire [ 71:0] smiMemClientReq28Flit;
+wire [ 71:0] smiMemClientResp28Flit;
+wire [ 71:0] smiMemClientReq29Flit;
+wire [ 71:0] smiMemClientResp29Flit;
+wire [ 71:0] smiMemClientReq30Flit;
+wire [ 71:0] smiMemClientResp30Flit;
+wire [ 71:0] smiMemClientReq31Flit;
+wire [ 71:0] smiMemClientResp31Flit;
+wire [ 71:0] smiMemClientReq32Flit;
+wire [ 71:0] smiMemClientResp32Flit;
+wire [ 71:0] smiMemClientReq33Flit;
+wire [ 71:0] smiMemClientResp33Flit;
+wire [ 71:0] smiMemClientReq34Flit;
+wire [ 71:0] smiMemClientResp34Flit;
+wire [ 71:0] smiMemClientReq35Flit;
+wire [ 71:0] smiMemClientResp35Flit;
+wire [ 71:0] smiMemClientReq36Flit;
+wire [ 71:0] smiMemClientResp36Flit;
+wire [ 71:0] smiMemClientReq37Flit;
+wire [ 71:0] smiMemClientResp37Flit;
+wire [ 71:0] smiMemClientReq38Flit;
+wire [ 71:0] smiMemClientResp38Flit;
+wire [ 71:0] smiMemClientReq39Flit;
+wire [ 71:0] smiMemClientResp39Flit;
+wire [ 71:0] smiMemClientReq40Flit;
+wire [ 71:0] smiMemClientResp40Flit;
+wire [ 71:0] smiMemClientReq41Flit;
+wire [ 71:0] smiMemClientResp41Flit;
+wire [ 71:0] smiMemClientReq42Flit;
+wire [ 71:0] smiMemClientResp42Flit;
+wire [ 71:0] smiMemClientReq43Flit;
+wire [ 71:0] smiMemClientResp43Flit;
+wire [ 71:0] smiMemClientReq44Flit;
+wire [ 71:0] smiMemClientResp44Flit;
+wire [ 71:0] smiMemClientReq45Flit;
+wire [ 71:0] smiMemClientResp45Flit;
+wire [ 71:0] smiMemClientReq46Flit;
+wire [ 71:0] smiMemClientResp46Flit;
+wire [ 71:0] smiMemClientReq47Flit;
+wire [ 71:0] smiMemClientResp47Flit;
+wire [ 71:0] smiMemClientReq48Flit;
+wire [ 71:0] smiMemClientResp48Flit;
+wire [ 71:0] smiMemClientReq49Flit;
+wire [ 71:0] smiMemClientResp49Flit;
+wire [ 71:0] smiMemClientReq50Flit;
+wire [ 71:0] smiMemClientResp50Flit;
+wire [ 71:0] smiMemClientReq51Flit;
+wire [ 71:0] smiMemClientResp51Flit;
+wire [ 71:0] smiMemClientReq52Flit;
+wire [ 71:0] smiMemClientResp52Flit;
+wire [ 71:0] smiMemClientReq53Flit;
+wire [ 71:0] smiMemClientResp53Flit;
+wire [ 71:0] smiMemClientReq54Flit;
+wire [ 71:0] smiMemClientResp54Flit;
+wire [ 71:0] smiMemClientReq55Flit;
+wire [ 71:0] smiMemClientResp55Flit;
+wire [ 71:0] smiMemClientReq56Flit;
+wire [ 71:0] smiMemClientResp56Flit;
+wire [ 71:0] smiMemClientReq57Flit;
+wire [ 71:0] smiMemClientResp57Flit;
+wire [ 71:0] smiMemClientReq58Flit;
+wire [ 71:0] smiMemClientResp58Flit;
+wire [ 71:0] smiMemClientReq59Flit;
+wire [ 71:0] smiMemClientResp59Flit;
+wire [ 71:0] smiMemClientReq60Flit;
+wire [ 71:0] smiMemClientResp60Flit;
+wire [ 71:0] smiMemClientReq61Flit;
+wire [ 71:0] smiMemClientResp61Flit;
+wire [ 71:0] smiMemClientReq62Flit;
+wire [ 71:0] smiMemClientResp62Flit;
+wire [ 71:0] smiMemClientReq63Flit;
+wire [ 71:0] smiMemClientResp63Flit;
+
+// Unused AXI interface signals.
+wire [  3:0] m_axi_gmem_arcache;
+wire [  3:0] m_axi_gmem_awcache;
+
+//
+// Instantiate the SMI/AXI memory controller adaptor.
+//
+smiAxiMemBusAdaptor #(6, 1, 33) axiBusAdaptor (
+  
+  // Connect SMI main memory bus.
+  .smiReqReady  (smiMemServerReqReady),
+  .smiReqEofc   (smiMemServerReqEofc),
+  .smiReqData   (smiMemServerReqData),
+  .smiReqStop   (smiMemServerReqStop),
+  .smiRespReady (smiMemServerRespReady),
+  .smiRespEofc  (smiMemServerRespEofc),
+  .smiRespData  (smiMemServerRespData),
+  .smiRespStop  (smiMemServerRespStop),
+  
+  // Connect active AXI read data bus signals.
+  .axiARValid   (m_axi_gmem_arvalid),
+  .axiARReady   (m_axi_gmem_arready),
+  .axiARId      (m_axi_gmem_arid),
+  .axiARAddr    (m_axi_gmem_araddr),
+  .axiARLen     (m_axi_gmem_arlen),
+  .axiARSize    (m_axi_gmem_arsize),
+  .axiARCache   (m_axi_gmem_arcache),
+
+  .axiRValid    (m_axi_gmem_rvalid),
+  .axiRReady    (m_axi_gmem_rready),
+  .axiRId       (m_axi_gmem_rid),
+  .axiRData     (m_axi_gmem_rdata),
+  .axiRResp     (m_axi_gmem_rresp),
+  .axiRLast     (m_axi_gmem_rlast),
+
+  // Connect active AXI write data bus signals.
+  .axiAWValid   (m_axi_gmem_awvalid),
+  .axiAWReady   (m_axi_gmem_awready),
+  .axiAWId      (m_axi_gmem_awid),
+  .axiAWAddr    (m_axi_gmem_awaddr),
+  .axiAWLen     (m_axi_gmem_awlen),
+  .axiAWSize    (m_axi_gmem_awsize),
+  .axiAWCache   (m_axi_gmem_awcache),
+
+  .axiWValid    (m_axi_gmem_wvalid),
+  .axiWReady    (m_axi_gmem_wready),
+  .axiWId       (m_axi_gmem_wid),
+  .axiWData     (m_axi_gmem_wdata),
+  .axiWStrb     (m_axi_gmem_wstrb),
+  .axiWLast     (m_axi_gmem_wlast),
+
+  .axiBValid    (m_axi_gmem_bvalid),
+  .axiBReady    (m_axi_gmem_bready),
+  .axiBId       (m_axi_gmem_bid),
+  .axiBResp     (m_axi_gmem_bresp),
+
+  // Connect system level signals.
+  .axiReset     (reset),
+  .clk          (clk),
+  .srst         (reset)
+);
+
+//
+// Instantiate the memory access arbitration logic.
+//
+smiMemArbitrationTreeX64S8 memArbitrationTree (
+
+  // SMI ports for smiMemClientReq0/smiMemClientResp0
+  .smiMemClientReq0Ready (smiMemClientReq0Ready),
+  .smiMemClientReq0Eofc  (smiMemClientReq0Eofc),
+  .smiMemClientReq0Data  (smiMemClientReq0Data),
+  .smiMemClientReq0Stop  (smiMemClientReq0Stop),
+  .smiMemClientResp0Ready (smiMemClientResp0Ready),
+  .smiMemClientResp0Eofc  (smiMemClientResp0Eofc),
+  .smiMemClientResp0Data  (smiMemClientResp0Data),
+  .smiMemClientResp0Stop  (smiMemClientResp0Stop),
+
+  // SMI ports for smiMemClientReq1/smiMemClientResp1
+  .smiMemClientReq1Ready (smiMemClientReq1Ready),
+  .smiMemClientReq1Eofc  (smiMemClientReq1Eofc),
+  .smiMemClientReq1Data  (smiMemClientReq1Data),
+  .smiMemClientReq1Stop  (smiMemClientReq1Stop),
+  .smiMemClientResp1Ready (smiMemClientResp1Ready),
+  .smiMemClientResp1Eofc  (smiMemClientResp1Eofc),
+  .smiMemClientResp1Data  (smiMemClientResp1Data),
+  .smiMemClientResp1Stop  (smiMemClientResp1Stop),
+
+  // SMI ports for smiMemClientReq2/smiMemClientResp2
+  .smiMemClientReq2Ready (smiMemClientReq2Ready),
+  .smiMemClientReq2Eofc  (smiMemClientReq2Eofc),
+  .smiMemClientReq2Data  (smiMemClientReq2Data),
+  .smiMemClientReq2Stop  (smiMemClientReq2Stop),
+  .smiMemClientResp2Ready (smiMemClientResp2Ready),
+  .smiMemClientResp2Eofc  (smiMemClientResp2Eofc),
+  .smiMemClientResp2Data  (smiMemClientResp2Data),
+  .smiMemClientResp2Stop  (smiMemClientResp2Stop),
+
+  // SMI ports for smiMemClientReq3/smiMemClientResp3
+  .smiMemClientReq3Ready (smiMemClientReq3Ready),
+  .smiMemClientReq3Eofc  (smiMemClientReq3Eofc),
+  .smiMemClientReq3Data  (smiMemClientReq3Data),
+  .smiMemClientReq3Stop  (smiMemClientReq3Stop),
+  .smiMemClientResp3Ready (smiMemClientResp3Ready),
+  .smiMemClientResp3Eofc  (smiMemClientResp3Eofc),
+  .smiMemClientResp3Data  (smiMemClientResp3Data),
+  .smiMemClientResp3Stop  (smiMemClientResp3Stop),
+
+  // SMI ports for smiMemClientReq4/smiMemClientResp4
+  .smiMemClientReq4Ready (smiMemClientReq4Ready),
+  .smiMemClientReq4Eofc  (smiMemClientReq4Eofc),
+  .smiMemClientReq4Data  (smiMemClientReq4Data),
+  .smiMemClientReq4Stop  (smiMemClientReq4Stop),
+  .smiMemClientResp4Ready (smiMemClientResp4Ready),
+  .smiMemClientResp4Eofc  (smiMemClientResp4Eofc),
+  .smiMemClientResp4Data  (smiMemClientResp4Data),
+  .smiMemClientResp4Stop  (smiMemClientResp4Stop),
+
+  // SMI ports for smiMemClientReq5/smiMemClientResp5
+  .smiMemClientReq5Ready (smiMemClientReq5Ready),
+  .smiMemClientReq5Eofc  (smiMemClientReq5Eofc),
+  .smiMemClientReq5Data  (smiMemClientReq5Data),
+  .smiMemClientReq5Stop  (smiMemClientReq5Stop),
+  .smiMemClientResp5Ready (smiMemClientResp5Ready),
+  .smiMemClientResp5Eofc  (smiMemClientResp5Eofc),
+  .smiMemClientResp5Data  (smiMemClientResp5Data),
+  .smiMemClientResp5Stop  (smiMemClientResp5Stop),
+
+  // SMI ports for smiMemClientReq6/smiMemClientResp6
+  .smiMemClientReq6Ready (smiMemClientReq6Ready),
+  .smiMemClientReq6Eofc  (smiMemClientReq6Eofc),
+  .smiMemClientReq6Data  (smiMemClientReq6Data),
+  .smiMemClientReq6Stop  (smiMemClientReq6Stop),
+  .smiMemClientResp6Ready (smiMemClientResp6Ready),
+  .smiMemClientResp6Eofc  (smiMemClientResp6Eofc),
+  .smiMemClientResp6Data  (smiMemClientResp6Data),
+  .smiMemClientResp6Stop  (smiMemClientResp6Stop),
+
+  // SMI ports for smiMemClientReq7/smiMemClientResp7
+  .smiMemClientReq7Ready (smiMemClientReq7Ready),
+  .smiMemClientReq7Eofc  (smiMemClientReq7Eofc),
+  .smiMemClientReq7Data  (smiMemClientReq7Data),
+  .smiMemClientReq7Stop  (smiMemClientReq7Stop),
+  .smiMemClientResp7Ready (smiMemClientResp7Ready),
+  .smiMemClientResp7Eofc  (smiMemClientResp7Eofc),
+  .smiMemClientResp7Data  (smiMemClientResp7Data),
+  .smiMemClientResp7Stop  (smiMemClientResp7Stop),
+
+  // SMI ports for smiMemClientReq8/smiMemClientResp8
+  .smiMemClientReq8Ready (smiMemClientReq8Ready),
+  .smiMemClientReq8Eofc  (smiMemClientReq8Eofc),
+  .smiMemClientReq8Data  (smiMemClientReq8Data),
+  .smiMemClientReq8Stop  (smiMemClientReq8Stop),
+  .smiMemClientResp8Ready (smiMemClientResp8Ready),
+  .smiMemClientResp8Eofc  (smiMemClientResp8Eofc),
+  .smiMemClientResp8Data  (smiMemClientResp8Data),
+  .smiMemClientResp8Stop  (smiMemClientResp8Stop),
+
+  // SMI ports for smiMemClientReq9/smiMemClientResp9
+  .smiMemClientReq9Ready (smiMemClientReq9Ready),
+  .smiMemClientReq9Eofc  (smiMemClientReq9Eofc),
+  .smiMemClientReq9Data  (smiMemClientReq9Data),
+  .smiMemClientReq9Stop  (smiMemClientReq9Stop),
+  .smiMemClientResp9Ready (smiMemClientResp9Ready),
+  .smiMemClientResp9Eofc  (smiMemClientResp9Eofc),
+  .smiMemClientResp9Data  (smiMemClientResp9Data),
+  .smiMemClientResp9Stop  (smiMemClientResp9Stop),
+
+  // SMI ports for smiMemClientReq10/smiMemClientResp10
+  .smiMemClientReq10Ready (smiMemClientReq10Ready),
+  .smiMemClientReq10Eofc  (smiMemClientReq10Eofc),
+  .smiMemClientReq10Data  (smiMemClientReq10Data),
+  .smiMemClientReq10Stop  (smiMemClientReq10Stop),
+  .smiMemClientResp10Ready (smiMemClientResp10Ready),
+  .smiMemClientResp10Eofc  (smiMemClientResp10Eofc),
+  .smiMemClientResp10Data  (smiMemClientResp10Data),
+  .smiMemClientResp10Stop  (smiMemClientResp10Stop),
+
+  // SMI ports for smiMemClientReq11/smiMemClientResp11
+  .smiMemClientReq11Ready (smiMemClientReq11Ready),
+  .smiMemClientReq11Eofc  (smiMemClientReq11Eofc),
+  .smiMemClientReq11Data  (smiMemClientReq11Data),
+  .smiMemClientReq11Stop  (smiMemClientReq11Stop),
+  .smiMemClientResp11Ready (smiMemClientResp11Ready),
+  .smiMemClientResp11Eofc  (smiMemClientResp11Eofc),
+  .smiMemClientResp11Data  (smiMemClientResp11Data),
+  .smiMemClientResp11Stop  (smiMemClientResp11Stop),
+
+  // SMI ports for smiMemClientReq12/smiMemClientResp12
+  .smiMemClientReq12Ready (smiMemClientReq12Ready),
+  .smiMemClientReq12Eofc  (smiMemClientReq12Eofc),
+  .smiMemClientReq12Data  (smiMemClientReq12Data),
+  .smiMemClientReq12Stop  (smiMemClientReq12Stop),
+  .smiMemClientResp12Ready (smiMemClientResp12Ready),
+  .smiMemClientResp12Eofc  (smiMemClientResp12Eofc),
+  .smiMemClientResp12Data  (smiMemClientResp12Data),
+  .smiMemClientResp12Stop  (smiMemClientResp12Stop),
+
+  // SMI ports for smiMemClientReq13/smiMemClientResp13
+  .smiMemClientReq13Ready (smiMemClientReq13Ready),
+  .smiMemClientReq13Eofc  (smiMemClientReq13Eofc),
+  .smiMemClientReq13Data  (smiMemClientReq13Data),
+  .smiMemClientReq13Stop  (smiMemClientReq13Stop),
+  .smiMemClientResp13Ready (smiMemClientResp13Ready),
+  .smiMemClientResp13Eofc  (smiMemClientResp13Eofc),
+  .smiMemClientResp13Data  (smiMemClientResp13Data),
+  .smiMemClientResp13Stop  (smiMemClientResp13Stop),
+
+  // SMI ports for smiMemClientReq14/smiMemClientResp14
+  .smiMemClientReq14Ready (smiMemClientReq14Ready),
+  .smiMemClientReq14Eofc  (smiMemClientReq14Eofc),
+  .smiMemClientReq14Data  (smiMemClientReq14Data),
+  .smiMemClientReq14Stop  (smiMemClientReq14Stop),
+  .smiMemClientResp14Ready (smiMemClientResp14Ready),
+  .smiMemClientResp14Eofc  (smiMemClientResp14Eofc),
+  .smiMemClientResp14Data  (smiMemClientResp14Data),
+  .smiMemClientResp14Stop  (smiMemClientResp14Stop),
+
+  // SMI ports for smiMemClientReq15/smiMemClientResp15
+  .smiMemClientReq15Ready (smiMemClientReq15Ready),
+  .smiMemClientReq15Eofc  (smiMemClientReq15Eofc),
+  .smiMemClientReq15Data  (smiMemClientReq15Data),
+  .smiMemClientReq15Stop  (smiMemClientReq15Stop),
+  .smiMemClientResp15Ready (smiMemClientResp15Ready),
+  .smiMemClientResp15Eofc  (smiMemClientResp15Eofc),
+  .smiMemClientResp15Data  (smiMemClientResp15Data),
+  .smiMemClientResp15Stop  (smiMemClientResp15Stop),
+
+  // SMI ports for smiMemClientReq16/smiMemClientResp16
+  .smiMemClientReq16Ready (smiMemClientReq16Ready),
+  .smiMemClientReq16Eofc  (smiMemClientReq16Eofc),
+  .smiMemClientReq16Data  (smiMemClientReq16Data),
+  .smiMemClientReq16Stop  (smiMemClientReq16Stop),
+  .smiMemClientResp16Ready (smiMemClientResp16Ready),
+  .smiMemClientResp16Eofc  (smiMemClientResp16Eofc),
+  .smiMemClientResp16Data  (smiMemClientResp16Data),
+  .smiMemClientResp16Stop  (smiMemClientResp16Stop),
+
+  // SMI ports for smiMemClientReq17/smiMemClientResp17
+  .smiMemClientReq17Ready (smiMemClientReq17Ready),
+  .smiMemClientReq17Eofc  (smiMemClientReq17Eofc),
+  .smiMemClientReq17Data  (smiMemClientReq17Data),
+  .smiMemClientReq17Stop  (smiMemClientReq17Stop),
+  .smiMemClientResp17Ready (smiMemClientResp17Ready),
+  .smiMemClientResp17Eofc  (smiMemClientResp17Eofc),
+  .smiMemClientResp17Data  (smiMemClientResp17Data),
+  .smiMemClientResp17Stop  (smiMemClientResp17Stop),
+
+  // SMI ports for smiMemClientReq18/smiMemClientResp18
+  .smiMemClientReq18Ready (smiMemClientReq18Ready),
+  .smiMemClientReq18Eofc  (smiMemClientReq18Eofc),
+  .smiMemClientReq18Data  (smiMemClientReq18Data),
+  .smiMemClientReq18Stop  (smiMemClientReq18Stop),
+  .smiMemClientResp18Ready (smiMemClientResp18Ready),
+  .smiMemClientResp18Eofc  (smiMemClientResp18Eofc),
+  .smiMemClientResp18Data  (smiMemClientResp18Data),
+  .smiMemClientResp18Stop  (smiMemClientResp18Stop),
+
+  // SMI ports for smiMemClientReq19/smiMemClientResp19
+  .smiMemClientReq19Ready (smiMemClientReq19Ready),
+  .smiMemClientReq19Eofc  (smiMemClientReq19Eofc),
+  .smiMemClientReq19Data  (smiMemClientReq19Data),
+  .smiMemClientReq19Stop  (smiMemClientReq19Stop),
+  .smiMemClientResp19Ready (smiMemClientResp19Ready),
+  .smiMemClientResp19Eofc  (smiMemClientResp19Eofc),
+  .smiMemClientResp19Data  (smiMemClientResp19Data),
+  .smiMemClientResp19Stop  (smiMemClientResp19Stop),
+
+  // SMI ports for smiMemClientReq20/smiMemClientResp20
+  .smiMemClientReq20Ready (smiMemClientReq20Ready),
+  .smiMemClientReq20Eofc  (smiMemClientReq20Eofc),
+  .smiMemClientReq20Data  (smiMemClientReq20Data),
+  .smiMemClientReq20Stop  (smiMemClientReq20Stop),
+  .smiMemClientResp20Ready (smiMemClientResp20Ready),
+  .smiMemClientResp20Eofc  (smiMemClientResp20Eofc),
+  .smiMemClientResp20Data  (smiMemClientResp20Data),
+  .smiMemClientResp20Stop  (smiMemClientResp20Stop),
+
+  // SMI ports for smiMemClientReq21/smiMemClientResp21
+  .smiMemClientReq21Ready (smiMemClientReq21Ready),
+  .smiMemClientReq21Eofc  (smiMemClientReq21Eofc),
+  .smiMemClientReq21Data  (smiMemClientReq21Data),
+  .smiMemClientReq21Stop  (smiMemClientReq21Stop),
+  .smiMemClientResp21Ready (smiMemClientResp21Ready),
+  .smiMemClientResp21Eofc  (smiMemClientResp21Eofc),
+  .smiMemClientResp21Data  (smiMemClientResp21Data),
+  .smiMemClientResp21Stop  (smiMemClientResp21Stop),
+
+  // SMI ports for smiMemClientReq22/smiMemClientResp22
+  .smiMemClientReq22Ready (smiMemClientReq22Ready),
+  .smiMemClientReq22Eofc  (smiMemClientReq22Eofc),
+  .smiMemClientReq22Data  (smiMemClientReq22Data),
+  .smiMemClientReq22Stop  (smiMemClientReq22Stop),
+  .smiMemClientResp22Ready (smiMemClientResp22Ready),
+  .smiMemClientResp22Eofc  (smiMemClientResp22Eofc),
+  .smiMemClientResp22Data  (smiMemClientResp22Data),
+  .smiMemClientResp22Stop  (smiMemClientResp22Stop),
+
+  // SMI ports for smiMemClientReq23/smiMemClientResp23
+  .smiMemClientReq23Ready (smiMemClientReq23Ready),
+  .smiMemClientReq23Eofc  (smiMemClientReq23Eofc),
+  .smiMemClientReq23Data  (smiMemClientReq23Data),
+  .smiMemClientReq23Stop  (smiMemClientReq23Stop),
+  .smiMemClientResp23Ready (smiMemClientResp23Ready),
+  .smiMemClientResp23Eofc  (smiMemClientResp23Eofc),
+  .smiMemClientResp23Data  (smiMemClientResp23Data),
+  .smiMemClientResp23Stop  (smiMemClientResp23Stop),
+
+  // SMI ports for smiMemClientReq24/smiMemClientResp24
+  .smiMemClientReq24Ready (smiMemClientReq24Ready),
+  .smiMemClientReq24Eofc  (smiMemClientReq24Eofc),
+  .smiMemClientReq24Data  (smiMemClientReq24Data),
+  .smiMemClientReq24Stop  (smiMemClientReq24Stop),
+  .smiMemClientResp24Ready (smiMemClientResp24Ready),
+  .smiMemClientResp24Eofc  (smiMemClientResp24Eofc),
+  .smiMemClientResp24Data  (smiMemClientResp24Data),
+  .smiMemClientResp24Stop  (smiMemClientResp24Stop),
+
+  // SMI ports for smiMemClientReq25/smiMemClientResp25
+  .smiMemClientReq25Ready (smiMemClientReq25Ready),
+  .smiMemClientReq25Eofc  (smiMemClientReq25Eofc),
+  .smiMemClientReq25Data  (smiMemClientReq25Data),
+  .smiMemClientReq25Stop  (smiMemClientReq25Stop),
+  .smiMemClientResp25Ready (smiMemClientResp25Ready),
+  .smiMemClientResp25Eofc  (smiMemClientResp25Eofc),
+  .smiMemClientResp25Data  (smiMemClientResp25Data),
+  .smiMemClientResp25Stop  (smiMemClientResp25Stop),
+
+  // SMI ports for smiMemClientReq26/smiMemClientResp26
+  .smiMemClientReq26Ready (smiMemClientReq26Ready),
+  .smiMemClientReq26Eofc  (smiMemClientReq26Eofc),
+  .smiMemClientReq26Data  (smiMemClientReq26Data),
+  .smiMemClientReq26Stop  (smiMemClientReq26Stop),
+  .smiMemClientResp26Ready (smiMemClientResp26Ready),
+  .smiMemClientResp26Eofc  (smiMemClientResp26Eofc),
+  .smiMemClientResp26Data  (smiMemClientResp26Data),
+  .smiMemClientResp26Stop  (smiMemClientResp26Stop),
+
+  // SMI ports for smiMemClientReq27/smiMemClientResp27
+  .smiMemClientReq27Ready (smiMemClientReq27Ready),
+  .smiMemClientReq27Eofc  (smiMemClientReq27Eofc),
+  .smiMemClientReq27Data  (smiMemClientReq27Data),
+  .smiMemClientReq27Stop  (smiMemClientReq27Stop),
+  .smiMemClientResp27Ready (smiMemClientResp27Ready),
+  .smiMemClientResp27Eofc  (smiMemClientResp27Eofc),
+  .smiMemClientResp27Data  (smiMemClientResp27Data),
+  .smiMemClientResp27Stop  (smiMemClientResp27Stop),
+
+  // SMI ports for smiMemClientReq28/smiMemClientResp28
+  .smiMemClientReq28Ready (smiMemClientReq28Ready),
+  .smiMemClientReq28Eofc  (smiMemClientReq28Eofc),
+  .smiMemClientReq28Data  (smiMemClientReq28Data),
+  .smiMemClientReq28Stop  (smiMemClientReq28Stop),
+  .smiMemClientResp28Ready (smiMemClientResp28Ready),
+  .smiMemClientResp28Eofc  (smiMemClientResp28Eofc),
+  .smiMemClientResp28Data  (smiMemClientResp28Data),
+  .smiMemClientResp28Stop  (smiMemClientResp28Stop),
+
+  // SMI ports for smiMemClientReq29/smiMemClientResp29
+  .smiMemClientReq29Ready (smiMemClientReq29Ready),
+  .smiMemClientReq29Eofc  (smiMemClientReq29Eofc),
+  .smiMemClientReq29Data  (smiMemClientReq29Data),
+  .smiMemClientReq29Stop  (smiMemClientReq29Stop),
+  .smiMemClientResp29Ready (smiMemClientResp29Ready),
+  .smiMemClientResp29Eofc  (smiMemClientResp29Eofc),
+  .smiMemClientResp29Data  (smiMemClientResp29Data),
+  .smiMemClientResp29Stop  (smiMemClientResp29Stop),
+
+  // SMI ports for smiMemClientReq30/smiMemClientResp30
+  .smiMemClientReq30Ready (smiMemClientReq30Ready),
+  .smiMemClientReq30Eofc  (smiMemClientReq30Eofc),
+  .smiMemClientReq30Data  (smiMemClientReq30Data),
+  .smiMemClientReq30Stop  (smiMemClientReq30Stop),
+  .smiMemClientResp30Ready (smiMemClientResp30Ready),
+  .smiMemClientResp30Eofc  (smiMemClientResp30Eofc),
+  .smiMemClientResp30Data  (smiMemClientResp30Data),
+  .smiMemClientResp30Stop  (smiMemClientResp30Stop),
+
+  // SMI ports for smiMemClientReq31/smiMemClientResp31
+  .smiMemClientReq31Ready (smiMemClientReq31Ready),
+  .smiMemClientReq31Eofc  (smiMemClientReq31Eofc),
+  .smiMemClientReq31Data  (smiMemClientReq31Data),
+  .smiMemClientReq31Stop  (smiMemClientReq31Stop),
+  .smiMemClientResp31Ready (smiMemClientResp31Ready),
+  .smiMemClientResp31Eofc  (smiMemClientResp31Eofc),
+  .smiMemClientResp31Data  (smiMemClientResp31Data),
+  .smiMemClientResp31Stop  (smiMemClientResp31Stop),
+
+  // SMI ports for smiMemClientReq32/smiMemClientResp32
+  .smiMemClientReq32Ready (smiMemClientReq32Ready),
+  .smiMemClientReq32Eofc  (smiMemClientReq32Eofc),
+  .smiMemClientReq32Data  (smiMemClientReq32Data),
+  .smiMemClientReq32Stop  (smiMemClientReq32Stop),
+  .smiMemClientResp32Ready (smiMemClientResp32Ready),
+  .smiMemClientResp32Eofc  (smiMemClientResp32Eofc),
+  .smiMemClientResp32Data  (smiMemClientResp32Data),
+  .smiMemClientResp32Stop  (smiMemClientResp32Stop),
+
+  // SMI ports for smiMemClientReq33/smiMemClientResp33
+  .smiMemClientReq33Ready (smiMemClientReq33Ready),
+  .smiMemClientReq33Eofc  (smiMemClientReq33Eofc),
+  .smiMemClientReq33Data  (smiMemClientReq33Data),
+  .smiMemClientReq33Stop  (smiMemClientReq33Stop),
+  .smiMemClientResp33Ready (smiMemClientResp33Ready),
+  .smiMemClientResp33Eofc  (smiMemClientResp33Eofc),
+  .smiMemClientResp33Data  (smiMemClientResp33Data),
+  .smiMemClientResp33Stop  (smiMemClientResp33Stop),
+
+  // SMI ports for smiMemClientReq34/smiMemClientResp34
+  .smiMemClientReq34Ready (smiMemClientReq34Ready),
+  .smiMemClientReq34Eofc  (smiMemClientReq34Eofc),
+  .smiMemClientReq34Data  (smiMemClientReq34Data),
+  .smiMemClientReq34Stop  (smiMemClientReq34Stop),
+  .smiMemClientResp34Ready (smiMemClientResp34Ready),
+  .smiMemClientResp34Eofc  (smiMemClientResp34Eofc),
+  .smiMemClientResp34Data  (smiMemClientResp34Data),
+  .smiMemClientResp34Stop  (smiMemClientResp34Stop),
+
+  // SMI ports for smiMemClientReq35/smiMemClientResp35
+  .smiMemClientReq35Ready (smiMemClientReq35Ready),
+  .smiMemClientReq35Eofc  (smiMemClientReq35Eofc),
+  .smiMemClientReq35Data  (smiMemClientReq35Data),
+  .smiMemClientReq35Stop  (smiMemClientReq35Stop),
+  .smiMemClientResp35Ready (smiMemClientResp35Ready),
+  .smiMemClientResp35Eofc  (smiMemClientResp35Eofc),
+  .smiMemClientResp35Data  (smiMemClientResp35Data),
+  .smiMemClientResp35Stop  (smiMemClientResp35Stop),
+
+  // SMI ports for smiMemClientReq36/smiMemClientResp36
+  .smiMemClientReq36Ready (smiMemClientReq36Ready),
+  .smiMemClientReq36Eofc  (smiMemClientReq36Eofc),
+  .smiMemClientReq36Data  (smiMemClientReq36Data),
+  .smiMemClientReq36Stop  (smiMemClientReq36Stop),
+  .smiMemClientResp36Ready (smiMemClientResp36Ready),
+  .smiMemClientResp36Eofc  (smiMemClientResp36Eofc),
+  .smiMemClientResp36Data  (smiMemClientResp36Data),
+  .smiMemClientResp36Stop  (smiMemClientResp36Stop),
+
+  // SMI ports for smiMemClientReq37/smiMemClientResp37
+  .smiMemClientReq37Ready (smiMemClientReq37Ready),
+  .smiMemClientReq37Eofc  (smiMemClientReq37Eofc),
+  .smiMemClientReq37Data  (smiMemClientReq37Data),
+  .smiMemClientReq37Stop  (smiMemClientReq37Stop),
+  .smiMemClientResp37Ready (smiMemClientResp37Ready),
+  .smiMemClientResp37Eofc  (smiMemClientResp37Eofc),
+  .smiMemClientResp37Data  (smiMemClientResp37Data),
+  .smiMemClientResp37Stop  (smiMemClientResp37Stop),
+
+  // SMI ports for smiMemClientReq38/smiMemClientResp38
+  .smiMemClientReq38Ready (smiMemClientReq38Ready),
+  .smiMemClientReq38Eofc  (smiMemClientReq38Eofc),
+  .smiMemClientReq38Data  (smiMemClientReq38Data),
+  .smiMemClientReq38Stop  (smiMemClientReq38Stop),
+  .smiMemClientResp38Ready (smiMemClientResp38Ready),
+  .smiMemClientResp38Eofc  (smiMemClientResp38Eofc),
+  .smiMemClientResp38Data  (smiMemClientResp38Data),
+  .smiMemClientResp38Stop  (smiMemClientResp38Stop),
+
+  // SMI ports for smiMemClientReq39/smiMemClientResp39
+  .smiMemClientReq39Ready (smiMemClientReq39Ready),
+  .smiMemClientReq39Eofc  (smiMemClientReq39Eofc),
+  .smiMemClientReq39Data  (smiMemClientReq39Data),
+  .smiMemClientReq39Stop  (smiMemClientReq39Stop),
+  .smiMemClientResp39Ready (smiMemClientResp39Ready),
+  .smiMemClientResp39Eofc  (smiMemClientResp39Eofc),
+  .smiMemClientResp39Data  (smiMemClientResp39Data),
+  .smiMemClientResp39Stop  (smiMemClientResp39Stop),
+
+  // SMI ports for smiMemClientReq40/smiMemClientResp40
+  .smiMemClientReq40Ready (smiMemClientReq40Ready),
+  .smiMemClientReq40Eofc  (smiMemClientReq40Eofc),
+  .smiMemClientReq40Data  (smiMemClientReq40Data),
+  .smiMemClientReq40Stop  (smiMemClientReq40Stop),
+  .smiMemClientResp40Ready (smiMemClientResp40Ready),
+  .smiMemClientResp40Eofc  (smiMemClientResp40Eofc),
+  .smiMemClientResp40Data  (smiMemClientResp40Data),
+  .smiMemClientResp40Stop  (smiMemClientResp40Stop),
+
+  // SMI ports for smiMemClientReq41/smiMemClientResp41
+  .smiMemClientReq41Ready (smiMemClientReq41Ready),
+  .smiMemClientReq41Eofc  (smiMemClientReq41Eofc),
+  .smiMemClientReq41Data  (smiMemClientReq41Data),
+  .smiMemClientReq41Stop  (smiMemClientReq41Stop),
+  .smiMemClientResp41Ready (smiMemClientResp41Ready),
+  .smiMemClientResp41Eofc  (smiMemClientResp41Eofc),
+  .smiMemClientResp41Data  (smiMemClientResp41Data),
+  .smiMemClientResp41Stop  (smiMemClientResp41Stop),
+
+  // SMI ports for smiMemClientReq42/smiMemClientResp42
+  .smiMemClientReq42Ready (smiMemClientReq42Ready),
+  .smiMemClientReq42Eofc  (smiMemClientReq42Eofc),
+  .smiMemClientReq42Data  (smiMemClientReq42Data),
+  .smiMemClientReq42Stop  (smiMemClientReq42Stop),
+  .smiMemClientResp42Ready (smiMemClientResp42Ready),
+  .smiMemClientResp42Eofc  (smiMemClientResp42Eofc),
+  .smiMemClientResp42Data  (smiMemClientResp42Data),
+  .smiMemClientResp42Stop  (smiMemClientResp42Stop),
+
+  // SMI ports for smiMemClientReq43/smiMemClientResp43
+  .smiMemClientReq43Ready (smiMemClientReq43Ready),
+  .smiMemClientReq43Eofc  (smiMemClientReq43Eofc),
+  .smiMemClientReq43Data  (smiMemClientReq43Data),
+  .smiMemClientReq43Stop  (smiMemClientReq43Stop),
+  .smiMemClientResp43Ready (smiMemClientResp43Ready),
+  .smiMemClientResp43Eofc  (smiMemClientResp43Eofc),
+  .smiMemClientResp43Data  (smiMemClientResp43Data),
+  .smiMemClientResp43Stop  (smiMemClientResp43Stop),
+
+  // SMI ports for smiMemClientReq44/smiMemClientResp44
+  .smiMemClientReq44Ready (smiMemClientReq44Ready),
+  .smiMemClientReq44Eofc  (smiMemClientReq44Eofc),
+  .smiMemClientReq44Data  (smiMemClientReq44Data),
+  .smiMemClientReq44Stop  (smiMemClientReq44Stop),
+  .smiMemClientResp44Ready (smiMemClientResp44Ready),
+  .smiMemClientResp44Eofc  (smiMemClientResp44Eofc),
+  .smiMemClientResp44Data  (smiMemClientResp44Data),
+  .smiMemClientResp44Stop  (smiMemClientResp44Stop),
+
+  // SMI ports for smiMemClientReq45/smiMemClientResp45
+  .smiMemClientReq45Ready (smiMemClientReq45Ready),
+  .smiMemClientReq45Eofc  (smiMemClientReq45Eofc),
+  .smiMemClientReq45Data  (smiMemClientReq45Data),
+  .smiMemClientReq45Stop  (smiMemClientReq45Stop),
+  .smiMemClientResp45Ready (smiMemClientResp45Ready),
+  .smiMemClientResp45Eofc  (smiMemClientResp45Eofc),
+  .smiMemClientResp45Data  (smiMemClientResp45Data),
+  .smiMemClientResp45Stop  (smiMemClientResp45Stop),
+
+  // SMI ports for smiMemClientReq46/smiMemClientResp46
+  .smiMemClientReq46Ready (smiMemClientReq46Ready),
+  .smiMemClientReq46Eofc  (smiMemClientReq46Eofc),
+  .smiMemClientReq46Data  (smiMemClientReq46Data),
+  .smiMemClientReq46Stop  (smiMemClientReq46Stop),
+  .smiMemClientResp46Ready (smiMemClientResp46Ready),
+  .smiMemClientResp46Eofc  (smiMemClientResp46Eofc),
+  .smiMemClientResp46Data  (smiMemClientResp46Data),
+  .smiMemClientResp46Stop  (smiMemClientResp46Stop),
+
+  // SMI ports for smiMemClientReq47/smiMemClientResp47
+  .smiMemClientReq47Ready (smiMemClientReq47Ready),
+  .smiMemClientReq47Eofc  (smiMemClientReq47Eofc),
+  .smiMemClientReq47Data  (smiMemClientReq47Data),
+  .smiMemClientReq47Stop  (smiMemClientReq47Stop),
+  .smiMemClientResp47Ready (smiMemClientResp47Ready),
+  .smiMemClientResp47Eofc  (smiMemClientResp47Eofc),
+  .smiMemClientResp47Data  (smiMemClientResp47Data),
+  .smiMemClientResp47Stop  (smiMemClientResp47Stop),
+
+  // SMI ports for smiMemClientReq48/smiMemClientResp48
+  .smiMemClientReq48Ready (smiMemClientReq48Ready),
+  .smiMemClientReq48Eofc  (smiMemClientReq48Eofc),
+  .smiMemClientReq48Data  (smiMemClientReq48Data),
+  .smiMemClientReq48Stop  (smiMemClientReq48Stop),
+  .smiMemClientResp48Ready (smiMemClientResp48Ready),
+  .smiMemClientResp48Eofc  (smiMemClientResp48Eofc),
+  .smiMemClientResp48Data  (smiMemClientResp48Data),
+  .smiMemClientResp48Stop  (smiMemClientResp48Stop),
+
+  // SMI ports for smiMemClientReq49/smiMemClientResp49
+  .smiMemClientReq49Ready (smiMemClientReq49Ready),
+  .smiMemClientReq49Eofc  (smiMemClientReq49Eofc),
+  .smiMemClientReq49Data  (smiMemClientReq49Data),
+  .smiMemClientReq49Stop  (smiMemClientReq49Stop),
+  .smiMemClientResp49Ready (smiMemClientResp49Ready),
+  .smiMemClientResp49Eofc  (smiMemClientResp49Eofc),
+  .smiMemClientResp49Data  (smiMemClientResp49Data),
+  .smiMemClientResp49Stop  (smiMemClientResp49Stop),
+
+  // SMI ports for smiMemClientReq50/smiMemClientResp50
+  .smiMemClientReq50Ready (smiMemClientReq50Ready),
+  .smiMemClientReq50Eofc  (smiMemClientReq50Eofc),
+  .smiMemClientReq50Data  (smiMemClientReq50Data),
+  .smiMemClientReq50Stop  (smiMemClientReq50Stop),
+  .smiMemClientResp50Ready (smiMemClientResp50Ready),
+  .smiMemClientResp50Eofc  (smiMemClientResp50Eofc),
+  .smiMemClientResp50Data  (smiMemClientResp50Data),
+  .smiMemClientResp50Stop  (smiMemClientResp50Stop),
+
+  // SMI ports for smiMemClientReq51/smiMemClientResp51
+  .smiMemClientReq51Ready (smiMemClientReq51Ready),
+  .smiMemClientReq51Eofc  (smiMemClientReq51Eofc),
+  .smiMemClientReq51Data  (smiMemClientReq51Data),
+  .smiMemClientReq51Stop  (smiMemClientReq51Stop),
+  .smiMemClientResp51Ready (smiMemClientResp51Ready),
+  .smiMemClientResp51Eofc  (smiMemClientResp51Eofc),
+  .smiMemClientResp51Data  (smiMemClientResp51Data),
+  .smiMemClientResp51Stop  (smiMemClientResp51Stop),
+
+  // SMI ports for smiMemClientReq52/smiMemClientResp52
+  .smiMemClientReq52Ready (smiMemClientReq52Ready),
+  .smiMemClientReq52Eofc  (smiMemClientReq52Eofc),
+  .smiMemClientReq52Data  (smiMemClientReq52Data),
+  .smiMemClientReq52Stop  (smiMemClientReq52Stop),
+  .smiMemClientResp52Ready (smiMemClientResp52Ready),
+  .smiMemClientResp52Eofc  (smiMemClientResp52Eofc),
+  .smiMemClientResp52Data  (smiMemClientResp52Data),
+  .smiMemClientResp52Stop  (smiMemClientResp52Stop),
+
+  // SMI ports for smiMemClientReq53/smiMemClientResp53
+  .smiMemClientReq53Ready (smiMemClientReq53Ready),
+  .smiMemClientReq53Eofc  (smiMemClientReq53Eofc),
+  .smiMemClientReq53Data  (smiMemClientReq53Data),
+  .smiMemClientReq53Stop  (smiMemClientReq53Stop),
+  .smiMemClientResp53Ready (smiMemClientResp53Ready),
+  .smiMemClientResp53Eofc  (smiMemClientResp53Eofc),
+  .smiMemClientResp53Data  (smiMemClientResp53Data),
+  .smiMemClientResp53Stop  (smiMemClientResp53Stop),
+
+  // SMI ports for smiMemClientReq54/smiMemClientResp54
+  .smiMemClientReq54Ready (smiMemClientReq54Ready),
+  .smiMemClientReq54Eofc  (smiMemClientReq54Eofc),
+  .smiMemClientReq54Data  (smiMemClientReq54Data),
+  .smiMemClientReq54Stop  (smiMemClientReq54Stop),
+  .smiMemClientResp54Ready (smiMemClientResp54Ready),
+  .smiMemClientResp54Eofc  (smiMemClientResp54Eofc),
+  .smiMemClientResp54Data  (smiMemClientResp54Data),
+  .smiMemClientResp54Stop  (smiMemClientResp54Stop),
+
+  // SMI ports for smiMemClientReq55/smiMemClientResp55
+  .smiMemClientReq55Ready (smiMemClientReq55Ready),
+  .smiMemClientReq55Eofc  (smiMemClientReq55Eofc),
+  .smiMemClientReq55Data  (smiMemClientReq55Data),
+  .smiMemClientReq55Stop  (smiMemClientReq55Stop),
+  .smiMemClientResp55Ready (smiMemClientResp55Ready),
+  .smiMemClientResp55Eofc  (smiMemClientResp55Eofc),
+  .smiMemClientResp55Data  (smiMemClientResp55Data),
+  .smiMemClientResp55Stop  (smiMemClientResp55Stop),
+
+  // SMI ports for smiMemClientReq56/smiMemClientResp56
+  .smiMemClientReq56Ready (smiMemClientReq56Ready),
+  .smiMemClientReq56Eofc  (smiMemClientReq56Eofc),
+  .smiMemClientReq56Data  (smiMemClientReq56Data),
+  .smiMemClientReq56Stop  (smiMemClientReq56Stop),
+  .smiMemClientResp56Ready (smiMemClientResp56Ready),
+  .smiMemClientResp56Eofc  (smiMemClientResp56Eofc),
+  .smiMemClientResp56Data  (smiMemClientResp56Data),
+  .smiMemClientResp56Stop  (smiMemClientResp56Stop),
+
+  // SMI ports for smiMemClientReq57/smiMemClientResp57
+  .smiMemClientReq57Ready (smiMemClientReq57Ready),
+  .smiMemClientReq57Eofc  (smiMemClientReq57Eofc),
+  .smiMemClientReq57Data  (smiMemClientReq57Data),
+  .smiMemClientReq57Stop  (smiMemClientReq57Stop),
+  .smiMemClientResp57Ready (smiMemClientResp57Ready),
+  .smiMemClientResp57Eofc  (smiMemClientResp57Eofc),
+  .smiMemClientResp57Data  (smiMemClientResp57Data),
+  .smiMemClientResp57Stop  (smiMemClientResp57Stop),
+
+  // SMI ports for smiMemClientReq58/smiMemClientResp58
+  .smiMemClientReq58Ready (smiMemClientReq58Ready),
+  .smiMemClientReq58Eofc  (smiMemClientReq58Eofc),
+  .smiMemClientReq58Data  (smiMemClientReq58Data),
+  .smiMemClientReq58Stop  (smiMemClientReq58Stop),
+  .smiMemClientResp58Ready (smiMemClientResp58Ready),
+  .smiMemClientResp58Eofc  (smiMemClientResp58Eofc),
+  .smiMemClientResp58Data  (smiMemClientResp58Data),
+  .smiMemClientResp58Stop  (smiMemClientResp58Stop),
+
+  // SMI ports for smiMemClientReq59/smiMemClientResp59
+  .smiMemClientReq59Ready (smiMemClientReq59Ready),
+  .smiMemClientReq59Eofc  (smiMemClientReq59Eofc),
+  .smiMemClientReq59Data  (smiMemClientReq59Data),
+  .smiMemClientReq59Stop  (smiMemClientReq59Stop),
+  .smiMemClientResp59Ready (smiMemClientResp59Ready),
+  .smiMemClientResp59Eofc  (smiMemClientResp59Eofc),
+  .smiMemClientResp59Data  (smiMemClientResp59Data),
+  .smiMemClientResp59Stop  (smiMemClientResp59Stop),
+
+  // SMI ports for smiMemClientReq60/smiMemClientResp60
+  .smiMemClientReq60Ready (smiMemClientReq60Ready),
+  .smiMemClientReq60Eofc  (smiMemClientReq60Eofc),
+  .smiMemClientReq60Data  (smiMemClientReq60Data),
+  .smiMemClientReq60Stop  (smiMemClientReq60Stop),
+  .smiMemClientResp60Ready (smiMemClientResp60Ready),
+  .smiMemClientResp60Eofc  (smiMemClientResp60Eofc),
+  .smiMemClientResp60Data  (smiMemClientResp60Data),
+  .smiMemClientResp60Stop  (smiMemClientResp60Stop),
+
+  // SMI ports for smiMemClientReq61/smiMemClientResp61
+  .smiMemClientReq61Ready (smiMemClientReq61Ready),
+  .smiMemClientReq61Eofc  (smiMemClientReq61Eofc),
+  .smiMemClientReq61Data  (smiMemClientReq61Data),
+  .smiMemClientReq61Stop  (smiMemClientReq61Stop),
+  .smiMemClientResp61Ready (smiMemClientResp61Ready),
+  .smiMemClientResp61Eofc  (smiMemClientResp61Eofc),
+  .smiMemClientResp61Data  (smiMemClientResp61Data),
+  .smiMemClientResp61Stop  (smiMemClientResp61Stop),
+
+  // SMI ports for smiMemClientReq62/smiMemClientResp62
+  .smiMemClientReq62Ready (smiMemClientReq62Ready),
+  .smiMemClientReq62Eofc  (smiMemClientReq62Eofc),
+  .smiMemClientReq62Data  (smiMemClientReq62Data),
+  .smiMemClientReq62Stop  (smiMemClientReq62Stop),
+  .smiMemClientResp62Ready (smiMemClientResp62Ready),
+  .smiMemClientResp62Eofc  (smiMemClientResp62Eofc),
+  .smiMemClientResp62Data  (smiMemClientResp62Data),
+  .smiMemClientResp62Stop  (smiMemClientResp62Stop),
+
+  // SMI ports for smiMemClientReq63/smiMemClientResp63
+  .smiMemClientReq63Ready (smiMemClientReq63Ready),
+  .smiMemClientReq63Eofc  (smiMemClientReq63Eofc),
+  .smiMemClientReq63Data  (smiMemClientReq63Data),
+  .smiMemClientReq63Stop  (smiMemClientReq63Stop),
+  .smiMemClientResp63Ready (smiMemClientResp63Ready),
+  .smiMemClientResp63Eofc  (smiMemClientResp63Eofc),
+  .smiMemClientResp63Data  (smiMemClientResp63Data),
+  .smiMemClientResp63Stop  (smiMemClientResp63Stop),
+
+
+  // SMI ports for smiMemServerReq/smiMemServerResp
+  .smiMemServerReqReady (smiMemServerReqReady),
+  .smiMemServerReqEofc  (smiMemServerReqEofc),
+  .smiMemServerReqData  (smiMemServerReqData),
+  .smiMemServerReqStop  (smiMemServerReqStop),
+  .smiMemServerRespReady (smiMemServerRespReady),
+  .smiMemServerRespEofc  (smiMemServerRespEofc),
+  .smiMemServerRespData  (smiMemServerRespData),
+  .smiMemServerRespStop  (smiMemServerRespStop),
+
+
+  // Connect system level signals.
+  .clk  (clk),
+  .srst (reset)
+);
+
+//
+// Map SMI flit vector signals.
+// 
+assign smiMemClientReq0Data  = smiMemClientReq0Flit [63:0];
+assign smiMemClientReq0Eofc  = smiMemClientReq0Flit [71:64];
+assign smiMemClientResp0Flit = { smiMemClientResp0Eofc, smiMemClientResp0Data };
+
+assign smiMemClientReq1Data  = smiMemClientReq1Flit [63:0];
+assign smiMemClientReq1Eofc  = smiMemClientReq1Flit [71:64];
+assign smiMemClientResp1Flit = { smiMemClientResp1Eofc, smiMemClientResp1Data };
+
+assign smiMemClientReq2Data  = smiMemClientReq2Flit [63:0];
+assign smiMemClientReq2Eofc  = smiMemClientReq2Flit [71:64];
+assign smiMemClientResp2Flit = { smiMemClientResp2Eofc, smiMemClientResp2Data };
+
+assign smiMemClientReq3Data  = smiMemClientReq3Flit [63:0];
+assign smiMemClientReq3Eofc  = smiMemClientReq3Flit [71:64];
+assign smiMemClientResp3Flit = { smiMemClientResp3Eofc, smiMemClientResp3Data };
+
+assign smiMemClientReq4Data  = smiMemClientReq4Flit [63:0];
+assign smiMemClientReq4Eofc  = smiMemClientReq4Flit [71:64];
+assign smiMemClientResp4Flit = { smiMemClientResp4Eofc, smiMemClientResp4Data };
+
+assign smiMemClientReq5Data  = smiMemClientReq5Flit [63:0];
+assign smiMemClientReq5Eofc  = smiMemClientReq5Flit [71:64];
+assign smiMemClientResp5Flit = { smiMemClientResp5Eofc, smiMemClientResp5Data };
+
+assign smiMemClientReq6Data  = smiMemClientReq6Flit [63:0];
+assign smiMemClientReq6Eofc  = smiMemClientReq6Flit [71:64];
+assign smiMemClientResp6Flit = { smiMemClientResp6Eofc, smiMemClientResp6Data };
+
+assign smiMemClientReq7Data  = smiMemClientReq7Flit [63:0];
+assign smiMemClientReq7Eofc  = smiMemClientReq7Flit [71:64];
+assign smiMemClientResp7Flit = { smiMemClientResp7Eofc, smiMemClientResp7Data };
+
+assign smiMemClientReq8Data  = smiMemClientReq8Flit [63:0];
+assign smiMemClientReq8Eofc  = smiMemClientReq8Flit [71:64];
+assign smiMemClientResp8Flit = { smiMemClientResp8Eofc, smiMemClientResp8Data };
+
+assign smiMemClientReq9Data  = smiMemClientReq9Flit [63:0];
+assign smiMemClientReq9Eofc  = smiMemClientReq9Flit [71:64];
+assign smiMemClientResp9Flit = { smiMemClientResp9Eofc, smiMemClientResp9Data };
+
+assign smiMemClientReq10Data  = smiMemClientReq10Flit [63:0];
+assign smiMemClientReq10Eofc  = smiMemClientReq10Flit [71:64];
+assign smiMemClientResp10Flit = { smiMemClientResp10Eofc, smiMemClientResp10Data };
+
+assign smiMemClientReq11Data  = smiMemClientReq11Flit [63:0];
+assign smiMemClientReq11Eofc  = smiMemClientReq11Flit [71:64];
+assign smiMemClientResp11Flit = { smiMemClientResp11Eofc, smiMemClientResp11Data };
+
+assign smiMemClientReq12Data  = smiMemClientReq12Flit [63:0];
+assign smiMemClientReq12Eofc  = smiMemClientReq12Flit [71:64];
+assign smiMemClientResp12Flit = { smiMemClientResp12Eofc, smiMemClientResp12Data };
+
+assign smiMemClientReq13Data  = smiMemClientReq13Flit [63:0];
+assign smiMemClientReq13Eofc  = smiMemClientReq13Flit [71:64];
+assign smiMemClientResp13Flit = { smiMemClientResp13Eofc, smiMemClientResp13Data };
+
+assign smiMemClientReq14Data  = smiMemClientReq14Flit [63:0];
+assign smiMemClientReq14Eofc  = smiMemClientReq14Flit [71:64];
+assign smiMemClientResp14Flit = { smiMemClientResp14Eofc, smiMemClientResp14Data };
+
+assign smiMemClientReq15Data  = smiMemClientReq15Flit [63:0];
+assign smiMemClientReq15Eofc  = smiMemClientReq15Flit [71:64];
+assign smiMemClientResp15Flit = { smiMemClientResp15Eofc, smiMemClientResp15Data };
+
+assign smiMemClientReq16Data  = smiMemClientReq16Flit [63:0];
+assign smiMemClientReq16Eofc  = smiMemClientReq16Flit [71:64];
+assign smiMemClientResp16Flit = { smiMemClientResp16Eofc, smiMemClientResp16Data };
+
+assign smiMemClientReq17Data  = smiMemClientReq17Flit [63:0];
+assign smiMemClientReq17Eofc  = smiMemClientReq17Flit [71:64];
+assign smiMemClientResp17Flit = { smiMemClientResp17Eofc, smiMemClientResp17Data };
+
+assign smiMemClientReq18Data  = smiMemClientReq18Flit [63:0];
+assign smiMemClientReq18Eofc  = smiMemClientReq18Flit [71:64];
+assign smiMemClientResp18Flit = { smiMemClientResp18Eofc, smiMemClientResp18Data };
+
+assign smiMemClientReq19Data  = smiMemClientReq19Flit [63:0];
+assign smiMemClientReq19Eofc  = smiMemClientReq19Flit [71:64];
+assign smiMemClientResp19Flit = { smiMemClientResp19Eofc, smiMemClientResp19Data };
+
+assign smiMemClientReq20Data  = smiMemClientReq20Flit [63:0];
+assign smiMemClientReq20Eofc  = smiMemClientReq20Flit [71:64];
+assign smiMemClientResp20Flit = { smiMemClientResp20Eofc, smiMemClientResp20Data };
+
+assign smiMemClientReq21Data  = smiMemClientReq21Flit [63:0];
+assign smiMemClientReq21Eofc  = smiMemClientReq21Flit [71:64];
+assign smiMemClientResp21Flit = { smiMemClientResp21Eofc, smiMemClientResp21Data };
+
+assign smiMemClientReq22Data  = smiMemClientReq22Flit [63:0];
+assign smiMemClientReq22Eofc  = smiMemClientReq22Flit [71:64];
+assign smiMemClientResp22Flit = { smiMemClientResp22Eofc, smiMemClientResp22Data };
+
+assign smiMemClientReq23Data  = smiMemClientReq23Flit [63:0];
+assign smiMemClientReq23Eofc  = smiMemClientReq23Flit [71:64];
+assign smiMemClientResp23Flit = { smiMemClientResp23Eofc, smiMemClientResp23Data };
+
+assign smiMemClientReq24Data  = smiMemClientReq24Flit [63:0];
+assign smiMemClientReq24Eofc  = smiMemClientReq24Flit [71:64];
+assign smiMemClientResp24Flit = { smiMemClientResp24Eofc, smiMemClientResp24Data };
+
+assign smiMemClientReq25Data  = smiMemClientReq25Flit [63:0];
+assign smiMemClientReq25Eofc  = smiMemClientReq25Flit [71:64];
+assign smiMemClientResp25Flit = { smiMemClientResp25Eofc, smiMemClientResp25Data };
+
+assign smiMemClientReq26Data  = smiMemClientReq26Flit [63:0];
+assign smiMemClientReq26Eofc  = smiMemClientReq26Flit [71:64];
+assign smiMemClientResp26Flit = { smiMemClientResp26Eofc, smiMemClientResp26Data };
+
+assign smiMemClientReq27Data  = smiMemClientReq27Flit [63:0];
+assign smiMemClientReq27Eofc  = smiMemClientReq27Flit [71:64];
+assign smiMemClientResp27Flit = { smiMemClientResp27Eofc, smiMemClientResp27Data };
+
+assign smiMemClientReq28Data  = smiMemClientReq28Flit [63:0];
+assign smiMemClientReq28Eofc  = smiMemClientReq28Flit [71:64];
+assign smiMemClientResp28Flit = { smiMemClientResp28Eofc, smiMemClientResp28Data };
+
+assign smiMemClientReq29Data  = smiMemClientReq29Flit [63:0];
+assign smiMemClientReq29Eofc  = smiMemClientReq29Flit [71:64];
+assign smiMemClientResp29Flit = { smiMemClientResp29Eofc, smiMemClientResp29Data };
+
+assign smiMemClientReq30Data  = smiMemClientReq30Flit [63:0];
+assign smiMemClientReq30Eofc  = smiMemClientReq30Flit [71:64];
+assign smiMemClientResp30Flit = { smiMemClientResp30Eofc, smiMemClientResp30Data };
+
+assign smiMemClientReq31Data  = smiMemClientReq31Flit [63:0];
+assign smiMemClientReq31Eofc  = smiMemClientReq31Flit [71:64];
+assign smiMemClientResp31Flit = { smiMemClientResp31Eofc, smiMemClientResp31Data };
+
+assign smiMemClientReq32Data  = smiMemClientReq32Flit [63:0];
+assign smiMemClientReq32Eofc  = smiMemClientReq32Flit [71:64];
+assign smiMemClientResp32Flit = { smiMemClientResp32Eofc, smiMemClientResp32Data };
+
+assign smiMemClientReq33Data  = smiMemClientReq33Flit [63:0];
+assign smiMemClientReq33Eofc  = smiMemClientReq33Flit [71:64];
+assign smiMemClientResp33Flit = { smiMemClientResp33Eofc, smiMemClientResp33Data };
+
+assign smiMemClientReq34Data  = smiMemClientReq34Flit [63:0];
+assign smiMemClientReq34Eofc  = smiMemClientReq34Flit [71:64];
+assign smiMemClientResp34Flit = { smiMemClientResp34Eofc, smiMemClientResp34Data };
+
+assign smiMemClientReq35Data  = smiMemClientReq35Flit [63:0];
+assign smiMemClientReq35Eofc  = smiMemClientReq35Flit [71:64];
+assign smiMemClientResp35Flit = { smiMemClientResp35Eofc, smiMemClientResp35Data };
+
+assign smiMemClientReq36Data  = smiMemClientReq36Flit [63:0];
+assign smiMemClientReq36Eofc  = smiMemClientReq36Flit [71:64];
+assign smiMemClientResp36Flit = { smiMemClientResp36Eofc, smiMemClientResp36Data };
+
+assign smiMemClientReq37Data  = smiMemClientReq37Flit [63:0];
+assign smiMemClientReq37Eofc  = smiMemClientReq37Flit [71:64];
+assign smiMemClientResp37Flit = { smiMemClientResp37Eofc, smiMemClientResp37Data };
+
+assign smiMemClientReq38Data  = smiMemClientReq38Flit [63:0];
+assign smiMemClientReq38Eofc  = smiMemClientReq38Flit [71:64];
+assign smiMemClientResp38Flit = { smiMemClientResp38Eofc, smiMemClientResp38Data };
+
+assign smiMemClientReq39Data  = smiMemClientReq39Flit [63:0];
+assign smiMemClientReq39Eofc  = smiMemClientReq39Flit [71:64];
+assign smiMemClientResp39Flit = { smiMemClientResp39Eofc, smiMemClientResp39Data };
+
+assign smiMemClientReq40Data  = smiMemClientReq40Flit [63:0];
+assign smiMemClientReq40Eofc  = smiMemClientReq40Flit [71:64];
+assign smiMemClientResp40Flit = { smiMemClientResp40Eofc, smiMemClientResp40Data };
+
+assign smiMemClientReq41Data  = smiMemClientReq41Flit [63:0];
+assign smiMemClientReq41Eofc  = smiMemClientReq41Flit [71:64];
+assign smiMemClientResp41Flit = { smiMemClientResp41Eofc, smiMemClientResp41Data };
+
+assign smiMemClientReq42Data  = smiMemClientReq42Flit [63:0];
+assign smiMemClientReq42Eofc  = smiMemClientReq42Flit [71:64];
+assign smiMemClientResp42Flit = { smiMemClientResp42Eofc, smiMemClientResp42Data };
+
+assign smiMemClientReq43Data  = smiMemClientReq43Flit [63:0];
+assign smiMemClientReq43Eofc  = smiMemClientReq43Flit [71:64];
+assign smiMemClientResp43Flit = { smiMemClientResp43Eofc, smiMemClientResp43Data };
+
+assign smiMemClientReq44Data  = smiMemClientReq44Flit [63:0];
+assign smiMemClientReq44Eofc  = smiMemClientReq44Flit [71:64];
+assign smiMemClientResp44Flit = { smiMemClientResp44Eofc, smiMemClientResp44Data };
+
+assign smiMemClientReq45Data  = smiMemClientReq45Flit [63:0];
+assign smiMemClientReq45Eofc  = smiMemClientReq45Flit [71:64];
+assign smiMemClientResp45Flit = { smiMemClientResp45Eofc, smiMemClientResp45Data };
+
+assign smiMemClientReq46Data  = smiMemClientReq46Flit [63:0];
+assign smiMemClientReq46Eofc  = smiMemClientReq46Flit [71:64];
+assign smiMemClientResp46Flit = { smiMemClientResp46Eofc, smiMemClientResp46Data };
+
+assign smiMemClientReq47Data  = smiMemClientReq47Flit [63:0];
+assign smiMemClientReq47Eofc  = smiMemClientReq47Flit [71:64];
+assign smiMemClientResp47Flit = { smiMemClientResp47Eofc, smiMemClientResp47Data };
+
+assign smiMemClientReq48Data  = smiMemClientReq48Flit [63:0];
+assign smiMemClientReq48Eofc  = smiMemClientReq48Flit [71:64];
+assign smiMemClientResp48Flit = { smiMemClientResp48Eofc, smiMemClientResp48Data };
+
+assign smiMemClientReq49Data  = smiMemClientReq49Flit [63:0];
+assign smiMemClientReq49Eofc  = smiMemClientReq49Flit [71:64];
+assign smiMemClientResp49Flit = { smiMemClientResp49Eofc, smiMemClientResp49Data };
+
+assign smiMemClientReq50Data  = smiMemClientReq50Flit [63:0];
+assign smiMemClientReq50Eofc  = smiMemClientReq50Flit [71:64];
+assign smiMemClientResp50Flit = { smiMemClientResp50Eofc, smiMemClientResp50Data };
+
+assign smiMemClientReq51Data  = smiMemClientReq51Flit [63:0];
+assign smiMemClientReq51Eofc  = smiMemClientReq51Flit [71:64];
+assign smiMemClientResp51Flit = { smiMemClientResp51Eofc, smiMemClientResp51Data };
+
+assign smiMemClientReq52Data  = smiMemClientReq52Flit [63:0];
+assign smiMemClientReq52Eofc  = smiMemClientReq52Flit [71:64];
+assign smiMemClientResp52Flit = { smiMemClientResp52Eofc, smiMemClientResp52Data };
+
+assign smiMemClientReq53Data  = smiMemClientReq53Flit [63:0];
+assign smiMemClientReq53Eofc  = smiMemClientReq53Flit [71:64];
+assign smiMemClientResp53Flit = { smiMemClientResp53Eofc, smiMemClientResp53Data };
+
+assign smiMemClientReq54Data  = smiMemClientReq54Flit [63:0];
+assign smiMemClientReq54Eofc  = smiMemClientReq54Flit [71:64];
+assign smiMemClientResp54Flit = { smiMemClientResp54Eofc, smiMemClientResp54Data };
+
+assign smiMemClientReq55Data  = smiMemClientReq55Flit [63:0];
+assign smiMemClientReq55Eofc  = smiMemClientReq55Flit [71:64];
+assign smiMemClientResp55Flit = { smiMemClientResp55Eofc, smiMemClientResp55Data };
+
+assign smiMemClientReq56Data  = smiMemClientReq56Flit [63:0];
+assign smiMemClientReq56Eofc  = smiMemClientReq56Flit [71:64];
+assign smiMemClientResp56Flit = { smiMemClientResp56Eofc, smiMemClientResp56Data };
+
+assign smiMemClientReq57Data  = smiMemClientReq57Flit [63:0];
+assign smiMemClientReq57Eofc  = smiMemClientReq57Flit [71:64];
+assign smiMemClientResp57Flit = { smiMemClientResp57Eofc, smiMemClientResp57Data };
+
+assign smiMemClientReq58Data  = smiMemClientReq58Flit [63:0];
+assign smiMemClientReq58Eofc  = smiMemClientReq58Flit [71:64];
+assign smiMemClientResp58Flit = { smiMemClientResp58Eofc, smiMemClientResp58Data };
+
+assign smiMemClientReq59Data  = smiMemClientReq59Flit [63:0];
+assign smiMemClientReq59Eofc  = smiMemClientReq59Flit [71:64];
+assign smiMemClientResp59Flit = { smiMemClientResp59Eofc, smiMemClientResp59Data };
+
+assign smiMemClientReq60Data  = smiMemClientReq60Flit [63:0];
+assign smiMemClientReq60Eofc  = smiMemClientReq60Flit [71:64];
+assign smiMemClientResp60Flit = { smiMemClientResp60Eofc, smiMemClientResp60Data };
+
+assign smiMemClientReq61Data  = smiMemClientReq61Flit [63:0];
+assign smiMemClientReq61Eofc  = smiMemClientReq61Flit [71:64];
+assign smiMemClientResp61Flit = { smiMemClientResp61Eofc, smiMemClientResp61Data };
+
+assign smiMemClientReq62Data  = smiMemClientReq62Flit [63:0];
+assign smiMemClientReq62Eofc  = smiMemClientReq62Flit [71:64];
+assign smiMemClientResp62Flit = { smiMemClientResp62Eofc, smiMemClientResp62Data };
+
+assign smiMemClientReq63Data  = smiMemClientReq63Flit [63:0];
+assign smiMemClientReq63Eofc  = smiMemClientReq63Flit [71:64];
+assign smiMemClientResp63Flit = { smiMemClientResp63Eofc, smiMemClientResp63Data };
+
+//
+// Instantiate the SMI kernel logic. Uses positional signal assignment since
+// the Go kernel parameter names may vary.
+//
+teak__main_x2e_Top smiKernel (
+
+  // Connect action control signals.
+  go_ready,
+  go_stop,
+  done_ready,
+  done_stop,
+
+  // Connect configuration register file access signals.
+  config_req_valid,
+  config_req_data,
+  config_req_stop,
+  config_resp_valid,
+  config_resp_data,
+  config_resp_stop,
+
+  // Kernel internal register access signals.
+  control_req_valid,
+  control_req_data,
+  control_req_stop,
+  control_resp_valid,
+  control_resp_data,
+  control_resp_stop,
+
+  // Kernel interrupt queue signals.
+  interrupt_valid,
+  interrupt_data,
+  interrupt_stop,
+
+  // Connect SMI for smiMemClientReq0/smiMemClientResp0.
+  smiMemClientReq0Ready,
+  smiMemClientReq0Flit,
+  smiMemClientReq0Stop,
+  smiMemClientResp0Ready,
+  smiMemClientResp0Flit,
+  smiMemClientResp0Stop,
+
+  // Connect SMI for smiMemClientReq1/smiMemClientResp1.
+  smiMemClientReq1Ready,
+  smiMemClientReq1Flit,
+  smiMemClientReq1Stop,
+  smiMemClientResp1Ready,
+  smiMemClientResp1Flit,
+  smiMemClientResp1Stop,
+
+  // Connect SMI for smiMemClientReq2/smiMemClientResp2.
+  smiMemClientReq2Ready,
+  smiMemClientReq2Flit,
+  smiMemClientReq2Stop,
+  smiMemClientResp2Ready,
+  smiMemClientResp2Flit,
+  smiMemClientResp2Stop,
+
+  // Connect SMI for smiMemClientReq3/smiMemClientResp3.
+  smiMemClientReq3Ready,
+  smiMemClientReq3Flit,
+  smiMemClientReq3Stop,
+  smiMemClientResp3Ready,
+  smiMemClientResp3Flit,
+  smiMemClientResp3Stop,
+
+  // Connect SMI for smiMemClientReq4/smiMemClientResp4.
+  smiMemClientReq4Ready,
+  smiMemClientReq4Flit,
+  smiMemClientReq4Stop,
+  smiMemClientResp4Ready,
+  smiMemClientResp4Flit,
+  smiMemClientResp4Stop,
+
+  // Connect SMI for smiMemClientReq5/smiMemClientResp5.
+  smiMemClientReq5Ready,
+  smiMemClientReq5Flit,
+  smiMemClientReq5Stop,
+  smiMemClientResp5Ready,
+  smiMemClientResp5Flit,
+  smiMemClientResp5Stop,
+
+  // Connect SMI for smiMemClientReq6/smiMemClientResp6.
+  smiMemClientReq6Ready,
+  smiMemClientReq6Flit,
+  smiMemClientReq6Stop,
+  smiMemClientResp6Ready,
+  smiMemClientResp6Flit,
+  smiMemClientResp6Stop,
+
+  // Connect SMI for smiMemClientReq7/smiMemClientResp7.
+  smiMemClientReq7Ready,
+  smiMemClientReq7Flit,
+  smiMemClientReq7Stop,
+  smiMemClientResp7Ready,
+  smiMemClientResp7Flit,
+  smiMemClientResp7Stop,
+
+  // Connect SMI for smiMemClientReq8/smiMemClientResp8.
+  smiMemClientReq8Ready,
+  smiMemClientReq8Flit,
+  smiMemClientReq8Stop,
+  smiMemClientResp8Ready,
+  smiMemClientResp8Flit,
+  smiMemClientResp8Stop,
+
+  // Connect SMI for smiMemClientReq9/smiMemClientResp9.
+  smiMemClientReq9Ready,
+  smiMemClientReq9Flit,
+  smiMemClientReq9Stop,
+  smiMemClientResp9Ready,
+  smiMemClientResp9Flit,
+  smiMemClientResp9Stop,
+
+  // Connect SMI for smiMemClientReq10/smiMemClientResp10.
+  smiMemClientReq10Ready,
+  smiMemClientReq10Flit,
+  smiMemClientReq10Stop,
+  smiMemClientResp10Ready,
+  smiMemClientResp10Flit,
+  smiMemClientResp10Stop,
+
+  // Connect SMI for smiMemClientReq11/smiMemClientResp11.
+  smiMemClientReq11Ready,
+  smiMemClientReq11Flit,
+  smiMemClientReq11Stop,
+  smiMemClientResp11Ready,
+  smiMemClientResp11Flit,
+  smiMemClientResp11Stop,
+
+  // Connect SMI for smiMemClientReq12/smiMemClientResp12.
+  smiMemClientReq12Ready,
+  smiMemClientReq12Flit,
+  smiMemClientReq12Stop,
+  smiMemClientResp12Ready,
+  smiMemClientResp12Flit,
+  smiMemClientResp12Stop,
+
+  // Connect SMI for smiMemClientReq13/smiMemClientResp13.
+  smiMemClientReq13Ready,
+  smiMemClientReq13Flit,
+  smiMemClientReq13Stop,
+  smiMemClientResp13Ready,
+  smiMemClientResp13Flit,
+  smiMemClientResp13Stop,
+
+  // Connect SMI for smiMemClientReq14/smiMemClientResp14.
+  smiMemClientReq14Ready,
+  smiMemClientReq14Flit,
+  smiMemClientReq14Stop,
+  smiMemClientResp14Ready,
+  smiMemClientResp14Flit,
+  smiMemClientResp14Stop,
+
+  // Connect SMI for smiMemClientReq15/smiMemClientResp15.
+  smiMemClientReq15Ready,
+  smiMemClientReq15Flit,
+  smiMemClientReq15Stop,
+  smiMemClientResp15Ready,
+  smiMemClientResp15Flit,
+  smiMemClientResp15Stop,
+
+  // Connect SMI for smiMemClientReq16/smiMemClientResp16.
+  smiMemClientReq16Ready,
+  smiMemClientReq16Flit,
+  smiMemClientReq16Stop,
+  smiMemClientResp16Ready,
+  smiMemClientResp16Flit,
+  smiMemClientResp16Stop,
+
+  // Connect SMI for smiMemClientReq17/smiMemClientResp17.
+  smiMemClientReq17Ready,
+  smiMemClientReq17Flit,
+  smiMemClientReq17Stop,
+  smiMemClientResp17Ready,
+  smiMemClientResp17Flit,
+  smiMemClientResp17Stop,
+
+  // Connect SMI for smiMemClientReq18/smiMemClientResp18.
+  smiMemClientReq18Ready,
+  smiMemClientReq18Flit,
+  smiMemClientReq18Stop,
+  smiMemClientResp18Ready,
+  smiMemClientResp18Flit,
+  smiMemClientResp18Stop,
+
+  // Connect SMI for smiMemClientReq19/smiMemClientResp19.
+  smiMemClientReq19Ready,
+  smiMemClientReq19Flit,
+  smiMemClientReq19Stop,
+  smiMemClientResp19Ready,
+  smiMemClientResp19Flit,
+  smiMemClientResp19Stop,
+
+  // Connect SMI for smiMemClientReq20/smiMemClientResp20.
+  smiMemClientReq20Ready,
+  smiMemClientReq20Flit,
+  smiMemClientReq20Stop,
+  smiMemClientResp20Ready,
+  smiMemClientResp20Flit,
+  smiMemClientResp20Stop,
+
+  // Connect SMI for smiMemClientReq21/smiMemClientResp21.
+  smiMemClientReq21Ready,
+  smiMemClientReq21Flit,
+  smiMemClientReq21Stop,
+  smiMemClientResp21Ready,
+  smiMemClientResp21Flit,
+  smiMemClientResp21Stop,
+
+  // Connect SMI for smiMemClientReq22/smiMemClientResp22.
+  smiMemClientReq22Ready,
+  smiMemClientReq22Flit,
+  smiMemClientReq22Stop,
+  smiMemClientResp22Ready,
+  smiMemClientResp22Flit,
+  smiMemClientResp22Stop,
+
+  // Connect SMI for smiMemClientReq23/smiMemClientResp23.
+  smiMemClientReq23Ready,
+  smiMemClientReq23Flit,
+  smiMemClientReq23Stop,
+  smiMemClientResp23Ready,
+  smiMemClientResp23Flit,
+  smiMemClientResp23Stop,
+
+  // Connect SMI for smiMemClientReq24/smiMemClientResp24.
+  smiMemClientReq24Ready,
+  smiMemClientReq24Flit,
+  smiMemClientReq24Stop,
+  smiMemClientResp24Ready,
+  smiMemClientResp24Flit,
+  smiMemClientResp24Stop,
+
+  // Connect SMI for smiMemClientReq25/smiMemClientResp25.
+  smiMemClientReq25Ready,
+  smiMemClientReq25Flit,
+  smiMemClientReq25Stop,
+  smiMemClientResp25Ready,
+  smiMemClientResp25Flit,
+  smiMemClientResp25Stop,
+
+  // Connect SMI for smiMemClientReq26/smiMemClientResp26.
+  smiMemClientReq26Ready,
+  smiMemClientReq26Flit,
+  smiMemClientReq26Stop,
+  smiMemClientResp26Ready,
+  smiMemClientResp26Flit,
+  smiMemClientResp26Stop,
+
+  // Connect SMI for smiMemClientReq27/smiMemClientResp27.
+  smiMemClientReq27Ready,
+  smiMemClientReq27Flit,
+  smiMemClientReq27Stop,
+  smiMemClientResp27Ready,
+  smiMemClientResp27Flit,
+  smiMemClientResp27Stop,
+
+  // Connect SMI for smiMemClientReq28/smiMemClientResp28.
+  smiMemClientReq28Ready,
+  smiMemClientReq28Flit,
+  smiMemClientReq28Stop,
+  smiMemClientResp28Ready,
+  smiMemClientResp28Flit,
+  smiMemClientResp28Stop,
+
+  // Connect SMI for smiMemClientReq29/smiMemClientResp29.
+  smiMemClientReq29Ready,
+  smiMemClientReq29Flit,
+  smiMemClientReq29Stop,
+  smiMemClientResp29Ready,
+  smiMemClientResp29Flit,
+  smiMemClientResp29Stop,
+
+  // Connect SMI for smiMemClientReq30/smiMemClientResp30.
+  smiMemClientReq30Ready,
+  smiMemClientReq30Flit,
+  smiMemClientReq30Stop,
+  smiMemClientResp30Ready,
+  smiMemClientResp30Flit,
+  smiMemClientResp30Stop,
+
+  // Connect SMI for smiMemClientReq31/smiMemClientResp31.
+  smiMemClientReq31Ready,
+  smiMemClientReq31Flit,
+  smiMemClientReq31Stop,
+  smiMemClientResp31Ready,
+  smiMemClientResp31Flit,
+  smiMemClientResp31Stop,
+
+  // Connect SMI for smiMemClientReq32/smiMemClientResp32.
+  smiMemClientReq32Ready,
+  smiMemClientReq32Flit,
+  smiMemClientReq32Stop,
+  smiMemClientResp32Ready,
+  smiMemClientResp32Flit,
+  smiMemClientResp32Stop,
+
+  // Connect SMI for smiMemClientReq33/smiMemClientResp33.
+  smiMemClientReq33Ready,
+  smiMemClientReq33Flit,
+  smiMemClientReq33Stop,
+  smiMemClientResp33Ready,
+  smiMemClientResp33Flit,
+  smiMemClientResp33Stop,
+
+  // Connect SMI for smiMemClientReq34/smiMemClientResp34.
+  smiMemClientReq34Ready,
+  smiMemClientReq34Flit,
+  smiMemClientReq34Stop,
+  smiMemClientResp34Ready,
+  smiMemClientResp34Flit,
+  smiMemClientResp34Stop,
+
+  // Connect SMI for smiMemClientReq35/smiMemClientResp35.
+  smiMemClientReq35Ready,
+  smiMemClientReq35Flit,
+  smiMemClientReq35Stop,
+  smiMemClientResp35Ready,
+  smiMemClientResp35Flit,
+  smiMemClientResp35Stop,
+
+  // Connect SMI for smiMemClientReq36/smiMemClientResp36.
+  smiMemClientReq36Ready,
+  smiMemClientReq36Flit,
+  smiMemClientReq36Stop,
+  smiMemClientResp36Ready,
+  smiMemClientResp36Flit,
+  smiMemClientResp36Stop,
+
+  // Connect SMI for smiMemClientReq37/smiMemClientResp37.
+  smiMemClientReq37Ready,
+  smiMemClientReq37Flit,
+  smiMemClientReq37Stop,
+  smiMemClientResp37Ready,
+  smiMemClientResp37Flit,
+  smiMemClientResp37Stop,
+
+  // Connect SMI for smiMemClientReq38/smiMemClientResp38.
+  smiMemClientReq38Ready,
+  smiMemClientReq38Flit,
+  smiMemClientReq38Stop,
+  smiMemClientResp38Ready,
+  smiMemClientResp38Flit,
+  smiMemClientResp38Stop,
+
+  // Connect SMI for smiMemClientReq39/smiMemClientResp39.
+  smiMemClientReq39Ready,
+  smiMemClientReq39Flit,
+  smiMemClientReq39Stop,
+  smiMemClientResp39Ready,
+  smiMemClientResp39Flit,
+  smiMemClientResp39Stop,
+
+  // Connect SMI for smiMemClientReq40/smiMemClientResp40.
+  smiMemClientReq40Ready,
+  smiMemClientReq40Flit,
+  smiMemClientReq40Stop,
+  smiMemClientResp40Ready,
+  smiMemClientResp40Flit,
+  smiMemClientResp40Stop,
+
+  // Connect SMI for smiMemClientReq41/smiMemClientResp41.
+  smiMemClientReq41Ready,
+  smiMemClientReq41Flit,
+  smiMemClientReq41Stop,
+  smiMemClientResp41Ready,
+  smiMemClientResp41Flit,
+  smiMemClientResp41Stop,
+
+  // Connect SMI for smiMemClientReq42/smiMemClientResp42.
+  smiMemClientReq42Ready,
+  smiMemClientReq42Flit,
+  smiMemClientReq42Stop,
+  smiMemClientResp42Ready,
+  smiMemClientResp42Flit,
+  smiMemClientResp42Stop,
+
+  // Connect SMI for smiMemClientReq43/smiMemClientResp43.
+  smiMemClientReq43Ready,
+  smiMemClientReq43Flit,
+  smiMemClientReq43Stop,
+  smiMemClientResp43Ready,
+  smiMemClientResp43Flit,
+  smiMemClientResp43Stop,
+
+  // Connect SMI for smiMemClientReq44/smiMemClientResp44.
+  smiMemClientReq44Ready,
+  smiMemClientReq44Flit,
+  smiMemClientReq44Stop,
+  smiMemClientResp44Ready,
+  smiMemClientResp44Flit,
+  smiMemClientResp44Stop,
+
+  // Connect SMI for smiMemClientReq45/smiMemClientResp45.
+  smiMemClientReq45Ready,
+  smiMemClientReq45Flit,
+  smiMemClientReq45Stop,
+  smiMemClientResp45Ready,
+  smiMemClientResp45Flit,
+  smiMemClientResp45Stop,
+
+  // Connect SMI for smiMemClientReq46/smiMemClientResp46.
+  smiMemClientReq46Ready,
+  smiMemClientReq46Flit,
+  smiMemClientReq46Stop,
+  smiMemClientResp46Ready,
+  smiMemClientResp46Flit,
+  smiMemClientResp46Stop,
+
+  // Connect SMI for smiMemClientReq47/smiMemClientResp47.
+  smiMemClientReq47Ready,
+  smiMemClientReq47Flit,
+  smiMemClientReq47Stop,
+  smiMemClientResp47Ready,
+  smiMemClientResp47Flit,
+  smiMemClientResp47Stop,
+
+  // Connect SMI for smiMemClientReq48/smiMemClientResp48.
+  smiMemClientReq48Ready,
+  smiMemClientReq48Flit,
+  smiMemClientReq48Stop,
+  smiMemClientResp48Ready,
+  smiMemClientResp48Flit,
+  smiMemClientResp48Stop,
+
+  // Connect SMI for smiMemClientReq49/smiMemClientResp49.
+  smiMemClientReq49Ready,
+  smiMemClientReq49Flit,
+  smiMemClientReq49Stop,
+  smiMemClientResp49Ready,
+  smiMemClientResp49Flit,
+  smiMemClientResp49Stop,
+
+  // Connect SMI for smiMemClientReq50/smiMemClientResp50.
+  smiMemClientReq50Ready,
+  smiMemClientReq50Flit,
+  smiMemClientReq50Stop,
+  smiMemClientResp50Ready,
+  smiMemClientResp50Flit,
+  smiMemClientResp50Stop,
+
+  // Connect SMI for smiMemClientReq51/smiMemClientResp51.
+  smiMemClientReq51Ready,
+  smiMemClientReq51Flit,
+  smiMemClientReq51Stop,
+  smiMemClientResp51Ready,
+  smiMemClientResp51Flit,
+  smiMemClientResp51Stop,
+
+  // Connect SMI for smiMemClientReq52/smiMemClientResp52.
+  smiMemClientReq52Ready,
+  smiMemClientReq52Flit,
+  smiMemClientReq52Stop,
+  smiMemClientResp52Ready,
+  smiMemClientResp52Flit,
+  smiMemClientResp52Stop,
+
+  // Connect SMI for smiMemClientReq53/smiMemClientResp53.
+  smiMemClientReq53Ready,
+  smiMemClientReq53Flit,
+  smiMemClientReq53Stop,
+  smiMemClientResp53Ready,
+  smiMemClientResp53Flit,
+  smiMemClientResp53Stop,
+
+  // Connect SMI for smiMemClientReq54/smiMemClientResp54.
+  smiMemClientReq54Ready,
+  smiMemClientReq54Flit,
+  smiMemClientReq54Stop,
+  smiMemClientResp54Ready,
+  smiMemClientResp54Flit,
+  smiMemClientResp54Stop,
+
+  // Connect SMI for smiMemClientReq55/smiMemClientResp55.
+  smiMemClientReq55Ready,
+  smiMemClientReq55Flit,
+  smiMemClientReq55Stop,
+  smiMemClientResp55Ready,
+  smiMemClientResp55Flit,
+  smiMemClientResp55Stop,
+
+  // Connect SMI for smiMemClientReq56/smiMemClientResp56.
+  smiMemClientReq56Ready,
+  smiMemClientReq56Flit,
+  smiMemClientReq56Stop,
+  smiMemClientResp56Ready,
+  smiMemClientResp56Flit,
+  smiMemClientResp56Stop,
+
+  // Connect SMI for smiMemClientReq57/smiMemClientResp57.
+  smiMemClientReq57Ready,
+  smiMemClientReq57Flit,
+  smiMemClientReq57Stop,
+  smiMemClientResp57Ready,
+  smiMemClientResp57Flit,
+  smiMemClientResp57Stop,
+
+  // Connect SMI for smiMemClientReq58/smiMemClientResp58.
+  smiMemClientReq58Ready,
+  smiMemClientReq58Flit,
+  smiMemClientReq58Stop,
+  smiMemClientResp58Ready,
+  smiMemClientResp58Flit,
+  smiMemClientResp58Stop,
+
+  // Connect SMI for smiMemClientReq59/smiMemClientResp59.
+  smiMemClientReq59Ready,
+  smiMemClientReq59Flit,
+  smiMemClientReq59Stop,
+  smiMemClientResp59Ready,
+  smiMemClientResp59Flit,
+  smiMemClientResp59Stop,
+
+  // Connect SMI for smiMemClientReq60/smiMemClientResp60.
+  smiMemClientReq60Ready,
+  smiMemClientReq60Flit,
+  smiMemClientReq60Stop,
+  smiMemClientResp60Ready,
+  smiMemClientResp60Flit,
+  smiMemClientResp60Stop,
+
+  // Connect SMI for smiMemClientReq61/smiMemClientResp61.
+  smiMemClientReq61Ready,
+  smiMemClientReq61Flit,
+  smiMemClientReq61Stop,
+  smiMemClientResp61Ready,
+  smiMemClientResp61Flit,
+  smiMemClientResp61Stop,
+
+  // Connect SMI for smiMemClientReq62/smiMemClientResp62.
+  smiMemClientReq62Ready,
+  smiMemClientReq62Flit,
+  smiMemClientReq62Stop,
+  smiMemClientResp62Ready,
+  smiMemClientResp62Flit,
+  smiMemClientResp62Stop,
+
+  // Connect SMI for smiMemClientReq63/smiMemClientResp63.
+  smiMemClientReq63Ready,
+  smiMemClientReq63Flit,
+  smiMemClientReq63Stop,
+  smiMemClientResp63Ready,
+  smiMemClientResp63Flit,
+  smiMemClientResp63Stop,
+
+  // Connect system level signals.
+  clk,
+  reset
+);
+
+endmodule
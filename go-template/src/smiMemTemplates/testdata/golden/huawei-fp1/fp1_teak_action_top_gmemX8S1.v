@@ -0,0 +1,505 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Created Mon, 01 Jan 2018 00:00:00 UTC
+// Machine generated file - DO NOT EDIT
+//
+
+`timescale 1ns/1ps
+
+module fp1_teak_action_top_gmem (
+
+  // Action control signals.
+  input          go_ready,
+  output         go_stop,
+  output         done_ready,
+  input          done_stop,
+
+  // Configuration register file access signals.
+  output         config_req_valid,
+  output [ 31:0] config_req_data,
+  input          config_req_stop,
+  input          config_resp_valid,
+  input  [ 31:0] config_resp_data,
+  output         config_resp_stop,
+
+  // Kernel internal register access signals.
+  input          control_req_valid,
+  input  [ 64:0] control_req_data,
+  output         control_req_stop,
+  output         control_resp_valid,
+  output [ 33:0] control_resp_data,
+  input          control_resp_stop,
+
+  // Kernel interrupt queue signals.
+  output         interrupt_valid,
+  output [ 31:0] interrupt_data,
+  input          interrupt_stop,
+
+  // Specifies the AXI master write address signals.
+  output [ 63:0] m_axi_gmem_awaddr,
+  output [  7:0] m_axi_gmem_awlen,
+  output [  2:0] m_axi_gmem_awsize,
+  output [  0:0] m_axi_gmem_awid,
+  output         m_axi_gmem_awvalid,
+  input          m_axi_gmem_awready,
+
+  // Specifies the AXI master write data signals.
+  output [ 63:0] m_axi_gmem_wdata,
+  output [  7:0] m_axi_gmem_wstrb,
+  output         m_axi_gmem_wlast,
+  output [  0:0] m_axi_gmem_wid,
+  output         m_axi_gmem_wvalid,
+  input          m_axi_gmem_wready,
+
+  // Specifies the AXI master write response signals.
+  input  [  1:0] m_axi_gmem_bresp,
+  input  [  0:0] m_axi_gmem_bid,
+  input          m_axi_gmem_bvalid,
+  output         m_axi_gmem_bready,
+
+  // Specifies the AXI master read address signals.
+  output [ 63:0] m_axi_gmem_araddr,
+  output [  7:0] m_axi_gmem_arlen,
+  output [  2:0] m_axi_gmem_arsize,
+  output [  0:0] m_axi_gmem_arid,
+  output         m_axi_gmem_arvalid,
+  input          m_axi_gmem_arready,
+
+  // Specifies the AXI master read data signals.
+  input  [ 63:0] m_axi_gmem_rdata,
+  input  [  1:0] m_axi_gmem_rresp,
+  input          m_axi_gmem_rlast,
+  input  [  0:0] m_axi_gmem_rid,
+  input          m_axi_gmem_rvalid,
+  output         m_axi_gmem_rready,
+
+  // Specify system level signals.
+  input          clk,
+  input          reset
+);
+
+// SMI connections for smiMemServerReq/smiMemServerResp
+wire         smiMemServerReqReady;
+wire [  7:0] smiMemServerReqEofc;
+wire [ 63:0] smiMemServerReqData;
+wire         smiMemServerReqStop;
+wire         smiMemServerRespReady;
+wire [  7:0] smiMemServerRespEofc;
+wire [ 63:0] smiMemServerRespData;
+wire         smiMemServerRespStop;
+
+// SMI connections for smiMemClientReq0/smiMemClientResp0
+wire         smiMemClientReq0Ready;
+wire [  7:0] smiMemClientReq0Eofc;
+wire [ 63:0] smiMemClientReq0Data;
+wire         smiMemClientReq0Stop;
+wire         smiMemClientResp0Ready;
+wire [  7:0] smiMemClientResp0Eofc;
+wire [ 63:0] smiMemClientResp0Data;
+wire         smiMemClientResp0Stop;
+
+// SMI connections for smiMemClientReq1/smiMemClientResp1
+wire         smiMemClientReq1Ready;
+wire [  7:0] smiMemClientReq1Eofc;
+wire [ 63:0] smiMemClientReq1Data;
+wire         smiMemClientReq1Stop;
+wire         smiMemClientResp1Ready;
+wire [  7:0] smiMemClientResp1Eofc;
+wire [ 63:0] smiMemClientResp1Data;
+wire         smiMemClientResp1Stop;
+
+// SMI connections for smiMemClientReq2/smiMemClientResp2
+wire         smiMemClientReq2Ready;
+wire [  7:0] smiMemClientReq2Eofc;
+wire [ 63:0] smiMemClientReq2Data;
+wire         smiMemClientReq2Stop;
+wire         smiMemClientResp2Ready;
+wire [  7:0] smiMemClientResp2Eofc;
+wire [ 63:0] smiMemClientResp2Data;
+wire         smiMemClientResp2Stop;
+
+// SMI connections for smiMemClientReq3/smiMemClientResp3
+wire         smiMemClientReq3Ready;
+wire [  7:0] smiMemClientReq3Eofc;
+wire [ 63:0] smiMemClientReq3Data;
+wire         smiMemClientReq3Stop;
+wire         smiMemClientResp3Ready;
+wire [  7:0] smiMemClientResp3Eofc;
+wire [ 63:0] smiMemClientResp3Data;
+wire         smiMemClientResp3Stop;
+
+// SMI connections for smiMemClientReq4/smiMemClientResp4
+wire         smiMemClientReq4Ready;
+wire [  7:0] smiMemClientReq4Eofc;
+wire [ 63:0] smiMemClientReq4Data;
+wire         smiMemClientReq4Stop;
+wire         smiMemClientResp4Ready;
+wire [  7:0] smiMemClientResp4Eofc;
+wire [ 63:0] smiMemClientResp4Data;
+wire         smiMemClientResp4Stop;
+
+// SMI connections for smiMemClientReq5/smiMemClientResp5
+wire         smiMemClientReq5Ready;
+wire [  7:0] smiMemClientReq5Eofc;
+wire [ 63:0] smiMemClientReq5Data;
+wire         smiMemClientReq5Stop;
+wire         smiMemClientResp5Ready;
+wire [  7:0] smiMemClientResp5Eofc;
+wire [ 63:0] smiMemClientResp5Data;
+wire         smiMemClientResp5Stop;
+
+// SMI connections for smiMemClientReq6/smiMemClientResp6
+wire         smiMemClientReq6Ready;
+wire [  7:0] smiMemClientReq6Eofc;
+wire [ 63:0] smiMemClientReq6Data;
+wire         smiMemClientReq6Stop;
+wire         smiMemClientResp6Ready;
+wire [  7:0] smiMemClientResp6Eofc;
+wire [ 63:0] smiMemClientResp6Data;
+wire         smiMemClientResp6Stop;
+
+// SMI connections for smiMemClientReq7/smiMemClientResp7
+wire         smiMemClientReq7Ready;
+wire [  7:0] smiMemClientReq7Eofc;
+wire [ 63:0] smiMemClientReq7Data;
+wire         smiMemClientReq7Stop;
+wire         smiMemClientResp7Ready;
+wire [  7:0] smiMemClientResp7Eofc;
+wire [ 63:0] smiMemClientResp7Data;
+wire         smiMemClientResp7Stop;
+
+// Concatenated SMI flit vectors. 
+wire [ 71:0] smiMemClientReq0Flit;
+wire [ 71:0] smiMemClientResp0Flit;
+wire [ 71:0] smiMemClientReq1Flit;
+wire [ 71:0] smiMemClientResp1Flit;
+wire [ 71:0] smiMemClientReq2Flit;
+wire [ 71:0] smiMemClientResp2Flit;
+wire [ 71:0] smiMemClientReq3Flit;
+wire [ 71:0] smiMemClientResp3Flit;
+wire [ 71:0] smiMemClientReq4Flit;
+wire [ 71:0] smiMemClientResp4Flit;
+wire [ 71:0] smiMemClientReq5Flit;
+wire [ 71:0] smiMemClientResp5Flit;
+wire [ 71:0] smiMemClientReq6Flit;
+wire [ 71:0] smiMemClientResp6Flit;
+wire [ 71:0] smiMemClientReq7Flit;
+wire [ 71:0] smiMemClientResp7Flit;
+
+// Unused AXI interface signals.
+wire [  3:0] m_axi_gmem_arcache;
+wire [  3:0] m_axi_gmem_awcache;
+
+//
+// Instantiate the SMI/AXI memory controller adaptor.
+//
+smiAxiMemBusAdaptor #(3, 1, 33) axiBusAdaptor (
+  
+  // Connect SMI main memory bus.
+  .smiReqReady  (smiMemServerReqReady),
+  .smiReqEofc   (smiMemServerReqEofc),
+  .smiReqData   (smiMemServerReqData),
+  .smiReqStop   (smiMemServerReqStop),
+  .smiRespReady (smiMemServerRespReady),
+  .smiRespEofc  (smiMemServerRespEofc),
+  .smiRespData  (smiMemServerRespData),
+  .smiRespStop  (smiMemServerRespStop),
+  
+  // Connect active AXI read data bus signals.
+  .axiARValid   (m_axi_gmem_arvalid),
+  .axiARReady   (m_axi_gmem_arready),
+  .axiARId      (m_axi_gmem_arid),
+  .axiARAddr    (m_axi_gmem_araddr),
+  .axiARLen     (m_axi_gmem_arlen),
+  .axiARSize    (m_axi_gmem_arsize),
+  .axiARCache   (m_axi_gmem_arcache),
+
+  .axiRValid    (m_axi_gmem_rvalid),
+  .axiRReady    (m_axi_gmem_rready),
+  .axiRId       (m_axi_gmem_rid),
+  .axiRData     (m_axi_gmem_rdata),
+  .axiRResp     (m_axi_gmem_rresp),
+  .axiRLast     (m_axi_gmem_rlast),
+
+  // Connect active AXI write data bus signals.
+  .axiAWValid   (m_axi_gmem_awvalid),
+  .axiAWReady   (m_axi_gmem_awready),
+  .axiAWId      (m_axi_gmem_awid),
+  .axiAWAddr    (m_axi_gmem_awaddr),
+  .axiAWLen     (m_axi_gmem_awlen),
+  .axiAWSize    (m_axi_gmem_awsize),
+  .axiAWCache   (m_axi_gmem_awcache),
+
+  .axiWValid    (m_axi_gmem_wvalid),
+  .axiWReady    (m_axi_gmem_wready),
+  .axiWId       (m_axi_gmem_wid),
+  .axiWData     (m_axi_gmem_wdata),
+  .axiWStrb     (m_axi_gmem_wstrb),
+  .axiWLast     (m_axi_gmem_wlast),
+
+  .axiBValid    (m_axi_gmem_bvalid),
+  .axiBReady    (m_axi_gmem_bready),
+  .axiBId       (m_axi_gmem_bid),
+  .axiBResp     (m_axi_gmem_bresp),
+
+  // Connect system level signals.
+  .axiReset     (reset),
+  .clk          (clk),
+  .srst         (reset)
+);
+
+//
+// Instantiate the memory access arbitration logic.
+//
+smiMemArbitrationTreeX8S1 memArbitrationTree (
+
+  // SMI ports for smiMemClientReq0/smiMemClientResp0
+  .smiMemClientReq0Ready (smiMemClientReq0Ready),
+  .smiMemClientReq0Eofc  (smiMemClientReq0Eofc),
+  .smiMemClientReq0Data  (smiMemClientReq0Data),
+  .smiMemClientReq0Stop  (smiMemClientReq0Stop),
+  .smiMemClientResp0Ready (smiMemClientResp0Ready),
+  .smiMemClientResp0Eofc  (smiMemClientResp0Eofc),
+  .smiMemClientResp0Data  (smiMemClientResp0Data),
+  .smiMemClientResp0Stop  (smiMemClientResp0Stop),
+
+  // SMI ports for smiMemClientReq1/smiMemClientResp1
+  .smiMemClientReq1Ready (smiMemClientReq1Ready),
+  .smiMemClientReq1Eofc  (smiMemClientReq1Eofc),
+  .smiMemClientReq1Data  (smiMemClientReq1Data),
+  .smiMemClientReq1Stop  (smiMemClientReq1Stop),
+  .smiMemClientResp1Ready (smiMemClientResp1Ready),
+  .smiMemClientResp1Eofc  (smiMemClientResp1Eofc),
+  .smiMemClientResp1Data  (smiMemClientResp1Data),
+  .smiMemClientResp1Stop  (smiMemClientResp1Stop),
+
+  // SMI ports for smiMemClientReq2/smiMemClientResp2
+  .smiMemClientReq2Ready (smiMemClientReq2Ready),
+  .smiMemClientReq2Eofc  (smiMemClientReq2Eofc),
+  .smiMemClientReq2Data  (smiMemClientReq2Data),
+  .smiMemClientReq2Stop  (smiMemClientReq2Stop),
+  .smiMemClientResp2Ready (smiMemClientResp2Ready),
+  .smiMemClientResp2Eofc  (smiMemClientResp2Eofc),
+  .smiMemClientResp2Data  (smiMemClientResp2Data),
+  .smiMemClientResp2Stop  (smiMemClientResp2Stop),
+
+  // SMI ports for smiMemClientReq3/smiMemClientResp3
+  .smiMemClientReq3Ready (smiMemClientReq3Ready),
+  .smiMemClientReq3Eofc  (smiMemClientReq3Eofc),
+  .smiMemClientReq3Data  (smiMemClientReq3Data),
+  .smiMemClientReq3Stop  (smiMemClientReq3Stop),
+  .smiMemClientResp3Ready (smiMemClientResp3Ready),
+  .smiMemClientResp3Eofc  (smiMemClientResp3Eofc),
+  .smiMemClientResp3Data  (smiMemClientResp3Data),
+  .smiMemClientResp3Stop  (smiMemClientResp3Stop),
+
+  // SMI ports for smiMemClientReq4/smiMemClientResp4
+  .smiMemClientReq4Ready (smiMemClientReq4Ready),
+  .smiMemClientReq4Eofc  (smiMemClientReq4Eofc),
+  .smiMemClientReq4Data  (smiMemClientReq4Data),
+  .smiMemClientReq4Stop  (smiMemClientReq4Stop),
+  .smiMemClientResp4Ready (smiMemClientResp4Ready),
+  .smiMemClientResp4Eofc  (smiMemClientResp4Eofc),
+  .smiMemClientResp4Data  (smiMemClientResp4Data),
+  .smiMemClientResp4Stop  (smiMemClientResp4Stop),
+
+  // SMI ports for smiMemClientReq5/smiMemClientResp5
+  .smiMemClientReq5Ready (smiMemClientReq5Ready),
+  .smiMemClientReq5Eofc  (smiMemClientReq5Eofc),
+  .smiMemClientReq5Data  (smiMemClientReq5Data),
+  .smiMemClientReq5Stop  (smiMemClientReq5Stop),
+  .smiMemClientResp5Ready (smiMemClientResp5Ready),
+  .smiMemClientResp5Eofc  (smiMemClientResp5Eofc),
+  .smiMemClientResp5Data  (smiMemClientResp5Data),
+  .smiMemClientResp5Stop  (smiMemClientResp5Stop),
+
+  // SMI ports for smiMemClientReq6/smiMemClientResp6
+  .smiMemClientReq6Ready (smiMemClientReq6Ready),
+  .smiMemClientReq6Eofc  (smiMemClientReq6Eofc),
+  .smiMemClientReq6Data  (smiMemClientReq6Data),
+  .smiMemClientReq6Stop  (smiMemClientReq6Stop),
+  .smiMemClientResp6Ready (smiMemClientResp6Ready),
+  .smiMemClientResp6Eofc  (smiMemClientResp6Eofc),
+  .smiMemClientResp6Data  (smiMemClientResp6Data),
+  .smiMemClientResp6Stop  (smiMemClientResp6Stop),
+
+  // SMI ports for smiMemClientReq7/smiMemClientResp7
+  .smiMemClientReq7Ready (smiMemClientReq7Ready),
+  .smiMemClientReq7Eofc  (smiMemClientReq7Eofc),
+  .smiMemClientReq7Data  (smiMemClientReq7Data),
+  .smiMemClientReq7Stop  (smiMemClientReq7Stop),
+  .smiMemClientResp7Ready (smiMemClientResp7Ready),
+  .smiMemClientResp7Eofc  (smiMemClientResp7Eofc),
+  .smiMemClientResp7Data  (smiMemClientResp7Data),
+  .smiMemClientResp7Stop  (smiMemClientResp7Stop),
+
+
+  // SMI ports for smiMemServerReq/smiMemServerResp
+  .smiMemServerReqReady (smiMemServerReqReady),
+  .smiMemServerReqEofc  (smiMemServerReqEofc),
+  .smiMemServerReqData  (smiMemServerReqData),
+  .smiMemServerReqStop  (smiMemServerReqStop),
+  .smiMemServerRespReady (smiMemServerRespReady),
+  .smiMemServerRespEofc  (smiMemServerRespEofc),
+  .smiMemServerRespData  (smiMemServerRespData),
+  .smiMemServerRespStop  (smiMemServerRespStop),
+
+
+  // Connect system level signals.
+  .clk  (clk),
+  .srst (reset)
+);
+
+//
+// Map SMI flit vector signals.
+// 
+assign smiMemClientReq0Data  = smiMemClientReq0Flit [63:0];
+assign smiMemClientReq0Eofc  = smiMemClientReq0Flit [71:64];
+assign smiMemClientResp0Flit = { smiMemClientResp0Eofc, smiMemClientResp0Data };
+
+assign smiMemClientReq1Data  = smiMemClientReq1Flit [63:0];
+assign smiMemClientReq1Eofc  = smiMemClientReq1Flit [71:64];
+assign smiMemClientResp1Flit = { smiMemClientResp1Eofc, smiMemClientResp1Data };
+
+assign smiMemClientReq2Data  = smiMemClientReq2Flit [63:0];
+assign smiMemClientReq2Eofc  = smiMemClientReq2Flit [71:64];
+assign smiMemClientResp2Flit = { smiMemClientResp2Eofc, smiMemClientResp2Data };
+
+assign smiMemClientReq3Data  = smiMemClientReq3Flit [63:0];
+assign smiMemClientReq3Eofc  = smiMemClientReq3Flit [71:64];
+assign smiMemClientResp3Flit = { smiMemClientResp3Eofc, smiMemClientResp3Data };
+
+assign smiMemClientReq4Data  = smiMemClientReq4Flit [63:0];
+assign smiMemClientReq4Eofc  = smiMemClientReq4Flit [71:64];
+assign smiMemClientResp4Flit = { smiMemClientResp4Eofc, smiMemClientResp4Data };
+
+assign smiMemClientReq5Data  = smiMemClientReq5Flit [63:0];
+assign smiMemClientReq5Eofc  = smiMemClientReq5Flit [71:64];
+assign smiMemClientResp5Flit = { smiMemClientResp5Eofc, smiMemClientResp5Data };
+
+assign smiMemClientReq6Data  = smiMemClientReq6Flit [63:0];
+assign smiMemClientReq6Eofc  = smiMemClientReq6Flit [71:64];
+assign smiMemClientResp6Flit = { smiMemClientResp6Eofc, smiMemClientResp6Data };
+
+assign smiMemClientReq7Data  = smiMemClientReq7Flit [63:0];
+assign smiMemClientReq7Eofc  = smiMemClientReq7Flit [71:64];
+assign smiMemClientResp7Flit = { smiMemClientResp7Eofc, smiMemClientResp7Data };
+
+//
+// Instantiate the SMI kernel logic. Uses positional signal assignment since
+// the Go kernel parameter names may vary.
+//
+teak__main_x2e_Top smiKernel (
+
+  // Connect action control signals.
+  go_ready,
+  go_stop,
+  done_ready,
+  done_stop,
+
+  // Connect configuration register file access signals.
+  config_req_valid,
+  config_req_data,
+  config_req_stop,
+  config_resp_valid,
+  config_resp_data,
+  config_resp_stop,
+
+  // Kernel internal register access signals.
+  control_req_valid,
+  control_req_data,
+  control_req_stop,
+  control_resp_valid,
+  control_resp_data,
+  control_resp_stop,
+
+  // Kernel interrupt queue signals.
+  interrupt_valid,
+  interrupt_data,
+  interrupt_stop,
+
+  // Connect SMI for smiMemClientReq0/smiMemClientResp0.
+  smiMemClientReq0Ready,
+  smiMemClientReq0Flit,
+  smiMemClientReq0Stop,
+  smiMemClientResp0Ready,
+  smiMemClientResp0Flit,
+  smiMemClientResp0Stop,
+
+  // Connect SMI for smiMemClientReq1/smiMemClientResp1.
+  smiMemClientReq1Ready,
+  smiMemClientReq1Flit,
+  smiMemClientReq1Stop,
+  smiMemClientResp1Ready,
+  smiMemClientResp1Flit,
+  smiMemClientResp1Stop,
+
+  // Connect SMI for smiMemClientReq2/smiMemClientResp2.
+  smiMemClientReq2Ready,
+  smiMemClientReq2Flit,
+  smiMemClientReq2Stop,
+  smiMemClientResp2Ready,
+  smiMemClientResp2Flit,
+  smiMemClientResp2Stop,
+
+  // Connect SMI for smiMemClientReq3/smiMemClientResp3.
+  smiMemClientReq3Ready,
+  smiMemClientReq3Flit,
+  smiMemClientReq3Stop,
+  smiMemClientResp3Ready,
+  smiMemClientResp3Flit,
+  smiMemClientResp3Stop,
+
+  // Connect SMI for smiMemClientReq4/smiMemClientResp4.
+  smiMemClientReq4Ready,
+  smiMemClientReq4Flit,
+  smiMemClientReq4Stop,
+  smiMemClientResp4Ready,
+  smiMemClientResp4Flit,
+  smiMemClientResp4Stop,
+
+  // Connect SMI for smiMemClientReq5/smiMemClientResp5.
+  smiMemClientReq5Ready,
+  smiMemClientReq5Flit,
+  smiMemClientReq5Stop,
+  smiMemClientResp5Ready,
+  smiMemClientResp5Flit,
+  smiMemClientResp5Stop,
+
+  // Connect SMI for smiMemClientReq6/smiMemClientResp6.
+  smiMemClientReq6Ready,
+  smiMemClientReq6Flit,
+  smiMemClientReq6Stop,
+  smiMemClientResp6Ready,
+  smiMemClientResp6Flit,
+  smiMemClientResp6Stop,
+
+  // Connect SMI for smiMemClientReq7/smiMemClientResp7.
+  smiMemClientReq7Ready,
+  smiMemClientReq7Flit,
+  smiMemClientReq7Stop,
+  smiMemClientResp7Ready,
+  smiMemClientResp7Flit,
+  smiMemClientResp7Stop,
+
+  // Connect system level signals.
+  clk,
+  reset
+);
+
+endmodule
@@ -0,0 +1,573 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Created Mon, 01 Jan 2018 00:00:00 UTC
+// Machine generated file - DO NOT EDIT
+//
+
+`timescale 1ns/1ps
+
+module smiMemArbitrationTreeX11S1 (
+  
+  // SMI ports for smiMemClientReq0/smiMemClientResp0
+  input          smiMemClientReq0Ready,
+  input  [  7:0] smiMemClientReq0Eofc,
+  input  [ 63:0] smiMemClientReq0Data,
+  output         smiMemClientReq0Stop,
+  output         smiMemClientResp0Ready,
+  output [  7:0] smiMemClientResp0Eofc,
+  output [ 63:0] smiMemClientResp0Data,
+  input          smiMemClientResp0Stop,
+
+  // SMI ports for smiMemClientReq1/smiMemClientResp1
+  input          smiMemClientReq1Ready,
+  input  [  7:0] smiMemClientReq1Eofc,
+  input  [ 63:0] smiMemClientReq1Data,
+  output         smiMemClientReq1Stop,
+  output         smiMemClientResp1Ready,
+  output [  7:0] smiMemClientResp1Eofc,
+  output [ 63:0] smiMemClientResp1Data,
+  input          smiMemClientResp1Stop,
+
+  // SMI ports for smiMemClientReq2/smiMemClientResp2
+  input          smiMemClientReq2Ready,
+  input  [  7:0] smiMemClientReq2Eofc,
+  input  [ 63:0] smiMemClientReq2Data,
+  output         smiMemClientReq2Stop,
+  output         smiMemClientResp2Ready,
+  output [  7:0] smiMemClientResp2Eofc,
+  output [ 63:0] smiMemClientResp2Data,
+  input          smiMemClientResp2Stop,
+
+  // SMI ports for smiMemClientReq3/smiMemClientResp3
+  input          smiMemClientReq3Ready,
+  input  [  7:0] smiMemClientReq3Eofc,
+  input  [ 63:0] smiMemClientReq3Data,
+  output         smiMemClientReq3Stop,
+  output         smiMemClientResp3Ready,
+  output [  7:0] smiMemClientResp3Eofc,
+  output [ 63:0] smiMemClientResp3Data,
+  input          smiMemClientResp3Stop,
+
+  // SMI ports for smiMemClientReq4/smiMemClientResp4
+  input          smiMemClientReq4Ready,
+  input  [  7:0] smiMemClientReq4Eofc,
+  input  [ 63:0] smiMemClientReq4Data,
+  output         smiMemClientReq4Stop,
+  output         smiMemClientResp4Ready,
+  output [  7:0] smiMemClientResp4Eofc,
+  output [ 63:0] smiMemClientResp4Data,
+  input          smiMemClientResp4Stop,
+
+  // SMI ports for smiMemClientReq5/smiMemClientResp5
+  input          smiMemClientReq5Ready,
+  input  [  7:0] smiMemClientReq5Eofc,
+  input  [ 63:0] smiMemClientReq5Data,
+  output         smiMemClientReq5Stop,
+  output         smiMemClientResp5Ready,
+  output [  7:0] smiMemClientResp5Eofc,
+  output [ 63:0] smiMemClientResp5Data,
+  input          smiMemClientResp5Stop,
+
+  // SMI ports for smiMemClientReq6/smiMemClientResp6
+  input          smiMemClientReq6Ready,
+  input  [  7:0] smiMemClientReq6Eofc,
+  input  [ 63:0] smiMemClientReq6Data,
+  output         smiMemClientReq6Stop,
+  output         smiMemClientResp6Ready,
+  output [  7:0] smiMemClientResp6Eofc,
+  output [ 63:0] smiMemClientResp6Data,
+  input          smiMemClientResp6Stop,
+
+  // SMI ports for smiMemClientReq7/smiMemClientResp7
+  input          smiMemClientReq7Ready,
+  input  [  7:0] smiMemClientReq7Eofc,
+  input  [ 63:0] smiMemClientReq7Data,
+  output         smiMemClientReq7Stop,
+  output         smiMemClientResp7Ready,
+  output [  7:0] smiMemClientResp7Eofc,
+  output [ 63:0] smiMemClientResp7Data,
+  input          smiMemClientResp7Stop,
+
+  // SMI ports for smiMemClientReq8/smiMemClientResp8
+  input          smiMemClientReq8Ready,
+  input  [  7:0] smiMemClientReq8Eofc,
+  input  [ 63:0] smiMemClientReq8Data,
+  output         smiMemClientReq8Stop,
+  output         smiMemClientResp8Ready,
+  output [  7:0] smiMemClientResp8Eofc,
+  output [ 63:0] smiMemClientResp8Data,
+  input          smiMemClientResp8Stop,
+
+  // SMI ports for smiMemClientReq9/smiMemClientResp9
+  input          smiMemClientReq9Ready,
+  input  [  7:0] smiMemClientReq9Eofc,
+  input  [ 63:0] smiMemClientReq9Data,
+  output         smiMemClientReq9Stop,
+  output         smiMemClientResp9Ready,
+  output [  7:0] smiMemClientResp9Eofc,
+  output [ 63:0] smiMemClientResp9Data,
+  input          smiMemClientResp9Stop,
+
+  // SMI ports for smiMemClientReq10/smiMemClientResp10
+  input          smiMemClientReq10Ready,
+  input  [  7:0] smiMemClientReq10Eofc,
+  input  [ 63:0] smiMemClientReq10Data,
+  output         smiMemClientReq10Stop,
+  output         smiMemClientResp10Ready,
+  output [  7:0] smiMemClientResp10Eofc,
+  output [ 63:0] smiMemClientResp10Data,
+  input          smiMemClientResp10Stop,
+
+  
+  // SMI ports for smiMemServerReq/smiMemServerResp
+  output         smiMemServerReqReady,
+  output [  7:0] smiMemServerReqEofc,
+  output [ 63:0] smiMemServerReqData,
+  input          smiMemServerReqStop,
+  input          smiMemServerRespReady,
+  input  [  7:0] smiMemServerRespEofc,
+  input  [ 63:0] smiMemServerRespData,
+  output         smiMemServerRespStop,
+
+
+  // Specify system level signals.
+  input clk,
+  input srst
+);
+  
+// SMI connections for smiWireReqL0I0/smiWireRespL0I0
+wire         smiWireReqL0I0Ready;
+wire [  7:0] smiWireReqL0I0Eofc;
+wire [ 63:0] smiWireReqL0I0Data;
+wire         smiWireReqL0I0Stop;
+wire         smiWireRespL0I0Ready;
+wire [  7:0] smiWireRespL0I0Eofc;
+wire [ 63:0] smiWireRespL0I0Data;
+wire         smiWireRespL0I0Stop;
+
+// SMI connections for smiWireReqL0I1/smiWireRespL0I1
+wire         smiWireReqL0I1Ready;
+wire [  7:0] smiWireReqL0I1Eofc;
+wire [ 63:0] smiWireReqL0I1Data;
+wire         smiWireReqL0I1Stop;
+wire         smiWireRespL0I1Ready;
+wire [  7:0] smiWireRespL0I1Eofc;
+wire [ 63:0] smiWireRespL0I1Data;
+wire         smiWireRespL0I1Stop;
+
+// SMI connections for smiWireReqL0I2/smiWireRespL0I2
+wire         smiWireReqL0I2Ready;
+wire [  7:0] smiWireReqL0I2Eofc;
+wire [ 63:0] smiWireReqL0I2Data;
+wire         smiWireReqL0I2Stop;
+wire         smiWireRespL0I2Ready;
+wire [  7:0] smiWireRespL0I2Eofc;
+wire [ 63:0] smiWireRespL0I2Data;
+wire         smiWireRespL0I2Stop;
+
+// SMI connections for smiWireReqL1I0/smiWireRespL1I0
+wire         smiWireReqL1I0Ready;
+wire [  7:0] smiWireReqL1I0Eofc;
+wire [ 63:0] smiWireReqL1I0Data;
+wire         smiWireReqL1I0Stop;
+wire         smiWireRespL1I0Ready;
+wire [  7:0] smiWireRespL1I0Eofc;
+wire [ 63:0] smiWireRespL1I0Data;
+wire         smiWireRespL1I0Stop;
+
+// SMI connections for smiWireReqL1I1/smiWireRespL1I1
+wire         smiWireReqL1I1Ready;
+wire [  7:0] smiWireReqL1I1Eofc;
+wire [ 63:0] smiWireReqL1I1Data;
+wire         smiWireReqL1I1Stop;
+wire         smiWireRespL1I1Ready;
+wire [  7:0] smiWireRespL1I1Eofc;
+wire [ 63:0] smiWireRespL1I1Data;
+wire         smiWireRespL1I1Stop;
+
+// SMI connections for smiWireReqL1I2/smiWireRespL1I2
+wire         smiWireReqL1I2Ready;
+wire [  7:0] smiWireReqL1I2Eofc;
+wire [ 63:0] smiWireReqL1I2Data;
+wire         smiWireReqL1I2Stop;
+wire         smiWireRespL1I2Ready;
+wire [  7:0] smiWireRespL1I2Eofc;
+wire [ 63:0] smiWireRespL1I2Data;
+wire         smiWireRespL1I2Stop;
+
+// SMI connections for smiWireReqL1I3/smiWireRespL1I3
+wire         smiWireReqL1I3Ready;
+wire [  7:0] smiWireReqL1I3Eofc;
+wire [ 63:0] smiWireReqL1I3Data;
+wire         smiWireReqL1I3Stop;
+wire         smiWireRespL1I3Ready;
+wire [  7:0] smiWireRespL1I3Eofc;
+wire [ 63:0] smiWireRespL1I3Data;
+wire         smiWireRespL1I3Stop;
+
+// SMI connections for smiWireReqL1I4/smiWireRespL1I4
+wire         smiWireReqL1I4Ready;
+wire [  7:0] smiWireReqL1I4Eofc;
+wire [ 63:0] smiWireReqL1I4Data;
+wire         smiWireReqL1I4Stop;
+wire         smiWireRespL1I4Ready;
+wire [  7:0] smiWireRespL1I4Eofc;
+wire [ 63:0] smiWireRespL1I4Data;
+wire         smiWireRespL1I4Stop;
+
+// SMI connections for smiWireReqL1I5/smiWireRespL1I5
+wire         smiWireReqL1I5Ready;
+wire [  7:0] smiWireReqL1I5Eofc;
+wire [ 63:0] smiWireReqL1I5Data;
+wire         smiWireReqL1I5Stop;
+wire         smiWireRespL1I5Ready;
+wire [  7:0] smiWireRespL1I5Eofc;
+wire [ 63:0] smiWireRespL1I5Data;
+wire         smiWireRespL1I5Stop;
+
+  
+// Directly map smiMemClientReq10 -> smiWireReqL1I5
+assign smiWireReqL1I5Ready = smiMemClientReq10Ready;
+assign smiWireReqL1I5Eofc = smiMemClientReq10Eofc;
+assign smiWireReqL1I5Data = smiMemClientReq10Data;
+assign smiMemClientReq10Stop = smiWireReqL1I5Stop;
+
+// Directly map smiWireRespL1I5 -> smiMemClientResp10
+assign smiMemClientResp10Ready = smiWireRespL1I5Ready;
+assign smiMemClientResp10Eofc = smiWireRespL1I5Eofc;
+assign smiMemClientResp10Data = smiWireRespL1I5Data;
+assign smiWireRespL1I5Stop = smiMemClientResp10Stop;
+
+  
+  
+// Instantiate transaction arbiter busArbiterL0I0
+smiTransactionArbiterX3 #(8, 4, 32, 4) busArbiterL0I0 (
+  
+  .smiReqAInReady   (smiWireReqL0I0Ready),
+  .smiReqAInEofc    (smiWireReqL0I0Eofc),
+  .smiReqAInData    (smiWireReqL0I0Data),
+  .smiReqAInStop    (smiWireReqL0I0Stop),
+  .smiRespAOutReady (smiWireRespL0I0Ready),
+  .smiRespAOutEofc  (smiWireRespL0I0Eofc),
+  .smiRespAOutData  (smiWireRespL0I0Data),
+  .smiRespAOutStop  (smiWireRespL0I0Stop),
+  
+  .smiReqBInReady   (smiWireReqL0I1Ready),
+  .smiReqBInEofc    (smiWireReqL0I1Eofc),
+  .smiReqBInData    (smiWireReqL0I1Data),
+  .smiReqBInStop    (smiWireReqL0I1Stop),
+  .smiRespBOutReady (smiWireRespL0I1Ready),
+  .smiRespBOutEofc  (smiWireRespL0I1Eofc),
+  .smiRespBOutData  (smiWireRespL0I1Data),
+  .smiRespBOutStop  (smiWireRespL0I1Stop),
+  
+  .smiReqCInReady   (smiWireReqL0I2Ready),
+  .smiReqCInEofc    (smiWireReqL0I2Eofc),
+  .smiReqCInData    (smiWireReqL0I2Data),
+  .smiReqCInStop    (smiWireReqL0I2Stop),
+  .smiRespCOutReady (smiWireRespL0I2Ready),
+  .smiRespCOutEofc  (smiWireRespL0I2Eofc),
+  .smiRespCOutData  (smiWireRespL0I2Data),
+  .smiRespCOutStop  (smiWireRespL0I2Stop),
+  
+  .smiReqOutReady (smiMemServerReqReady),
+  .smiReqOutEofc  (smiMemServerReqEofc),
+  .smiReqOutData  (smiMemServerReqData),
+  .smiReqOutStop  (smiMemServerReqStop),
+  .smiRespInReady (smiMemServerRespReady),
+  .smiRespInEofc  (smiMemServerRespEofc),
+  .smiRespInData  (smiMemServerRespData),
+  .smiRespInStop  (smiMemServerRespStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL1I0
+smiTransactionArbiterX2 #(8, 4, 32, 4) busArbiterL1I0 (
+  
+  .smiReqAInReady   (smiWireReqL1I0Ready),
+  .smiReqAInEofc    (smiWireReqL1I0Eofc),
+  .smiReqAInData    (smiWireReqL1I0Data),
+  .smiReqAInStop    (smiWireReqL1I0Stop),
+  .smiRespAOutReady (smiWireRespL1I0Ready),
+  .smiRespAOutEofc  (smiWireRespL1I0Eofc),
+  .smiRespAOutData  (smiWireRespL1I0Data),
+  .smiRespAOutStop  (smiWireRespL1I0Stop),
+  
+  .smiReqBInReady   (smiWireReqL1I1Ready),
+  .smiReqBInEofc    (smiWireReqL1I1Eofc),
+  .smiReqBInData    (smiWireReqL1I1Data),
+  .smiReqBInStop    (smiWireReqL1I1Stop),
+  .smiRespBOutReady (smiWireRespL1I1Ready),
+  .smiRespBOutEofc  (smiWireRespL1I1Eofc),
+  .smiRespBOutData  (smiWireRespL1I1Data),
+  .smiRespBOutStop  (smiWireRespL1I1Stop),
+  
+  .smiReqOutReady (smiWireReqL0I0Ready),
+  .smiReqOutEofc  (smiWireReqL0I0Eofc),
+  .smiReqOutData  (smiWireReqL0I0Data),
+  .smiReqOutStop  (smiWireReqL0I0Stop),
+  .smiRespInReady (smiWireRespL0I0Ready),
+  .smiRespInEofc  (smiWireRespL0I0Eofc),
+  .smiRespInData  (smiWireRespL0I0Data),
+  .smiRespInStop  (smiWireRespL0I0Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL1I1
+smiTransactionArbiterX2 #(8, 4, 32, 4) busArbiterL1I1 (
+  
+  .smiReqAInReady   (smiWireReqL1I2Ready),
+  .smiReqAInEofc    (smiWireReqL1I2Eofc),
+  .smiReqAInData    (smiWireReqL1I2Data),
+  .smiReqAInStop    (smiWireReqL1I2Stop),
+  .smiRespAOutReady (smiWireRespL1I2Ready),
+  .smiRespAOutEofc  (smiWireRespL1I2Eofc),
+  .smiRespAOutData  (smiWireRespL1I2Data),
+  .smiRespAOutStop  (smiWireRespL1I2Stop),
+  
+  .smiReqBInReady   (smiWireReqL1I3Ready),
+  .smiReqBInEofc    (smiWireReqL1I3Eofc),
+  .smiReqBInData    (smiWireReqL1I3Data),
+  .smiReqBInStop    (smiWireReqL1I3Stop),
+  .smiRespBOutReady (smiWireRespL1I3Ready),
+  .smiRespBOutEofc  (smiWireRespL1I3Eofc),
+  .smiRespBOutData  (smiWireRespL1I3Data),
+  .smiRespBOutStop  (smiWireRespL1I3Stop),
+  
+  .smiReqOutReady (smiWireReqL0I1Ready),
+  .smiReqOutEofc  (smiWireReqL0I1Eofc),
+  .smiReqOutData  (smiWireReqL0I1Data),
+  .smiReqOutStop  (smiWireReqL0I1Stop),
+  .smiRespInReady (smiWireRespL0I1Ready),
+  .smiRespInEofc  (smiWireRespL0I1Eofc),
+  .smiRespInData  (smiWireRespL0I1Data),
+  .smiRespInStop  (smiWireRespL0I1Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL1I2
+smiTransactionArbiterX2 #(8, 4, 32, 4) busArbiterL1I2 (
+  
+  .smiReqAInReady   (smiWireReqL1I4Ready),
+  .smiReqAInEofc    (smiWireReqL1I4Eofc),
+  .smiReqAInData    (smiWireReqL1I4Data),
+  .smiReqAInStop    (smiWireReqL1I4Stop),
+  .smiRespAOutReady (smiWireRespL1I4Ready),
+  .smiRespAOutEofc  (smiWireRespL1I4Eofc),
+  .smiRespAOutData  (smiWireRespL1I4Data),
+  .smiRespAOutStop  (smiWireRespL1I4Stop),
+  
+  .smiReqBInReady   (smiWireReqL1I5Ready),
+  .smiReqBInEofc    (smiWireReqL1I5Eofc),
+  .smiReqBInData    (smiWireReqL1I5Data),
+  .smiReqBInStop    (smiWireReqL1I5Stop),
+  .smiRespBOutReady (smiWireRespL1I5Ready),
+  .smiRespBOutEofc  (smiWireRespL1I5Eofc),
+  .smiRespBOutData  (smiWireRespL1I5Data),
+  .smiRespBOutStop  (smiWireRespL1I5Stop),
+  
+  .smiReqOutReady (smiWireReqL0I2Ready),
+  .smiReqOutEofc  (smiWireReqL0I2Eofc),
+  .smiReqOutData  (smiWireReqL0I2Data),
+  .smiReqOutStop  (smiWireReqL0I2Stop),
+  .smiRespInReady (smiWireRespL0I2Ready),
+  .smiRespInEofc  (smiWireRespL0I2Eofc),
+  .smiRespInData  (smiWireRespL0I2Data),
+  .smiRespInStop  (smiWireRespL0I2Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I0
+smiTransactionArbiterX2 #(8, 4, 32, 4) busArbiterL2I0 (
+  
+  .smiReqAInReady   (smiMemClientReq0Ready),
+  .smiReqAInEofc    (smiMemClientReq0Eofc),
+  .smiReqAInData    (smiMemClientReq0Data),
+  .smiReqAInStop    (smiMemClientReq0Stop),
+  .smiRespAOutReady (smiMemClientResp0Ready),
+  .smiRespAOutEofc  (smiMemClientResp0Eofc),
+  .smiRespAOutData  (smiMemClientResp0Data),
+  .smiRespAOutStop  (smiMemClientResp0Stop),
+  
+  .smiReqBInReady   (smiMemClientReq1Ready),
+  .smiReqBInEofc    (smiMemClientReq1Eofc),
+  .smiReqBInData    (smiMemClientReq1Data),
+  .smiReqBInStop    (smiMemClientReq1Stop),
+  .smiRespBOutReady (smiMemClientResp1Ready),
+  .smiRespBOutEofc  (smiMemClientResp1Eofc),
+  .smiRespBOutData  (smiMemClientResp1Data),
+  .smiRespBOutStop  (smiMemClientResp1Stop),
+  
+  .smiReqOutReady (smiWireReqL1I0Ready),
+  .smiReqOutEofc  (smiWireReqL1I0Eofc),
+  .smiReqOutData  (smiWireReqL1I0Data),
+  .smiReqOutStop  (smiWireReqL1I0Stop),
+  .smiRespInReady (smiWireRespL1I0Ready),
+  .smiRespInEofc  (smiWireRespL1I0Eofc),
+  .smiRespInData  (smiWireRespL1I0Data),
+  .smiRespInStop  (smiWireRespL1I0Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I1
+smiTransactionArbiterX2 #(8, 4, 32, 4) busArbiterL2I1 (
+  
+  .smiReqAInReady   (smiMemClientReq2Ready),
+  .smiReqAInEofc    (smiMemClientReq2Eofc),
+  .smiReqAInData    (smiMemClientReq2Data),
+  .smiReqAInStop    (smiMemClientReq2Stop),
+  .smiRespAOutReady (smiMemClientResp2Ready),
+  .smiRespAOutEofc  (smiMemClientResp2Eofc),
+  .smiRespAOutData  (smiMemClientResp2Data),
+  .smiRespAOutStop  (smiMemClientResp2Stop),
+  
+  .smiReqBInReady   (smiMemClientReq3Ready),
+  .smiReqBInEofc    (smiMemClientReq3Eofc),
+  .smiReqBInData    (smiMemClientReq3Data),
+  .smiReqBInStop    (smiMemClientReq3Stop),
+  .smiRespBOutReady (smiMemClientResp3Ready),
+  .smiRespBOutEofc  (smiMemClientResp3Eofc),
+  .smiRespBOutData  (smiMemClientResp3Data),
+  .smiRespBOutStop  (smiMemClientResp3Stop),
+  
+  .smiReqOutReady (smiWireReqL1I1Ready),
+  .smiReqOutEofc  (smiWireReqL1I1Eofc),
+  .smiReqOutData  (smiWireReqL1I1Data),
+  .smiReqOutStop  (smiWireReqL1I1Stop),
+  .smiRespInReady (smiWireRespL1I1Ready),
+  .smiRespInEofc  (smiWireRespL1I1Eofc),
+  .smiRespInData  (smiWireRespL1I1Data),
+  .smiRespInStop  (smiWireRespL1I1Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I2
+smiTransactionArbiterX2 #(8, 4, 32, 4) busArbiterL2I2 (
+  
+  .smiReqAInReady   (smiMemClientReq4Ready),
+  .smiReqAInEofc    (smiMemClientReq4Eofc),
+  .smiReqAInData    (smiMemClientReq4Data),
+  .smiReqAInStop    (smiMemClientReq4Stop),
+  .smiRespAOutReady (smiMemClientResp4Ready),
+  .smiRespAOutEofc  (smiMemClientResp4Eofc),
+  .smiRespAOutData  (smiMemClientResp4Data),
+  .smiRespAOutStop  (smiMemClientResp4Stop),
+  
+  .smiReqBInReady   (smiMemClientReq5Ready),
+  .smiReqBInEofc    (smiMemClientReq5Eofc),
+  .smiReqBInData    (smiMemClientReq5Data),
+  .smiReqBInStop    (smiMemClientReq5Stop),
+  .smiRespBOutReady (smiMemClientResp5Ready),
+  .smiRespBOutEofc  (smiMemClientResp5Eofc),
+  .smiRespBOutData  (smiMemClientResp5Data),
+  .smiRespBOutStop  (smiMemClientResp5Stop),
+  
+  .smiReqOutReady (smiWireReqL1I2Ready),
+  .smiReqOutEofc  (smiWireReqL1I2Eofc),
+  .smiReqOutData  (smiWireReqL1I2Data),
+  .smiReqOutStop  (smiWireReqL1I2Stop),
+  .smiRespInReady (smiWireRespL1I2Ready),
+  .smiRespInEofc  (smiWireRespL1I2Eofc),
+  .smiRespInData  (smiWireRespL1I2Data),
+  .smiRespInStop  (smiWireRespL1I2Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I3
+smiTransactionArbiterX2 #(8, 4, 32, 4) busArbiterL2I3 (
+  
+  .smiReqAInReady   (smiMemClientReq6Ready),
+  .smiReqAInEofc    (smiMemClientReq6Eofc),
+  .smiReqAInData    (smiMemClientReq6Data),
+  .smiReqAInStop    (smiMemClientReq6Stop),
+  .smiRespAOutReady (smiMemClientResp6Ready),
+  .smiRespAOutEofc  (smiMemClientResp6Eofc),
+  .smiRespAOutData  (smiMemClientResp6Data),
+  .smiRespAOutStop  (smiMemClientResp6Stop),
+  
+  .smiReqBInReady   (smiMemClientReq7Ready),
+  .smiReqBInEofc    (smiMemClientReq7Eofc),
+  .smiReqBInData    (smiMemClientReq7Data),
+  .smiReqBInStop    (smiMemClientReq7Stop),
+  .smiRespBOutReady (smiMemClientResp7Ready),
+  .smiRespBOutEofc  (smiMemClientResp7Eofc),
+  .smiRespBOutData  (smiMemClientResp7Data),
+  .smiRespBOutStop  (smiMemClientResp7Stop),
+  
+  .smiReqOutReady (smiWireReqL1I3Ready),
+  .smiReqOutEofc  (smiWireReqL1I3Eofc),
+  .smiReqOutData  (smiWireReqL1I3Data),
+  .smiReqOutStop  (smiWireReqL1I3Stop),
+  .smiRespInReady (smiWireRespL1I3Ready),
+  .smiRespInEofc  (smiWireRespL1I3Eofc),
+  .smiRespInData  (smiWireRespL1I3Data),
+  .smiRespInStop  (smiWireRespL1I3Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I4
+smiTransactionArbiterX2 #(8, 4, 32, 4) busArbiterL2I4 (
+  
+  .smiReqAInReady   (smiMemClientReq8Ready),
+  .smiReqAInEofc    (smiMemClientReq8Eofc),
+  .smiReqAInData    (smiMemClientReq8Data),
+  .smiReqAInStop    (smiMemClientReq8Stop),
+  .smiRespAOutReady (smiMemClientResp8Ready),
+  .smiRespAOutEofc  (smiMemClientResp8Eofc),
+  .smiRespAOutData  (smiMemClientResp8Data),
+  .smiRespAOutStop  (smiMemClientResp8Stop),
+  
+  .smiReqBInReady   (smiMemClientReq9Ready),
+  .smiReqBInEofc    (smiMemClientReq9Eofc),
+  .smiReqBInData    (smiMemClientReq9Data),
+  .smiReqBInStop    (smiMemClientReq9Stop),
+  .smiRespBOutReady (smiMemClientResp9Ready),
+  .smiRespBOutEofc  (smiMemClientResp9Eofc),
+  .smiRespBOutData  (smiMemClientResp9Data),
+  .smiRespBOutStop  (smiMemClientResp9Stop),
+  
+  .smiReqOutReady (smiWireReqL1I4Ready),
+  .smiReqOutEofc  (smiWireReqL1I4Eofc),
+  .smiReqOutData  (smiWireReqL1I4Data),
+  .smiReqOutStop  (smiWireReqL1I4Stop),
+  .smiRespInReady (smiWireRespL1I4Ready),
+  .smiRespInEofc  (smiWireRespL1I4Eofc),
+  .smiRespInData  (smiWireRespL1I4Data),
+  .smiRespInStop  (smiWireRespL1I4Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+endmodule
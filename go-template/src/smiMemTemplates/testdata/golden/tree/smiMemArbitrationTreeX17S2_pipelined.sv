@@ -0,0 +1,969 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Created Mon, 01 Jan 2018 00:00:00 UTC
+// Machine generated file - DO NOT EDIT
+//
+
+`timescale 1ns/1ps
+
+module smiMemArbitrationTreeX17S2 (
+
+  // SMI client port smiMemClient0
+  smi_if.server smiMemClient0,
+
+  // SMI client port smiMemClient1
+  smi_if.server smiMemClient1,
+
+  // SMI client port smiMemClient2
+  smi_if.server smiMemClient2,
+
+  // SMI client port smiMemClient3
+  smi_if.server smiMemClient3,
+
+  // SMI client port smiMemClient4
+  smi_if.server smiMemClient4,
+
+  // SMI client port smiMemClient5
+  smi_if.server smiMemClient5,
+
+  // SMI client port smiMemClient6
+  smi_if.server smiMemClient6,
+
+  // SMI client port smiMemClient7
+  smi_if.server smiMemClient7,
+
+  // SMI client port smiMemClient8
+  smi_if.server smiMemClient8,
+
+  // SMI client port smiMemClient9
+  smi_if.server smiMemClient9,
+
+  // SMI client port smiMemClient10
+  smi_if.server smiMemClient10,
+
+  // SMI client port smiMemClient11
+  smi_if.server smiMemClient11,
+
+  // SMI client port smiMemClient12
+  smi_if.server smiMemClient12,
+
+  // SMI client port smiMemClient13
+  smi_if.server smiMemClient13,
+
+  // SMI client port smiMemClient14
+  smi_if.server smiMemClient14,
+
+  // SMI client port smiMemClient15
+  smi_if.server smiMemClient15,
+
+  // SMI client port smiMemClient16
+  smi_if.server smiMemClient16,
+
+  // SMI server port smiMemServer
+  smi_if.client smiMemServer,
+
+  // Specify system level signals.
+  input logic clk,
+  input logic srst
+);
+
+smi_if #(16) smiWireL0I0 ();
+smi_if #(16) smiWireL0I1 ();
+smi_if #(16) smiWireL0I2 ();
+smi_if #(16) smiWireL1I0 ();
+smi_if #(16) smiWireL1I1 ();
+smi_if #(16) smiWireL1I2 ();
+smi_if #(16) smiWireL1I3 ();
+smi_if #(16) smiWireL1I4 ();
+smi_if #(16) smiWireL1I5 ();
+smi_if #(16) smiWireL1I6 ();
+smi_if #(16) smiWireL1I7 ();
+smi_if #(16) smiWireL1I8 ();
+smi_if #(8) smiPipe0 ();
+smi_if #(8) smiPipe1 ();
+smi_if #(16) smiPipe2 ();
+smi_if #(16) smiPipe3 ();
+smi_if #(16) smiPipe4 ();
+smi_if #(16) smiPipe5 ();
+smi_if #(16) smiPipe6 ();
+smi_if #(16) smiPipe7 ();
+smi_if #(16) smiPipe8 ();
+smi_if #(16) smiPipe9 ();
+smi_if #(16) smiPipe10 ();
+smi_if #(16) smiPipe11 ();
+smi_if #(16) smiPipe12 ();
+smi_if #(16) smiPipe13 ();
+smi_if #(16) smiPipe14 ();
+
+
+
+// Instantiate SMI request scaler busWidthScalerL2I8Req
+smiFlitScaleX2 #(8) busWidthScalerL2I8Req (
+
+  .smiInReady  (smiMemClient16.reqReady),
+  .smiInEofc   (smiMemClient16.reqEofc),
+  .smiInData   (smiMemClient16.reqData),
+  .smiInStop   (smiMemClient16.reqStop),
+
+  .smiOutReady (smiWireL1I8.reqReady),
+  .smiOutEofc  (smiWireL1I8.reqEofc),
+  .smiOutData  (smiWireL1I8.reqData),
+  .smiOutStop  (smiWireL1I8.reqStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI response scaler busWidthScalerL2I8Resp
+smiFlitScaleD2 #(8*2) busWidthScalerL2I8Resp (
+
+  .smiInReady  (smiWireL1I8.respReady),
+  .smiInEofc   (smiWireL1I8.respEofc),
+  .smiInData   (smiWireL1I8.respData),
+  .smiInStop   (smiWireL1I8.respStop),
+
+  .smiOutReady (smiMemClient16.respReady),
+  .smiOutEofc  (smiMemClient16.respEofc),
+  .smiOutData  (smiMemClient16.respData),
+  .smiOutStop  (smiMemClient16.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+
+// Instantiate transaction arbiter busArbiterL0I0
+smiTransactionArbiterX3 #(16, 4, 32, 4) busArbiterL0I0 (
+  
+  .smiReqAInReady   (smiPipe2.reqReady),
+  .smiReqAInEofc    (smiPipe2.reqEofc),
+  .smiReqAInData    (smiPipe2.reqData),
+  .smiReqAInStop    (smiPipe2.reqStop),
+  .smiRespAOutReady (smiPipe2.respReady),
+  .smiRespAOutEofc  (smiPipe2.respEofc),
+  .smiRespAOutData  (smiPipe2.respData),
+  .smiRespAOutStop  (smiPipe2.respStop),
+  
+  .smiReqBInReady   (smiPipe3.reqReady),
+  .smiReqBInEofc    (smiPipe3.reqEofc),
+  .smiReqBInData    (smiPipe3.reqData),
+  .smiReqBInStop    (smiPipe3.reqStop),
+  .smiRespBOutReady (smiPipe3.respReady),
+  .smiRespBOutEofc  (smiPipe3.respEofc),
+  .smiRespBOutData  (smiPipe3.respData),
+  .smiRespBOutStop  (smiPipe3.respStop),
+  
+  .smiReqCInReady   (smiPipe4.reqReady),
+  .smiReqCInEofc    (smiPipe4.reqEofc),
+  .smiReqCInData    (smiPipe4.reqData),
+  .smiReqCInStop    (smiPipe4.reqStop),
+  .smiRespCOutReady (smiPipe4.respReady),
+  .smiRespCOutEofc  (smiPipe4.respEofc),
+  .smiRespCOutData  (smiPipe4.respData),
+  .smiRespCOutStop  (smiPipe4.respStop),
+  
+  .smiReqOutReady (smiPipe14.reqReady),
+  .smiReqOutEofc  (smiPipe14.reqEofc),
+  .smiReqOutData  (smiPipe14.reqData),
+  .smiReqOutStop  (smiPipe14.reqStop),
+  .smiRespInReady (smiPipe14.respReady),
+  .smiRespInEofc  (smiPipe14.respEofc),
+  .smiRespInData  (smiPipe14.respData),
+  .smiRespInStop  (smiPipe14.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL1I0
+smiTransactionArbiterX3 #(16, 4, 32, 4) busArbiterL1I0 (
+  
+  .smiReqAInReady   (smiPipe5.reqReady),
+  .smiReqAInEofc    (smiPipe5.reqEofc),
+  .smiReqAInData    (smiPipe5.reqData),
+  .smiReqAInStop    (smiPipe5.reqStop),
+  .smiRespAOutReady (smiPipe5.respReady),
+  .smiRespAOutEofc  (smiPipe5.respEofc),
+  .smiRespAOutData  (smiPipe5.respData),
+  .smiRespAOutStop  (smiPipe5.respStop),
+  
+  .smiReqBInReady   (smiPipe6.reqReady),
+  .smiReqBInEofc    (smiPipe6.reqEofc),
+  .smiReqBInData    (smiPipe6.reqData),
+  .smiReqBInStop    (smiPipe6.reqStop),
+  .smiRespBOutReady (smiPipe6.respReady),
+  .smiRespBOutEofc  (smiPipe6.respEofc),
+  .smiRespBOutData  (smiPipe6.respData),
+  .smiRespBOutStop  (smiPipe6.respStop),
+  
+  .smiReqCInReady   (smiPipe7.reqReady),
+  .smiReqCInEofc    (smiPipe7.reqEofc),
+  .smiReqCInData    (smiPipe7.reqData),
+  .smiReqCInStop    (smiPipe7.reqStop),
+  .smiRespCOutReady (smiPipe7.respReady),
+  .smiRespCOutEofc  (smiPipe7.respEofc),
+  .smiRespCOutData  (smiPipe7.respData),
+  .smiRespCOutStop  (smiPipe7.respStop),
+  
+  .smiReqOutReady (smiWireL0I0.reqReady),
+  .smiReqOutEofc  (smiWireL0I0.reqEofc),
+  .smiReqOutData  (smiWireL0I0.reqData),
+  .smiReqOutStop  (smiWireL0I0.reqStop),
+  .smiRespInReady (smiWireL0I0.respReady),
+  .smiRespInEofc  (smiWireL0I0.respEofc),
+  .smiRespInData  (smiWireL0I0.respData),
+  .smiRespInStop  (smiWireL0I0.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL1I1
+smiTransactionArbiterX3 #(16, 4, 32, 4) busArbiterL1I1 (
+  
+  .smiReqAInReady   (smiPipe8.reqReady),
+  .smiReqAInEofc    (smiPipe8.reqEofc),
+  .smiReqAInData    (smiPipe8.reqData),
+  .smiReqAInStop    (smiPipe8.reqStop),
+  .smiRespAOutReady (smiPipe8.respReady),
+  .smiRespAOutEofc  (smiPipe8.respEofc),
+  .smiRespAOutData  (smiPipe8.respData),
+  .smiRespAOutStop  (smiPipe8.respStop),
+  
+  .smiReqBInReady   (smiPipe9.reqReady),
+  .smiReqBInEofc    (smiPipe9.reqEofc),
+  .smiReqBInData    (smiPipe9.reqData),
+  .smiReqBInStop    (smiPipe9.reqStop),
+  .smiRespBOutReady (smiPipe9.respReady),
+  .smiRespBOutEofc  (smiPipe9.respEofc),
+  .smiRespBOutData  (smiPipe9.respData),
+  .smiRespBOutStop  (smiPipe9.respStop),
+  
+  .smiReqCInReady   (smiPipe10.reqReady),
+  .smiReqCInEofc    (smiPipe10.reqEofc),
+  .smiReqCInData    (smiPipe10.reqData),
+  .smiReqCInStop    (smiPipe10.reqStop),
+  .smiRespCOutReady (smiPipe10.respReady),
+  .smiRespCOutEofc  (smiPipe10.respEofc),
+  .smiRespCOutData  (smiPipe10.respData),
+  .smiRespCOutStop  (smiPipe10.respStop),
+  
+  .smiReqOutReady (smiWireL0I1.reqReady),
+  .smiReqOutEofc  (smiWireL0I1.reqEofc),
+  .smiReqOutData  (smiWireL0I1.reqData),
+  .smiReqOutStop  (smiWireL0I1.reqStop),
+  .smiRespInReady (smiWireL0I1.respReady),
+  .smiRespInEofc  (smiWireL0I1.respEofc),
+  .smiRespInData  (smiWireL0I1.respData),
+  .smiRespInStop  (smiWireL0I1.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL1I2
+smiTransactionArbiterX3 #(16, 4, 32, 4) busArbiterL1I2 (
+  
+  .smiReqAInReady   (smiPipe11.reqReady),
+  .smiReqAInEofc    (smiPipe11.reqEofc),
+  .smiReqAInData    (smiPipe11.reqData),
+  .smiReqAInStop    (smiPipe11.reqStop),
+  .smiRespAOutReady (smiPipe11.respReady),
+  .smiRespAOutEofc  (smiPipe11.respEofc),
+  .smiRespAOutData  (smiPipe11.respData),
+  .smiRespAOutStop  (smiPipe11.respStop),
+  
+  .smiReqBInReady   (smiPipe12.reqReady),
+  .smiReqBInEofc    (smiPipe12.reqEofc),
+  .smiReqBInData    (smiPipe12.reqData),
+  .smiReqBInStop    (smiPipe12.reqStop),
+  .smiRespBOutReady (smiPipe12.respReady),
+  .smiRespBOutEofc  (smiPipe12.respEofc),
+  .smiRespBOutData  (smiPipe12.respData),
+  .smiRespBOutStop  (smiPipe12.respStop),
+  
+  .smiReqCInReady   (smiPipe13.reqReady),
+  .smiReqCInEofc    (smiPipe13.reqEofc),
+  .smiReqCInData    (smiPipe13.reqData),
+  .smiReqCInStop    (smiPipe13.reqStop),
+  .smiRespCOutReady (smiPipe13.respReady),
+  .smiRespCOutEofc  (smiPipe13.respEofc),
+  .smiRespCOutData  (smiPipe13.respData),
+  .smiRespCOutStop  (smiPipe13.respStop),
+  
+  .smiReqOutReady (smiWireL0I2.reqReady),
+  .smiReqOutEofc  (smiWireL0I2.reqEofc),
+  .smiReqOutData  (smiWireL0I2.reqData),
+  .smiReqOutStop  (smiWireL0I2.reqStop),
+  .smiRespInReady (smiWireL0I2.respReady),
+  .smiRespInEofc  (smiWireL0I2.respEofc),
+  .smiRespInData  (smiWireL0I2.respData),
+  .smiRespInStop  (smiWireL0I2.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I0
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I0 (
+  
+  .smiReqAInReady   (smiPipe0.reqReady),
+  .smiReqAInEofc    (smiPipe0.reqEofc),
+  .smiReqAInData    (smiPipe0.reqData),
+  .smiReqAInStop    (smiPipe0.reqStop),
+  .smiRespAOutReady (smiPipe0.respReady),
+  .smiRespAOutEofc  (smiPipe0.respEofc),
+  .smiRespAOutData  (smiPipe0.respData),
+  .smiRespAOutStop  (smiPipe0.respStop),
+  
+  .smiReqBInReady   (smiMemClient1.reqReady),
+  .smiReqBInEofc    (smiMemClient1.reqEofc),
+  .smiReqBInData    (smiMemClient1.reqData),
+  .smiReqBInStop    (smiMemClient1.reqStop),
+  .smiRespBOutReady (smiMemClient1.respReady),
+  .smiRespBOutEofc  (smiMemClient1.respEofc),
+  .smiRespBOutData  (smiMemClient1.respData),
+  .smiRespBOutStop  (smiMemClient1.respStop),
+  
+  .smiReqOutReady (smiWireL1I0.reqReady),
+  .smiReqOutEofc  (smiWireL1I0.reqEofc),
+  .smiReqOutData  (smiWireL1I0.reqData),
+  .smiReqOutStop  (smiWireL1I0.reqStop),
+  .smiRespInReady (smiWireL1I0.respReady),
+  .smiRespInEofc  (smiWireL1I0.respEofc),
+  .smiRespInData  (smiWireL1I0.respData),
+  .smiRespInStop  (smiWireL1I0.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I1
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I1 (
+  
+  .smiReqAInReady   (smiMemClient2.reqReady),
+  .smiReqAInEofc    (smiMemClient2.reqEofc),
+  .smiReqAInData    (smiMemClient2.reqData),
+  .smiReqAInStop    (smiMemClient2.reqStop),
+  .smiRespAOutReady (smiMemClient2.respReady),
+  .smiRespAOutEofc  (smiMemClient2.respEofc),
+  .smiRespAOutData  (smiMemClient2.respData),
+  .smiRespAOutStop  (smiMemClient2.respStop),
+  
+  .smiReqBInReady   (smiMemClient3.reqReady),
+  .smiReqBInEofc    (smiMemClient3.reqEofc),
+  .smiReqBInData    (smiMemClient3.reqData),
+  .smiReqBInStop    (smiMemClient3.reqStop),
+  .smiRespBOutReady (smiMemClient3.respReady),
+  .smiRespBOutEofc  (smiMemClient3.respEofc),
+  .smiRespBOutData  (smiMemClient3.respData),
+  .smiRespBOutStop  (smiMemClient3.respStop),
+  
+  .smiReqOutReady (smiWireL1I1.reqReady),
+  .smiReqOutEofc  (smiWireL1I1.reqEofc),
+  .smiReqOutData  (smiWireL1I1.reqData),
+  .smiReqOutStop  (smiWireL1I1.reqStop),
+  .smiRespInReady (smiWireL1I1.respReady),
+  .smiRespInEofc  (smiWireL1I1.respEofc),
+  .smiRespInData  (smiWireL1I1.respData),
+  .smiRespInStop  (smiWireL1I1.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I2
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I2 (
+  
+  .smiReqAInReady   (smiMemClient4.reqReady),
+  .smiReqAInEofc    (smiMemClient4.reqEofc),
+  .smiReqAInData    (smiMemClient4.reqData),
+  .smiReqAInStop    (smiMemClient4.reqStop),
+  .smiRespAOutReady (smiMemClient4.respReady),
+  .smiRespAOutEofc  (smiMemClient4.respEofc),
+  .smiRespAOutData  (smiMemClient4.respData),
+  .smiRespAOutStop  (smiMemClient4.respStop),
+  
+  .smiReqBInReady   (smiPipe1.reqReady),
+  .smiReqBInEofc    (smiPipe1.reqEofc),
+  .smiReqBInData    (smiPipe1.reqData),
+  .smiReqBInStop    (smiPipe1.reqStop),
+  .smiRespBOutReady (smiPipe1.respReady),
+  .smiRespBOutEofc  (smiPipe1.respEofc),
+  .smiRespBOutData  (smiPipe1.respData),
+  .smiRespBOutStop  (smiPipe1.respStop),
+  
+  .smiReqOutReady (smiWireL1I2.reqReady),
+  .smiReqOutEofc  (smiWireL1I2.reqEofc),
+  .smiReqOutData  (smiWireL1I2.reqData),
+  .smiReqOutStop  (smiWireL1I2.reqStop),
+  .smiRespInReady (smiWireL1I2.respReady),
+  .smiRespInEofc  (smiWireL1I2.respEofc),
+  .smiRespInData  (smiWireL1I2.respData),
+  .smiRespInStop  (smiWireL1I2.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I3
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I3 (
+  
+  .smiReqAInReady   (smiMemClient6.reqReady),
+  .smiReqAInEofc    (smiMemClient6.reqEofc),
+  .smiReqAInData    (smiMemClient6.reqData),
+  .smiReqAInStop    (smiMemClient6.reqStop),
+  .smiRespAOutReady (smiMemClient6.respReady),
+  .smiRespAOutEofc  (smiMemClient6.respEofc),
+  .smiRespAOutData  (smiMemClient6.respData),
+  .smiRespAOutStop  (smiMemClient6.respStop),
+  
+  .smiReqBInReady   (smiMemClient7.reqReady),
+  .smiReqBInEofc    (smiMemClient7.reqEofc),
+  .smiReqBInData    (smiMemClient7.reqData),
+  .smiReqBInStop    (smiMemClient7.reqStop),
+  .smiRespBOutReady (smiMemClient7.respReady),
+  .smiRespBOutEofc  (smiMemClient7.respEofc),
+  .smiRespBOutData  (smiMemClient7.respData),
+  .smiRespBOutStop  (smiMemClient7.respStop),
+  
+  .smiReqOutReady (smiWireL1I3.reqReady),
+  .smiReqOutEofc  (smiWireL1I3.reqEofc),
+  .smiReqOutData  (smiWireL1I3.reqData),
+  .smiReqOutStop  (smiWireL1I3.reqStop),
+  .smiRespInReady (smiWireL1I3.respReady),
+  .smiRespInEofc  (smiWireL1I3.respEofc),
+  .smiRespInData  (smiWireL1I3.respData),
+  .smiRespInStop  (smiWireL1I3.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I4
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I4 (
+  
+  .smiReqAInReady   (smiMemClient8.reqReady),
+  .smiReqAInEofc    (smiMemClient8.reqEofc),
+  .smiReqAInData    (smiMemClient8.reqData),
+  .smiReqAInStop    (smiMemClient8.reqStop),
+  .smiRespAOutReady (smiMemClient8.respReady),
+  .smiRespAOutEofc  (smiMemClient8.respEofc),
+  .smiRespAOutData  (smiMemClient8.respData),
+  .smiRespAOutStop  (smiMemClient8.respStop),
+  
+  .smiReqBInReady   (smiMemClient9.reqReady),
+  .smiReqBInEofc    (smiMemClient9.reqEofc),
+  .smiReqBInData    (smiMemClient9.reqData),
+  .smiReqBInStop    (smiMemClient9.reqStop),
+  .smiRespBOutReady (smiMemClient9.respReady),
+  .smiRespBOutEofc  (smiMemClient9.respEofc),
+  .smiRespBOutData  (smiMemClient9.respData),
+  .smiRespBOutStop  (smiMemClient9.respStop),
+  
+  .smiReqOutReady (smiWireL1I4.reqReady),
+  .smiReqOutEofc  (smiWireL1I4.reqEofc),
+  .smiReqOutData  (smiWireL1I4.reqData),
+  .smiReqOutStop  (smiWireL1I4.reqStop),
+  .smiRespInReady (smiWireL1I4.respReady),
+  .smiRespInEofc  (smiWireL1I4.respEofc),
+  .smiRespInData  (smiWireL1I4.respData),
+  .smiRespInStop  (smiWireL1I4.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I5
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I5 (
+  
+  .smiReqAInReady   (smiMemClient10.reqReady),
+  .smiReqAInEofc    (smiMemClient10.reqEofc),
+  .smiReqAInData    (smiMemClient10.reqData),
+  .smiReqAInStop    (smiMemClient10.reqStop),
+  .smiRespAOutReady (smiMemClient10.respReady),
+  .smiRespAOutEofc  (smiMemClient10.respEofc),
+  .smiRespAOutData  (smiMemClient10.respData),
+  .smiRespAOutStop  (smiMemClient10.respStop),
+  
+  .smiReqBInReady   (smiMemClient11.reqReady),
+  .smiReqBInEofc    (smiMemClient11.reqEofc),
+  .smiReqBInData    (smiMemClient11.reqData),
+  .smiReqBInStop    (smiMemClient11.reqStop),
+  .smiRespBOutReady (smiMemClient11.respReady),
+  .smiRespBOutEofc  (smiMemClient11.respEofc),
+  .smiRespBOutData  (smiMemClient11.respData),
+  .smiRespBOutStop  (smiMemClient11.respStop),
+  
+  .smiReqOutReady (smiWireL1I5.reqReady),
+  .smiReqOutEofc  (smiWireL1I5.reqEofc),
+  .smiReqOutData  (smiWireL1I5.reqData),
+  .smiReqOutStop  (smiWireL1I5.reqStop),
+  .smiRespInReady (smiWireL1I5.respReady),
+  .smiRespInEofc  (smiWireL1I5.respEofc),
+  .smiRespInData  (smiWireL1I5.respData),
+  .smiRespInStop  (smiWireL1I5.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I6
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I6 (
+  
+  .smiReqAInReady   (smiMemClient12.reqReady),
+  .smiReqAInEofc    (smiMemClient12.reqEofc),
+  .smiReqAInData    (smiMemClient12.reqData),
+  .smiReqAInStop    (smiMemClient12.reqStop),
+  .smiRespAOutReady (smiMemClient12.respReady),
+  .smiRespAOutEofc  (smiMemClient12.respEofc),
+  .smiRespAOutData  (smiMemClient12.respData),
+  .smiRespAOutStop  (smiMemClient12.respStop),
+  
+  .smiReqBInReady   (smiMemClient13.reqReady),
+  .smiReqBInEofc    (smiMemClient13.reqEofc),
+  .smiReqBInData    (smiMemClient13.reqData),
+  .smiReqBInStop    (smiMemClient13.reqStop),
+  .smiRespBOutReady (smiMemClient13.respReady),
+  .smiRespBOutEofc  (smiMemClient13.respEofc),
+  .smiRespBOutData  (smiMemClient13.respData),
+  .smiRespBOutStop  (smiMemClient13.respStop),
+  
+  .smiReqOutReady (smiWireL1I6.reqReady),
+  .smiReqOutEofc  (smiWireL1I6.reqEofc),
+  .smiReqOutData  (smiWireL1I6.reqData),
+  .smiReqOutStop  (smiWireL1I6.reqStop),
+  .smiRespInReady (smiWireL1I6.respReady),
+  .smiRespInEofc  (smiWireL1I6.respEofc),
+  .smiRespInData  (smiWireL1I6.respData),
+  .smiRespInStop  (smiWireL1I6.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I7
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I7 (
+  
+  .smiReqAInReady   (smiMemClient14.reqReady),
+  .smiReqAInEofc    (smiMemClient14.reqEofc),
+  .smiReqAInData    (smiMemClient14.reqData),
+  .smiReqAInStop    (smiMemClient14.reqStop),
+  .smiRespAOutReady (smiMemClient14.respReady),
+  .smiRespAOutEofc  (smiMemClient14.respEofc),
+  .smiRespAOutData  (smiMemClient14.respData),
+  .smiRespAOutStop  (smiMemClient14.respStop),
+  
+  .smiReqBInReady   (smiMemClient15.reqReady),
+  .smiReqBInEofc    (smiMemClient15.reqEofc),
+  .smiReqBInData    (smiMemClient15.reqData),
+  .smiReqBInStop    (smiMemClient15.reqStop),
+  .smiRespBOutReady (smiMemClient15.respReady),
+  .smiRespBOutEofc  (smiMemClient15.respEofc),
+  .smiRespBOutData  (smiMemClient15.respData),
+  .smiRespBOutStop  (smiMemClient15.respStop),
+  
+  .smiReqOutReady (smiWireL1I7.reqReady),
+  .smiReqOutEofc  (smiWireL1I7.reqEofc),
+  .smiReqOutData  (smiWireL1I7.reqData),
+  .smiReqOutStop  (smiWireL1I7.reqStop),
+  .smiRespInReady (smiWireL1I7.respReady),
+  .smiRespInEofc  (smiWireL1I7.respEofc),
+  .smiRespInData  (smiWireL1I7.respData),
+  .smiRespInStop  (smiWireL1I7.respStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline0
+smiMemBusPipelineStage #(8) busPipeline0 (
+
+  .smiReqInReady   (smiMemClient0.reqReady),
+  .smiReqInEofc    (smiMemClient0.reqEofc),
+  .smiReqInData    (smiMemClient0.reqData),
+  .smiReqInStop    (smiMemClient0.reqStop),
+  .smiRespOutReady (smiMemClient0.respReady),
+  .smiRespOutEofc  (smiMemClient0.respEofc),
+  .smiRespOutData  (smiMemClient0.respData),
+  .smiRespOutStop  (smiMemClient0.respStop),
+
+  .smiReqOutReady  (smiPipe0.reqReady),
+  .smiReqOutEofc   (smiPipe0.reqEofc),
+  .smiReqOutData   (smiPipe0.reqData),
+  .smiReqOutStop   (smiPipe0.reqStop),
+  .smiRespInReady  (smiPipe0.respReady),
+  .smiRespInEofc   (smiPipe0.respEofc),
+  .smiRespInData   (smiPipe0.respData),
+  .smiRespInStop   (smiPipe0.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline1
+smiMemBusPipelineStage #(8) busPipeline1 (
+
+  .smiReqInReady   (smiMemClient5.reqReady),
+  .smiReqInEofc    (smiMemClient5.reqEofc),
+  .smiReqInData    (smiMemClient5.reqData),
+  .smiReqInStop    (smiMemClient5.reqStop),
+  .smiRespOutReady (smiMemClient5.respReady),
+  .smiRespOutEofc  (smiMemClient5.respEofc),
+  .smiRespOutData  (smiMemClient5.respData),
+  .smiRespOutStop  (smiMemClient5.respStop),
+
+  .smiReqOutReady  (smiPipe1.reqReady),
+  .smiReqOutEofc   (smiPipe1.reqEofc),
+  .smiReqOutData   (smiPipe1.reqData),
+  .smiReqOutStop   (smiPipe1.reqStop),
+  .smiRespInReady  (smiPipe1.respReady),
+  .smiRespInEofc   (smiPipe1.respEofc),
+  .smiRespInData   (smiPipe1.respData),
+  .smiRespInStop   (smiPipe1.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline2
+smiMemBusPipelineStage #(16) busPipeline2 (
+
+  .smiReqInReady   (smiWireL0I0.reqReady),
+  .smiReqInEofc    (smiWireL0I0.reqEofc),
+  .smiReqInData    (smiWireL0I0.reqData),
+  .smiReqInStop    (smiWireL0I0.reqStop),
+  .smiRespOutReady (smiWireL0I0.respReady),
+  .smiRespOutEofc  (smiWireL0I0.respEofc),
+  .smiRespOutData  (smiWireL0I0.respData),
+  .smiRespOutStop  (smiWireL0I0.respStop),
+
+  .smiReqOutReady  (smiPipe2.reqReady),
+  .smiReqOutEofc   (smiPipe2.reqEofc),
+  .smiReqOutData   (smiPipe2.reqData),
+  .smiReqOutStop   (smiPipe2.reqStop),
+  .smiRespInReady  (smiPipe2.respReady),
+  .smiRespInEofc   (smiPipe2.respEofc),
+  .smiRespInData   (smiPipe2.respData),
+  .smiRespInStop   (smiPipe2.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline3
+smiMemBusPipelineStage #(16) busPipeline3 (
+
+  .smiReqInReady   (smiWireL0I1.reqReady),
+  .smiReqInEofc    (smiWireL0I1.reqEofc),
+  .smiReqInData    (smiWireL0I1.reqData),
+  .smiReqInStop    (smiWireL0I1.reqStop),
+  .smiRespOutReady (smiWireL0I1.respReady),
+  .smiRespOutEofc  (smiWireL0I1.respEofc),
+  .smiRespOutData  (smiWireL0I1.respData),
+  .smiRespOutStop  (smiWireL0I1.respStop),
+
+  .smiReqOutReady  (smiPipe3.reqReady),
+  .smiReqOutEofc   (smiPipe3.reqEofc),
+  .smiReqOutData   (smiPipe3.reqData),
+  .smiReqOutStop   (smiPipe3.reqStop),
+  .smiRespInReady  (smiPipe3.respReady),
+  .smiRespInEofc   (smiPipe3.respEofc),
+  .smiRespInData   (smiPipe3.respData),
+  .smiRespInStop   (smiPipe3.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline4
+smiMemBusPipelineStage #(16) busPipeline4 (
+
+  .smiReqInReady   (smiWireL0I2.reqReady),
+  .smiReqInEofc    (smiWireL0I2.reqEofc),
+  .smiReqInData    (smiWireL0I2.reqData),
+  .smiReqInStop    (smiWireL0I2.reqStop),
+  .smiRespOutReady (smiWireL0I2.respReady),
+  .smiRespOutEofc  (smiWireL0I2.respEofc),
+  .smiRespOutData  (smiWireL0I2.respData),
+  .smiRespOutStop  (smiWireL0I2.respStop),
+
+  .smiReqOutReady  (smiPipe4.reqReady),
+  .smiReqOutEofc   (smiPipe4.reqEofc),
+  .smiReqOutData   (smiPipe4.reqData),
+  .smiReqOutStop   (smiPipe4.reqStop),
+  .smiRespInReady  (smiPipe4.respReady),
+  .smiRespInEofc   (smiPipe4.respEofc),
+  .smiRespInData   (smiPipe4.respData),
+  .smiRespInStop   (smiPipe4.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline5
+smiMemBusPipelineStage #(16) busPipeline5 (
+
+  .smiReqInReady   (smiWireL1I0.reqReady),
+  .smiReqInEofc    (smiWireL1I0.reqEofc),
+  .smiReqInData    (smiWireL1I0.reqData),
+  .smiReqInStop    (smiWireL1I0.reqStop),
+  .smiRespOutReady (smiWireL1I0.respReady),
+  .smiRespOutEofc  (smiWireL1I0.respEofc),
+  .smiRespOutData  (smiWireL1I0.respData),
+  .smiRespOutStop  (smiWireL1I0.respStop),
+
+  .smiReqOutReady  (smiPipe5.reqReady),
+  .smiReqOutEofc   (smiPipe5.reqEofc),
+  .smiReqOutData   (smiPipe5.reqData),
+  .smiReqOutStop   (smiPipe5.reqStop),
+  .smiRespInReady  (smiPipe5.respReady),
+  .smiRespInEofc   (smiPipe5.respEofc),
+  .smiRespInData   (smiPipe5.respData),
+  .smiRespInStop   (smiPipe5.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline6
+smiMemBusPipelineStage #(16) busPipeline6 (
+
+  .smiReqInReady   (smiWireL1I1.reqReady),
+  .smiReqInEofc    (smiWireL1I1.reqEofc),
+  .smiReqInData    (smiWireL1I1.reqData),
+  .smiReqInStop    (smiWireL1I1.reqStop),
+  .smiRespOutReady (smiWireL1I1.respReady),
+  .smiRespOutEofc  (smiWireL1I1.respEofc),
+  .smiRespOutData  (smiWireL1I1.respData),
+  .smiRespOutStop  (smiWireL1I1.respStop),
+
+  .smiReqOutReady  (smiPipe6.reqReady),
+  .smiReqOutEofc   (smiPipe6.reqEofc),
+  .smiReqOutData   (smiPipe6.reqData),
+  .smiReqOutStop   (smiPipe6.reqStop),
+  .smiRespInReady  (smiPipe6.respReady),
+  .smiRespInEofc   (smiPipe6.respEofc),
+  .smiRespInData   (smiPipe6.respData),
+  .smiRespInStop   (smiPipe6.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline7
+smiMemBusPipelineStage #(16) busPipeline7 (
+
+  .smiReqInReady   (smiWireL1I2.reqReady),
+  .smiReqInEofc    (smiWireL1I2.reqEofc),
+  .smiReqInData    (smiWireL1I2.reqData),
+  .smiReqInStop    (smiWireL1I2.reqStop),
+  .smiRespOutReady (smiWireL1I2.respReady),
+  .smiRespOutEofc  (smiWireL1I2.respEofc),
+  .smiRespOutData  (smiWireL1I2.respData),
+  .smiRespOutStop  (smiWireL1I2.respStop),
+
+  .smiReqOutReady  (smiPipe7.reqReady),
+  .smiReqOutEofc   (smiPipe7.reqEofc),
+  .smiReqOutData   (smiPipe7.reqData),
+  .smiReqOutStop   (smiPipe7.reqStop),
+  .smiRespInReady  (smiPipe7.respReady),
+  .smiRespInEofc   (smiPipe7.respEofc),
+  .smiRespInData   (smiPipe7.respData),
+  .smiRespInStop   (smiPipe7.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline8
+smiMemBusPipelineStage #(16) busPipeline8 (
+
+  .smiReqInReady   (smiWireL1I3.reqReady),
+  .smiReqInEofc    (smiWireL1I3.reqEofc),
+  .smiReqInData    (smiWireL1I3.reqData),
+  .smiReqInStop    (smiWireL1I3.reqStop),
+  .smiRespOutReady (smiWireL1I3.respReady),
+  .smiRespOutEofc  (smiWireL1I3.respEofc),
+  .smiRespOutData  (smiWireL1I3.respData),
+  .smiRespOutStop  (smiWireL1I3.respStop),
+
+  .smiReqOutReady  (smiPipe8.reqReady),
+  .smiReqOutEofc   (smiPipe8.reqEofc),
+  .smiReqOutData   (smiPipe8.reqData),
+  .smiReqOutStop   (smiPipe8.reqStop),
+  .smiRespInReady  (smiPipe8.respReady),
+  .smiRespInEofc   (smiPipe8.respEofc),
+  .smiRespInData   (smiPipe8.respData),
+  .smiRespInStop   (smiPipe8.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline9
+smiMemBusPipelineStage #(16) busPipeline9 (
+
+  .smiReqInReady   (smiWireL1I4.reqReady),
+  .smiReqInEofc    (smiWireL1I4.reqEofc),
+  .smiReqInData    (smiWireL1I4.reqData),
+  .smiReqInStop    (smiWireL1I4.reqStop),
+  .smiRespOutReady (smiWireL1I4.respReady),
+  .smiRespOutEofc  (smiWireL1I4.respEofc),
+  .smiRespOutData  (smiWireL1I4.respData),
+  .smiRespOutStop  (smiWireL1I4.respStop),
+
+  .smiReqOutReady  (smiPipe9.reqReady),
+  .smiReqOutEofc   (smiPipe9.reqEofc),
+  .smiReqOutData   (smiPipe9.reqData),
+  .smiReqOutStop   (smiPipe9.reqStop),
+  .smiRespInReady  (smiPipe9.respReady),
+  .smiRespInEofc   (smiPipe9.respEofc),
+  .smiRespInData   (smiPipe9.respData),
+  .smiRespInStop   (smiPipe9.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline10
+smiMemBusPipelineStage #(16) busPipeline10 (
+
+  .smiReqInReady   (smiWireL1I5.reqReady),
+  .smiReqInEofc    (smiWireL1I5.reqEofc),
+  .smiReqInData    (smiWireL1I5.reqData),
+  .smiReqInStop    (smiWireL1I5.reqStop),
+  .smiRespOutReady (smiWireL1I5.respReady),
+  .smiRespOutEofc  (smiWireL1I5.respEofc),
+  .smiRespOutData  (smiWireL1I5.respData),
+  .smiRespOutStop  (smiWireL1I5.respStop),
+
+  .smiReqOutReady  (smiPipe10.reqReady),
+  .smiReqOutEofc   (smiPipe10.reqEofc),
+  .smiReqOutData   (smiPipe10.reqData),
+  .smiReqOutStop   (smiPipe10.reqStop),
+  .smiRespInReady  (smiPipe10.respReady),
+  .smiRespInEofc   (smiPipe10.respEofc),
+  .smiRespInData   (smiPipe10.respData),
+  .smiRespInStop   (smiPipe10.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline11
+smiMemBusPipelineStage #(16) busPipeline11 (
+
+  .smiReqInReady   (smiWireL1I6.reqReady),
+  .smiReqInEofc    (smiWireL1I6.reqEofc),
+  .smiReqInData    (smiWireL1I6.reqData),
+  .smiReqInStop    (smiWireL1I6.reqStop),
+  .smiRespOutReady (smiWireL1I6.respReady),
+  .smiRespOutEofc  (smiWireL1I6.respEofc),
+  .smiRespOutData  (smiWireL1I6.respData),
+  .smiRespOutStop  (smiWireL1I6.respStop),
+
+  .smiReqOutReady  (smiPipe11.reqReady),
+  .smiReqOutEofc   (smiPipe11.reqEofc),
+  .smiReqOutData   (smiPipe11.reqData),
+  .smiReqOutStop   (smiPipe11.reqStop),
+  .smiRespInReady  (smiPipe11.respReady),
+  .smiRespInEofc   (smiPipe11.respEofc),
+  .smiRespInData   (smiPipe11.respData),
+  .smiRespInStop   (smiPipe11.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline12
+smiMemBusPipelineStage #(16) busPipeline12 (
+
+  .smiReqInReady   (smiWireL1I7.reqReady),
+  .smiReqInEofc    (smiWireL1I7.reqEofc),
+  .smiReqInData    (smiWireL1I7.reqData),
+  .smiReqInStop    (smiWireL1I7.reqStop),
+  .smiRespOutReady (smiWireL1I7.respReady),
+  .smiRespOutEofc  (smiWireL1I7.respEofc),
+  .smiRespOutData  (smiWireL1I7.respData),
+  .smiRespOutStop  (smiWireL1I7.respStop),
+
+  .smiReqOutReady  (smiPipe12.reqReady),
+  .smiReqOutEofc   (smiPipe12.reqEofc),
+  .smiReqOutData   (smiPipe12.reqData),
+  .smiReqOutStop   (smiPipe12.reqStop),
+  .smiRespInReady  (smiPipe12.respReady),
+  .smiRespInEofc   (smiPipe12.respEofc),
+  .smiRespInData   (smiPipe12.respData),
+  .smiRespInStop   (smiPipe12.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline13
+smiMemBusPipelineStage #(16) busPipeline13 (
+
+  .smiReqInReady   (smiWireL1I8.reqReady),
+  .smiReqInEofc    (smiWireL1I8.reqEofc),
+  .smiReqInData    (smiWireL1I8.reqData),
+  .smiReqInStop    (smiWireL1I8.reqStop),
+  .smiRespOutReady (smiWireL1I8.respReady),
+  .smiRespOutEofc  (smiWireL1I8.respEofc),
+  .smiRespOutData  (smiWireL1I8.respData),
+  .smiRespOutStop  (smiWireL1I8.respStop),
+
+  .smiReqOutReady  (smiPipe13.reqReady),
+  .smiReqOutEofc   (smiPipe13.reqEofc),
+  .smiReqOutData   (smiPipe13.reqData),
+  .smiReqOutStop   (smiPipe13.reqStop),
+  .smiRespInReady  (smiPipe13.respReady),
+  .smiRespInEofc   (smiPipe13.respEofc),
+  .smiRespInData   (smiPipe13.respData),
+  .smiRespInStop   (smiPipe13.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline14
+smiMemBusPipelineStage #(16) busPipeline14 (
+
+  .smiReqInReady   (smiPipe14.reqReady),
+  .smiReqInEofc    (smiPipe14.reqEofc),
+  .smiReqInData    (smiPipe14.reqData),
+  .smiReqInStop    (smiPipe14.reqStop),
+  .smiRespOutReady (smiPipe14.respReady),
+  .smiRespOutEofc  (smiPipe14.respEofc),
+  .smiRespOutData  (smiPipe14.respData),
+  .smiRespOutStop  (smiPipe14.respStop),
+
+  .smiReqOutReady  (smiMemServer.reqReady),
+  .smiReqOutEofc   (smiMemServer.reqEofc),
+  .smiReqOutData   (smiMemServer.reqData),
+  .smiReqOutStop   (smiMemServer.reqStop),
+  .smiRespInReady  (smiMemServer.respReady),
+  .smiRespInEofc   (smiMemServer.respEofc),
+  .smiRespInData   (smiMemServer.respData),
+  .smiRespInStop   (smiMemServer.respStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+endmodule
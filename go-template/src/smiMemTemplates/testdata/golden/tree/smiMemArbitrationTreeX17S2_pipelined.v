@@ -0,0 +1,1339 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Created Mon, 01 Jan 2018 00:00:00 UTC
+// Machine generated file - DO NOT EDIT
+//
+
+`timescale 1ns/1ps
+
+module smiMemArbitrationTreeX17S2 (
+  
+  // SMI ports for smiMemClientReq0/smiMemClientResp0
+  input          smiMemClientReq0Ready,
+  input  [  7:0] smiMemClientReq0Eofc,
+  input  [ 63:0] smiMemClientReq0Data,
+  output         smiMemClientReq0Stop,
+  output         smiMemClientResp0Ready,
+  output [  7:0] smiMemClientResp0Eofc,
+  output [ 63:0] smiMemClientResp0Data,
+  input          smiMemClientResp0Stop,
+
+  // SMI ports for smiMemClientReq1/smiMemClientResp1
+  input          smiMemClientReq1Ready,
+  input  [  7:0] smiMemClientReq1Eofc,
+  input  [ 63:0] smiMemClientReq1Data,
+  output         smiMemClientReq1Stop,
+  output         smiMemClientResp1Ready,
+  output [  7:0] smiMemClientResp1Eofc,
+  output [ 63:0] smiMemClientResp1Data,
+  input          smiMemClientResp1Stop,
+
+  // SMI ports for smiMemClientReq2/smiMemClientResp2
+  input          smiMemClientReq2Ready,
+  input  [  7:0] smiMemClientReq2Eofc,
+  input  [ 63:0] smiMemClientReq2Data,
+  output         smiMemClientReq2Stop,
+  output         smiMemClientResp2Ready,
+  output [  7:0] smiMemClientResp2Eofc,
+  output [ 63:0] smiMemClientResp2Data,
+  input          smiMemClientResp2Stop,
+
+  // SMI ports for smiMemClientReq3/smiMemClientResp3
+  input          smiMemClientReq3Ready,
+  input  [  7:0] smiMemClientReq3Eofc,
+  input  [ 63:0] smiMemClientReq3Data,
+  output         smiMemClientReq3Stop,
+  output         smiMemClientResp3Ready,
+  output [  7:0] smiMemClientResp3Eofc,
+  output [ 63:0] smiMemClientResp3Data,
+  input          smiMemClientResp3Stop,
+
+  // SMI ports for smiMemClientReq4/smiMemClientResp4
+  input          smiMemClientReq4Ready,
+  input  [  7:0] smiMemClientReq4Eofc,
+  input  [ 63:0] smiMemClientReq4Data,
+  output         smiMemClientReq4Stop,
+  output         smiMemClientResp4Ready,
+  output [  7:0] smiMemClientResp4Eofc,
+  output [ 63:0] smiMemClientResp4Data,
+  input          smiMemClientResp4Stop,
+
+  // SMI ports for smiMemClientReq5/smiMemClientResp5
+  input          smiMemClientReq5Ready,
+  input  [  7:0] smiMemClientReq5Eofc,
+  input  [ 63:0] smiMemClientReq5Data,
+  output         smiMemClientReq5Stop,
+  output         smiMemClientResp5Ready,
+  output [  7:0] smiMemClientResp5Eofc,
+  output [ 63:0] smiMemClientResp5Data,
+  input          smiMemClientResp5Stop,
+
+  // SMI ports for smiMemClientReq6/smiMemClientResp6
+  input          smiMemClientReq6Ready,
+  input  [  7:0] smiMemClientReq6Eofc,
+  input  [ 63:0] smiMemClientReq6Data,
+  output         smiMemClientReq6Stop,
+  output         smiMemClientResp6Ready,
+  output [  7:0] smiMemClientResp6Eofc,
+  output [ 63:0] smiMemClientResp6Data,
+  input          smiMemClientResp6Stop,
+
+  // SMI ports for smiMemClientReq7/smiMemClientResp7
+  input          smiMemClientReq7Ready,
+  input  [  7:0] smiMemClientReq7Eofc,
+  input  [ 63:0] smiMemClientReq7Data,
+  output         smiMemClientReq7Stop,
+  output         smiMemClientResp7Ready,
+  output [  7:0] smiMemClientResp7Eofc,
+  output [ 63:0] smiMemClientResp7Data,
+  input          smiMemClientResp7Stop,
+
+  // SMI ports for smiMemClientReq8/smiMemClientResp8
+  input          smiMemClientReq8Ready,
+  input  [  7:0] smiMemClientReq8Eofc,
+  input  [ 63:0] smiMemClientReq8Data,
+  output         smiMemClientReq8Stop,
+  output         smiMemClientResp8Ready,
+  output [  7:0] smiMemClientResp8Eofc,
+  output [ 63:0] smiMemClientResp8Data,
+  input          smiMemClientResp8Stop,
+
+  // SMI ports for smiMemClientReq9/smiMemClientResp9
+  input          smiMemClientReq9Ready,
+  input  [  7:0] smiMemClientReq9Eofc,
+  input  [ 63:0] smiMemClientReq9Data,
+  output         smiMemClientReq9Stop,
+  output         smiMemClientResp9Ready,
+  output [  7:0] smiMemClientResp9Eofc,
+  output [ 63:0] smiMemClientResp9Data,
+  input          smiMemClientResp9Stop,
+
+  // SMI ports for smiMemClientReq10/smiMemClientResp10
+  input          smiMemClientReq10Ready,
+  input  [  7:0] smiMemClientReq10Eofc,
+  input  [ 63:0] smiMemClientReq10Data,
+  output         smiMemClientReq10Stop,
+  output         smiMemClientResp10Ready,
+  output [  7:0] smiMemClientResp10Eofc,
+  output [ 63:0] smiMemClientResp10Data,
+  input          smiMemClientResp10Stop,
+
+  // SMI ports for smiMemClientReq11/smiMemClientResp11
+  input          smiMemClientReq11Ready,
+  input  [  7:0] smiMemClientReq11Eofc,
+  input  [ 63:0] smiMemClientReq11Data,
+  output         smiMemClientReq11Stop,
+  output         smiMemClientResp11Ready,
+  output [  7:0] smiMemClientResp11Eofc,
+  output [ 63:0] smiMemClientResp11Data,
+  input          smiMemClientResp11Stop,
+
+  // SMI ports for smiMemClientReq12/smiMemClientResp12
+  input          smiMemClientReq12Ready,
+  input  [  7:0] smiMemClientReq12Eofc,
+  input  [ 63:0] smiMemClientReq12Data,
+  output         smiMemClientReq12Stop,
+  output         smiMemClientResp12Ready,
+  output [  7:0] smiMemClientResp12Eofc,
+  output [ 63:0] smiMemClientResp12Data,
+  input          smiMemClientResp12Stop,
+
+  // SMI ports for smiMemClientReq13/smiMemClientResp13
+  input          smiMemClientReq13Ready,
+  input  [  7:0] smiMemClientReq13Eofc,
+  input  [ 63:0] smiMemClientReq13Data,
+  output         smiMemClientReq13Stop,
+  output         smiMemClientResp13Ready,
+  output [  7:0] smiMemClientResp13Eofc,
+  output [ 63:0] smiMemClientResp13Data,
+  input          smiMemClientResp13Stop,
+
+  // SMI ports for smiMemClientReq14/smiMemClientResp14
+  input          smiMemClientReq14Ready,
+  input  [  7:0] smiMemClientReq14Eofc,
+  input  [ 63:0] smiMemClientReq14Data,
+  output         smiMemClientReq14Stop,
+  output         smiMemClientResp14Ready,
+  output [  7:0] smiMemClientResp14Eofc,
+  output [ 63:0] smiMemClientResp14Data,
+  input          smiMemClientResp14Stop,
+
+  // SMI ports for smiMemClientReq15/smiMemClientResp15
+  input          smiMemClientReq15Ready,
+  input  [  7:0] smiMemClientReq15Eofc,
+  input  [ 63:0] smiMemClientReq15Data,
+  output         smiMemClientReq15Stop,
+  output         smiMemClientResp15Ready,
+  output [  7:0] smiMemClientResp15Eofc,
+  output [ 63:0] smiMemClientResp15Data,
+  input          smiMemClientResp15Stop,
+
+  // SMI ports for smiMemClientReq16/smiMemClientResp16
+  input          smiMemClientReq16Ready,
+  input  [  7:0] smiMemClientReq16Eofc,
+  input  [ 63:0] smiMemClientReq16Data,
+  output         smiMemClientReq16Stop,
+  output         smiMemClientResp16Ready,
+  output [  7:0] smiMemClientResp16Eofc,
+  output [ 63:0] smiMemClientResp16Data,
+  input          smiMemClientResp16Stop,
+
+  
+  // SMI ports for smiMemServerReq/smiMemServerResp
+  output         smiMemServerReqReady,
+  output [  7:0] smiMemServerReqEofc,
+  output [127:0] smiMemServerReqData,
+  input          smiMemServerReqStop,
+  input          smiMemServerRespReady,
+  input  [  7:0] smiMemServerRespEofc,
+  input  [127:0] smiMemServerRespData,
+  output         smiMemServerRespStop,
+
+
+  // Specify system level signals.
+  input clk,
+  input srst
+);
+  
+// SMI connections for smiWireReqL0I0/smiWireRespL0I0
+wire         smiWireReqL0I0Ready;
+wire [  7:0] smiWireReqL0I0Eofc;
+wire [127:0] smiWireReqL0I0Data;
+wire         smiWireReqL0I0Stop;
+wire         smiWireRespL0I0Ready;
+wire [  7:0] smiWireRespL0I0Eofc;
+wire [127:0] smiWireRespL0I0Data;
+wire         smiWireRespL0I0Stop;
+
+// SMI connections for smiWireReqL0I1/smiWireRespL0I1
+wire         smiWireReqL0I1Ready;
+wire [  7:0] smiWireReqL0I1Eofc;
+wire [127:0] smiWireReqL0I1Data;
+wire         smiWireReqL0I1Stop;
+wire         smiWireRespL0I1Ready;
+wire [  7:0] smiWireRespL0I1Eofc;
+wire [127:0] smiWireRespL0I1Data;
+wire         smiWireRespL0I1Stop;
+
+// SMI connections for smiWireReqL0I2/smiWireRespL0I2
+wire         smiWireReqL0I2Ready;
+wire [  7:0] smiWireReqL0I2Eofc;
+wire [127:0] smiWireReqL0I2Data;
+wire         smiWireReqL0I2Stop;
+wire         smiWireRespL0I2Ready;
+wire [  7:0] smiWireRespL0I2Eofc;
+wire [127:0] smiWireRespL0I2Data;
+wire         smiWireRespL0I2Stop;
+
+// SMI connections for smiWireReqL1I0/smiWireRespL1I0
+wire         smiWireReqL1I0Ready;
+wire [  7:0] smiWireReqL1I0Eofc;
+wire [127:0] smiWireReqL1I0Data;
+wire         smiWireReqL1I0Stop;
+wire         smiWireRespL1I0Ready;
+wire [  7:0] smiWireRespL1I0Eofc;
+wire [127:0] smiWireRespL1I0Data;
+wire         smiWireRespL1I0Stop;
+
+// SMI connections for smiWireReqL1I1/smiWireRespL1I1
+wire         smiWireReqL1I1Ready;
+wire [  7:0] smiWireReqL1I1Eofc;
+wire [127:0] smiWireReqL1I1Data;
+wire         smiWireReqL1I1Stop;
+wire         smiWireRespL1I1Ready;
+wire [  7:0] smiWireRespL1I1Eofc;
+wire [127:0] smiWireRespL1I1Data;
+wire         smiWireRespL1I1Stop;
+
+// SMI connections for smiWireReqL1I2/smiWireRespL1I2
+wire         smiWireReqL1I2Ready;
+wire [  7:0] smiWireReqL1I2Eofc;
+wire [127:0] smiWireReqL1I2Data;
+wire         smiWireReqL1I2Stop;
+wire         smiWireRespL1I2Ready;
+wire [  7:0] smiWireRespL1I2Eofc;
+wire [127:0] smiWireRespL1I2Data;
+wire         smiWireRespL1I2Stop;
+
+// SMI connections for smiWireReqL1I3/smiWireRespL1I3
+wire         smiWireReqL1I3Ready;
+wire [  7:0] smiWireReqL1I3Eofc;
+wire [127:0] smiWireReqL1I3Data;
+wire         smiWireReqL1I3Stop;
+wire         smiWireRespL1I3Ready;
+wire [  7:0] smiWireRespL1I3Eofc;
+wire [127:0] smiWireRespL1I3Data;
+wire         smiWireRespL1I3Stop;
+
+// SMI connections for smiWireReqL1I4/smiWireRespL1I4
+wire         smiWireReqL1I4Ready;
+wire [  7:0] smiWireReqL1I4Eofc;
+wire [127:0] smiWireReqL1I4Data;
+wire         smiWireReqL1I4Stop;
+wire         smiWireRespL1I4Ready;
+wire [  7:0] smiWireRespL1I4Eofc;
+wire [127:0] smiWireRespL1I4Data;
+wire         smiWireRespL1I4Stop;
+
+// SMI connections for smiWireReqL1I5/smiWireRespL1I5
+wire         smiWireReqL1I5Ready;
+wire [  7:0] smiWireReqL1I5Eofc;
+wire [127:0] smiWireReqL1I5Data;
+wire         smiWireReqL1I5Stop;
+wire         smiWireRespL1I5Ready;
+wire [  7:0] smiWireRespL1I5Eofc;
+wire [127:0] smiWireRespL1I5Data;
+wire         smiWireRespL1I5Stop;
+
+// SMI connections for smiWireReqL1I6/smiWireRespL1I6
+wire         smiWireReqL1I6Ready;
+wire [  7:0] smiWireReqL1I6Eofc;
+wire [127:0] smiWireReqL1I6Data;
+wire         smiWireReqL1I6Stop;
+wire         smiWireRespL1I6Ready;
+wire [  7:0] smiWireRespL1I6Eofc;
+wire [127:0] smiWireRespL1I6Data;
+wire         smiWireRespL1I6Stop;
+
+// SMI connections for smiWireReqL1I7/smiWireRespL1I7
+wire         smiWireReqL1I7Ready;
+wire [  7:0] smiWireReqL1I7Eofc;
+wire [127:0] smiWireReqL1I7Data;
+wire         smiWireReqL1I7Stop;
+wire         smiWireRespL1I7Ready;
+wire [  7:0] smiWireRespL1I7Eofc;
+wire [127:0] smiWireRespL1I7Data;
+wire         smiWireRespL1I7Stop;
+
+// SMI connections for smiWireReqL1I8/smiWireRespL1I8
+wire         smiWireReqL1I8Ready;
+wire [  7:0] smiWireReqL1I8Eofc;
+wire [127:0] smiWireReqL1I8Data;
+wire         smiWireReqL1I8Stop;
+wire         smiWireRespL1I8Ready;
+wire [  7:0] smiWireRespL1I8Eofc;
+wire [127:0] smiWireRespL1I8Data;
+wire         smiWireRespL1I8Stop;
+
+// SMI connections for smiPipeReq0/smiPipeResp0
+wire         smiPipeReq0Ready;
+wire [  7:0] smiPipeReq0Eofc;
+wire [ 63:0] smiPipeReq0Data;
+wire         smiPipeReq0Stop;
+wire         smiPipeResp0Ready;
+wire [  7:0] smiPipeResp0Eofc;
+wire [ 63:0] smiPipeResp0Data;
+wire         smiPipeResp0Stop;
+
+// SMI connections for smiPipeReq1/smiPipeResp1
+wire         smiPipeReq1Ready;
+wire [  7:0] smiPipeReq1Eofc;
+wire [ 63:0] smiPipeReq1Data;
+wire         smiPipeReq1Stop;
+wire         smiPipeResp1Ready;
+wire [  7:0] smiPipeResp1Eofc;
+wire [ 63:0] smiPipeResp1Data;
+wire         smiPipeResp1Stop;
+
+// SMI connections for smiPipeReq2/smiPipeResp2
+wire         smiPipeReq2Ready;
+wire [  7:0] smiPipeReq2Eofc;
+wire [127:0] smiPipeReq2Data;
+wire         smiPipeReq2Stop;
+wire         smiPipeResp2Ready;
+wire [  7:0] smiPipeResp2Eofc;
+wire [127:0] smiPipeResp2Data;
+wire         smiPipeResp2Stop;
+
+// SMI connections for smiPipeReq3/smiPipeResp3
+wire         smiPipeReq3Ready;
+wire [  7:0] smiPipeReq3Eofc;
+wire [127:0] smiPipeReq3Data;
+wire         smiPipeReq3Stop;
+wire         smiPipeResp3Ready;
+wire [  7:0] smiPipeResp3Eofc;
+wire [127:0] smiPipeResp3Data;
+wire         smiPipeResp3Stop;
+
+// SMI connections for smiPipeReq4/smiPipeResp4
+wire         smiPipeReq4Ready;
+wire [  7:0] smiPipeReq4Eofc;
+wire [127:0] smiPipeReq4Data;
+wire         smiPipeReq4Stop;
+wire         smiPipeResp4Ready;
+wire [  7:0] smiPipeResp4Eofc;
+wire [127:0] smiPipeResp4Data;
+wire         smiPipeResp4Stop;
+
+// SMI connections for smiPipeReq5/smiPipeResp5
+wire         smiPipeReq5Ready;
+wire [  7:0] smiPipeReq5Eofc;
+wire [127:0] smiPipeReq5Data;
+wire         smiPipeReq5Stop;
+wire         smiPipeResp5Ready;
+wire [  7:0] smiPipeResp5Eofc;
+wire [127:0] smiPipeResp5Data;
+wire         smiPipeResp5Stop;
+
+// SMI connections for smiPipeReq6/smiPipeResp6
+wire         smiPipeReq6Ready;
+wire [  7:0] smiPipeReq6Eofc;
+wire [127:0] smiPipeReq6Data;
+wire         smiPipeReq6Stop;
+wire         smiPipeResp6Ready;
+wire [  7:0] smiPipeResp6Eofc;
+wire [127:0] smiPipeResp6Data;
+wire         smiPipeResp6Stop;
+
+// SMI connections for smiPipeReq7/smiPipeResp7
+wire         smiPipeReq7Ready;
+wire [  7:0] smiPipeReq7Eofc;
+wire [127:0] smiPipeReq7Data;
+wire         smiPipeReq7Stop;
+wire         smiPipeResp7Ready;
+wire [  7:0] smiPipeResp7Eofc;
+wire [127:0] smiPipeResp7Data;
+wire         smiPipeResp7Stop;
+
+// SMI connections for smiPipeReq8/smiPipeResp8
+wire         smiPipeReq8Ready;
+wire [  7:0] smiPipeReq8Eofc;
+wire [127:0] smiPipeReq8Data;
+wire         smiPipeReq8Stop;
+wire         smiPipeResp8Ready;
+wire [  7:0] smiPipeResp8Eofc;
+wire [127:0] smiPipeResp8Data;
+wire         smiPipeResp8Stop;
+
+// SMI connections for smiPipeReq9/smiPipeResp9
+wire         smiPipeReq9Ready;
+wire [  7:0] smiPipeReq9Eofc;
+wire [127:0] smiPipeReq9Data;
+wire         smiPipeReq9Stop;
+wire         smiPipeResp9Ready;
+wire [  7:0] smiPipeResp9Eofc;
+wire [127:0] smiPipeResp9Data;
+wire         smiPipeResp9Stop;
+
+// SMI connections for smiPipeReq10/smiPipeResp10
+wire         smiPipeReq10Ready;
+wire [  7:0] smiPipeReq10Eofc;
+wire [127:0] smiPipeReq10Data;
+wire         smiPipeReq10Stop;
+wire         smiPipeResp10Ready;
+wire [  7:0] smiPipeResp10Eofc;
+wire [127:0] smiPipeResp10Data;
+wire         smiPipeResp10Stop;
+
+// SMI connections for smiPipeReq11/smiPipeResp11
+wire         smiPipeReq11Ready;
+wire [  7:0] smiPipeReq11Eofc;
+wire [127:0] smiPipeReq11Data;
+wire         smiPipeReq11Stop;
+wire         smiPipeResp11Ready;
+wire [  7:0] smiPipeResp11Eofc;
+wire [127:0] smiPipeResp11Data;
+wire         smiPipeResp11Stop;
+
+// SMI connections for smiPipeReq12/smiPipeResp12
+wire         smiPipeReq12Ready;
+wire [  7:0] smiPipeReq12Eofc;
+wire [127:0] smiPipeReq12Data;
+wire         smiPipeReq12Stop;
+wire         smiPipeResp12Ready;
+wire [  7:0] smiPipeResp12Eofc;
+wire [127:0] smiPipeResp12Data;
+wire         smiPipeResp12Stop;
+
+// SMI connections for smiPipeReq13/smiPipeResp13
+wire         smiPipeReq13Ready;
+wire [  7:0] smiPipeReq13Eofc;
+wire [127:0] smiPipeReq13Data;
+wire         smiPipeReq13Stop;
+wire         smiPipeResp13Ready;
+wire [  7:0] smiPipeResp13Eofc;
+wire [127:0] smiPipeResp13Data;
+wire         smiPipeResp13Stop;
+
+// SMI connections for smiPipeReq14/smiPipeResp14
+wire         smiPipeReq14Ready;
+wire [  7:0] smiPipeReq14Eofc;
+wire [127:0] smiPipeReq14Data;
+wire         smiPipeReq14Stop;
+wire         smiPipeResp14Ready;
+wire [  7:0] smiPipeResp14Eofc;
+wire [127:0] smiPipeResp14Data;
+wire         smiPipeResp14Stop;
+
+  
+  
+// Instantiate SMI request scaler busWidthScalerL2I8Req
+smiFlitScaleX2 #(8) busWidthScalerL2I8Req (
+
+  .smiInReady  (smiMemClientReq16Ready),
+  .smiInEofc   (smiMemClientReq16Eofc),
+  .smiInData   (smiMemClientReq16Data),
+  .smiInStop   (smiMemClientReq16Stop),
+
+  .smiOutReady (smiWireReqL1I8Ready),
+  .smiOutEofc  (smiWireReqL1I8Eofc),
+  .smiOutData  (smiWireReqL1I8Data),
+  .smiOutStop  (smiWireReqL1I8Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI response scaler busWidthScalerL2I8Resp
+smiFlitScaleD2 #(8*2) busWidthScalerL2I8Resp (
+
+  .smiInReady  (smiWireRespL1I8Ready),
+  .smiInEofc   (smiWireRespL1I8Eofc),
+  .smiInData   (smiWireRespL1I8Data),
+  .smiInStop   (smiWireRespL1I8Stop),
+
+  .smiOutReady (smiMemClientResp16Ready),
+  .smiOutEofc  (smiMemClientResp16Eofc),
+  .smiOutData  (smiMemClientResp16Data),
+  .smiOutStop  (smiMemClientResp16Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+  
+// Instantiate transaction arbiter busArbiterL0I0
+smiTransactionArbiterX3 #(16, 4, 32, 4) busArbiterL0I0 (
+  
+  .smiReqAInReady   (smiPipeReq2Ready),
+  .smiReqAInEofc    (smiPipeReq2Eofc),
+  .smiReqAInData    (smiPipeReq2Data),
+  .smiReqAInStop    (smiPipeReq2Stop),
+  .smiRespAOutReady (smiPipeResp2Ready),
+  .smiRespAOutEofc  (smiPipeResp2Eofc),
+  .smiRespAOutData  (smiPipeResp2Data),
+  .smiRespAOutStop  (smiPipeResp2Stop),
+  
+  .smiReqBInReady   (smiPipeReq3Ready),
+  .smiReqBInEofc    (smiPipeReq3Eofc),
+  .smiReqBInData    (smiPipeReq3Data),
+  .smiReqBInStop    (smiPipeReq3Stop),
+  .smiRespBOutReady (smiPipeResp3Ready),
+  .smiRespBOutEofc  (smiPipeResp3Eofc),
+  .smiRespBOutData  (smiPipeResp3Data),
+  .smiRespBOutStop  (smiPipeResp3Stop),
+  
+  .smiReqCInReady   (smiPipeReq4Ready),
+  .smiReqCInEofc    (smiPipeReq4Eofc),
+  .smiReqCInData    (smiPipeReq4Data),
+  .smiReqCInStop    (smiPipeReq4Stop),
+  .smiRespCOutReady (smiPipeResp4Ready),
+  .smiRespCOutEofc  (smiPipeResp4Eofc),
+  .smiRespCOutData  (smiPipeResp4Data),
+  .smiRespCOutStop  (smiPipeResp4Stop),
+  
+  .smiReqOutReady (smiPipeReq14Ready),
+  .smiReqOutEofc  (smiPipeReq14Eofc),
+  .smiReqOutData  (smiPipeReq14Data),
+  .smiReqOutStop  (smiPipeReq14Stop),
+  .smiRespInReady (smiPipeResp14Ready),
+  .smiRespInEofc  (smiPipeResp14Eofc),
+  .smiRespInData  (smiPipeResp14Data),
+  .smiRespInStop  (smiPipeResp14Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL1I0
+smiTransactionArbiterX3 #(16, 4, 32, 4) busArbiterL1I0 (
+  
+  .smiReqAInReady   (smiPipeReq5Ready),
+  .smiReqAInEofc    (smiPipeReq5Eofc),
+  .smiReqAInData    (smiPipeReq5Data),
+  .smiReqAInStop    (smiPipeReq5Stop),
+  .smiRespAOutReady (smiPipeResp5Ready),
+  .smiRespAOutEofc  (smiPipeResp5Eofc),
+  .smiRespAOutData  (smiPipeResp5Data),
+  .smiRespAOutStop  (smiPipeResp5Stop),
+  
+  .smiReqBInReady   (smiPipeReq6Ready),
+  .smiReqBInEofc    (smiPipeReq6Eofc),
+  .smiReqBInData    (smiPipeReq6Data),
+  .smiReqBInStop    (smiPipeReq6Stop),
+  .smiRespBOutReady (smiPipeResp6Ready),
+  .smiRespBOutEofc  (smiPipeResp6Eofc),
+  .smiRespBOutData  (smiPipeResp6Data),
+  .smiRespBOutStop  (smiPipeResp6Stop),
+  
+  .smiReqCInReady   (smiPipeReq7Ready),
+  .smiReqCInEofc    (smiPipeReq7Eofc),
+  .smiReqCInData    (smiPipeReq7Data),
+  .smiReqCInStop    (smiPipeReq7Stop),
+  .smiRespCOutReady (smiPipeResp7Ready),
+  .smiRespCOutEofc  (smiPipeResp7Eofc),
+  .smiRespCOutData  (smiPipeResp7Data),
+  .smiRespCOutStop  (smiPipeResp7Stop),
+  
+  .smiReqOutReady (smiWireReqL0I0Ready),
+  .smiReqOutEofc  (smiWireReqL0I0Eofc),
+  .smiReqOutData  (smiWireReqL0I0Data),
+  .smiReqOutStop  (smiWireReqL0I0Stop),
+  .smiRespInReady (smiWireRespL0I0Ready),
+  .smiRespInEofc  (smiWireRespL0I0Eofc),
+  .smiRespInData  (smiWireRespL0I0Data),
+  .smiRespInStop  (smiWireRespL0I0Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL1I1
+smiTransactionArbiterX3 #(16, 4, 32, 4) busArbiterL1I1 (
+  
+  .smiReqAInReady   (smiPipeReq8Ready),
+  .smiReqAInEofc    (smiPipeReq8Eofc),
+  .smiReqAInData    (smiPipeReq8Data),
+  .smiReqAInStop    (smiPipeReq8Stop),
+  .smiRespAOutReady (smiPipeResp8Ready),
+  .smiRespAOutEofc  (smiPipeResp8Eofc),
+  .smiRespAOutData  (smiPipeResp8Data),
+  .smiRespAOutStop  (smiPipeResp8Stop),
+  
+  .smiReqBInReady   (smiPipeReq9Ready),
+  .smiReqBInEofc    (smiPipeReq9Eofc),
+  .smiReqBInData    (smiPipeReq9Data),
+  .smiReqBInStop    (smiPipeReq9Stop),
+  .smiRespBOutReady (smiPipeResp9Ready),
+  .smiRespBOutEofc  (smiPipeResp9Eofc),
+  .smiRespBOutData  (smiPipeResp9Data),
+  .smiRespBOutStop  (smiPipeResp9Stop),
+  
+  .smiReqCInReady   (smiPipeReq10Ready),
+  .smiReqCInEofc    (smiPipeReq10Eofc),
+  .smiReqCInData    (smiPipeReq10Data),
+  .smiReqCInStop    (smiPipeReq10Stop),
+  .smiRespCOutReady (smiPipeResp10Ready),
+  .smiRespCOutEofc  (smiPipeResp10Eofc),
+  .smiRespCOutData  (smiPipeResp10Data),
+  .smiRespCOutStop  (smiPipeResp10Stop),
+  
+  .smiReqOutReady (smiWireReqL0I1Ready),
+  .smiReqOutEofc  (smiWireReqL0I1Eofc),
+  .smiReqOutData  (smiWireReqL0I1Data),
+  .smiReqOutStop  (smiWireReqL0I1Stop),
+  .smiRespInReady (smiWireRespL0I1Ready),
+  .smiRespInEofc  (smiWireRespL0I1Eofc),
+  .smiRespInData  (smiWireRespL0I1Data),
+  .smiRespInStop  (smiWireRespL0I1Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL1I2
+smiTransactionArbiterX3 #(16, 4, 32, 4) busArbiterL1I2 (
+  
+  .smiReqAInReady   (smiPipeReq11Ready),
+  .smiReqAInEofc    (smiPipeReq11Eofc),
+  .smiReqAInData    (smiPipeReq11Data),
+  .smiReqAInStop    (smiPipeReq11Stop),
+  .smiRespAOutReady (smiPipeResp11Ready),
+  .smiRespAOutEofc  (smiPipeResp11Eofc),
+  .smiRespAOutData  (smiPipeResp11Data),
+  .smiRespAOutStop  (smiPipeResp11Stop),
+  
+  .smiReqBInReady   (smiPipeReq12Ready),
+  .smiReqBInEofc    (smiPipeReq12Eofc),
+  .smiReqBInData    (smiPipeReq12Data),
+  .smiReqBInStop    (smiPipeReq12Stop),
+  .smiRespBOutReady (smiPipeResp12Ready),
+  .smiRespBOutEofc  (smiPipeResp12Eofc),
+  .smiRespBOutData  (smiPipeResp12Data),
+  .smiRespBOutStop  (smiPipeResp12Stop),
+  
+  .smiReqCInReady   (smiPipeReq13Ready),
+  .smiReqCInEofc    (smiPipeReq13Eofc),
+  .smiReqCInData    (smiPipeReq13Data),
+  .smiReqCInStop    (smiPipeReq13Stop),
+  .smiRespCOutReady (smiPipeResp13Ready),
+  .smiRespCOutEofc  (smiPipeResp13Eofc),
+  .smiRespCOutData  (smiPipeResp13Data),
+  .smiRespCOutStop  (smiPipeResp13Stop),
+  
+  .smiReqOutReady (smiWireReqL0I2Ready),
+  .smiReqOutEofc  (smiWireReqL0I2Eofc),
+  .smiReqOutData  (smiWireReqL0I2Data),
+  .smiReqOutStop  (smiWireReqL0I2Stop),
+  .smiRespInReady (smiWireRespL0I2Ready),
+  .smiRespInEofc  (smiWireRespL0I2Eofc),
+  .smiRespInData  (smiWireRespL0I2Data),
+  .smiRespInStop  (smiWireRespL0I2Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I0
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I0 (
+  
+  .smiReqAInReady   (smiPipeReq0Ready),
+  .smiReqAInEofc    (smiPipeReq0Eofc),
+  .smiReqAInData    (smiPipeReq0Data),
+  .smiReqAInStop    (smiPipeReq0Stop),
+  .smiRespAOutReady (smiPipeResp0Ready),
+  .smiRespAOutEofc  (smiPipeResp0Eofc),
+  .smiRespAOutData  (smiPipeResp0Data),
+  .smiRespAOutStop  (smiPipeResp0Stop),
+  
+  .smiReqBInReady   (smiMemClientReq1Ready),
+  .smiReqBInEofc    (smiMemClientReq1Eofc),
+  .smiReqBInData    (smiMemClientReq1Data),
+  .smiReqBInStop    (smiMemClientReq1Stop),
+  .smiRespBOutReady (smiMemClientResp1Ready),
+  .smiRespBOutEofc  (smiMemClientResp1Eofc),
+  .smiRespBOutData  (smiMemClientResp1Data),
+  .smiRespBOutStop  (smiMemClientResp1Stop),
+  
+  .smiReqOutReady (smiWireReqL1I0Ready),
+  .smiReqOutEofc  (smiWireReqL1I0Eofc),
+  .smiReqOutData  (smiWireReqL1I0Data),
+  .smiReqOutStop  (smiWireReqL1I0Stop),
+  .smiRespInReady (smiWireRespL1I0Ready),
+  .smiRespInEofc  (smiWireRespL1I0Eofc),
+  .smiRespInData  (smiWireRespL1I0Data),
+  .smiRespInStop  (smiWireRespL1I0Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I1
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I1 (
+  
+  .smiReqAInReady   (smiMemClientReq2Ready),
+  .smiReqAInEofc    (smiMemClientReq2Eofc),
+  .smiReqAInData    (smiMemClientReq2Data),
+  .smiReqAInStop    (smiMemClientReq2Stop),
+  .smiRespAOutReady (smiMemClientResp2Ready),
+  .smiRespAOutEofc  (smiMemClientResp2Eofc),
+  .smiRespAOutData  (smiMemClientResp2Data),
+  .smiRespAOutStop  (smiMemClientResp2Stop),
+  
+  .smiReqBInReady   (smiMemClientReq3Ready),
+  .smiReqBInEofc    (smiMemClientReq3Eofc),
+  .smiReqBInData    (smiMemClientReq3Data),
+  .smiReqBInStop    (smiMemClientReq3Stop),
+  .smiRespBOutReady (smiMemClientResp3Ready),
+  .smiRespBOutEofc  (smiMemClientResp3Eofc),
+  .smiRespBOutData  (smiMemClientResp3Data),
+  .smiRespBOutStop  (smiMemClientResp3Stop),
+  
+  .smiReqOutReady (smiWireReqL1I1Ready),
+  .smiReqOutEofc  (smiWireReqL1I1Eofc),
+  .smiReqOutData  (smiWireReqL1I1Data),
+  .smiReqOutStop  (smiWireReqL1I1Stop),
+  .smiRespInReady (smiWireRespL1I1Ready),
+  .smiRespInEofc  (smiWireRespL1I1Eofc),
+  .smiRespInData  (smiWireRespL1I1Data),
+  .smiRespInStop  (smiWireRespL1I1Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I2
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I2 (
+  
+  .smiReqAInReady   (smiMemClientReq4Ready),
+  .smiReqAInEofc    (smiMemClientReq4Eofc),
+  .smiReqAInData    (smiMemClientReq4Data),
+  .smiReqAInStop    (smiMemClientReq4Stop),
+  .smiRespAOutReady (smiMemClientResp4Ready),
+  .smiRespAOutEofc  (smiMemClientResp4Eofc),
+  .smiRespAOutData  (smiMemClientResp4Data),
+  .smiRespAOutStop  (smiMemClientResp4Stop),
+  
+  .smiReqBInReady   (smiPipeReq1Ready),
+  .smiReqBInEofc    (smiPipeReq1Eofc),
+  .smiReqBInData    (smiPipeReq1Data),
+  .smiReqBInStop    (smiPipeReq1Stop),
+  .smiRespBOutReady (smiPipeResp1Ready),
+  .smiRespBOutEofc  (smiPipeResp1Eofc),
+  .smiRespBOutData  (smiPipeResp1Data),
+  .smiRespBOutStop  (smiPipeResp1Stop),
+  
+  .smiReqOutReady (smiWireReqL1I2Ready),
+  .smiReqOutEofc  (smiWireReqL1I2Eofc),
+  .smiReqOutData  (smiWireReqL1I2Data),
+  .smiReqOutStop  (smiWireReqL1I2Stop),
+  .smiRespInReady (smiWireRespL1I2Ready),
+  .smiRespInEofc  (smiWireRespL1I2Eofc),
+  .smiRespInData  (smiWireRespL1I2Data),
+  .smiRespInStop  (smiWireRespL1I2Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I3
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I3 (
+  
+  .smiReqAInReady   (smiMemClientReq6Ready),
+  .smiReqAInEofc    (smiMemClientReq6Eofc),
+  .smiReqAInData    (smiMemClientReq6Data),
+  .smiReqAInStop    (smiMemClientReq6Stop),
+  .smiRespAOutReady (smiMemClientResp6Ready),
+  .smiRespAOutEofc  (smiMemClientResp6Eofc),
+  .smiRespAOutData  (smiMemClientResp6Data),
+  .smiRespAOutStop  (smiMemClientResp6Stop),
+  
+  .smiReqBInReady   (smiMemClientReq7Ready),
+  .smiReqBInEofc    (smiMemClientReq7Eofc),
+  .smiReqBInData    (smiMemClientReq7Data),
+  .smiReqBInStop    (smiMemClientReq7Stop),
+  .smiRespBOutReady (smiMemClientResp7Ready),
+  .smiRespBOutEofc  (smiMemClientResp7Eofc),
+  .smiRespBOutData  (smiMemClientResp7Data),
+  .smiRespBOutStop  (smiMemClientResp7Stop),
+  
+  .smiReqOutReady (smiWireReqL1I3Ready),
+  .smiReqOutEofc  (smiWireReqL1I3Eofc),
+  .smiReqOutData  (smiWireReqL1I3Data),
+  .smiReqOutStop  (smiWireReqL1I3Stop),
+  .smiRespInReady (smiWireRespL1I3Ready),
+  .smiRespInEofc  (smiWireRespL1I3Eofc),
+  .smiRespInData  (smiWireRespL1I3Data),
+  .smiRespInStop  (smiWireRespL1I3Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I4
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I4 (
+  
+  .smiReqAInReady   (smiMemClientReq8Ready),
+  .smiReqAInEofc    (smiMemClientReq8Eofc),
+  .smiReqAInData    (smiMemClientReq8Data),
+  .smiReqAInStop    (smiMemClientReq8Stop),
+  .smiRespAOutReady (smiMemClientResp8Ready),
+  .smiRespAOutEofc  (smiMemClientResp8Eofc),
+  .smiRespAOutData  (smiMemClientResp8Data),
+  .smiRespAOutStop  (smiMemClientResp8Stop),
+  
+  .smiReqBInReady   (smiMemClientReq9Ready),
+  .smiReqBInEofc    (smiMemClientReq9Eofc),
+  .smiReqBInData    (smiMemClientReq9Data),
+  .smiReqBInStop    (smiMemClientReq9Stop),
+  .smiRespBOutReady (smiMemClientResp9Ready),
+  .smiRespBOutEofc  (smiMemClientResp9Eofc),
+  .smiRespBOutData  (smiMemClientResp9Data),
+  .smiRespBOutStop  (smiMemClientResp9Stop),
+  
+  .smiReqOutReady (smiWireReqL1I4Ready),
+  .smiReqOutEofc  (smiWireReqL1I4Eofc),
+  .smiReqOutData  (smiWireReqL1I4Data),
+  .smiReqOutStop  (smiWireReqL1I4Stop),
+  .smiRespInReady (smiWireRespL1I4Ready),
+  .smiRespInEofc  (smiWireRespL1I4Eofc),
+  .smiRespInData  (smiWireRespL1I4Data),
+  .smiRespInStop  (smiWireRespL1I4Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I5
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I5 (
+  
+  .smiReqAInReady   (smiMemClientReq10Ready),
+  .smiReqAInEofc    (smiMemClientReq10Eofc),
+  .smiReqAInData    (smiMemClientReq10Data),
+  .smiReqAInStop    (smiMemClientReq10Stop),
+  .smiRespAOutReady (smiMemClientResp10Ready),
+  .smiRespAOutEofc  (smiMemClientResp10Eofc),
+  .smiRespAOutData  (smiMemClientResp10Data),
+  .smiRespAOutStop  (smiMemClientResp10Stop),
+  
+  .smiReqBInReady   (smiMemClientReq11Ready),
+  .smiReqBInEofc    (smiMemClientReq11Eofc),
+  .smiReqBInData    (smiMemClientReq11Data),
+  .smiReqBInStop    (smiMemClientReq11Stop),
+  .smiRespBOutReady (smiMemClientResp11Ready),
+  .smiRespBOutEofc  (smiMemClientResp11Eofc),
+  .smiRespBOutData  (smiMemClientResp11Data),
+  .smiRespBOutStop  (smiMemClientResp11Stop),
+  
+  .smiReqOutReady (smiWireReqL1I5Ready),
+  .smiReqOutEofc  (smiWireReqL1I5Eofc),
+  .smiReqOutData  (smiWireReqL1I5Data),
+  .smiReqOutStop  (smiWireReqL1I5Stop),
+  .smiRespInReady (smiWireRespL1I5Ready),
+  .smiRespInEofc  (smiWireRespL1I5Eofc),
+  .smiRespInData  (smiWireRespL1I5Data),
+  .smiRespInStop  (smiWireRespL1I5Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I6
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I6 (
+  
+  .smiReqAInReady   (smiMemClientReq12Ready),
+  .smiReqAInEofc    (smiMemClientReq12Eofc),
+  .smiReqAInData    (smiMemClientReq12Data),
+  .smiReqAInStop    (smiMemClientReq12Stop),
+  .smiRespAOutReady (smiMemClientResp12Ready),
+  .smiRespAOutEofc  (smiMemClientResp12Eofc),
+  .smiRespAOutData  (smiMemClientResp12Data),
+  .smiRespAOutStop  (smiMemClientResp12Stop),
+  
+  .smiReqBInReady   (smiMemClientReq13Ready),
+  .smiReqBInEofc    (smiMemClientReq13Eofc),
+  .smiReqBInData    (smiMemClientReq13Data),
+  .smiReqBInStop    (smiMemClientReq13Stop),
+  .smiRespBOutReady (smiMemClientResp13Ready),
+  .smiRespBOutEofc  (smiMemClientResp13Eofc),
+  .smiRespBOutData  (smiMemClientResp13Data),
+  .smiRespBOutStop  (smiMemClientResp13Stop),
+  
+  .smiReqOutReady (smiWireReqL1I6Ready),
+  .smiReqOutEofc  (smiWireReqL1I6Eofc),
+  .smiReqOutData  (smiWireReqL1I6Data),
+  .smiReqOutStop  (smiWireReqL1I6Stop),
+  .smiRespInReady (smiWireRespL1I6Ready),
+  .smiRespInEofc  (smiWireRespL1I6Eofc),
+  .smiRespInData  (smiWireRespL1I6Data),
+  .smiRespInStop  (smiWireRespL1I6Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate transaction arbiter busArbiterL2I7
+smiTransactionScaledArbiterX2 #(8, 4, 32, 4) busArbiterL2I7 (
+  
+  .smiReqAInReady   (smiMemClientReq14Ready),
+  .smiReqAInEofc    (smiMemClientReq14Eofc),
+  .smiReqAInData    (smiMemClientReq14Data),
+  .smiReqAInStop    (smiMemClientReq14Stop),
+  .smiRespAOutReady (smiMemClientResp14Ready),
+  .smiRespAOutEofc  (smiMemClientResp14Eofc),
+  .smiRespAOutData  (smiMemClientResp14Data),
+  .smiRespAOutStop  (smiMemClientResp14Stop),
+  
+  .smiReqBInReady   (smiMemClientReq15Ready),
+  .smiReqBInEofc    (smiMemClientReq15Eofc),
+  .smiReqBInData    (smiMemClientReq15Data),
+  .smiReqBInStop    (smiMemClientReq15Stop),
+  .smiRespBOutReady (smiMemClientResp15Ready),
+  .smiRespBOutEofc  (smiMemClientResp15Eofc),
+  .smiRespBOutData  (smiMemClientResp15Data),
+  .smiRespBOutStop  (smiMemClientResp15Stop),
+  
+  .smiReqOutReady (smiWireReqL1I7Ready),
+  .smiReqOutEofc  (smiWireReqL1I7Eofc),
+  .smiReqOutData  (smiWireReqL1I7Data),
+  .smiReqOutStop  (smiWireReqL1I7Stop),
+  .smiRespInReady (smiWireRespL1I7Ready),
+  .smiRespInEofc  (smiWireRespL1I7Eofc),
+  .smiRespInData  (smiWireRespL1I7Data),
+  .smiRespInStop  (smiWireRespL1I7Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline0
+smiMemBusPipelineStage #(8) busPipeline0 (
+
+  .smiReqInReady   (smiMemClientReq0Ready),
+  .smiReqInEofc    (smiMemClientReq0Eofc),
+  .smiReqInData    (smiMemClientReq0Data),
+  .smiReqInStop    (smiMemClientReq0Stop),
+  .smiRespOutReady (smiMemClientResp0Ready),
+  .smiRespOutEofc  (smiMemClientResp0Eofc),
+  .smiRespOutData  (smiMemClientResp0Data),
+  .smiRespOutStop  (smiMemClientResp0Stop),
+
+  .smiReqOutReady  (smiPipeReq0Ready),
+  .smiReqOutEofc   (smiPipeReq0Eofc),
+  .smiReqOutData   (smiPipeReq0Data),
+  .smiReqOutStop   (smiPipeReq0Stop),
+  .smiRespInReady  (smiPipeResp0Ready),
+  .smiRespInEofc   (smiPipeResp0Eofc),
+  .smiRespInData   (smiPipeResp0Data),
+  .smiRespInStop   (smiPipeResp0Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline1
+smiMemBusPipelineStage #(8) busPipeline1 (
+
+  .smiReqInReady   (smiMemClientReq5Ready),
+  .smiReqInEofc    (smiMemClientReq5Eofc),
+  .smiReqInData    (smiMemClientReq5Data),
+  .smiReqInStop    (smiMemClientReq5Stop),
+  .smiRespOutReady (smiMemClientResp5Ready),
+  .smiRespOutEofc  (smiMemClientResp5Eofc),
+  .smiRespOutData  (smiMemClientResp5Data),
+  .smiRespOutStop  (smiMemClientResp5Stop),
+
+  .smiReqOutReady  (smiPipeReq1Ready),
+  .smiReqOutEofc   (smiPipeReq1Eofc),
+  .smiReqOutData   (smiPipeReq1Data),
+  .smiReqOutStop   (smiPipeReq1Stop),
+  .smiRespInReady  (smiPipeResp1Ready),
+  .smiRespInEofc   (smiPipeResp1Eofc),
+  .smiRespInData   (smiPipeResp1Data),
+  .smiRespInStop   (smiPipeResp1Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline2
+smiMemBusPipelineStage #(16) busPipeline2 (
+
+  .smiReqInReady   (smiWireReqL0I0Ready),
+  .smiReqInEofc    (smiWireReqL0I0Eofc),
+  .smiReqInData    (smiWireReqL0I0Data),
+  .smiReqInStop    (smiWireReqL0I0Stop),
+  .smiRespOutReady (smiWireRespL0I0Ready),
+  .smiRespOutEofc  (smiWireRespL0I0Eofc),
+  .smiRespOutData  (smiWireRespL0I0Data),
+  .smiRespOutStop  (smiWireRespL0I0Stop),
+
+  .smiReqOutReady  (smiPipeReq2Ready),
+  .smiReqOutEofc   (smiPipeReq2Eofc),
+  .smiReqOutData   (smiPipeReq2Data),
+  .smiReqOutStop   (smiPipeReq2Stop),
+  .smiRespInReady  (smiPipeResp2Ready),
+  .smiRespInEofc   (smiPipeResp2Eofc),
+  .smiRespInData   (smiPipeResp2Data),
+  .smiRespInStop   (smiPipeResp2Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline3
+smiMemBusPipelineStage #(16) busPipeline3 (
+
+  .smiReqInReady   (smiWireReqL0I1Ready),
+  .smiReqInEofc    (smiWireReqL0I1Eofc),
+  .smiReqInData    (smiWireReqL0I1Data),
+  .smiReqInStop    (smiWireReqL0I1Stop),
+  .smiRespOutReady (smiWireRespL0I1Ready),
+  .smiRespOutEofc  (smiWireRespL0I1Eofc),
+  .smiRespOutData  (smiWireRespL0I1Data),
+  .smiRespOutStop  (smiWireRespL0I1Stop),
+
+  .smiReqOutReady  (smiPipeReq3Ready),
+  .smiReqOutEofc   (smiPipeReq3Eofc),
+  .smiReqOutData   (smiPipeReq3Data),
+  .smiReqOutStop   (smiPipeReq3Stop),
+  .smiRespInReady  (smiPipeResp3Ready),
+  .smiRespInEofc   (smiPipeResp3Eofc),
+  .smiRespInData   (smiPipeResp3Data),
+  .smiRespInStop   (smiPipeResp3Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline4
+smiMemBusPipelineStage #(16) busPipeline4 (
+
+  .smiReqInReady   (smiWireReqL0I2Ready),
+  .smiReqInEofc    (smiWireReqL0I2Eofc),
+  .smiReqInData    (smiWireReqL0I2Data),
+  .smiReqInStop    (smiWireReqL0I2Stop),
+  .smiRespOutReady (smiWireRespL0I2Ready),
+  .smiRespOutEofc  (smiWireRespL0I2Eofc),
+  .smiRespOutData  (smiWireRespL0I2Data),
+  .smiRespOutStop  (smiWireRespL0I2Stop),
+
+  .smiReqOutReady  (smiPipeReq4Ready),
+  .smiReqOutEofc   (smiPipeReq4Eofc),
+  .smiReqOutData   (smiPipeReq4Data),
+  .smiReqOutStop   (smiPipeReq4Stop),
+  .smiRespInReady  (smiPipeResp4Ready),
+  .smiRespInEofc   (smiPipeResp4Eofc),
+  .smiRespInData   (smiPipeResp4Data),
+  .smiRespInStop   (smiPipeResp4Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline5
+smiMemBusPipelineStage #(16) busPipeline5 (
+
+  .smiReqInReady   (smiWireReqL1I0Ready),
+  .smiReqInEofc    (smiWireReqL1I0Eofc),
+  .smiReqInData    (smiWireReqL1I0Data),
+  .smiReqInStop    (smiWireReqL1I0Stop),
+  .smiRespOutReady (smiWireRespL1I0Ready),
+  .smiRespOutEofc  (smiWireRespL1I0Eofc),
+  .smiRespOutData  (smiWireRespL1I0Data),
+  .smiRespOutStop  (smiWireRespL1I0Stop),
+
+  .smiReqOutReady  (smiPipeReq5Ready),
+  .smiReqOutEofc   (smiPipeReq5Eofc),
+  .smiReqOutData   (smiPipeReq5Data),
+  .smiReqOutStop   (smiPipeReq5Stop),
+  .smiRespInReady  (smiPipeResp5Ready),
+  .smiRespInEofc   (smiPipeResp5Eofc),
+  .smiRespInData   (smiPipeResp5Data),
+  .smiRespInStop   (smiPipeResp5Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline6
+smiMemBusPipelineStage #(16) busPipeline6 (
+
+  .smiReqInReady   (smiWireReqL1I1Ready),
+  .smiReqInEofc    (smiWireReqL1I1Eofc),
+  .smiReqInData    (smiWireReqL1I1Data),
+  .smiReqInStop    (smiWireReqL1I1Stop),
+  .smiRespOutReady (smiWireRespL1I1Ready),
+  .smiRespOutEofc  (smiWireRespL1I1Eofc),
+  .smiRespOutData  (smiWireRespL1I1Data),
+  .smiRespOutStop  (smiWireRespL1I1Stop),
+
+  .smiReqOutReady  (smiPipeReq6Ready),
+  .smiReqOutEofc   (smiPipeReq6Eofc),
+  .smiReqOutData   (smiPipeReq6Data),
+  .smiReqOutStop   (smiPipeReq6Stop),
+  .smiRespInReady  (smiPipeResp6Ready),
+  .smiRespInEofc   (smiPipeResp6Eofc),
+  .smiRespInData   (smiPipeResp6Data),
+  .smiRespInStop   (smiPipeResp6Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline7
+smiMemBusPipelineStage #(16) busPipeline7 (
+
+  .smiReqInReady   (smiWireReqL1I2Ready),
+  .smiReqInEofc    (smiWireReqL1I2Eofc),
+  .smiReqInData    (smiWireReqL1I2Data),
+  .smiReqInStop    (smiWireReqL1I2Stop),
+  .smiRespOutReady (smiWireRespL1I2Ready),
+  .smiRespOutEofc  (smiWireRespL1I2Eofc),
+  .smiRespOutData  (smiWireRespL1I2Data),
+  .smiRespOutStop  (smiWireRespL1I2Stop),
+
+  .smiReqOutReady  (smiPipeReq7Ready),
+  .smiReqOutEofc   (smiPipeReq7Eofc),
+  .smiReqOutData   (smiPipeReq7Data),
+  .smiReqOutStop   (smiPipeReq7Stop),
+  .smiRespInReady  (smiPipeResp7Ready),
+  .smiRespInEofc   (smiPipeResp7Eofc),
+  .smiRespInData   (smiPipeResp7Data),
+  .smiRespInStop   (smiPipeResp7Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline8
+smiMemBusPipelineStage #(16) busPipeline8 (
+
+  .smiReqInReady   (smiWireReqL1I3Ready),
+  .smiReqInEofc    (smiWireReqL1I3Eofc),
+  .smiReqInData    (smiWireReqL1I3Data),
+  .smiReqInStop    (smiWireReqL1I3Stop),
+  .smiRespOutReady (smiWireRespL1I3Ready),
+  .smiRespOutEofc  (smiWireRespL1I3Eofc),
+  .smiRespOutData  (smiWireRespL1I3Data),
+  .smiRespOutStop  (smiWireRespL1I3Stop),
+
+  .smiReqOutReady  (smiPipeReq8Ready),
+  .smiReqOutEofc   (smiPipeReq8Eofc),
+  .smiReqOutData   (smiPipeReq8Data),
+  .smiReqOutStop   (smiPipeReq8Stop),
+  .smiRespInReady  (smiPipeResp8Ready),
+  .smiRespInEofc   (smiPipeResp8Eofc),
+  .smiRespInData   (smiPipeResp8Data),
+  .smiRespInStop   (smiPipeResp8Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline9
+smiMemBusPipelineStage #(16) busPipeline9 (
+
+  .smiReqInReady   (smiWireReqL1I4Ready),
+  .smiReqInEofc    (smiWireReqL1I4Eofc),
+  .smiReqInData    (smiWireReqL1I4Data),
+  .smiReqInStop    (smiWireReqL1I4Stop),
+  .smiRespOutReady (smiWireRespL1I4Ready),
+  .smiRespOutEofc  (smiWireRespL1I4Eofc),
+  .smiRespOutData  (smiWireRespL1I4Data),
+  .smiRespOutStop  (smiWireRespL1I4Stop),
+
+  .smiReqOutReady  (smiPipeReq9Ready),
+  .smiReqOutEofc   (smiPipeReq9Eofc),
+  .smiReqOutData   (smiPipeReq9Data),
+  .smiReqOutStop   (smiPipeReq9Stop),
+  .smiRespInReady  (smiPipeResp9Ready),
+  .smiRespInEofc   (smiPipeResp9Eofc),
+  .smiRespInData   (smiPipeResp9Data),
+  .smiRespInStop   (smiPipeResp9Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline10
+smiMemBusPipelineStage #(16) busPipeline10 (
+
+  .smiReqInReady   (smiWireReqL1I5Ready),
+  .smiReqInEofc    (smiWireReqL1I5Eofc),
+  .smiReqInData    (smiWireReqL1I5Data),
+  .smiReqInStop    (smiWireReqL1I5Stop),
+  .smiRespOutReady (smiWireRespL1I5Ready),
+  .smiRespOutEofc  (smiWireRespL1I5Eofc),
+  .smiRespOutData  (smiWireRespL1I5Data),
+  .smiRespOutStop  (smiWireRespL1I5Stop),
+
+  .smiReqOutReady  (smiPipeReq10Ready),
+  .smiReqOutEofc   (smiPipeReq10Eofc),
+  .smiReqOutData   (smiPipeReq10Data),
+  .smiReqOutStop   (smiPipeReq10Stop),
+  .smiRespInReady  (smiPipeResp10Ready),
+  .smiRespInEofc   (smiPipeResp10Eofc),
+  .smiRespInData   (smiPipeResp10Data),
+  .smiRespInStop   (smiPipeResp10Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline11
+smiMemBusPipelineStage #(16) busPipeline11 (
+
+  .smiReqInReady   (smiWireReqL1I6Ready),
+  .smiReqInEofc    (smiWireReqL1I6Eofc),
+  .smiReqInData    (smiWireReqL1I6Data),
+  .smiReqInStop    (smiWireReqL1I6Stop),
+  .smiRespOutReady (smiWireRespL1I6Ready),
+  .smiRespOutEofc  (smiWireRespL1I6Eofc),
+  .smiRespOutData  (smiWireRespL1I6Data),
+  .smiRespOutStop  (smiWireRespL1I6Stop),
+
+  .smiReqOutReady  (smiPipeReq11Ready),
+  .smiReqOutEofc   (smiPipeReq11Eofc),
+  .smiReqOutData   (smiPipeReq11Data),
+  .smiReqOutStop   (smiPipeReq11Stop),
+  .smiRespInReady  (smiPipeResp11Ready),
+  .smiRespInEofc   (smiPipeResp11Eofc),
+  .smiRespInData   (smiPipeResp11Data),
+  .smiRespInStop   (smiPipeResp11Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline12
+smiMemBusPipelineStage #(16) busPipeline12 (
+
+  .smiReqInReady   (smiWireReqL1I7Ready),
+  .smiReqInEofc    (smiWireReqL1I7Eofc),
+  .smiReqInData    (smiWireReqL1I7Data),
+  .smiReqInStop    (smiWireReqL1I7Stop),
+  .smiRespOutReady (smiWireRespL1I7Ready),
+  .smiRespOutEofc  (smiWireRespL1I7Eofc),
+  .smiRespOutData  (smiWireRespL1I7Data),
+  .smiRespOutStop  (smiWireRespL1I7Stop),
+
+  .smiReqOutReady  (smiPipeReq12Ready),
+  .smiReqOutEofc   (smiPipeReq12Eofc),
+  .smiReqOutData   (smiPipeReq12Data),
+  .smiReqOutStop   (smiPipeReq12Stop),
+  .smiRespInReady  (smiPipeResp12Ready),
+  .smiRespInEofc   (smiPipeResp12Eofc),
+  .smiRespInData   (smiPipeResp12Data),
+  .smiRespInStop   (smiPipeResp12Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline13
+smiMemBusPipelineStage #(16) busPipeline13 (
+
+  .smiReqInReady   (smiWireReqL1I8Ready),
+  .smiReqInEofc    (smiWireReqL1I8Eofc),
+  .smiReqInData    (smiWireReqL1I8Data),
+  .smiReqInStop    (smiWireReqL1I8Stop),
+  .smiRespOutReady (smiWireRespL1I8Ready),
+  .smiRespOutEofc  (smiWireRespL1I8Eofc),
+  .smiRespOutData  (smiWireRespL1I8Data),
+  .smiRespOutStop  (smiWireRespL1I8Stop),
+
+  .smiReqOutReady  (smiPipeReq13Ready),
+  .smiReqOutEofc   (smiPipeReq13Eofc),
+  .smiReqOutData   (smiPipeReq13Data),
+  .smiReqOutStop   (smiPipeReq13Stop),
+  .smiRespInReady  (smiPipeResp13Ready),
+  .smiRespInEofc   (smiPipeResp13Eofc),
+  .smiRespInData   (smiPipeResp13Data),
+  .smiRespInStop   (smiPipeResp13Stop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+// Instantiate SMI pipeline stage busPipeline14
+smiMemBusPipelineStage #(16) busPipeline14 (
+
+  .smiReqInReady   (smiPipeReq14Ready),
+  .smiReqInEofc    (smiPipeReq14Eofc),
+  .smiReqInData    (smiPipeReq14Data),
+  .smiReqInStop    (smiPipeReq14Stop),
+  .smiRespOutReady (smiPipeResp14Ready),
+  .smiRespOutEofc  (smiPipeResp14Eofc),
+  .smiRespOutData  (smiPipeResp14Data),
+  .smiRespOutStop  (smiPipeResp14Stop),
+
+  .smiReqOutReady  (smiMemServerReqReady),
+  .smiReqOutEofc   (smiMemServerReqEofc),
+  .smiReqOutData   (smiMemServerReqData),
+  .smiReqOutStop   (smiMemServerReqStop),
+  .smiRespInReady  (smiMemServerRespReady),
+  .smiRespInEofc   (smiMemServerRespEofc),
+  .smiRespInData   (smiMemServerRespData),
+  .smiRespInStop   (smiMemServerRespStop),
+
+  .clk             (clk),
+  .srst            (srst)
+);
+
+endmodule
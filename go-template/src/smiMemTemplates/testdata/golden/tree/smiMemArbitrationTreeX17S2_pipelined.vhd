@@ -0,0 +1,1357 @@
+--
+-- Copyright 2018 ReconfigureIO
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+
+--
+-- Created Mon, 01 Jan 2018 00:00:00 UTC
+-- Machine generated file - DO NOT EDIT
+--
+
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity smiMemArbitrationTreeX17S2 is
+  port (
+    smiMemClientReq0Ready    : in  std_logic;
+    smiMemClientReq0Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq0Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq0Stop     : out std_logic;
+    smiMemClientResp0Ready   : out std_logic;
+    smiMemClientResp0Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp0Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp0Stop    : in  std_logic;
+    smiMemClientReq1Ready    : in  std_logic;
+    smiMemClientReq1Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq1Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq1Stop     : out std_logic;
+    smiMemClientResp1Ready   : out std_logic;
+    smiMemClientResp1Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp1Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp1Stop    : in  std_logic;
+    smiMemClientReq2Ready    : in  std_logic;
+    smiMemClientReq2Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq2Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq2Stop     : out std_logic;
+    smiMemClientResp2Ready   : out std_logic;
+    smiMemClientResp2Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp2Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp2Stop    : in  std_logic;
+    smiMemClientReq3Ready    : in  std_logic;
+    smiMemClientReq3Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq3Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq3Stop     : out std_logic;
+    smiMemClientResp3Ready   : out std_logic;
+    smiMemClientResp3Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp3Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp3Stop    : in  std_logic;
+    smiMemClientReq4Ready    : in  std_logic;
+    smiMemClientReq4Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq4Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq4Stop     : out std_logic;
+    smiMemClientResp4Ready   : out std_logic;
+    smiMemClientResp4Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp4Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp4Stop    : in  std_logic;
+    smiMemClientReq5Ready    : in  std_logic;
+    smiMemClientReq5Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq5Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq5Stop     : out std_logic;
+    smiMemClientResp5Ready   : out std_logic;
+    smiMemClientResp5Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp5Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp5Stop    : in  std_logic;
+    smiMemClientReq6Ready    : in  std_logic;
+    smiMemClientReq6Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq6Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq6Stop     : out std_logic;
+    smiMemClientResp6Ready   : out std_logic;
+    smiMemClientResp6Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp6Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp6Stop    : in  std_logic;
+    smiMemClientReq7Ready    : in  std_logic;
+    smiMemClientReq7Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq7Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq7Stop     : out std_logic;
+    smiMemClientResp7Ready   : out std_logic;
+    smiMemClientResp7Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp7Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp7Stop    : in  std_logic;
+    smiMemClientReq8Ready    : in  std_logic;
+    smiMemClientReq8Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq8Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq8Stop     : out std_logic;
+    smiMemClientResp8Ready   : out std_logic;
+    smiMemClientResp8Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp8Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp8Stop    : in  std_logic;
+    smiMemClientReq9Ready    : in  std_logic;
+    smiMemClientReq9Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq9Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq9Stop     : out std_logic;
+    smiMemClientResp9Ready   : out std_logic;
+    smiMemClientResp9Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp9Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp9Stop    : in  std_logic;
+    smiMemClientReq10Ready   : in  std_logic;
+    smiMemClientReq10Eofc    : in  std_logic_vector(7 downto 0);
+    smiMemClientReq10Data    : in  std_logic_vector(63 downto 0);
+    smiMemClientReq10Stop    : out std_logic;
+    smiMemClientResp10Ready  : out std_logic;
+    smiMemClientResp10Eofc   : out std_logic_vector(7 downto 0);
+    smiMemClientResp10Data   : out std_logic_vector(63 downto 0);
+    smiMemClientResp10Stop   : in  std_logic;
+    smiMemClientReq11Ready   : in  std_logic;
+    smiMemClientReq11Eofc    : in  std_logic_vector(7 downto 0);
+    smiMemClientReq11Data    : in  std_logic_vector(63 downto 0);
+    smiMemClientReq11Stop    : out std_logic;
+    smiMemClientResp11Ready  : out std_logic;
+    smiMemClientResp11Eofc   : out std_logic_vector(7 downto 0);
+    smiMemClientResp11Data   : out std_logic_vector(63 downto 0);
+    smiMemClientResp11Stop   : in  std_logic;
+    smiMemClientReq12Ready   : in  std_logic;
+    smiMemClientReq12Eofc    : in  std_logic_vector(7 downto 0);
+    smiMemClientReq12Data    : in  std_logic_vector(63 downto 0);
+    smiMemClientReq12Stop    : out std_logic;
+    smiMemClientResp12Ready  : out std_logic;
+    smiMemClientResp12Eofc   : out std_logic_vector(7 downto 0);
+    smiMemClientResp12Data   : out std_logic_vector(63 downto 0);
+    smiMemClientResp12Stop   : in  std_logic;
+    smiMemClientReq13Ready   : in  std_logic;
+    smiMemClientReq13Eofc    : in  std_logic_vector(7 downto 0);
+    smiMemClientReq13Data    : in  std_logic_vector(63 downto 0);
+    smiMemClientReq13Stop    : out std_logic;
+    smiMemClientResp13Ready  : out std_logic;
+    smiMemClientResp13Eofc   : out std_logic_vector(7 downto 0);
+    smiMemClientResp13Data   : out std_logic_vector(63 downto 0);
+    smiMemClientResp13Stop   : in  std_logic;
+    smiMemClientReq14Ready   : in  std_logic;
+    smiMemClientReq14Eofc    : in  std_logic_vector(7 downto 0);
+    smiMemClientReq14Data    : in  std_logic_vector(63 downto 0);
+    smiMemClientReq14Stop    : out std_logic;
+    smiMemClientResp14Ready  : out std_logic;
+    smiMemClientResp14Eofc   : out std_logic_vector(7 downto 0);
+    smiMemClientResp14Data   : out std_logic_vector(63 downto 0);
+    smiMemClientResp14Stop   : in  std_logic;
+    smiMemClientReq15Ready   : in  std_logic;
+    smiMemClientReq15Eofc    : in  std_logic_vector(7 downto 0);
+    smiMemClientReq15Data    : in  std_logic_vector(63 downto 0);
+    smiMemClientReq15Stop    : out std_logic;
+    smiMemClientResp15Ready  : out std_logic;
+    smiMemClientResp15Eofc   : out std_logic_vector(7 downto 0);
+    smiMemClientResp15Data   : out std_logic_vector(63 downto 0);
+    smiMemClientResp15Stop   : in  std_logic;
+    smiMemClientReq16Ready   : in  std_logic;
+    smiMemClientReq16Eofc    : in  std_logic_vector(7 downto 0);
+    smiMemClientReq16Data    : in  std_logic_vector(63 downto 0);
+    smiMemClientReq16Stop    : out std_logic;
+    smiMemClientResp16Ready  : out std_logic;
+    smiMemClientResp16Eofc   : out std_logic_vector(7 downto 0);
+    smiMemClientResp16Data   : out std_logic_vector(63 downto 0);
+    smiMemClientResp16Stop   : in  std_logic;
+    smiMemServerReqReady     : out std_logic;
+    smiMemServerReqEofc      : out std_logic_vector(7 downto 0);
+    smiMemServerReqData      : out std_logic_vector(127 downto 0);
+    smiMemServerReqStop      : in  std_logic;
+    smiMemServerRespReady    : in  std_logic;
+    smiMemServerRespEofc     : in  std_logic_vector(7 downto 0);
+    smiMemServerRespData     : in  std_logic_vector(127 downto 0);
+    smiMemServerRespStop     : out std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+end smiMemArbitrationTreeX17S2;
+
+architecture rtl of smiMemArbitrationTreeX17S2 is
+
+  component smiFlitScaleD2
+  generic (
+    FlitWidth                : integer := 4);
+  port (
+    smiInReady               : in  std_logic;
+    smiInEofc                : in  std_logic_vector(7 downto 0);
+    smiInData                : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiInStop                : out std_logic;
+    smiOutReady              : out std_logic;
+    smiOutEofc               : out std_logic_vector(7 downto 0);
+    smiOutData               : out std_logic_vector(FlitWidth*8/2-1 downto 0);
+    smiOutStop               : in  std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+  end component;
+
+  component smiFlitScaleX2
+  generic (
+    FlitWidth                : integer := 4);
+  port (
+    smiInReady               : in  std_logic;
+    smiInEofc                : in  std_logic_vector(7 downto 0);
+    smiInData                : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiInStop                : out std_logic;
+    smiOutReady              : out std_logic;
+    smiOutEofc               : out std_logic_vector(7 downto 0);
+    smiOutData               : out std_logic_vector(FlitWidth*16-1 downto 0);
+    smiOutStop               : in  std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+  end component;
+
+  component smiMemBusPipelineStage
+  generic (
+    FlitWidth                : integer := 8);
+  port (
+    smiReqInReady            : in  std_logic;
+    smiReqInEofc             : in  std_logic_vector(7 downto 0);
+    smiReqInData             : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqInStop             : out std_logic;
+    smiRespOutReady          : out std_logic;
+    smiRespOutEofc           : out std_logic_vector(7 downto 0);
+    smiRespOutData           : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespOutStop           : in  std_logic;
+    smiReqOutReady           : out std_logic;
+    smiReqOutEofc            : out std_logic_vector(7 downto 0);
+    smiReqOutData            : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqOutStop            : in  std_logic;
+    smiRespInReady           : in  std_logic;
+    smiRespInEofc            : in  std_logic_vector(7 downto 0);
+    smiRespInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespInStop            : out std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+  end component;
+
+  component smiTransactionArbiterX3
+  generic (
+    FlitWidth                : integer := 4;
+    TagIdWidth               : integer := 2;
+    FifoSize                 : integer := 16;
+    MaxFrameCount            : integer := 7);
+  port (
+    smiReqAInReady           : in  std_logic;
+    smiReqAInEofc            : in  std_logic_vector(7 downto 0);
+    smiReqAInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqAInStop            : out std_logic;
+    smiRespAOutReady         : out std_logic;
+    smiRespAOutEofc          : out std_logic_vector(7 downto 0);
+    smiRespAOutData          : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespAOutStop          : in  std_logic;
+    smiReqBInReady           : in  std_logic;
+    smiReqBInEofc            : in  std_logic_vector(7 downto 0);
+    smiReqBInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqBInStop            : out std_logic;
+    smiRespBOutReady         : out std_logic;
+    smiRespBOutEofc          : out std_logic_vector(7 downto 0);
+    smiRespBOutData          : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespBOutStop          : in  std_logic;
+    smiReqCInReady           : in  std_logic;
+    smiReqCInEofc            : in  std_logic_vector(7 downto 0);
+    smiReqCInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqCInStop            : out std_logic;
+    smiRespCOutReady         : out std_logic;
+    smiRespCOutEofc          : out std_logic_vector(7 downto 0);
+    smiRespCOutData          : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespCOutStop          : in  std_logic;
+    smiReqOutReady           : out std_logic;
+    smiReqOutEofc            : out std_logic_vector(7 downto 0);
+    smiReqOutData            : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqOutStop            : in  std_logic;
+    smiRespInReady           : in  std_logic;
+    smiRespInEofc            : in  std_logic_vector(7 downto 0);
+    smiRespInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespInStop            : out std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+  end component;
+
+  component smiTransactionScaledArbiterX2
+  generic (
+    FlitWidth                : integer := 4;
+    TagIdWidth               : integer := 2;
+    FifoSize                 : integer := 16;
+    MaxAssembledFrames       : integer := 15);
+  port (
+    smiReqAInReady           : in  std_logic;
+    smiReqAInEofc            : in  std_logic_vector(7 downto 0);
+    smiReqAInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqAInStop            : out std_logic;
+    smiRespAOutReady         : out std_logic;
+    smiRespAOutEofc          : out std_logic_vector(7 downto 0);
+    smiRespAOutData          : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespAOutStop          : in  std_logic;
+    smiReqBInReady           : in  std_logic;
+    smiReqBInEofc            : in  std_logic_vector(7 downto 0);
+    smiReqBInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqBInStop            : out std_logic;
+    smiRespBOutReady         : out std_logic;
+    smiRespBOutEofc          : out std_logic_vector(7 downto 0);
+    smiRespBOutData          : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespBOutStop          : in  std_logic;
+    smiReqOutReady           : out std_logic;
+    smiReqOutEofc            : out std_logic_vector(7 downto 0);
+    smiReqOutData            : out std_logic_vector(FlitWidth*16-1 downto 0);
+    smiReqOutStop            : in  std_logic;
+    smiRespInReady           : in  std_logic;
+    smiRespInEofc            : in  std_logic_vector(7 downto 0);
+    smiRespInData            : in  std_logic_vector(FlitWidth*16-1 downto 0);
+    smiRespInStop            : out std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+  end component;
+
+  signal smiWireReqL0I0Ready      : std_logic;
+  signal smiWireReqL0I0Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL0I0Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL0I0Stop       : std_logic;
+  signal smiWireRespL0I0Ready     : std_logic;
+  signal smiWireRespL0I0Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL0I0Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL0I0Stop      : std_logic;
+  signal smiWireReqL0I1Ready      : std_logic;
+  signal smiWireReqL0I1Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL0I1Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL0I1Stop       : std_logic;
+  signal smiWireRespL0I1Ready     : std_logic;
+  signal smiWireRespL0I1Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL0I1Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL0I1Stop      : std_logic;
+  signal smiWireReqL0I2Ready      : std_logic;
+  signal smiWireReqL0I2Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL0I2Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL0I2Stop       : std_logic;
+  signal smiWireRespL0I2Ready     : std_logic;
+  signal smiWireRespL0I2Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL0I2Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL0I2Stop      : std_logic;
+  signal smiWireReqL1I0Ready      : std_logic;
+  signal smiWireReqL1I0Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL1I0Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL1I0Stop       : std_logic;
+  signal smiWireRespL1I0Ready     : std_logic;
+  signal smiWireRespL1I0Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL1I0Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL1I0Stop      : std_logic;
+  signal smiWireReqL1I1Ready      : std_logic;
+  signal smiWireReqL1I1Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL1I1Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL1I1Stop       : std_logic;
+  signal smiWireRespL1I1Ready     : std_logic;
+  signal smiWireRespL1I1Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL1I1Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL1I1Stop      : std_logic;
+  signal smiWireReqL1I2Ready      : std_logic;
+  signal smiWireReqL1I2Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL1I2Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL1I2Stop       : std_logic;
+  signal smiWireRespL1I2Ready     : std_logic;
+  signal smiWireRespL1I2Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL1I2Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL1I2Stop      : std_logic;
+  signal smiWireReqL1I3Ready      : std_logic;
+  signal smiWireReqL1I3Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL1I3Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL1I3Stop       : std_logic;
+  signal smiWireRespL1I3Ready     : std_logic;
+  signal smiWireRespL1I3Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL1I3Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL1I3Stop      : std_logic;
+  signal smiWireReqL1I4Ready      : std_logic;
+  signal smiWireReqL1I4Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL1I4Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL1I4Stop       : std_logic;
+  signal smiWireRespL1I4Ready     : std_logic;
+  signal smiWireRespL1I4Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL1I4Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL1I4Stop      : std_logic;
+  signal smiWireReqL1I5Ready      : std_logic;
+  signal smiWireReqL1I5Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL1I5Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL1I5Stop       : std_logic;
+  signal smiWireRespL1I5Ready     : std_logic;
+  signal smiWireRespL1I5Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL1I5Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL1I5Stop      : std_logic;
+  signal smiWireReqL1I6Ready      : std_logic;
+  signal smiWireReqL1I6Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL1I6Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL1I6Stop       : std_logic;
+  signal smiWireRespL1I6Ready     : std_logic;
+  signal smiWireRespL1I6Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL1I6Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL1I6Stop      : std_logic;
+  signal smiWireReqL1I7Ready      : std_logic;
+  signal smiWireReqL1I7Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL1I7Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL1I7Stop       : std_logic;
+  signal smiWireRespL1I7Ready     : std_logic;
+  signal smiWireRespL1I7Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL1I7Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL1I7Stop      : std_logic;
+  signal smiWireReqL1I8Ready      : std_logic;
+  signal smiWireReqL1I8Eofc       : std_logic_vector(7 downto 0);
+  signal smiWireReqL1I8Data       : std_logic_vector(127 downto 0);
+  signal smiWireReqL1I8Stop       : std_logic;
+  signal smiWireRespL1I8Ready     : std_logic;
+  signal smiWireRespL1I8Eofc      : std_logic_vector(7 downto 0);
+  signal smiWireRespL1I8Data      : std_logic_vector(127 downto 0);
+  signal smiWireRespL1I8Stop      : std_logic;
+  signal smiPipeReq0Ready         : std_logic;
+  signal smiPipeReq0Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq0Data          : std_logic_vector(63 downto 0);
+  signal smiPipeReq0Stop          : std_logic;
+  signal smiPipeResp0Ready        : std_logic;
+  signal smiPipeResp0Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp0Data         : std_logic_vector(63 downto 0);
+  signal smiPipeResp0Stop         : std_logic;
+  signal smiPipeReq1Ready         : std_logic;
+  signal smiPipeReq1Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq1Data          : std_logic_vector(63 downto 0);
+  signal smiPipeReq1Stop          : std_logic;
+  signal smiPipeResp1Ready        : std_logic;
+  signal smiPipeResp1Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp1Data         : std_logic_vector(63 downto 0);
+  signal smiPipeResp1Stop         : std_logic;
+  signal smiPipeReq2Ready         : std_logic;
+  signal smiPipeReq2Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq2Data          : std_logic_vector(127 downto 0);
+  signal smiPipeReq2Stop          : std_logic;
+  signal smiPipeResp2Ready        : std_logic;
+  signal smiPipeResp2Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp2Data         : std_logic_vector(127 downto 0);
+  signal smiPipeResp2Stop         : std_logic;
+  signal smiPipeReq3Ready         : std_logic;
+  signal smiPipeReq3Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq3Data          : std_logic_vector(127 downto 0);
+  signal smiPipeReq3Stop          : std_logic;
+  signal smiPipeResp3Ready        : std_logic;
+  signal smiPipeResp3Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp3Data         : std_logic_vector(127 downto 0);
+  signal smiPipeResp3Stop         : std_logic;
+  signal smiPipeReq4Ready         : std_logic;
+  signal smiPipeReq4Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq4Data          : std_logic_vector(127 downto 0);
+  signal smiPipeReq4Stop          : std_logic;
+  signal smiPipeResp4Ready        : std_logic;
+  signal smiPipeResp4Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp4Data         : std_logic_vector(127 downto 0);
+  signal smiPipeResp4Stop         : std_logic;
+  signal smiPipeReq5Ready         : std_logic;
+  signal smiPipeReq5Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq5Data          : std_logic_vector(127 downto 0);
+  signal smiPipeReq5Stop          : std_logic;
+  signal smiPipeResp5Ready        : std_logic;
+  signal smiPipeResp5Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp5Data         : std_logic_vector(127 downto 0);
+  signal smiPipeResp5Stop         : std_logic;
+  signal smiPipeReq6Ready         : std_logic;
+  signal smiPipeReq6Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq6Data          : std_logic_vector(127 downto 0);
+  signal smiPipeReq6Stop          : std_logic;
+  signal smiPipeResp6Ready        : std_logic;
+  signal smiPipeResp6Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp6Data         : std_logic_vector(127 downto 0);
+  signal smiPipeResp6Stop         : std_logic;
+  signal smiPipeReq7Ready         : std_logic;
+  signal smiPipeReq7Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq7Data          : std_logic_vector(127 downto 0);
+  signal smiPipeReq7Stop          : std_logic;
+  signal smiPipeResp7Ready        : std_logic;
+  signal smiPipeResp7Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp7Data         : std_logic_vector(127 downto 0);
+  signal smiPipeResp7Stop         : std_logic;
+  signal smiPipeReq8Ready         : std_logic;
+  signal smiPipeReq8Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq8Data          : std_logic_vector(127 downto 0);
+  signal smiPipeReq8Stop          : std_logic;
+  signal smiPipeResp8Ready        : std_logic;
+  signal smiPipeResp8Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp8Data         : std_logic_vector(127 downto 0);
+  signal smiPipeResp8Stop         : std_logic;
+  signal smiPipeReq9Ready         : std_logic;
+  signal smiPipeReq9Eofc          : std_logic_vector(7 downto 0);
+  signal smiPipeReq9Data          : std_logic_vector(127 downto 0);
+  signal smiPipeReq9Stop          : std_logic;
+  signal smiPipeResp9Ready        : std_logic;
+  signal smiPipeResp9Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeResp9Data         : std_logic_vector(127 downto 0);
+  signal smiPipeResp9Stop         : std_logic;
+  signal smiPipeReq10Ready        : std_logic;
+  signal smiPipeReq10Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeReq10Data         : std_logic_vector(127 downto 0);
+  signal smiPipeReq10Stop         : std_logic;
+  signal smiPipeResp10Ready       : std_logic;
+  signal smiPipeResp10Eofc        : std_logic_vector(7 downto 0);
+  signal smiPipeResp10Data        : std_logic_vector(127 downto 0);
+  signal smiPipeResp10Stop        : std_logic;
+  signal smiPipeReq11Ready        : std_logic;
+  signal smiPipeReq11Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeReq11Data         : std_logic_vector(127 downto 0);
+  signal smiPipeReq11Stop         : std_logic;
+  signal smiPipeResp11Ready       : std_logic;
+  signal smiPipeResp11Eofc        : std_logic_vector(7 downto 0);
+  signal smiPipeResp11Data        : std_logic_vector(127 downto 0);
+  signal smiPipeResp11Stop        : std_logic;
+  signal smiPipeReq12Ready        : std_logic;
+  signal smiPipeReq12Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeReq12Data         : std_logic_vector(127 downto 0);
+  signal smiPipeReq12Stop         : std_logic;
+  signal smiPipeResp12Ready       : std_logic;
+  signal smiPipeResp12Eofc        : std_logic_vector(7 downto 0);
+  signal smiPipeResp12Data        : std_logic_vector(127 downto 0);
+  signal smiPipeResp12Stop        : std_logic;
+  signal smiPipeReq13Ready        : std_logic;
+  signal smiPipeReq13Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeReq13Data         : std_logic_vector(127 downto 0);
+  signal smiPipeReq13Stop         : std_logic;
+  signal smiPipeResp13Ready       : std_logic;
+  signal smiPipeResp13Eofc        : std_logic_vector(7 downto 0);
+  signal smiPipeResp13Data        : std_logic_vector(127 downto 0);
+  signal smiPipeResp13Stop        : std_logic;
+  signal smiPipeReq14Ready        : std_logic;
+  signal smiPipeReq14Eofc         : std_logic_vector(7 downto 0);
+  signal smiPipeReq14Data         : std_logic_vector(127 downto 0);
+  signal smiPipeReq14Stop         : std_logic;
+  signal smiPipeResp14Ready       : std_logic;
+  signal smiPipeResp14Eofc        : std_logic_vector(7 downto 0);
+  signal smiPipeResp14Data        : std_logic_vector(127 downto 0);
+  signal smiPipeResp14Stop        : std_logic;
+
+begin
+
+  -- Instantiate SMI request scaler busWidthScalerL2I8Req
+  busWidthScalerL2I8Req : smiFlitScaleX2
+  generic map (FlitWidth => 8)
+  port map (
+    smiInReady  => smiMemClientReq16Ready,
+    smiInEofc   => smiMemClientReq16Eofc,
+    smiInData   => smiMemClientReq16Data,
+    smiInStop   => smiMemClientReq16Stop,
+    smiOutReady => smiWireReqL1I8Ready,
+    smiOutEofc  => smiWireReqL1I8Eofc,
+    smiOutData  => smiWireReqL1I8Data,
+    smiOutStop  => smiWireReqL1I8Stop,
+    clk         => clk,
+    srst        => srst);
+
+  -- Instantiate SMI response scaler busWidthScalerL2I8Resp
+  busWidthScalerL2I8Resp : smiFlitScaleD2
+  generic map (FlitWidth => 8*2)
+  port map (
+    smiInReady  => smiWireRespL1I8Ready,
+    smiInEofc   => smiWireRespL1I8Eofc,
+    smiInData   => smiWireRespL1I8Data,
+    smiInStop   => smiWireRespL1I8Stop,
+    smiOutReady => smiMemClientResp16Ready,
+    smiOutEofc  => smiMemClientResp16Eofc,
+    smiOutData  => smiMemClientResp16Data,
+    smiOutStop  => smiMemClientResp16Stop,
+    clk         => clk,
+    srst        => srst);
+
+  -- Instantiate transaction arbiter busArbiterL0I0
+  busArbiterL0I0 : smiTransactionArbiterX3
+  generic map (
+    FlitWidth  => 16,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxFrameCount => 4)
+  port map (
+    smiReqAInReady   => smiPipeReq2Ready,
+    smiReqAInEofc    => smiPipeReq2Eofc,
+    smiReqAInData    => smiPipeReq2Data,
+    smiReqAInStop    => smiPipeReq2Stop,
+    smiRespAOutReady => smiPipeResp2Ready,
+    smiRespAOutEofc  => smiPipeResp2Eofc,
+    smiRespAOutData  => smiPipeResp2Data,
+    smiRespAOutStop  => smiPipeResp2Stop,
+    smiReqBInReady   => smiPipeReq3Ready,
+    smiReqBInEofc    => smiPipeReq3Eofc,
+    smiReqBInData    => smiPipeReq3Data,
+    smiReqBInStop    => smiPipeReq3Stop,
+    smiRespBOutReady => smiPipeResp3Ready,
+    smiRespBOutEofc  => smiPipeResp3Eofc,
+    smiRespBOutData  => smiPipeResp3Data,
+    smiRespBOutStop  => smiPipeResp3Stop,
+    smiReqCInReady   => smiPipeReq4Ready,
+    smiReqCInEofc    => smiPipeReq4Eofc,
+    smiReqCInData    => smiPipeReq4Data,
+    smiReqCInStop    => smiPipeReq4Stop,
+    smiRespCOutReady => smiPipeResp4Ready,
+    smiRespCOutEofc  => smiPipeResp4Eofc,
+    smiRespCOutData  => smiPipeResp4Data,
+    smiRespCOutStop  => smiPipeResp4Stop,
+    smiReqOutReady => smiPipeReq14Ready,
+    smiReqOutEofc  => smiPipeReq14Eofc,
+    smiReqOutData  => smiPipeReq14Data,
+    smiReqOutStop  => smiPipeReq14Stop,
+    smiRespInReady => smiPipeResp14Ready,
+    smiRespInEofc  => smiPipeResp14Eofc,
+    smiRespInData  => smiPipeResp14Data,
+    smiRespInStop  => smiPipeResp14Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL1I0
+  busArbiterL1I0 : smiTransactionArbiterX3
+  generic map (
+    FlitWidth  => 16,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxFrameCount => 4)
+  port map (
+    smiReqAInReady   => smiPipeReq5Ready,
+    smiReqAInEofc    => smiPipeReq5Eofc,
+    smiReqAInData    => smiPipeReq5Data,
+    smiReqAInStop    => smiPipeReq5Stop,
+    smiRespAOutReady => smiPipeResp5Ready,
+    smiRespAOutEofc  => smiPipeResp5Eofc,
+    smiRespAOutData  => smiPipeResp5Data,
+    smiRespAOutStop  => smiPipeResp5Stop,
+    smiReqBInReady   => smiPipeReq6Ready,
+    smiReqBInEofc    => smiPipeReq6Eofc,
+    smiReqBInData    => smiPipeReq6Data,
+    smiReqBInStop    => smiPipeReq6Stop,
+    smiRespBOutReady => smiPipeResp6Ready,
+    smiRespBOutEofc  => smiPipeResp6Eofc,
+    smiRespBOutData  => smiPipeResp6Data,
+    smiRespBOutStop  => smiPipeResp6Stop,
+    smiReqCInReady   => smiPipeReq7Ready,
+    smiReqCInEofc    => smiPipeReq7Eofc,
+    smiReqCInData    => smiPipeReq7Data,
+    smiReqCInStop    => smiPipeReq7Stop,
+    smiRespCOutReady => smiPipeResp7Ready,
+    smiRespCOutEofc  => smiPipeResp7Eofc,
+    smiRespCOutData  => smiPipeResp7Data,
+    smiRespCOutStop  => smiPipeResp7Stop,
+    smiReqOutReady => smiWireReqL0I0Ready,
+    smiReqOutEofc  => smiWireReqL0I0Eofc,
+    smiReqOutData  => smiWireReqL0I0Data,
+    smiReqOutStop  => smiWireReqL0I0Stop,
+    smiRespInReady => smiWireRespL0I0Ready,
+    smiRespInEofc  => smiWireRespL0I0Eofc,
+    smiRespInData  => smiWireRespL0I0Data,
+    smiRespInStop  => smiWireRespL0I0Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL1I1
+  busArbiterL1I1 : smiTransactionArbiterX3
+  generic map (
+    FlitWidth  => 16,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxFrameCount => 4)
+  port map (
+    smiReqAInReady   => smiPipeReq8Ready,
+    smiReqAInEofc    => smiPipeReq8Eofc,
+    smiReqAInData    => smiPipeReq8Data,
+    smiReqAInStop    => smiPipeReq8Stop,
+    smiRespAOutReady => smiPipeResp8Ready,
+    smiRespAOutEofc  => smiPipeResp8Eofc,
+    smiRespAOutData  => smiPipeResp8Data,
+    smiRespAOutStop  => smiPipeResp8Stop,
+    smiReqBInReady   => smiPipeReq9Ready,
+    smiReqBInEofc    => smiPipeReq9Eofc,
+    smiReqBInData    => smiPipeReq9Data,
+    smiReqBInStop    => smiPipeReq9Stop,
+    smiRespBOutReady => smiPipeResp9Ready,
+    smiRespBOutEofc  => smiPipeResp9Eofc,
+    smiRespBOutData  => smiPipeResp9Data,
+    smiRespBOutStop  => smiPipeResp9Stop,
+    smiReqCInReady   => smiPipeReq10Ready,
+    smiReqCInEofc    => smiPipeReq10Eofc,
+    smiReqCInData    => smiPipeReq10Data,
+    smiReqCInStop    => smiPipeReq10Stop,
+    smiRespCOutReady => smiPipeResp10Ready,
+    smiRespCOutEofc  => smiPipeResp10Eofc,
+    smiRespCOutData  => smiPipeResp10Data,
+    smiRespCOutStop  => smiPipeResp10Stop,
+    smiReqOutReady => smiWireReqL0I1Ready,
+    smiReqOutEofc  => smiWireReqL0I1Eofc,
+    smiReqOutData  => smiWireReqL0I1Data,
+    smiReqOutStop  => smiWireReqL0I1Stop,
+    smiRespInReady => smiWireRespL0I1Ready,
+    smiRespInEofc  => smiWireRespL0I1Eofc,
+    smiRespInData  => smiWireRespL0I1Data,
+    smiRespInStop  => smiWireRespL0I1Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL1I2
+  busArbiterL1I2 : smiTransactionArbiterX3
+  generic map (
+    FlitWidth  => 16,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxFrameCount => 4)
+  port map (
+    smiReqAInReady   => smiPipeReq11Ready,
+    smiReqAInEofc    => smiPipeReq11Eofc,
+    smiReqAInData    => smiPipeReq11Data,
+    smiReqAInStop    => smiPipeReq11Stop,
+    smiRespAOutReady => smiPipeResp11Ready,
+    smiRespAOutEofc  => smiPipeResp11Eofc,
+    smiRespAOutData  => smiPipeResp11Data,
+    smiRespAOutStop  => smiPipeResp11Stop,
+    smiReqBInReady   => smiPipeReq12Ready,
+    smiReqBInEofc    => smiPipeReq12Eofc,
+    smiReqBInData    => smiPipeReq12Data,
+    smiReqBInStop    => smiPipeReq12Stop,
+    smiRespBOutReady => smiPipeResp12Ready,
+    smiRespBOutEofc  => smiPipeResp12Eofc,
+    smiRespBOutData  => smiPipeResp12Data,
+    smiRespBOutStop  => smiPipeResp12Stop,
+    smiReqCInReady   => smiPipeReq13Ready,
+    smiReqCInEofc    => smiPipeReq13Eofc,
+    smiReqCInData    => smiPipeReq13Data,
+    smiReqCInStop    => smiPipeReq13Stop,
+    smiRespCOutReady => smiPipeResp13Ready,
+    smiRespCOutEofc  => smiPipeResp13Eofc,
+    smiRespCOutData  => smiPipeResp13Data,
+    smiRespCOutStop  => smiPipeResp13Stop,
+    smiReqOutReady => smiWireReqL0I2Ready,
+    smiReqOutEofc  => smiWireReqL0I2Eofc,
+    smiReqOutData  => smiWireReqL0I2Data,
+    smiReqOutStop  => smiWireReqL0I2Stop,
+    smiRespInReady => smiWireRespL0I2Ready,
+    smiRespInEofc  => smiWireRespL0I2Eofc,
+    smiRespInData  => smiWireRespL0I2Data,
+    smiRespInStop  => smiWireRespL0I2Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL2I0
+  busArbiterL2I0 : smiTransactionScaledArbiterX2
+  generic map (
+    FlitWidth  => 8,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxAssembledFrames => 4)
+  port map (
+    smiReqAInReady   => smiPipeReq0Ready,
+    smiReqAInEofc    => smiPipeReq0Eofc,
+    smiReqAInData    => smiPipeReq0Data,
+    smiReqAInStop    => smiPipeReq0Stop,
+    smiRespAOutReady => smiPipeResp0Ready,
+    smiRespAOutEofc  => smiPipeResp0Eofc,
+    smiRespAOutData  => smiPipeResp0Data,
+    smiRespAOutStop  => smiPipeResp0Stop,
+    smiReqBInReady   => smiMemClientReq1Ready,
+    smiReqBInEofc    => smiMemClientReq1Eofc,
+    smiReqBInData    => smiMemClientReq1Data,
+    smiReqBInStop    => smiMemClientReq1Stop,
+    smiRespBOutReady => smiMemClientResp1Ready,
+    smiRespBOutEofc  => smiMemClientResp1Eofc,
+    smiRespBOutData  => smiMemClientResp1Data,
+    smiRespBOutStop  => smiMemClientResp1Stop,
+    smiReqOutReady => smiWireReqL1I0Ready,
+    smiReqOutEofc  => smiWireReqL1I0Eofc,
+    smiReqOutData  => smiWireReqL1I0Data,
+    smiReqOutStop  => smiWireReqL1I0Stop,
+    smiRespInReady => smiWireRespL1I0Ready,
+    smiRespInEofc  => smiWireRespL1I0Eofc,
+    smiRespInData  => smiWireRespL1I0Data,
+    smiRespInStop  => smiWireRespL1I0Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL2I1
+  busArbiterL2I1 : smiTransactionScaledArbiterX2
+  generic map (
+    FlitWidth  => 8,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxAssembledFrames => 4)
+  port map (
+    smiReqAInReady   => smiMemClientReq2Ready,
+    smiReqAInEofc    => smiMemClientReq2Eofc,
+    smiReqAInData    => smiMemClientReq2Data,
+    smiReqAInStop    => smiMemClientReq2Stop,
+    smiRespAOutReady => smiMemClientResp2Ready,
+    smiRespAOutEofc  => smiMemClientResp2Eofc,
+    smiRespAOutData  => smiMemClientResp2Data,
+    smiRespAOutStop  => smiMemClientResp2Stop,
+    smiReqBInReady   => smiMemClientReq3Ready,
+    smiReqBInEofc    => smiMemClientReq3Eofc,
+    smiReqBInData    => smiMemClientReq3Data,
+    smiReqBInStop    => smiMemClientReq3Stop,
+    smiRespBOutReady => smiMemClientResp3Ready,
+    smiRespBOutEofc  => smiMemClientResp3Eofc,
+    smiRespBOutData  => smiMemClientResp3Data,
+    smiRespBOutStop  => smiMemClientResp3Stop,
+    smiReqOutReady => smiWireReqL1I1Ready,
+    smiReqOutEofc  => smiWireReqL1I1Eofc,
+    smiReqOutData  => smiWireReqL1I1Data,
+    smiReqOutStop  => smiWireReqL1I1Stop,
+    smiRespInReady => smiWireRespL1I1Ready,
+    smiRespInEofc  => smiWireRespL1I1Eofc,
+    smiRespInData  => smiWireRespL1I1Data,
+    smiRespInStop  => smiWireRespL1I1Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL2I2
+  busArbiterL2I2 : smiTransactionScaledArbiterX2
+  generic map (
+    FlitWidth  => 8,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxAssembledFrames => 4)
+  port map (
+    smiReqAInReady   => smiMemClientReq4Ready,
+    smiReqAInEofc    => smiMemClientReq4Eofc,
+    smiReqAInData    => smiMemClientReq4Data,
+    smiReqAInStop    => smiMemClientReq4Stop,
+    smiRespAOutReady => smiMemClientResp4Ready,
+    smiRespAOutEofc  => smiMemClientResp4Eofc,
+    smiRespAOutData  => smiMemClientResp4Data,
+    smiRespAOutStop  => smiMemClientResp4Stop,
+    smiReqBInReady   => smiPipeReq1Ready,
+    smiReqBInEofc    => smiPipeReq1Eofc,
+    smiReqBInData    => smiPipeReq1Data,
+    smiReqBInStop    => smiPipeReq1Stop,
+    smiRespBOutReady => smiPipeResp1Ready,
+    smiRespBOutEofc  => smiPipeResp1Eofc,
+    smiRespBOutData  => smiPipeResp1Data,
+    smiRespBOutStop  => smiPipeResp1Stop,
+    smiReqOutReady => smiWireReqL1I2Ready,
+    smiReqOutEofc  => smiWireReqL1I2Eofc,
+    smiReqOutData  => smiWireReqL1I2Data,
+    smiReqOutStop  => smiWireReqL1I2Stop,
+    smiRespInReady => smiWireRespL1I2Ready,
+    smiRespInEofc  => smiWireRespL1I2Eofc,
+    smiRespInData  => smiWireRespL1I2Data,
+    smiRespInStop  => smiWireRespL1I2Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL2I3
+  busArbiterL2I3 : smiTransactionScaledArbiterX2
+  generic map (
+    FlitWidth  => 8,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxAssembledFrames => 4)
+  port map (
+    smiReqAInReady   => smiMemClientReq6Ready,
+    smiReqAInEofc    => smiMemClientReq6Eofc,
+    smiReqAInData    => smiMemClientReq6Data,
+    smiReqAInStop    => smiMemClientReq6Stop,
+    smiRespAOutReady => smiMemClientResp6Ready,
+    smiRespAOutEofc  => smiMemClientResp6Eofc,
+    smiRespAOutData  => smiMemClientResp6Data,
+    smiRespAOutStop  => smiMemClientResp6Stop,
+    smiReqBInReady   => smiMemClientReq7Ready,
+    smiReqBInEofc    => smiMemClientReq7Eofc,
+    smiReqBInData    => smiMemClientReq7Data,
+    smiReqBInStop    => smiMemClientReq7Stop,
+    smiRespBOutReady => smiMemClientResp7Ready,
+    smiRespBOutEofc  => smiMemClientResp7Eofc,
+    smiRespBOutData  => smiMemClientResp7Data,
+    smiRespBOutStop  => smiMemClientResp7Stop,
+    smiReqOutReady => smiWireReqL1I3Ready,
+    smiReqOutEofc  => smiWireReqL1I3Eofc,
+    smiReqOutData  => smiWireReqL1I3Data,
+    smiReqOutStop  => smiWireReqL1I3Stop,
+    smiRespInReady => smiWireRespL1I3Ready,
+    smiRespInEofc  => smiWireRespL1I3Eofc,
+    smiRespInData  => smiWireRespL1I3Data,
+    smiRespInStop  => smiWireRespL1I3Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL2I4
+  busArbiterL2I4 : smiTransactionScaledArbiterX2
+  generic map (
+    FlitWidth  => 8,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxAssembledFrames => 4)
+  port map (
+    smiReqAInReady   => smiMemClientReq8Ready,
+    smiReqAInEofc    => smiMemClientReq8Eofc,
+    smiReqAInData    => smiMemClientReq8Data,
+    smiReqAInStop    => smiMemClientReq8Stop,
+    smiRespAOutReady => smiMemClientResp8Ready,
+    smiRespAOutEofc  => smiMemClientResp8Eofc,
+    smiRespAOutData  => smiMemClientResp8Data,
+    smiRespAOutStop  => smiMemClientResp8Stop,
+    smiReqBInReady   => smiMemClientReq9Ready,
+    smiReqBInEofc    => smiMemClientReq9Eofc,
+    smiReqBInData    => smiMemClientReq9Data,
+    smiReqBInStop    => smiMemClientReq9Stop,
+    smiRespBOutReady => smiMemClientResp9Ready,
+    smiRespBOutEofc  => smiMemClientResp9Eofc,
+    smiRespBOutData  => smiMemClientResp9Data,
+    smiRespBOutStop  => smiMemClientResp9Stop,
+    smiReqOutReady => smiWireReqL1I4Ready,
+    smiReqOutEofc  => smiWireReqL1I4Eofc,
+    smiReqOutData  => smiWireReqL1I4Data,
+    smiReqOutStop  => smiWireReqL1I4Stop,
+    smiRespInReady => smiWireRespL1I4Ready,
+    smiRespInEofc  => smiWireRespL1I4Eofc,
+    smiRespInData  => smiWireRespL1I4Data,
+    smiRespInStop  => smiWireRespL1I4Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL2I5
+  busArbiterL2I5 : smiTransactionScaledArbiterX2
+  generic map (
+    FlitWidth  => 8,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxAssembledFrames => 4)
+  port map (
+    smiReqAInReady   => smiMemClientReq10Ready,
+    smiReqAInEofc    => smiMemClientReq10Eofc,
+    smiReqAInData    => smiMemClientReq10Data,
+    smiReqAInStop    => smiMemClientReq10Stop,
+    smiRespAOutReady => smiMemClientResp10Ready,
+    smiRespAOutEofc  => smiMemClientResp10Eofc,
+    smiRespAOutData  => smiMemClientResp10Data,
+    smiRespAOutStop  => smiMemClientResp10Stop,
+    smiReqBInReady   => smiMemClientReq11Ready,
+    smiReqBInEofc    => smiMemClientReq11Eofc,
+    smiReqBInData    => smiMemClientReq11Data,
+    smiReqBInStop    => smiMemClientReq11Stop,
+    smiRespBOutReady => smiMemClientResp11Ready,
+    smiRespBOutEofc  => smiMemClientResp11Eofc,
+    smiRespBOutData  => smiMemClientResp11Data,
+    smiRespBOutStop  => smiMemClientResp11Stop,
+    smiReqOutReady => smiWireReqL1I5Ready,
+    smiReqOutEofc  => smiWireReqL1I5Eofc,
+    smiReqOutData  => smiWireReqL1I5Data,
+    smiReqOutStop  => smiWireReqL1I5Stop,
+    smiRespInReady => smiWireRespL1I5Ready,
+    smiRespInEofc  => smiWireRespL1I5Eofc,
+    smiRespInData  => smiWireRespL1I5Data,
+    smiRespInStop  => smiWireRespL1I5Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL2I6
+  busArbiterL2I6 : smiTransactionScaledArbiterX2
+  generic map (
+    FlitWidth  => 8,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxAssembledFrames => 4)
+  port map (
+    smiReqAInReady   => smiMemClientReq12Ready,
+    smiReqAInEofc    => smiMemClientReq12Eofc,
+    smiReqAInData    => smiMemClientReq12Data,
+    smiReqAInStop    => smiMemClientReq12Stop,
+    smiRespAOutReady => smiMemClientResp12Ready,
+    smiRespAOutEofc  => smiMemClientResp12Eofc,
+    smiRespAOutData  => smiMemClientResp12Data,
+    smiRespAOutStop  => smiMemClientResp12Stop,
+    smiReqBInReady   => smiMemClientReq13Ready,
+    smiReqBInEofc    => smiMemClientReq13Eofc,
+    smiReqBInData    => smiMemClientReq13Data,
+    smiReqBInStop    => smiMemClientReq13Stop,
+    smiRespBOutReady => smiMemClientResp13Ready,
+    smiRespBOutEofc  => smiMemClientResp13Eofc,
+    smiRespBOutData  => smiMemClientResp13Data,
+    smiRespBOutStop  => smiMemClientResp13Stop,
+    smiReqOutReady => smiWireReqL1I6Ready,
+    smiReqOutEofc  => smiWireReqL1I6Eofc,
+    smiReqOutData  => smiWireReqL1I6Data,
+    smiReqOutStop  => smiWireReqL1I6Stop,
+    smiRespInReady => smiWireRespL1I6Ready,
+    smiRespInEofc  => smiWireRespL1I6Eofc,
+    smiRespInData  => smiWireRespL1I6Data,
+    smiRespInStop  => smiWireRespL1I6Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate transaction arbiter busArbiterL2I7
+  busArbiterL2I7 : smiTransactionScaledArbiterX2
+  generic map (
+    FlitWidth  => 8,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxAssembledFrames => 4)
+  port map (
+    smiReqAInReady   => smiMemClientReq14Ready,
+    smiReqAInEofc    => smiMemClientReq14Eofc,
+    smiReqAInData    => smiMemClientReq14Data,
+    smiReqAInStop    => smiMemClientReq14Stop,
+    smiRespAOutReady => smiMemClientResp14Ready,
+    smiRespAOutEofc  => smiMemClientResp14Eofc,
+    smiRespAOutData  => smiMemClientResp14Data,
+    smiRespAOutStop  => smiMemClientResp14Stop,
+    smiReqBInReady   => smiMemClientReq15Ready,
+    smiReqBInEofc    => smiMemClientReq15Eofc,
+    smiReqBInData    => smiMemClientReq15Data,
+    smiReqBInStop    => smiMemClientReq15Stop,
+    smiRespBOutReady => smiMemClientResp15Ready,
+    smiRespBOutEofc  => smiMemClientResp15Eofc,
+    smiRespBOutData  => smiMemClientResp15Data,
+    smiRespBOutStop  => smiMemClientResp15Stop,
+    smiReqOutReady => smiWireReqL1I7Ready,
+    smiReqOutEofc  => smiWireReqL1I7Eofc,
+    smiReqOutData  => smiWireReqL1I7Data,
+    smiReqOutStop  => smiWireReqL1I7Stop,
+    smiRespInReady => smiWireRespL1I7Ready,
+    smiRespInEofc  => smiWireRespL1I7Eofc,
+    smiRespInData  => smiWireRespL1I7Data,
+    smiRespInStop  => smiWireRespL1I7Stop,
+    clk            => clk,
+    srst           => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline0
+  busPipeline0 : smiMemBusPipelineStage
+  generic map (FlitWidth => 8)
+  port map (
+    smiReqInReady   => smiMemClientReq0Ready,
+    smiReqInEofc    => smiMemClientReq0Eofc,
+    smiReqInData    => smiMemClientReq0Data,
+    smiReqInStop    => smiMemClientReq0Stop,
+    smiRespOutReady => smiMemClientResp0Ready,
+    smiRespOutEofc  => smiMemClientResp0Eofc,
+    smiRespOutData  => smiMemClientResp0Data,
+    smiRespOutStop  => smiMemClientResp0Stop,
+    smiReqOutReady  => smiPipeReq0Ready,
+    smiReqOutEofc   => smiPipeReq0Eofc,
+    smiReqOutData   => smiPipeReq0Data,
+    smiReqOutStop   => smiPipeReq0Stop,
+    smiRespInReady  => smiPipeResp0Ready,
+    smiRespInEofc   => smiPipeResp0Eofc,
+    smiRespInData   => smiPipeResp0Data,
+    smiRespInStop   => smiPipeResp0Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline1
+  busPipeline1 : smiMemBusPipelineStage
+  generic map (FlitWidth => 8)
+  port map (
+    smiReqInReady   => smiMemClientReq5Ready,
+    smiReqInEofc    => smiMemClientReq5Eofc,
+    smiReqInData    => smiMemClientReq5Data,
+    smiReqInStop    => smiMemClientReq5Stop,
+    smiRespOutReady => smiMemClientResp5Ready,
+    smiRespOutEofc  => smiMemClientResp5Eofc,
+    smiRespOutData  => smiMemClientResp5Data,
+    smiRespOutStop  => smiMemClientResp5Stop,
+    smiReqOutReady  => smiPipeReq1Ready,
+    smiReqOutEofc   => smiPipeReq1Eofc,
+    smiReqOutData   => smiPipeReq1Data,
+    smiReqOutStop   => smiPipeReq1Stop,
+    smiRespInReady  => smiPipeResp1Ready,
+    smiRespInEofc   => smiPipeResp1Eofc,
+    smiRespInData   => smiPipeResp1Data,
+    smiRespInStop   => smiPipeResp1Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline2
+  busPipeline2 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL0I0Ready,
+    smiReqInEofc    => smiWireReqL0I0Eofc,
+    smiReqInData    => smiWireReqL0I0Data,
+    smiReqInStop    => smiWireReqL0I0Stop,
+    smiRespOutReady => smiWireRespL0I0Ready,
+    smiRespOutEofc  => smiWireRespL0I0Eofc,
+    smiRespOutData  => smiWireRespL0I0Data,
+    smiRespOutStop  => smiWireRespL0I0Stop,
+    smiReqOutReady  => smiPipeReq2Ready,
+    smiReqOutEofc   => smiPipeReq2Eofc,
+    smiReqOutData   => smiPipeReq2Data,
+    smiReqOutStop   => smiPipeReq2Stop,
+    smiRespInReady  => smiPipeResp2Ready,
+    smiRespInEofc   => smiPipeResp2Eofc,
+    smiRespInData   => smiPipeResp2Data,
+    smiRespInStop   => smiPipeResp2Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline3
+  busPipeline3 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL0I1Ready,
+    smiReqInEofc    => smiWireReqL0I1Eofc,
+    smiReqInData    => smiWireReqL0I1Data,
+    smiReqInStop    => smiWireReqL0I1Stop,
+    smiRespOutReady => smiWireRespL0I1Ready,
+    smiRespOutEofc  => smiWireRespL0I1Eofc,
+    smiRespOutData  => smiWireRespL0I1Data,
+    smiRespOutStop  => smiWireRespL0I1Stop,
+    smiReqOutReady  => smiPipeReq3Ready,
+    smiReqOutEofc   => smiPipeReq3Eofc,
+    smiReqOutData   => smiPipeReq3Data,
+    smiReqOutStop   => smiPipeReq3Stop,
+    smiRespInReady  => smiPipeResp3Ready,
+    smiRespInEofc   => smiPipeResp3Eofc,
+    smiRespInData   => smiPipeResp3Data,
+    smiRespInStop   => smiPipeResp3Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline4
+  busPipeline4 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL0I2Ready,
+    smiReqInEofc    => smiWireReqL0I2Eofc,
+    smiReqInData    => smiWireReqL0I2Data,
+    smiReqInStop    => smiWireReqL0I2Stop,
+    smiRespOutReady => smiWireRespL0I2Ready,
+    smiRespOutEofc  => smiWireRespL0I2Eofc,
+    smiRespOutData  => smiWireRespL0I2Data,
+    smiRespOutStop  => smiWireRespL0I2Stop,
+    smiReqOutReady  => smiPipeReq4Ready,
+    smiReqOutEofc   => smiPipeReq4Eofc,
+    smiReqOutData   => smiPipeReq4Data,
+    smiReqOutStop   => smiPipeReq4Stop,
+    smiRespInReady  => smiPipeResp4Ready,
+    smiRespInEofc   => smiPipeResp4Eofc,
+    smiRespInData   => smiPipeResp4Data,
+    smiRespInStop   => smiPipeResp4Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline5
+  busPipeline5 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL1I0Ready,
+    smiReqInEofc    => smiWireReqL1I0Eofc,
+    smiReqInData    => smiWireReqL1I0Data,
+    smiReqInStop    => smiWireReqL1I0Stop,
+    smiRespOutReady => smiWireRespL1I0Ready,
+    smiRespOutEofc  => smiWireRespL1I0Eofc,
+    smiRespOutData  => smiWireRespL1I0Data,
+    smiRespOutStop  => smiWireRespL1I0Stop,
+    smiReqOutReady  => smiPipeReq5Ready,
+    smiReqOutEofc   => smiPipeReq5Eofc,
+    smiReqOutData   => smiPipeReq5Data,
+    smiReqOutStop   => smiPipeReq5Stop,
+    smiRespInReady  => smiPipeResp5Ready,
+    smiRespInEofc   => smiPipeResp5Eofc,
+    smiRespInData   => smiPipeResp5Data,
+    smiRespInStop   => smiPipeResp5Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline6
+  busPipeline6 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL1I1Ready,
+    smiReqInEofc    => smiWireReqL1I1Eofc,
+    smiReqInData    => smiWireReqL1I1Data,
+    smiReqInStop    => smiWireReqL1I1Stop,
+    smiRespOutReady => smiWireRespL1I1Ready,
+    smiRespOutEofc  => smiWireRespL1I1Eofc,
+    smiRespOutData  => smiWireRespL1I1Data,
+    smiRespOutStop  => smiWireRespL1I1Stop,
+    smiReqOutReady  => smiPipeReq6Ready,
+    smiReqOutEofc   => smiPipeReq6Eofc,
+    smiReqOutData   => smiPipeReq6Data,
+    smiReqOutStop   => smiPipeReq6Stop,
+    smiRespInReady  => smiPipeResp6Ready,
+    smiRespInEofc   => smiPipeResp6Eofc,
+    smiRespInData   => smiPipeResp6Data,
+    smiRespInStop   => smiPipeResp6Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline7
+  busPipeline7 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL1I2Ready,
+    smiReqInEofc    => smiWireReqL1I2Eofc,
+    smiReqInData    => smiWireReqL1I2Data,
+    smiReqInStop    => smiWireReqL1I2Stop,
+    smiRespOutReady => smiWireRespL1I2Ready,
+    smiRespOutEofc  => smiWireRespL1I2Eofc,
+    smiRespOutData  => smiWireRespL1I2Data,
+    smiRespOutStop  => smiWireRespL1I2Stop,
+    smiReqOutReady  => smiPipeReq7Ready,
+    smiReqOutEofc   => smiPipeReq7Eofc,
+    smiReqOutData   => smiPipeReq7Data,
+    smiReqOutStop   => smiPipeReq7Stop,
+    smiRespInReady  => smiPipeResp7Ready,
+    smiRespInEofc   => smiPipeResp7Eofc,
+    smiRespInData   => smiPipeResp7Data,
+    smiRespInStop   => smiPipeResp7Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline8
+  busPipeline8 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL1I3Ready,
+    smiReqInEofc    => smiWireReqL1I3Eofc,
+    smiReqInData    => smiWireReqL1I3Data,
+    smiReqInStop    => smiWireReqL1I3Stop,
+    smiRespOutReady => smiWireRespL1I3Ready,
+    smiRespOutEofc  => smiWireRespL1I3Eofc,
+    smiRespOutData  => smiWireRespL1I3Data,
+    smiRespOutStop  => smiWireRespL1I3Stop,
+    smiReqOutReady  => smiPipeReq8Ready,
+    smiReqOutEofc   => smiPipeReq8Eofc,
+    smiReqOutData   => smiPipeReq8Data,
+    smiReqOutStop   => smiPipeReq8Stop,
+    smiRespInReady  => smiPipeResp8Ready,
+    smiRespInEofc   => smiPipeResp8Eofc,
+    smiRespInData   => smiPipeResp8Data,
+    smiRespInStop   => smiPipeResp8Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline9
+  busPipeline9 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL1I4Ready,
+    smiReqInEofc    => smiWireReqL1I4Eofc,
+    smiReqInData    => smiWireReqL1I4Data,
+    smiReqInStop    => smiWireReqL1I4Stop,
+    smiRespOutReady => smiWireRespL1I4Ready,
+    smiRespOutEofc  => smiWireRespL1I4Eofc,
+    smiRespOutData  => smiWireRespL1I4Data,
+    smiRespOutStop  => smiWireRespL1I4Stop,
+    smiReqOutReady  => smiPipeReq9Ready,
+    smiReqOutEofc   => smiPipeReq9Eofc,
+    smiReqOutData   => smiPipeReq9Data,
+    smiReqOutStop   => smiPipeReq9Stop,
+    smiRespInReady  => smiPipeResp9Ready,
+    smiRespInEofc   => smiPipeResp9Eofc,
+    smiRespInData   => smiPipeResp9Data,
+    smiRespInStop   => smiPipeResp9Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline10
+  busPipeline10 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL1I5Ready,
+    smiReqInEofc    => smiWireReqL1I5Eofc,
+    smiReqInData    => smiWireReqL1I5Data,
+    smiReqInStop    => smiWireReqL1I5Stop,
+    smiRespOutReady => smiWireRespL1I5Ready,
+    smiRespOutEofc  => smiWireRespL1I5Eofc,
+    smiRespOutData  => smiWireRespL1I5Data,
+    smiRespOutStop  => smiWireRespL1I5Stop,
+    smiReqOutReady  => smiPipeReq10Ready,
+    smiReqOutEofc   => smiPipeReq10Eofc,
+    smiReqOutData   => smiPipeReq10Data,
+    smiReqOutStop   => smiPipeReq10Stop,
+    smiRespInReady  => smiPipeResp10Ready,
+    smiRespInEofc   => smiPipeResp10Eofc,
+    smiRespInData   => smiPipeResp10Data,
+    smiRespInStop   => smiPipeResp10Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline11
+  busPipeline11 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL1I6Ready,
+    smiReqInEofc    => smiWireReqL1I6Eofc,
+    smiReqInData    => smiWireReqL1I6Data,
+    smiReqInStop    => smiWireReqL1I6Stop,
+    smiRespOutReady => smiWireRespL1I6Ready,
+    smiRespOutEofc  => smiWireRespL1I6Eofc,
+    smiRespOutData  => smiWireRespL1I6Data,
+    smiRespOutStop  => smiWireRespL1I6Stop,
+    smiReqOutReady  => smiPipeReq11Ready,
+    smiReqOutEofc   => smiPipeReq11Eofc,
+    smiReqOutData   => smiPipeReq11Data,
+    smiReqOutStop   => smiPipeReq11Stop,
+    smiRespInReady  => smiPipeResp11Ready,
+    smiRespInEofc   => smiPipeResp11Eofc,
+    smiRespInData   => smiPipeResp11Data,
+    smiRespInStop   => smiPipeResp11Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline12
+  busPipeline12 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL1I7Ready,
+    smiReqInEofc    => smiWireReqL1I7Eofc,
+    smiReqInData    => smiWireReqL1I7Data,
+    smiReqInStop    => smiWireReqL1I7Stop,
+    smiRespOutReady => smiWireRespL1I7Ready,
+    smiRespOutEofc  => smiWireRespL1I7Eofc,
+    smiRespOutData  => smiWireRespL1I7Data,
+    smiRespOutStop  => smiWireRespL1I7Stop,
+    smiReqOutReady  => smiPipeReq12Ready,
+    smiReqOutEofc   => smiPipeReq12Eofc,
+    smiReqOutData   => smiPipeReq12Data,
+    smiReqOutStop   => smiPipeReq12Stop,
+    smiRespInReady  => smiPipeResp12Ready,
+    smiRespInEofc   => smiPipeResp12Eofc,
+    smiRespInData   => smiPipeResp12Data,
+    smiRespInStop   => smiPipeResp12Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline13
+  busPipeline13 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiWireReqL1I8Ready,
+    smiReqInEofc    => smiWireReqL1I8Eofc,
+    smiReqInData    => smiWireReqL1I8Data,
+    smiReqInStop    => smiWireReqL1I8Stop,
+    smiRespOutReady => smiWireRespL1I8Ready,
+    smiRespOutEofc  => smiWireRespL1I8Eofc,
+    smiRespOutData  => smiWireRespL1I8Data,
+    smiRespOutStop  => smiWireRespL1I8Stop,
+    smiReqOutReady  => smiPipeReq13Ready,
+    smiReqOutEofc   => smiPipeReq13Eofc,
+    smiReqOutData   => smiPipeReq13Data,
+    smiReqOutStop   => smiPipeReq13Stop,
+    smiRespInReady  => smiPipeResp13Ready,
+    smiRespInEofc   => smiPipeResp13Eofc,
+    smiRespInData   => smiPipeResp13Data,
+    smiRespInStop   => smiPipeResp13Stop,
+    clk             => clk,
+    srst            => srst);
+
+  -- Instantiate SMI pipeline stage busPipeline14
+  busPipeline14 : smiMemBusPipelineStage
+  generic map (FlitWidth => 16)
+  port map (
+    smiReqInReady   => smiPipeReq14Ready,
+    smiReqInEofc    => smiPipeReq14Eofc,
+    smiReqInData    => smiPipeReq14Data,
+    smiReqInStop    => smiPipeReq14Stop,
+    smiRespOutReady => smiPipeResp14Ready,
+    smiRespOutEofc  => smiPipeResp14Eofc,
+    smiRespOutData  => smiPipeResp14Data,
+    smiRespOutStop  => smiPipeResp14Stop,
+    smiReqOutReady  => smiMemServerReqReady,
+    smiReqOutEofc   => smiMemServerReqEofc,
+    smiReqOutData   => smiMemServerReqData,
+    smiReqOutStop   => smiMemServerReqStop,
+    smiRespInReady  => smiMemServerRespReady,
+    smiRespInEofc   => smiMemServerRespEofc,
+    smiRespInData   => smiMemServerRespData,
+    smiRespInStop   => smiMemServerRespStop,
+    clk             => clk,
+    srst            => srst);
+
+end rtl;
@@ -0,0 +1,185 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Created Mon, 01 Jan 2018 00:00:00 UTC
+// Machine generated file - DO NOT EDIT
+//
+
+`timescale 1ns/1ps
+
+module smiMemArbitrationTreeX3S1 (
+  
+  // SMI ports for smiMemClientReq0/smiMemClientResp0
+  input          smiMemClientReq0Ready,
+  input  [  7:0] smiMemClientReq0Eofc,
+  input  [ 63:0] smiMemClientReq0Data,
+  output         smiMemClientReq0Stop,
+  output         smiMemClientResp0Ready,
+  output [  7:0] smiMemClientResp0Eofc,
+  output [ 63:0] smiMemClientResp0Data,
+  input          smiMemClientResp0Stop,
+
+  // SMI ports for smiMemClientReq1/smiMemClientResp1
+  input          smiMemClientReq1Ready,
+  input  [  7:0] smiMemClientReq1Eofc,
+  input  [ 63:0] smiMemClientReq1Data,
+  output         smiMemClientReq1Stop,
+  output         smiMemClientResp1Ready,
+  output [  7:0] smiMemClientResp1Eofc,
+  output [ 63:0] smiMemClientResp1Data,
+  input          smiMemClientResp1Stop,
+
+  // SMI ports for smiMemClientReq2/smiMemClientResp2
+  input          smiMemClientReq2Ready,
+  input  [  7:0] smiMemClientReq2Eofc,
+  input  [ 63:0] smiMemClientReq2Data,
+  output         smiMemClientReq2Stop,
+  output         smiMemClientResp2Ready,
+  output [  7:0] smiMemClientResp2Eofc,
+  output [ 63:0] smiMemClientResp2Data,
+  input          smiMemClientResp2Stop,
+
+  
+  // SMI ports for smiMemServerReq/smiMemServerResp
+  output         smiMemServerReqReady,
+  output [  7:0] smiMemServerReqEofc,
+  output [ 63:0] smiMemServerReqData,
+  input          smiMemServerReqStop,
+  input          smiMemServerRespReady,
+  input  [  7:0] smiMemServerRespEofc,
+  input  [ 63:0] smiMemServerRespData,
+  output         smiMemServerRespStop,
+
+
+  // Specify system level signals.
+  input clk,
+  input srst
+);
+  
+// SMI connections for smiMemScaledReq0/smiMemScaledResp0
+wire         smiMemScaledReq0Ready;
+wire [  7:0] smiMemScaledReq0Eofc;
+wire [ 63:0] smiMemScaledReq0Data;
+wire         smiMemScaledReq0Stop;
+wire         smiMemScaledResp0Ready;
+wire [  7:0] smiMemScaledResp0Eofc;
+wire [ 63:0] smiMemScaledResp0Data;
+wire         smiMemScaledResp0Stop;
+
+// SMI connections for smiMemScaledReq1/smiMemScaledResp1
+wire         smiMemScaledReq1Ready;
+wire [  7:0] smiMemScaledReq1Eofc;
+wire [ 63:0] smiMemScaledReq1Data;
+wire         smiMemScaledReq1Stop;
+wire         smiMemScaledResp1Ready;
+wire [  7:0] smiMemScaledResp1Eofc;
+wire [ 63:0] smiMemScaledResp1Data;
+wire         smiMemScaledResp1Stop;
+
+// SMI connections for smiMemScaledReq2/smiMemScaledResp2
+wire         smiMemScaledReq2Ready;
+wire [  7:0] smiMemScaledReq2Eofc;
+wire [ 63:0] smiMemScaledReq2Data;
+wire         smiMemScaledReq2Stop;
+wire         smiMemScaledResp2Ready;
+wire [  7:0] smiMemScaledResp2Eofc;
+wire [ 63:0] smiMemScaledResp2Data;
+wire         smiMemScaledResp2Stop;
+
+  
+// Directly map smiMemClientReq0 -> smiMemScaledReq0
+assign smiMemScaledReq0Ready = smiMemClientReq0Ready;
+assign smiMemScaledReq0Eofc = smiMemClientReq0Eofc;
+assign smiMemScaledReq0Data = smiMemClientReq0Data;
+assign smiMemClientReq0Stop = smiMemScaledReq0Stop;
+
+// Directly map smiMemScaledResp0 -> smiMemClientResp0
+assign smiMemClientResp0Ready = smiMemScaledResp0Ready;
+assign smiMemClientResp0Eofc = smiMemScaledResp0Eofc;
+assign smiMemClientResp0Data = smiMemScaledResp0Data;
+assign smiMemScaledResp0Stop = smiMemClientResp0Stop;
+
+// Directly map smiMemClientReq1 -> smiMemScaledReq1
+assign smiMemScaledReq1Ready = smiMemClientReq1Ready;
+assign smiMemScaledReq1Eofc = smiMemClientReq1Eofc;
+assign smiMemScaledReq1Data = smiMemClientReq1Data;
+assign smiMemClientReq1Stop = smiMemScaledReq1Stop;
+
+// Directly map smiMemScaledResp1 -> smiMemClientResp1
+assign smiMemClientResp1Ready = smiMemScaledResp1Ready;
+assign smiMemClientResp1Eofc = smiMemScaledResp1Eofc;
+assign smiMemClientResp1Data = smiMemScaledResp1Data;
+assign smiMemScaledResp1Stop = smiMemClientResp1Stop;
+
+// Directly map smiMemClientReq2 -> smiMemScaledReq2
+assign smiMemScaledReq2Ready = smiMemClientReq2Ready;
+assign smiMemScaledReq2Eofc = smiMemClientReq2Eofc;
+assign smiMemScaledReq2Data = smiMemClientReq2Data;
+assign smiMemClientReq2Stop = smiMemScaledReq2Stop;
+
+// Directly map smiMemScaledResp2 -> smiMemClientResp2
+assign smiMemClientResp2Ready = smiMemScaledResp2Ready;
+assign smiMemClientResp2Eofc = smiMemScaledResp2Eofc;
+assign smiMemClientResp2Data = smiMemScaledResp2Data;
+assign smiMemScaledResp2Stop = smiMemClientResp2Stop;
+
+  
+  
+// Instantiate transaction arbiter busArbiter
+smiTransactionArbiterX3 #(8, 4, 32, 4) busArbiter (
+  
+  .smiReqAInReady   (smiMemScaledReq0Ready),
+  .smiReqAInEofc    (smiMemScaledReq0Eofc),
+  .smiReqAInData    (smiMemScaledReq0Data),
+  .smiReqAInStop    (smiMemScaledReq0Stop),
+  .smiRespAOutReady (smiMemScaledResp0Ready),
+  .smiRespAOutEofc  (smiMemScaledResp0Eofc),
+  .smiRespAOutData  (smiMemScaledResp0Data),
+  .smiRespAOutStop  (smiMemScaledResp0Stop),
+  
+  .smiReqBInReady   (smiMemScaledReq1Ready),
+  .smiReqBInEofc    (smiMemScaledReq1Eofc),
+  .smiReqBInData    (smiMemScaledReq1Data),
+  .smiReqBInStop    (smiMemScaledReq1Stop),
+  .smiRespBOutReady (smiMemScaledResp1Ready),
+  .smiRespBOutEofc  (smiMemScaledResp1Eofc),
+  .smiRespBOutData  (smiMemScaledResp1Data),
+  .smiRespBOutStop  (smiMemScaledResp1Stop),
+  
+  .smiReqCInReady   (smiMemScaledReq2Ready),
+  .smiReqCInEofc    (smiMemScaledReq2Eofc),
+  .smiReqCInData    (smiMemScaledReq2Data),
+  .smiReqCInStop    (smiMemScaledReq2Stop),
+  .smiRespCOutReady (smiMemScaledResp2Ready),
+  .smiRespCOutEofc  (smiMemScaledResp2Eofc),
+  .smiRespCOutData  (smiMemScaledResp2Data),
+  .smiRespCOutStop  (smiMemScaledResp2Stop),
+  
+  .smiReqOutReady (smiMemServerReqReady),
+  .smiReqOutEofc  (smiMemServerReqEofc),
+  .smiReqOutData  (smiMemServerReqData),
+  .smiReqOutStop  (smiMemServerReqStop),
+  .smiRespInReady (smiMemServerRespReady),
+  .smiRespInEofc  (smiMemServerRespEofc),
+  .smiRespInData  (smiMemServerRespData),
+  .smiRespInStop  (smiMemServerRespStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+endmodule
@@ -0,0 +1,251 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Created Mon, 01 Jan 2018 00:00:00 UTC
+// Machine generated file - DO NOT EDIT
+//
+
+`timescale 1ns/1ps
+
+module smiMemArbitrationTreeX3S4 (
+  
+  // SMI ports for smiMemClientReq0/smiMemClientResp0
+  input          smiMemClientReq0Ready,
+  input  [  7:0] smiMemClientReq0Eofc,
+  input  [ 63:0] smiMemClientReq0Data,
+  output         smiMemClientReq0Stop,
+  output         smiMemClientResp0Ready,
+  output [  7:0] smiMemClientResp0Eofc,
+  output [ 63:0] smiMemClientResp0Data,
+  input          smiMemClientResp0Stop,
+
+  // SMI ports for smiMemClientReq1/smiMemClientResp1
+  input          smiMemClientReq1Ready,
+  input  [  7:0] smiMemClientReq1Eofc,
+  input  [ 63:0] smiMemClientReq1Data,
+  output         smiMemClientReq1Stop,
+  output         smiMemClientResp1Ready,
+  output [  7:0] smiMemClientResp1Eofc,
+  output [ 63:0] smiMemClientResp1Data,
+  input          smiMemClientResp1Stop,
+
+  // SMI ports for smiMemClientReq2/smiMemClientResp2
+  input          smiMemClientReq2Ready,
+  input  [  7:0] smiMemClientReq2Eofc,
+  input  [ 63:0] smiMemClientReq2Data,
+  output         smiMemClientReq2Stop,
+  output         smiMemClientResp2Ready,
+  output [  7:0] smiMemClientResp2Eofc,
+  output [ 63:0] smiMemClientResp2Data,
+  input          smiMemClientResp2Stop,
+
+  
+  // SMI ports for smiMemServerReq/smiMemServerResp
+  output         smiMemServerReqReady,
+  output [  7:0] smiMemServerReqEofc,
+  output [255:0] smiMemServerReqData,
+  input          smiMemServerReqStop,
+  input          smiMemServerRespReady,
+  input  [  7:0] smiMemServerRespEofc,
+  input  [255:0] smiMemServerRespData,
+  output         smiMemServerRespStop,
+
+
+  // Specify system level signals.
+  input clk,
+  input srst
+);
+  
+// SMI connections for smiMemScaledReq0/smiMemScaledResp0
+wire         smiMemScaledReq0Ready;
+wire [  7:0] smiMemScaledReq0Eofc;
+wire [255:0] smiMemScaledReq0Data;
+wire         smiMemScaledReq0Stop;
+wire         smiMemScaledResp0Ready;
+wire [  7:0] smiMemScaledResp0Eofc;
+wire [255:0] smiMemScaledResp0Data;
+wire         smiMemScaledResp0Stop;
+
+// SMI connections for smiMemScaledReq1/smiMemScaledResp1
+wire         smiMemScaledReq1Ready;
+wire [  7:0] smiMemScaledReq1Eofc;
+wire [255:0] smiMemScaledReq1Data;
+wire         smiMemScaledReq1Stop;
+wire         smiMemScaledResp1Ready;
+wire [  7:0] smiMemScaledResp1Eofc;
+wire [255:0] smiMemScaledResp1Data;
+wire         smiMemScaledResp1Stop;
+
+// SMI connections for smiMemScaledReq2/smiMemScaledResp2
+wire         smiMemScaledReq2Ready;
+wire [  7:0] smiMemScaledReq2Eofc;
+wire [255:0] smiMemScaledReq2Data;
+wire         smiMemScaledReq2Stop;
+wire         smiMemScaledResp2Ready;
+wire [  7:0] smiMemScaledResp2Eofc;
+wire [255:0] smiMemScaledResp2Data;
+wire         smiMemScaledResp2Stop;
+
+  
+  
+// Instantiate SMI request scaler busWidthScaler0Req
+smiFlitScaleX4 #(8) busWidthScaler0Req (
+
+  .smiInReady  (smiMemClientReq0Ready),
+  .smiInEofc   (smiMemClientReq0Eofc),
+  .smiInData   (smiMemClientReq0Data),
+  .smiInStop   (smiMemClientReq0Stop),
+
+  .smiOutReady (smiMemScaledReq0Ready),
+  .smiOutEofc  (smiMemScaledReq0Eofc),
+  .smiOutData  (smiMemScaledReq0Data),
+  .smiOutStop  (smiMemScaledReq0Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI response scaler busWidthScaler0Resp
+smiFlitScaleD4 #(8*4) busWidthScaler0Resp (
+
+  .smiInReady  (smiMemScaledResp0Ready),
+  .smiInEofc   (smiMemScaledResp0Eofc),
+  .smiInData   (smiMemScaledResp0Data),
+  .smiInStop   (smiMemScaledResp0Stop),
+
+  .smiOutReady (smiMemClientResp0Ready),
+  .smiOutEofc  (smiMemClientResp0Eofc),
+  .smiOutData  (smiMemClientResp0Data),
+  .smiOutStop  (smiMemClientResp0Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI request scaler busWidthScaler1Req
+smiFlitScaleX4 #(8) busWidthScaler1Req (
+
+  .smiInReady  (smiMemClientReq1Ready),
+  .smiInEofc   (smiMemClientReq1Eofc),
+  .smiInData   (smiMemClientReq1Data),
+  .smiInStop   (smiMemClientReq1Stop),
+
+  .smiOutReady (smiMemScaledReq1Ready),
+  .smiOutEofc  (smiMemScaledReq1Eofc),
+  .smiOutData  (smiMemScaledReq1Data),
+  .smiOutStop  (smiMemScaledReq1Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI response scaler busWidthScaler1Resp
+smiFlitScaleD4 #(8*4) busWidthScaler1Resp (
+
+  .smiInReady  (smiMemScaledResp1Ready),
+  .smiInEofc   (smiMemScaledResp1Eofc),
+  .smiInData   (smiMemScaledResp1Data),
+  .smiInStop   (smiMemScaledResp1Stop),
+
+  .smiOutReady (smiMemClientResp1Ready),
+  .smiOutEofc  (smiMemClientResp1Eofc),
+  .smiOutData  (smiMemClientResp1Data),
+  .smiOutStop  (smiMemClientResp1Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI request scaler busWidthScaler2Req
+smiFlitScaleX4 #(8) busWidthScaler2Req (
+
+  .smiInReady  (smiMemClientReq2Ready),
+  .smiInEofc   (smiMemClientReq2Eofc),
+  .smiInData   (smiMemClientReq2Data),
+  .smiInStop   (smiMemClientReq2Stop),
+
+  .smiOutReady (smiMemScaledReq2Ready),
+  .smiOutEofc  (smiMemScaledReq2Eofc),
+  .smiOutData  (smiMemScaledReq2Data),
+  .smiOutStop  (smiMemScaledReq2Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+// Instantiate SMI response scaler busWidthScaler2Resp
+smiFlitScaleD4 #(8*4) busWidthScaler2Resp (
+
+  .smiInReady  (smiMemScaledResp2Ready),
+  .smiInEofc   (smiMemScaledResp2Eofc),
+  .smiInData   (smiMemScaledResp2Data),
+  .smiInStop   (smiMemScaledResp2Stop),
+
+  .smiOutReady (smiMemClientResp2Ready),
+  .smiOutEofc  (smiMemClientResp2Eofc),
+  .smiOutData  (smiMemClientResp2Data),
+  .smiOutStop  (smiMemClientResp2Stop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+  
+// Instantiate transaction arbiter busArbiter
+smiTransactionArbiterX3 #(32, 4, 32, 4) busArbiter (
+  
+  .smiReqAInReady   (smiMemScaledReq0Ready),
+  .smiReqAInEofc    (smiMemScaledReq0Eofc),
+  .smiReqAInData    (smiMemScaledReq0Data),
+  .smiReqAInStop    (smiMemScaledReq0Stop),
+  .smiRespAOutReady (smiMemScaledResp0Ready),
+  .smiRespAOutEofc  (smiMemScaledResp0Eofc),
+  .smiRespAOutData  (smiMemScaledResp0Data),
+  .smiRespAOutStop  (smiMemScaledResp0Stop),
+  
+  .smiReqBInReady   (smiMemScaledReq1Ready),
+  .smiReqBInEofc    (smiMemScaledReq1Eofc),
+  .smiReqBInData    (smiMemScaledReq1Data),
+  .smiReqBInStop    (smiMemScaledReq1Stop),
+  .smiRespBOutReady (smiMemScaledResp1Ready),
+  .smiRespBOutEofc  (smiMemScaledResp1Eofc),
+  .smiRespBOutData  (smiMemScaledResp1Data),
+  .smiRespBOutStop  (smiMemScaledResp1Stop),
+  
+  .smiReqCInReady   (smiMemScaledReq2Ready),
+  .smiReqCInEofc    (smiMemScaledReq2Eofc),
+  .smiReqCInData    (smiMemScaledReq2Data),
+  .smiReqCInStop    (smiMemScaledReq2Stop),
+  .smiRespCOutReady (smiMemScaledResp2Ready),
+  .smiRespCOutEofc  (smiMemScaledResp2Eofc),
+  .smiRespCOutData  (smiMemScaledResp2Data),
+  .smiRespCOutStop  (smiMemScaledResp2Stop),
+  
+  .smiReqOutReady (smiMemServerReqReady),
+  .smiReqOutEofc  (smiMemServerReqEofc),
+  .smiReqOutData  (smiMemServerReqData),
+  .smiReqOutStop  (smiMemServerReqStop),
+  .smiRespInReady (smiMemServerRespReady),
+  .smiRespInEofc  (smiMemServerRespEofc),
+  .smiRespInData  (smiMemServerRespData),
+  .smiRespInStop  (smiMemServerRespStop),
+
+  .clk  (clk),
+  .srst (srst)
+);
+
+endmodule
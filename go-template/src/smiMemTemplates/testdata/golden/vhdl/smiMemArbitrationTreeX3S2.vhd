@@ -0,0 +1,300 @@
+--
+-- Copyright 2018 ReconfigureIO
+--
+-- Licensed under the Apache License, Version 2.0 (the "License");
+-- you may not use this file except in compliance with the License.
+-- You may obtain a copy of the License at
+--
+--     http://www.apache.org/licenses/LICENSE-2.0
+--
+-- Unless required by applicable law or agreed to in writing, software
+-- distributed under the License is distributed on an "AS IS" BASIS,
+-- WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+-- See the License for the specific language governing permissions and
+-- limitations under the License.
+--
+
+--
+-- Created Mon, 01 Jan 2018 00:00:00 UTC
+-- Machine generated file - DO NOT EDIT
+--
+
+library ieee;
+use ieee.std_logic_1164.all;
+
+entity smiMemArbitrationTreeX3S2 is
+  port (
+    smiMemClientReq0Ready    : in  std_logic;
+    smiMemClientReq0Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq0Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq0Stop     : out std_logic;
+    smiMemClientResp0Ready   : out std_logic;
+    smiMemClientResp0Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp0Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp0Stop    : in  std_logic;
+    smiMemClientReq1Ready    : in  std_logic;
+    smiMemClientReq1Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq1Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq1Stop     : out std_logic;
+    smiMemClientResp1Ready   : out std_logic;
+    smiMemClientResp1Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp1Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp1Stop    : in  std_logic;
+    smiMemClientReq2Ready    : in  std_logic;
+    smiMemClientReq2Eofc     : in  std_logic_vector(7 downto 0);
+    smiMemClientReq2Data     : in  std_logic_vector(63 downto 0);
+    smiMemClientReq2Stop     : out std_logic;
+    smiMemClientResp2Ready   : out std_logic;
+    smiMemClientResp2Eofc    : out std_logic_vector(7 downto 0);
+    smiMemClientResp2Data    : out std_logic_vector(63 downto 0);
+    smiMemClientResp2Stop    : in  std_logic;
+    smiMemServerReqReady     : out std_logic;
+    smiMemServerReqEofc      : out std_logic_vector(7 downto 0);
+    smiMemServerReqData      : out std_logic_vector(127 downto 0);
+    smiMemServerReqStop      : in  std_logic;
+    smiMemServerRespReady    : in  std_logic;
+    smiMemServerRespEofc     : in  std_logic_vector(7 downto 0);
+    smiMemServerRespData     : in  std_logic_vector(127 downto 0);
+    smiMemServerRespStop     : out std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+end smiMemArbitrationTreeX3S2;
+
+architecture rtl of smiMemArbitrationTreeX3S2 is
+
+  component smiFlitScaleD2
+  generic (
+    FlitWidth                : integer := 4);
+  port (
+    smiInReady               : in  std_logic;
+    smiInEofc                : in  std_logic_vector(7 downto 0);
+    smiInData                : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiInStop                : out std_logic;
+    smiOutReady              : out std_logic;
+    smiOutEofc               : out std_logic_vector(7 downto 0);
+    smiOutData               : out std_logic_vector(FlitWidth*8/2-1 downto 0);
+    smiOutStop               : in  std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+  end component;
+
+  component smiFlitScaleX2
+  generic (
+    FlitWidth                : integer := 4);
+  port (
+    smiInReady               : in  std_logic;
+    smiInEofc                : in  std_logic_vector(7 downto 0);
+    smiInData                : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiInStop                : out std_logic;
+    smiOutReady              : out std_logic;
+    smiOutEofc               : out std_logic_vector(7 downto 0);
+    smiOutData               : out std_logic_vector(FlitWidth*16-1 downto 0);
+    smiOutStop               : in  std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+  end component;
+
+  component smiTransactionArbiterX3
+  generic (
+    FlitWidth                : integer := 4;
+    TagIdWidth               : integer := 2;
+    FifoSize                 : integer := 16;
+    MaxFrameCount            : integer := 7);
+  port (
+    smiReqAInReady           : in  std_logic;
+    smiReqAInEofc            : in  std_logic_vector(7 downto 0);
+    smiReqAInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqAInStop            : out std_logic;
+    smiRespAOutReady         : out std_logic;
+    smiRespAOutEofc          : out std_logic_vector(7 downto 0);
+    smiRespAOutData          : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespAOutStop          : in  std_logic;
+    smiReqBInReady           : in  std_logic;
+    smiReqBInEofc            : in  std_logic_vector(7 downto 0);
+    smiReqBInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqBInStop            : out std_logic;
+    smiRespBOutReady         : out std_logic;
+    smiRespBOutEofc          : out std_logic_vector(7 downto 0);
+    smiRespBOutData          : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespBOutStop          : in  std_logic;
+    smiReqCInReady           : in  std_logic;
+    smiReqCInEofc            : in  std_logic_vector(7 downto 0);
+    smiReqCInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqCInStop            : out std_logic;
+    smiRespCOutReady         : out std_logic;
+    smiRespCOutEofc          : out std_logic_vector(7 downto 0);
+    smiRespCOutData          : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespCOutStop          : in  std_logic;
+    smiReqOutReady           : out std_logic;
+    smiReqOutEofc            : out std_logic_vector(7 downto 0);
+    smiReqOutData            : out std_logic_vector(FlitWidth*8-1 downto 0);
+    smiReqOutStop            : in  std_logic;
+    smiRespInReady           : in  std_logic;
+    smiRespInEofc            : in  std_logic_vector(7 downto 0);
+    smiRespInData            : in  std_logic_vector(FlitWidth*8-1 downto 0);
+    smiRespInStop            : out std_logic;
+    clk                      : in  std_logic;
+    srst                     : in  std_logic);
+  end component;
+
+  signal smiMemScaledReq0Ready    : std_logic;
+  signal smiMemScaledReq0Eofc     : std_logic_vector(7 downto 0);
+  signal smiMemScaledReq0Data     : std_logic_vector(127 downto 0);
+  signal smiMemScaledReq0Stop     : std_logic;
+  signal smiMemScaledResp0Ready   : std_logic;
+  signal smiMemScaledResp0Eofc    : std_logic_vector(7 downto 0);
+  signal smiMemScaledResp0Data    : std_logic_vector(127 downto 0);
+  signal smiMemScaledResp0Stop    : std_logic;
+  signal smiMemScaledReq1Ready    : std_logic;
+  signal smiMemScaledReq1Eofc     : std_logic_vector(7 downto 0);
+  signal smiMemScaledReq1Data     : std_logic_vector(127 downto 0);
+  signal smiMemScaledReq1Stop     : std_logic;
+  signal smiMemScaledResp1Ready   : std_logic;
+  signal smiMemScaledResp1Eofc    : std_logic_vector(7 downto 0);
+  signal smiMemScaledResp1Data    : std_logic_vector(127 downto 0);
+  signal smiMemScaledResp1Stop    : std_logic;
+  signal smiMemScaledReq2Ready    : std_logic;
+  signal smiMemScaledReq2Eofc     : std_logic_vector(7 downto 0);
+  signal smiMemScaledReq2Data     : std_logic_vector(127 downto 0);
+  signal smiMemScaledReq2Stop     : std_logic;
+  signal smiMemScaledResp2Ready   : std_logic;
+  signal smiMemScaledResp2Eofc    : std_logic_vector(7 downto 0);
+  signal smiMemScaledResp2Data    : std_logic_vector(127 downto 0);
+  signal smiMemScaledResp2Stop    : std_logic;
+
+begin
+
+  -- Instantiate SMI request scaler busWidthScaler0Req
+  busWidthScaler0Req : smiFlitScaleX2
+  generic map (FlitWidth => 8)
+  port map (
+    smiInReady  => smiMemClientReq0Ready,
+    smiInEofc   => smiMemClientReq0Eofc,
+    smiInData   => smiMemClientReq0Data,
+    smiInStop   => smiMemClientReq0Stop,
+    smiOutReady => smiMemScaledReq0Ready,
+    smiOutEofc  => smiMemScaledReq0Eofc,
+    smiOutData  => smiMemScaledReq0Data,
+    smiOutStop  => smiMemScaledReq0Stop,
+    clk         => clk,
+    srst        => srst);
+
+  -- Instantiate SMI response scaler busWidthScaler0Resp
+  busWidthScaler0Resp : smiFlitScaleD2
+  generic map (FlitWidth => 8*2)
+  port map (
+    smiInReady  => smiMemScaledResp0Ready,
+    smiInEofc   => smiMemScaledResp0Eofc,
+    smiInData   => smiMemScaledResp0Data,
+    smiInStop   => smiMemScaledResp0Stop,
+    smiOutReady => smiMemClientResp0Ready,
+    smiOutEofc  => smiMemClientResp0Eofc,
+    smiOutData  => smiMemClientResp0Data,
+    smiOutStop  => smiMemClientResp0Stop,
+    clk         => clk,
+    srst        => srst);
+
+  -- Instantiate SMI request scaler busWidthScaler1Req
+  busWidthScaler1Req : smiFlitScaleX2
+  generic map (FlitWidth => 8)
+  port map (
+    smiInReady  => smiMemClientReq1Ready,
+    smiInEofc   => smiMemClientReq1Eofc,
+    smiInData   => smiMemClientReq1Data,
+    smiInStop   => smiMemClientReq1Stop,
+    smiOutReady => smiMemScaledReq1Ready,
+    smiOutEofc  => smiMemScaledReq1Eofc,
+    smiOutData  => smiMemScaledReq1Data,
+    smiOutStop  => smiMemScaledReq1Stop,
+    clk         => clk,
+    srst        => srst);
+
+  -- Instantiate SMI response scaler busWidthScaler1Resp
+  busWidthScaler1Resp : smiFlitScaleD2
+  generic map (FlitWidth => 8*2)
+  port map (
+    smiInReady  => smiMemScaledResp1Ready,
+    smiInEofc   => smiMemScaledResp1Eofc,
+    smiInData   => smiMemScaledResp1Data,
+    smiInStop   => smiMemScaledResp1Stop,
+    smiOutReady => smiMemClientResp1Ready,
+    smiOutEofc  => smiMemClientResp1Eofc,
+    smiOutData  => smiMemClientResp1Data,
+    smiOutStop  => smiMemClientResp1Stop,
+    clk         => clk,
+    srst        => srst);
+
+  -- Instantiate SMI request scaler busWidthScaler2Req
+  busWidthScaler2Req : smiFlitScaleX2
+  generic map (FlitWidth => 8)
+  port map (
+    smiInReady  => smiMemClientReq2Ready,
+    smiInEofc   => smiMemClientReq2Eofc,
+    smiInData   => smiMemClientReq2Data,
+    smiInStop   => smiMemClientReq2Stop,
+    smiOutReady => smiMemScaledReq2Ready,
+    smiOutEofc  => smiMemScaledReq2Eofc,
+    smiOutData  => smiMemScaledReq2Data,
+    smiOutStop  => smiMemScaledReq2Stop,
+    clk         => clk,
+    srst        => srst);
+
+  -- Instantiate SMI response scaler busWidthScaler2Resp
+  busWidthScaler2Resp : smiFlitScaleD2
+  generic map (FlitWidth => 8*2)
+  port map (
+    smiInReady  => smiMemScaledResp2Ready,
+    smiInEofc   => smiMemScaledResp2Eofc,
+    smiInData   => smiMemScaledResp2Data,
+    smiInStop   => smiMemScaledResp2Stop,
+    smiOutReady => smiMemClientResp2Ready,
+    smiOutEofc  => smiMemClientResp2Eofc,
+    smiOutData  => smiMemClientResp2Data,
+    smiOutStop  => smiMemClientResp2Stop,
+    clk         => clk,
+    srst        => srst);
+
+  -- Instantiate transaction arbiter busArbiter
+  busArbiter : smiTransactionArbiterX3
+  generic map (
+    FlitWidth  => 16,
+    TagIdWidth => 4,
+    FifoSize   => 32,
+    MaxFrameCount => 4)
+  port map (
+    smiReqAInReady   => smiMemScaledReq0Ready,
+    smiReqAInEofc    => smiMemScaledReq0Eofc,
+    smiReqAInData    => smiMemScaledReq0Data,
+    smiReqAInStop    => smiMemScaledReq0Stop,
+    smiRespAOutReady => smiMemScaledResp0Ready,
+    smiRespAOutEofc  => smiMemScaledResp0Eofc,
+    smiRespAOutData  => smiMemScaledResp0Data,
+    smiRespAOutStop  => smiMemScaledResp0Stop,
+    smiReqBInReady   => smiMemScaledReq1Ready,
+    smiReqBInEofc    => smiMemScaledReq1Eofc,
+    smiReqBInData    => smiMemScaledReq1Data,
+    smiReqBInStop    => smiMemScaledReq1Stop,
+    smiRespBOutReady => smiMemScaledResp1Ready,
+    smiRespBOutEofc  => smiMemScaledResp1Eofc,
+    smiRespBOutData  => smiMemScaledResp1Data,
+    smiRespBOutStop  => smiMemScaledResp1Stop,
+    smiReqCInReady   => smiMemScaledReq2Ready,
+    smiReqCInEofc    => smiMemScaledReq2Eofc,
+    smiReqCInData    => smiMemScaledReq2Data,
+    smiReqCInStop    => smiMemScaledReq2Stop,
+    smiRespCOutReady => smiMemScaledResp2Ready,
+    smiRespCOutEofc  => smiMemScaledResp2Eofc,
+    smiRespCOutData  => smiMemScaledResp2Data,
+    smiRespCOutStop  => smiMemScaledResp2Stop,
+    smiReqOutReady => smiMemServerReqReady,
+    smiReqOutEofc  => smiMemServerReqEofc,
+    smiReqOutData  => smiMemServerReqData,
+    smiReqOutStop  => smiMemServerReqStop,
+    smiRespInReady => smiMemServerRespReady,
+    smiRespInEofc  => smiMemServerRespEofc,
+    smiRespInData  => smiMemServerRespData,
+    smiRespInStop  => smiMemServerRespStop,
+    clk            => clk,
+    srst           => srst);
+
+end rtl;
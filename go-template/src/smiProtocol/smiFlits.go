@@ -0,0 +1,167 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiProtocol
+
+import (
+	"errors"
+	"fmt"
+)
+
+//
+// Specifies the range of supported SMI flit widths in bytes. Flit widths must
+// be an integer power of two within this range. The minimum width corresponds
+// to the 64-bit SMI client links used by the Verilog library components.
+//
+const (
+	MinFlitWidth = 8
+	MaxFlitWidth = 128
+)
+
+//
+// Flit specifies the contents of a single SMI flit. The end of frame control
+// value is zero for all but the last flit in a frame, where it specifies the
+// number of valid data bytes in the flit. The data slice length is always the
+// flit width, with unused bytes in the last flit being set to zero.
+//
+type Flit struct {
+	Eofc uint8  // End of frame control value.
+	Data []byte // Flit data bytes, in little endian order.
+}
+
+//
+// CheckFlitWidth checks that the flit width specified in bytes is supported.
+// Returns an error item which will be set to 'nil' if the flit width is valid.
+//
+func CheckFlitWidth(flitWidth uint) error {
+	if (flitWidth < MinFlitWidth) || (flitWidth > MaxFlitWidth) ||
+		((flitWidth & (flitWidth - 1)) != 0) {
+		return errors.New(fmt.Sprintf(
+			"Invalid SMI flit width (%d bytes)", flitWidth))
+	}
+	return nil
+}
+
+//
+// FrameToFlits splits an SMI frame byte sequence into a sequence of flits of
+// the specified width in bytes. Returns the flit sequence and an error item
+// which will be set to 'nil' on successful completion.
+//
+func FrameToFlits(frame []byte, flitWidth uint) ([]Flit, error) {
+	if err := CheckFlitWidth(flitWidth); err != nil {
+		return nil, err
+	}
+	if len(frame) == 0 {
+		return nil, errors.New("Empty SMI frame")
+	}
+	width := int(flitWidth)
+	numFlits := (len(frame) + width - 1) / width
+	flits := make([]Flit, numFlits)
+	for i := range flits {
+		flits[i].Data = make([]byte, width)
+		copy(flits[i].Data, frame[i*width:])
+	}
+	flits[numFlits-1].Eofc = uint8(len(frame) - (numFlits-1)*width)
+	return flits, nil
+}
+
+//
+// FlitsToFrame reassembles an SMI frame byte sequence from a sequence of flits
+// which make up a single complete frame. All flits must have the same
+// supported width and only the last flit may have a non-zero end of frame
+// control value. Returns the frame byte sequence and an error item which will
+// be set to 'nil' on successful completion.
+//
+func FlitsToFrame(flits []Flit) ([]byte, error) {
+	if len(flits) == 0 {
+		return nil, errors.New("Empty SMI flit sequence")
+	}
+	width := len(flits[0].Data)
+	if err := CheckFlitWidth(uint(width)); err != nil {
+		return nil, err
+	}
+	frame := make([]byte, 0, width*len(flits))
+	for i, flit := range flits {
+		if len(flit.Data) != width {
+			return nil, errors.New(fmt.Sprintf(
+				"Inconsistent SMI flit width (%d bytes) at flit %d", len(flit.Data), i))
+		}
+		last := (i == len(flits)-1)
+		if !last && (flit.Eofc != 0) {
+			return nil, errors.New(fmt.Sprintf(
+				"Unexpected SMI end of frame at flit %d", i))
+		}
+		if last && ((flit.Eofc == 0) || (int(flit.Eofc) > width)) {
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid SMI end of frame control (%d) at flit %d", flit.Eofc, i))
+		}
+		if last {
+			frame = append(frame, flit.Data[:flit.Eofc]...)
+		} else {
+			frame = append(frame, flit.Data...)
+		}
+	}
+	return frame, nil
+}
+
+//
+// SplitFlitStream splits a continuous stream of flits into the flit sequences
+// for the individual frames, using the end of frame control values. Returns
+// the list of flit sequences and an error item which will be set to 'nil' on
+// successful completion. An error is returned if the stream ends part way
+// through a frame.
+//
+func SplitFlitStream(flits []Flit) ([][]Flit, error) {
+	frames := make([][]Flit, 0)
+	start := 0
+	for i, flit := range flits {
+		if flit.Eofc != 0 {
+			frames = append(frames, flits[start:i+1])
+			start = i + 1
+		}
+	}
+	if start != len(flits) {
+		return frames, errors.New(fmt.Sprintf(
+			"Incomplete SMI frame at end of flit stream (%d flits)", len(flits)-start))
+	}
+	return frames, nil
+}
+
+//
+// EncodeFlits serialises an SMI memory access frame to a sequence of flits of
+// the specified width in bytes. Returns the flit sequence and an error item
+// which will be set to 'nil' on successful completion.
+//
+func EncodeFlits(frame Frame, flitWidth uint) ([]Flit, error) {
+	frameBytes, err := frame.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return FrameToFlits(frameBytes, flitWidth)
+}
+
+//
+// DecodeFlits parses a sequence of flits which make up a single complete SMI
+// memory access frame. Returns the decoded frame and an error item which will
+// be set to 'nil' on successful completion.
+//
+func DecodeFlits(flits []Flit) (Frame, error) {
+	frameBytes, err := FlitsToFrame(flits)
+	if err != nil {
+		return nil, err
+	}
+	return DecodeFrame(frameBytes)
+}
@@ -0,0 +1,323 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+//
+// Package smiProtocol provides encoding and decoding of SMI memory access
+// protocol frames, as described in design-docs/Memory_Access_Protocol.pdf.
+// Frames may be converted to and from sequences of SMI flits for any of the
+// supported flit widths.
+//
+package smiProtocol
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+//
+// Specifies the SMI memory access frame type identifiers. Each response type
+// identifier is the ones complement of the corresponding request type.
+//
+const (
+	WriteReqIdByte  = 0x01 // Memory write request.
+	ReadReqIdByte   = 0x02 // Memory read request.
+	ReadRespIdByte  = 0xFD // Memory read response.
+	WriteRespIdByte = 0xFE // Memory write response.
+)
+
+//
+// Specifies the SMI memory access option byte values.
+//
+const (
+	ReadOptDefault  = 0x00 // Use default buffered read options.
+	ReadOptDirect   = 0x01 // Perform direct unbuffered read.
+	WriteOptDefault = 0x00 // Use default buffered write options.
+	WriteOptDirect  = 0x01 // Perform direct unbuffered write.
+)
+
+//
+// Specifies the SMI memory access response status values. These correspond
+// to the standard AXI response encoding.
+//
+const (
+	StatusOkay   = 0x00 // Normal access success.
+	StatusExOkay = 0x01 // Exclusive access success.
+	StatusSlvErr = 0x02 // Slave error.
+	StatusDecErr = 0x03 // Decode error.
+)
+
+//
+// Specifies the sizes of the fixed frame headers in bytes.
+//
+const (
+	RequestHeaderSize  = 14 // Read and write request header size.
+	ResponseHeaderSize = 4  // Read and write response header size.
+)
+
+//
+// Specifies the maximum number of data bytes which may be transferred using
+// a single read or write request.
+//
+const MaxBurstLength = 0xFFFF
+
+//
+// Frame is implemented by all the SMI memory access frame types, allowing
+// them to be serialised to the corresponding byte sequence.
+//
+type Frame interface {
+	Encode() ([]byte, error)
+}
+
+//
+// ReadRequest specifies the contents of an SMI memory read request frame.
+//
+type ReadRequest struct {
+	Options uint8  // Read option flags.
+	Tag     uint16 // Transaction tag, returned in the response.
+	Address uint64 // Byte address of the first byte to read.
+	Length  uint16 // Number of bytes to read.
+}
+
+//
+// WriteRequest specifies the contents of an SMI memory write request frame.
+// The burst length is derived from the size of the write data.
+//
+type WriteRequest struct {
+	Options uint8  // Write option flags.
+	Tag     uint16 // Transaction tag, returned in the response.
+	Address uint64 // Byte address of the first byte to write.
+	Data    []byte // Write data bytes.
+}
+
+//
+// ReadResponse specifies the contents of an SMI memory read response frame.
+//
+type ReadResponse struct {
+	Status uint8  // Response status, using the AXI response encoding.
+	Tag    uint16 // Transaction tag from the corresponding request.
+	Data   []byte // Read data bytes.
+}
+
+//
+// WriteResponse specifies the contents of an SMI memory write response frame.
+//
+type WriteResponse struct {
+	Status uint8  // Response status, using the AXI response encoding.
+	Tag    uint16 // Transaction tag from the corresponding request.
+}
+
+//
+// Ok indicates whether the read response status reports a successful access.
+// This matches the status check used by the Verilog library components.
+//
+func (resp ReadResponse) Ok() bool {
+	return (resp.Status & StatusSlvErr) == 0
+}
+
+//
+// Ok indicates whether the write response status reports a successful access.
+// This matches the status check used by the Verilog library components.
+//
+func (resp WriteResponse) Ok() bool {
+	return (resp.Status & StatusSlvErr) == 0
+}
+
+//
+// Encode serialises the read request to the SMI frame byte sequence.
+//
+func (req ReadRequest) Encode() ([]byte, error) {
+	frame := make([]byte, RequestHeaderSize)
+	encodeRequestHeader(frame, ReadReqIdByte, req.Options, req.Tag,
+		req.Address, req.Length)
+	return frame, nil
+}
+
+//
+// Encode serialises the write request to the SMI frame byte sequence.
+//
+func (req WriteRequest) Encode() ([]byte, error) {
+	if len(req.Data) > MaxBurstLength {
+		return nil, errors.New(fmt.Sprintf(
+			"Write burst length (%d) exceeds maximum (%d)", len(req.Data), MaxBurstLength))
+	}
+	frame := make([]byte, RequestHeaderSize+len(req.Data))
+	encodeRequestHeader(frame, WriteReqIdByte, req.Options, req.Tag,
+		req.Address, uint16(len(req.Data)))
+	copy(frame[RequestHeaderSize:], req.Data)
+	return frame, nil
+}
+
+//
+// Encode serialises the read response to the SMI frame byte sequence.
+//
+func (resp ReadResponse) Encode() ([]byte, error) {
+	frame := make([]byte, ResponseHeaderSize+len(resp.Data))
+	encodeResponseHeader(frame, ReadRespIdByte, resp.Status, resp.Tag)
+	copy(frame[ResponseHeaderSize:], resp.Data)
+	return frame, nil
+}
+
+//
+// Encode serialises the write response to the SMI frame byte sequence.
+//
+func (resp WriteResponse) Encode() ([]byte, error) {
+	frame := make([]byte, ResponseHeaderSize)
+	encodeResponseHeader(frame, WriteRespIdByte, resp.Status, resp.Tag)
+	return frame, nil
+}
+
+//
+// Writes the common request header fields to the start of a frame buffer.
+//
+func encodeRequestHeader(frame []byte, idByte uint8, options uint8,
+	tag uint16, address uint64, length uint16) {
+
+	frame[0] = idByte
+	frame[1] = options
+	binary.LittleEndian.PutUint16(frame[2:], tag)
+	binary.LittleEndian.PutUint64(frame[4:], address)
+	binary.LittleEndian.PutUint16(frame[12:], length)
+}
+
+//
+// Writes the common response header fields to the start of a frame buffer.
+//
+func encodeResponseHeader(frame []byte, idByte uint8, status uint8, tag uint16) {
+	frame[0] = idByte
+	frame[1] = status
+	binary.LittleEndian.PutUint16(frame[2:], tag)
+}
+
+//
+// DecodeFrame parses an SMI frame byte sequence, returning the corresponding
+// frame type. The concrete type of the returned frame will be one of
+// ReadRequest, WriteRequest, ReadResponse or WriteResponse. Returns an error
+// item which will be set to 'nil' on successful completion.
+//
+func DecodeFrame(frame []byte) (Frame, error) {
+	if len(frame) == 0 {
+		return nil, errors.New("Empty SMI frame")
+	}
+	switch frame[0] {
+	case ReadReqIdByte:
+		return DecodeReadRequest(frame)
+	case WriteReqIdByte:
+		return DecodeWriteRequest(frame)
+	case ReadRespIdByte:
+		return DecodeReadResponse(frame)
+	case WriteRespIdByte:
+		return DecodeWriteResponse(frame)
+	default:
+		return nil, errors.New(fmt.Sprintf(
+			"Invalid SMI frame type (0x%02X)", frame[0]))
+	}
+}
+
+//
+// Checks the frame type and minimum length for a frame being decoded.
+//
+func checkFrameHeader(frame []byte, idByte uint8, headerSize int) error {
+	if len(frame) < headerSize {
+		return errors.New(fmt.Sprintf(
+			"SMI frame length (%d) shorter than header (%d)", len(frame), headerSize))
+	}
+	if frame[0] != idByte {
+		return errors.New(fmt.Sprintf(
+			"Unexpected SMI frame type (0x%02X), expected 0x%02X", frame[0], idByte))
+	}
+	return nil
+}
+
+//
+// DecodeReadRequest parses an SMI read request frame byte sequence. Returns
+// the read request and an error item which will be set to 'nil' on successful
+// completion.
+//
+func DecodeReadRequest(frame []byte) (ReadRequest, error) {
+	req := ReadRequest{}
+	if err := checkFrameHeader(frame, ReadReqIdByte, RequestHeaderSize); err != nil {
+		return req, err
+	}
+	if len(frame) != RequestHeaderSize {
+		return req, errors.New(fmt.Sprintf(
+			"Invalid SMI read request length (%d)", len(frame)))
+	}
+	req.Options = frame[1]
+	req.Tag = binary.LittleEndian.Uint16(frame[2:])
+	req.Address = binary.LittleEndian.Uint64(frame[4:])
+	req.Length = binary.LittleEndian.Uint16(frame[12:])
+	return req, nil
+}
+
+//
+// DecodeWriteRequest parses an SMI write request frame byte sequence. The
+// write data length must match the burst length field. Returns the write
+// request and an error item which will be set to 'nil' on successful
+// completion.
+//
+func DecodeWriteRequest(frame []byte) (WriteRequest, error) {
+	req := WriteRequest{}
+	if err := checkFrameHeader(frame, WriteReqIdByte, RequestHeaderSize); err != nil {
+		return req, err
+	}
+	length := int(binary.LittleEndian.Uint16(frame[12:]))
+	if len(frame) != RequestHeaderSize+length {
+		return req, errors.New(fmt.Sprintf(
+			"SMI write data length (%d) does not match burst length (%d)",
+			len(frame)-RequestHeaderSize, length))
+	}
+	req.Options = frame[1]
+	req.Tag = binary.LittleEndian.Uint16(frame[2:])
+	req.Address = binary.LittleEndian.Uint64(frame[4:])
+	req.Data = append([]byte{}, frame[RequestHeaderSize:]...)
+	return req, nil
+}
+
+//
+// DecodeReadResponse parses an SMI read response frame byte sequence. Returns
+// the read response and an error item which will be set to 'nil' on
+// successful completion.
+//
+func DecodeReadResponse(frame []byte) (ReadResponse, error) {
+	resp := ReadResponse{}
+	if err := checkFrameHeader(frame, ReadRespIdByte, ResponseHeaderSize); err != nil {
+		return resp, err
+	}
+	resp.Status = frame[1]
+	resp.Tag = binary.LittleEndian.Uint16(frame[2:])
+	resp.Data = append([]byte{}, frame[ResponseHeaderSize:]...)
+	return resp, nil
+}
+
+//
+// DecodeWriteResponse parses an SMI write response frame byte sequence.
+// Returns the write response and an error item which will be set to 'nil' on
+// successful completion.
+//
+func DecodeWriteResponse(frame []byte) (WriteResponse, error) {
+	resp := WriteResponse{}
+	if err := checkFrameHeader(frame, WriteRespIdByte, ResponseHeaderSize); err != nil {
+		return resp, err
+	}
+	if len(frame) != ResponseHeaderSize {
+		return resp, errors.New(fmt.Sprintf(
+			"Invalid SMI write response length (%d)", len(frame)))
+	}
+	resp.Status = frame[1]
+	resp.Tag = binary.LittleEndian.Uint16(frame[2:])
+	return resp, nil
+}
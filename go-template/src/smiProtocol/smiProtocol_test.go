@@ -0,0 +1,222 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiProtocol
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+//
+// Lists the supported flit widths in bytes.
+//
+var testFlitWidths = []uint{8, 16, 32, 64, 128}
+
+//
+// Creates a byte sequence with a simple incrementing pattern.
+//
+func makeTestData(length int, seed byte) []byte {
+	data := make([]byte, length)
+	for i := range data {
+		data[i] = seed + byte(i)
+	}
+	return data
+}
+
+//
+// Lists a representative set of frames covering each frame type.
+//
+func makeTestFrames() []Frame {
+	frames := []Frame{
+		ReadRequest{ReadOptDefault, 0x1234, 0x0123456789ABCDEF, 8},
+		ReadRequest{ReadOptDirect, 0xFFFF, 0xFFFFFFFFFFFFFFF8, MaxBurstLength},
+		WriteResponse{StatusOkay, 0x0001},
+		WriteResponse{StatusSlvErr, 0xA5A5},
+		ReadResponse{StatusDecErr, 0x0002, []byte{}}}
+	for _, length := range []int{0, 1, 2, 3, 7, 8, 9, 50, 127, 128, 129, 4096} {
+		frames = append(frames,
+			WriteRequest{WriteOptDirect, uint16(length), 0x1000 + uint64(length),
+				makeTestData(length, byte(length))},
+			ReadResponse{StatusOkay, uint16(length), makeTestData(length, 0x80)})
+	}
+	return frames
+}
+
+//
+// Tests that the fixed frame layout matches the Verilog library encoding.
+//
+func TestFrameLayout(t *testing.T) {
+	frame, err := WriteRequest{WriteOptDirect, 0x0201, 0x0A09080706050403,
+		[]byte{0xEE, 0xFF}}.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []byte{0x01, 0x01, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07,
+		0x08, 0x09, 0x0A, 0x02, 0x00, 0xEE, 0xFF}
+	if !bytes.Equal(frame, expected) {
+		t.Errorf("write request encoding % X, expected % X", frame, expected)
+	}
+
+	frame, err = ReadResponse{StatusSlvErr, 0x0403, []byte{0x55}}.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []byte{0xFD, 0x02, 0x03, 0x04, 0x55}
+	if !bytes.Equal(frame, expected) {
+		t.Errorf("read response encoding % X, expected % X", frame, expected)
+	}
+}
+
+//
+// Tests that each frame type round trips through the flit encoding at all
+// supported flit widths.
+//
+func TestFlitRoundTrip(t *testing.T) {
+	for _, flitWidth := range testFlitWidths {
+		for _, frame := range makeTestFrames() {
+			flits, err := EncodeFlits(frame, flitWidth)
+			if err != nil {
+				t.Fatalf("width %d: encode %T: %v", flitWidth, frame, err)
+			}
+			frameBytes, _ := frame.Encode()
+			numFlits := (len(frameBytes) + int(flitWidth) - 1) / int(flitWidth)
+			if len(flits) != numFlits {
+				t.Errorf("width %d: %T encoded to %d flits, expected %d",
+					flitWidth, frame, len(flits), numFlits)
+			}
+			for i, flit := range flits {
+				if uint(len(flit.Data)) != flitWidth {
+					t.Errorf("width %d: flit %d has %d bytes", flitWidth, i, len(flit.Data))
+				}
+				if (i != len(flits)-1) && (flit.Eofc != 0) {
+					t.Errorf("width %d: early end of frame at flit %d", flitWidth, i)
+				}
+			}
+			decoded, err := DecodeFlits(flits)
+			if err != nil {
+				t.Fatalf("width %d: decode %T: %v", flitWidth, frame, err)
+			}
+			if !reflect.DeepEqual(decoded, frame) {
+				t.Errorf("width %d: decoded %+v, expected %+v", flitWidth, decoded, frame)
+			}
+		}
+	}
+}
+
+//
+// Tests that a stream of concatenated frames can be split and decoded.
+//
+func TestFlitStreamSplit(t *testing.T) {
+	for _, flitWidth := range testFlitWidths {
+		frames := makeTestFrames()
+		stream := make([]Flit, 0)
+		for _, frame := range frames {
+			flits, err := EncodeFlits(frame, flitWidth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stream = append(stream, flits...)
+		}
+		split, err := SplitFlitStream(stream)
+		if err != nil {
+			t.Fatalf("width %d: %v", flitWidth, err)
+		}
+		if len(split) != len(frames) {
+			t.Fatalf("width %d: split into %d frames, expected %d",
+				flitWidth, len(split), len(frames))
+		}
+		for i, flits := range split {
+			decoded, err := DecodeFlits(flits)
+			if err != nil {
+				t.Fatalf("width %d: frame %d: %v", flitWidth, i, err)
+			}
+			if !reflect.DeepEqual(decoded, frames[i]) {
+				t.Errorf("width %d: frame %d decoded %+v, expected %+v",
+					flitWidth, i, decoded, frames[i])
+			}
+		}
+		if _, err := SplitFlitStream(stream[:len(stream)-1]); err == nil {
+			t.Errorf("width %d: incomplete stream not detected", flitWidth)
+		}
+	}
+}
+
+//
+// Tests that invalid flit widths, flit sequences and frames are rejected.
+//
+func TestInvalidEncodings(t *testing.T) {
+	for _, flitWidth := range []uint{0, 1, 2, 3, 4, 12, 256} {
+		if err := CheckFlitWidth(flitWidth); err == nil {
+			t.Errorf("flit width %d not rejected", flitWidth)
+		}
+	}
+
+	data := make([]byte, 8)
+	invalidFlits := [][]Flit{
+		{},
+		{{0, data}},
+		{{9, data}},
+		{{8, data}, {8, data}},
+		{{0, data}, {4, data[:4]}},
+		{{4, make([]byte, 3)}}}
+	for i, flits := range invalidFlits {
+		if _, err := FlitsToFrame(flits); err == nil {
+			t.Errorf("invalid flit sequence %d not rejected", i)
+		}
+	}
+
+	invalidFrames := [][]byte{
+		{},
+		{0x00, 0x00, 0x00, 0x00},
+		{0xFF, 0x00, 0x00, 0x00},
+		{ReadReqIdByte, 0x00, 0x00, 0x00},
+		append(make([]byte, RequestHeaderSize+1), 0),
+		{WriteRespIdByte, 0x00, 0x00},
+		{WriteRespIdByte, 0x00, 0x00, 0x00, 0x00}}
+	invalidFrames[4][0] = ReadReqIdByte
+	for i, frame := range invalidFrames {
+		if _, err := DecodeFrame(frame); err == nil {
+			t.Errorf("invalid frame %d not rejected", i)
+		}
+	}
+
+	// Write requests with mismatched burst lengths are rejected.
+	frame, _ := WriteRequest{Data: makeTestData(4, 0)}.Encode()
+	if _, err := DecodeFrame(frame[:len(frame)-1]); err == nil {
+		t.Errorf("truncated write request not rejected")
+	}
+	if _, err := (WriteRequest{Data: make([]byte, MaxBurstLength+1)}).Encode(); err == nil {
+		t.Errorf("oversized write request not rejected")
+	}
+}
+
+//
+// Tests the response status checks.
+//
+func TestResponseStatus(t *testing.T) {
+	expected := map[uint8]bool{
+		StatusOkay: true, StatusExOkay: true, StatusSlvErr: false, StatusDecErr: false}
+	for status, ok := range expected {
+		if (ReadResponse{Status: status}).Ok() != ok {
+			t.Errorf("read response status %d: Ok() != %v", status, ok)
+		}
+		if (WriteResponse{Status: status}).Ok() != ok {
+			t.Errorf("write response status %d: Ok() != %v", status, ok)
+		}
+	}
+}
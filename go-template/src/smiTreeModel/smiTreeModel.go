@@ -0,0 +1,711 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+//
+// Package smiTreeModel provides a cycle approximate transaction level model of
+// the SMI memory arbitration trees generated by the smiMemTemplates package.
+// The model is built from the same tree topology as the generated Verilog and
+// may be used to evaluate proposed configurations without running synthesis.
+// Frames are transferred as complete units over each link, taking one clock
+// cycle per flit, with the frame based round robin arbitration, frame FIFO
+// limits and transaction tag limits of the Verilog components being modelled
+// explicitly. Pipeline register stages are modelled as fixed latencies, which
+// by default are the same minimum component latencies that are used for the
+// analytical client path figures. The model additionally accounts for the
+// time taken to transfer each frame over each link and for the arbitration
+// cycle taken by idle arbiters.
+//
+package smiTreeModel
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemTemplates"
+	"github.com/ReconfigureIO/smi/go-template/src/smiProtocol"
+	"sort"
+)
+
+//
+// ModelConfig specifies the timing parameters which are not derived from the
+// arbitration tree topology. All latencies are specified in clock cycles. The
+// arbiter and scaler latencies override the library component latencies when
+// set to a non-zero value.
+//
+type ModelConfig struct {
+	ArbiterLatency       uint // Register stages through an arbiter in each direction, or zero.
+	ScalerStageLatency   uint // Register stages per factor of two width scaling, or zero.
+	ServerLatency        uint // Memory access latency at the server.
+	ServerQueueDepth     uint // Maximum number of requests queued at the server.
+	ClientMaxOutstanding uint // Maximum outstanding transactions per client, or zero.
+}
+
+//
+// DefaultModelConfig returns a model configuration which uses the Verilog
+// library component latencies and typical values for an external DDR memory
+// controller.
+//
+func DefaultModelConfig() ModelConfig {
+	return ModelConfig{
+		ArbiterLatency:       0,
+		ScalerStageLatency:   0,
+		ServerLatency:        40,
+		ServerQueueDepth:     16,
+		ClientMaxOutstanding: 0}
+}
+
+//
+// Specifies the number of cycles without progress after which the model is
+// assumed to have deadlocked.
+//
+const stallCycleLimit = 1 << 20
+
+//
+// Holds the state of a single transaction as it passes through the tree.
+//
+type treeFrame struct {
+	client   uint
+	write    bool
+	length   uint
+	response bool
+	created  uint64
+	issued   uint64
+	route    []*treePort // Arbiter ports traversed by the request.
+}
+
+//
+// Determines the number of bytes in the current frame encoding.
+//
+func (frame *treeFrame) size() uint {
+	switch {
+	case frame.response && frame.write:
+		return smiProtocol.ResponseHeaderSize
+	case frame.response:
+		return smiProtocol.ResponseHeaderSize + frame.length
+	case frame.write:
+		return smiProtocol.RequestHeaderSize + frame.length
+	default:
+		return smiProtocol.RequestHeaderSize
+	}
+}
+
+//
+// Determines the number of flits needed to transfer the current frame
+// encoding over a link of the specified width.
+//
+func (frame *treeFrame) flits(flitWidth uint) uint {
+	return (frame.size() + flitWidth - 1) / flitWidth
+}
+
+//
+// Specifies the common interface for model components which accept frames.
+// Space for a frame must be reserved before the start of a transfer, with the
+// frame being delivered once the transfer completes.
+//
+type frameSink interface {
+	reserve(frame *treeFrame) bool
+	deliver(frame *treeFrame, now uint64)
+}
+
+//
+// Implements a frame aware FIFO with optional frame and flit count limits.
+// A FIFO which is empty will always accept a frame, so that frames which are
+// larger than the FIFO are not blocked.
+//
+type frameQueue struct {
+	flitWidth uint
+	maxFrames uint
+	maxFlits  uint
+	numFrames uint // Reserved and queued frames.
+	numFlits  uint // Reserved and queued flits.
+	frames    []*treeFrame
+}
+
+func (queue *frameQueue) reserve(frame *treeFrame) bool {
+	flits := frame.flits(queue.flitWidth)
+	if queue.numFrames != 0 {
+		if (queue.maxFrames != 0) && (queue.numFrames >= queue.maxFrames) {
+			return false
+		}
+		if (queue.maxFlits != 0) && (queue.numFlits+flits > queue.maxFlits) {
+			return false
+		}
+	}
+	queue.numFrames++
+	queue.numFlits += flits
+	return true
+}
+
+func (queue *frameQueue) deliver(frame *treeFrame, now uint64) {
+	queue.frames = append(queue.frames, frame)
+}
+
+func (queue *frameQueue) head() *treeFrame {
+	if len(queue.frames) == 0 {
+		return nil
+	}
+	return queue.frames[0]
+}
+
+func (queue *frameQueue) release() {
+	queue.numFrames--
+	queue.numFlits -= queue.frames[0].flits(queue.flitWidth)
+	queue.frames = queue.frames[1:]
+}
+
+//
+// Implements a point to point link which transfers a single frame at a time.
+// Frames are delivered after the final flit has been transferred and the
+// link latency has elapsed.
+//
+type treeLink struct {
+	flitWidth uint
+	latency   uint
+	busyUntil uint64
+	inFlight  []linkTransfer
+}
+
+type linkTransfer struct {
+	frame   *treeFrame
+	sink    frameSink
+	arrival uint64
+}
+
+func (link *treeLink) idle(now uint64) bool {
+	return now >= link.busyUntil
+}
+
+func (link *treeLink) start(frame *treeFrame, sink frameSink, now uint64) bool {
+	if !link.idle(now) || !sink.reserve(frame) {
+		return false
+	}
+	link.busyUntil = now + uint64(frame.flits(link.flitWidth))
+	link.inFlight = append(link.inFlight,
+		linkTransfer{frame, sink, link.busyUntil + uint64(link.latency)})
+	return true
+}
+
+func (link *treeLink) update(now uint64) {
+	for len(link.inFlight) != 0 && link.inFlight[0].arrival <= now {
+		link.inFlight[0].sink.deliver(link.inFlight[0].frame, now)
+		link.inFlight = link.inFlight[1:]
+	}
+}
+
+//
+// Models a single client side port of an arbiter, including the transaction
+// tag matcher and the request and response frame buffers.
+//
+type treePort struct {
+	reqQueue  frameQueue
+	respQueue frameQueue
+	respLink  treeLink
+	respDest  frameSink
+	maxTags   uint
+	numTags   uint
+}
+
+func (port *treePort) reserve(frame *treeFrame) bool {
+	if port.numTags >= port.maxTags || !port.reqQueue.reserve(frame) {
+		return false
+	}
+	port.numTags++
+	return true
+}
+
+func (port *treePort) deliver(frame *treeFrame, now uint64) {
+	frame.route = append(frame.route, port)
+	port.reqQueue.deliver(frame, now)
+}
+
+//
+// Forwards response frames from the response buffer to the client side.
+//
+func (port *treePort) forward(now uint64) {
+	frame := port.respQueue.head()
+	if frame != nil && port.respLink.start(frame, port.respDest, now) {
+		port.respQueue.release()
+		port.numTags--
+	}
+}
+
+//
+// Models a single arbiter, which selects request frames from its client side
+// ports and steers response frames back to them.
+//
+type treeNode struct {
+	name       string
+	reqLatency uint // Request path latency through the arbiter.
+	ports      []*treePort
+	reqLink    treeLink
+	reqDest    frameSink
+	steerQueue frameQueue
+	steerLink  treeLink
+	current    int  // Port currently selected by the arbiter, or -1 if idle.
+	frameDone  bool // Frame transfer from the current port is complete.
+}
+
+//
+// Implements the arbitration state machine used by the Verilog frame
+// arbiters. At the end of each frame, arbitration passes directly to the next
+// port if it has a frame waiting. Otherwise the arbiter returns to the idle
+// state, which takes an additional clock cycle to select the lowest numbered
+// port with a frame waiting.
+//
+func (node *treeNode) arbitrate(now uint64) {
+	if !node.reqLink.idle(now) {
+		return
+	}
+	if node.frameDone {
+		node.frameDone = false
+		next := (node.current + 1) % len(node.ports)
+		if node.ports[next].reqQueue.head() != nil {
+			node.current = next
+		} else {
+			node.current = -1
+		}
+	}
+	if node.current < 0 {
+		for i, port := range node.ports {
+			if port.reqQueue.head() != nil {
+				node.current = i
+				break
+			}
+		}
+		return
+	}
+	port := node.ports[node.current]
+	frame := port.reqQueue.head()
+	if frame != nil && node.reqLink.start(frame, node.reqDest, now) {
+		port.reqQueue.release()
+		node.frameDone = true
+	}
+}
+
+//
+// Steers response frames to the response buffer of the originating port.
+//
+func (node *treeNode) steer(now uint64) {
+	frame := node.steerQueue.head()
+	if frame == nil {
+		return
+	}
+	port := frame.route[len(frame.route)-1]
+	if node.steerLink.start(frame, &port.respQueue, now) {
+		frame.route = frame.route[:len(frame.route)-1]
+		node.steerQueue.release()
+	}
+}
+
+//
+// Models the memory server, which completes requests in order after a fixed
+// access latency.
+//
+type treeServer struct {
+	queue          frameQueue
+	completions    []uint64
+	lastCompletion uint64
+	latency        uint
+	respLink       treeLink
+	respDest       frameSink
+}
+
+func (server *treeServer) reserve(frame *treeFrame) bool {
+	return server.queue.reserve(frame)
+}
+
+func (server *treeServer) deliver(frame *treeFrame, now uint64) {
+	completion := now + uint64(server.latency)
+	if completion <= server.lastCompletion {
+		completion = server.lastCompletion + 1
+	}
+	server.lastCompletion = completion
+	server.completions = append(server.completions, completion)
+	server.queue.deliver(frame, now)
+}
+
+func (server *treeServer) respond(now uint64) {
+	frame := server.queue.head()
+	if frame == nil || server.completions[0] > now {
+		return
+	}
+	frame.response = true
+	if server.respLink.start(frame, server.respDest, now) {
+		server.queue.release()
+		server.completions = server.completions[1:]
+	} else {
+		frame.response = false
+	}
+}
+
+//
+// Models a single SMI client, which issues its transactions in order.
+//
+type treeClient struct {
+	pending     []*treeFrame
+	outstanding uint
+	reqLink     treeLink
+	reqDest     frameSink
+	stats       *clientStats
+}
+
+func (client *treeClient) reserve(frame *treeFrame) bool {
+	return true
+}
+
+func (client *treeClient) deliver(frame *treeFrame, now uint64) {
+	client.outstanding--
+	client.stats.complete(frame, now)
+}
+
+func (client *treeClient) issue(now uint64, maxOutstanding uint) bool {
+	if len(client.pending) == 0 || client.pending[0].created > now {
+		return false
+	}
+	if (maxOutstanding != 0) && (client.outstanding >= maxOutstanding) {
+		return false
+	}
+	frame := client.pending[0]
+	if !client.reqLink.start(frame, client.reqDest, now) {
+		return false
+	}
+	frame.issued = now
+	client.pending = client.pending[1:]
+	client.outstanding++
+	return true
+}
+
+//
+// Holds the complete set of model components.
+//
+type treeModel struct {
+	config  ModelConfig
+	clients []*treeClient
+	nodes   []*treeNode
+	server  *treeServer
+}
+
+//
+// Specifies the endpoint which drives a given connection, after following
+// any bus width scalers, pipeline stages and direct assignments. The link
+// width is the narrowest flit width along the path.
+//
+type treeEndpoint struct {
+	client      int       // Client index, or -1 for an arbiter.
+	node        *treeNode // Driving arbiter, or 'nil' for a client.
+	flitWidth   uint
+	reqLatency  uint
+	respLatency uint
+}
+
+//
+// Determines the number of scaling stages for a given scaling factor.
+//
+func scalerStages(scaleFactor uint) uint {
+	stages := uint(0)
+	for scaleFactor > 1 {
+		scaleFactor >>= 1
+		stages++
+	}
+	return stages
+}
+
+//
+// Builds the model components from the arbitration tree topology.
+//
+func newTreeModel(topology smiMemTemplates.ArbitrationTreeTopology,
+	config ModelConfig) (*treeModel, error) {
+
+	model := &treeModel{config: config}
+	clientIndices := make(map[string]int)
+	for i, conn := range topology.ClientConns {
+		clientIndices[conn.Name] = i
+		model.clients = append(model.clients, &treeClient{
+			stats: &clientStats{client: uint(i)}})
+	}
+
+	// Index the tree components by their server side connection names and
+	// create the arbiter models.
+	drivers := make(map[string]*smiMemTemplates.ArbitrationTreeNode)
+	arbiters := make(map[string]*treeNode)
+	for i := range topology.Nodes {
+		node := &topology.Nodes[i]
+		if _, ok := drivers[node.ServerConn.Name]; ok {
+			return nil, errors.New(fmt.Sprintf(
+				"Multiple drivers for SMI connection (%s)", node.ServerConn.Name))
+		}
+		drivers[node.ServerConn.Name] = node
+		if node.Kind != smiMemTemplates.TreeNodeArbiter {
+			continue
+		}
+		if len(node.ClientConns) < 2 {
+			return nil, errors.New(fmt.Sprintf(
+				"Arbiter (%s) has fewer than two client ports", node.InstanceName))
+		}
+		reqLatency, respLatency := node.Latency()
+		if config.ArbiterLatency != 0 {
+			reqLatency = config.ArbiterLatency
+			respLatency = config.ArbiterLatency
+		}
+		arbiter := &treeNode{name: node.InstanceName, reqLatency: reqLatency, current: -1}
+		arbiter.steerQueue = frameQueue{flitWidth: node.ServerConn.FlitWidth, maxFrames: 1}
+		arbiter.steerLink = treeLink{flitWidth: node.ServerConn.FlitWidth}
+		for range node.ClientConns {
+			arbiter.ports = append(arbiter.ports, &treePort{
+				reqQueue: frameQueue{node.ServerConn.FlitWidth,
+					node.FifoFrames, node.FifoFlitDepth, 0, 0, nil},
+				respQueue: frameQueue{node.ServerConn.FlitWidth,
+					0, node.FifoFlitDepth, 0, 0, nil},
+				respLink: treeLink{latency: respLatency},
+				maxTags: 1 << node.TagIdWidth})
+		}
+		arbiters[node.ServerConn.Name] = arbiter
+		model.nodes = append(model.nodes, arbiter)
+	}
+
+	// Follow the bus width scalers, pipeline stages and assignments from a
+	// connection to the client or arbiter which drives it.
+	resolve := func(conn smiMemTemplates.ArbitrationTreeConn) (treeEndpoint, error) {
+		endpoint := treeEndpoint{-1, nil, conn.FlitWidth, 0, 0}
+		for depth := 0; depth <= len(topology.Nodes); depth++ {
+			if index, ok := clientIndices[conn.Name]; ok {
+				endpoint.client = index
+				return endpoint, nil
+			}
+			driver, ok := drivers[conn.Name]
+			if !ok {
+				break
+			}
+			if driver.Kind == smiMemTemplates.TreeNodeArbiter {
+				endpoint.node = arbiters[conn.Name]
+				return endpoint, nil
+			}
+			reqLatency, respLatency := driver.Latency()
+			if (driver.Kind == smiMemTemplates.TreeNodeScaler) && (config.ScalerStageLatency != 0) {
+				reqLatency = config.ScalerStageLatency * scalerStages(driver.ScaleFactor)
+				respLatency = reqLatency
+			}
+			endpoint.reqLatency += reqLatency
+			endpoint.respLatency += respLatency
+			conn = driver.ClientConns[0]
+			if conn.FlitWidth < endpoint.flitWidth {
+				endpoint.flitWidth = conn.FlitWidth
+			}
+		}
+		return endpoint, errors.New(fmt.Sprintf(
+			"SMI connection (%s) has no valid driver", conn.Name))
+	}
+
+	// Connect an endpoint to the specified request sink and response source.
+	// Arbiter port response links are initialised with the arbiter response
+	// latency, to which the latency of the path to the endpoint is added.
+	connected := make(map[interface{}]bool)
+	connect := func(endpoint treeEndpoint, reqSink frameSink, respLink *treeLink,
+		respDest *frameSink) error {
+
+		respLink.flitWidth = endpoint.flitWidth
+		respLink.latency += endpoint.respLatency
+		if endpoint.node != nil {
+			if connected[endpoint.node] {
+				return errors.New(fmt.Sprintf(
+					"Arbiter (%s) has multiple server side connections", endpoint.node.name))
+			}
+			connected[endpoint.node] = true
+			endpoint.node.reqLink = treeLink{flitWidth: endpoint.flitWidth,
+				latency: endpoint.reqLatency + endpoint.node.reqLatency}
+			endpoint.node.reqDest = reqSink
+			*respDest = &endpoint.node.steerQueue
+		} else {
+			client := model.clients[endpoint.client]
+			if connected[client] {
+				return errors.New(fmt.Sprintf(
+					"SMI client (%s) has multiple connections",
+					topology.ClientConns[endpoint.client].Name))
+			}
+			connected[client] = true
+			client.reqLink = treeLink{flitWidth: endpoint.flitWidth,
+				latency: endpoint.reqLatency}
+			client.reqDest = reqSink
+			*respDest = client
+		}
+		return nil
+	}
+
+	model.server = &treeServer{latency: config.ServerLatency}
+	model.server.queue = frameQueue{flitWidth: topology.ServerConn.FlitWidth,
+		maxFrames: config.ServerQueueDepth}
+	endpoint, err := resolve(topology.ServerConn)
+	if err != nil {
+		return nil, err
+	}
+	err = connect(endpoint, model.server, &model.server.respLink,
+		&model.server.respDest)
+	if err != nil {
+		return nil, err
+	}
+	for i := range topology.Nodes {
+		node := &topology.Nodes[i]
+		if node.Kind != smiMemTemplates.TreeNodeArbiter {
+			continue
+		}
+		arbiter := arbiters[node.ServerConn.Name]
+		for j, conn := range node.ClientConns {
+			endpoint, err := resolve(conn)
+			if err != nil {
+				return nil, err
+			}
+			port := arbiter.ports[j]
+			err = connect(endpoint, port, &port.respLink, &port.respDest)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Check that all clients and arbiters are reachable from the server.
+	for i, client := range model.clients {
+		if !connected[client] {
+			return nil, errors.New(fmt.Sprintf(
+				"SMI client (%s) is not connected", topology.ClientConns[i].Name))
+		}
+	}
+	for _, node := range model.nodes {
+		if !connected[node] {
+			return nil, errors.New(fmt.Sprintf(
+				"Arbiter (%s) is not connected", node.name))
+		}
+	}
+	return model, nil
+}
+
+//
+// Advances the model state by a single clock cycle. Returns 'true' if any
+// transactions were issued or completed during the clock cycle.
+//
+func (model *treeModel) step(now uint64) bool {
+	completed := uint(0)
+	for _, client := range model.clients {
+		completed += client.stats.completed
+		client.reqLink.update(now)
+	}
+	for _, node := range model.nodes {
+		node.reqLink.update(now)
+		node.steerLink.update(now)
+		for _, port := range node.ports {
+			port.respLink.update(now)
+		}
+	}
+	model.server.respLink.update(now)
+
+	progress := false
+	for _, client := range model.clients {
+		completed -= client.stats.completed
+	}
+	if completed != 0 {
+		progress = true
+	}
+	for _, client := range model.clients {
+		if client.issue(now, model.config.ClientMaxOutstanding) {
+			progress = true
+		}
+	}
+	for _, node := range model.nodes {
+		node.arbitrate(now)
+	}
+	model.server.respond(now)
+	for _, node := range model.nodes {
+		node.steer(now)
+		for _, port := range node.ports {
+			port.forward(now)
+		}
+	}
+	return progress
+}
+
+//
+// Simulate runs the arbitration tree model for the specified topology and
+// model configuration, using the supplied list of client transactions. The
+// simulation runs until all transactions have completed or the specified
+// maximum number of clock cycles has elapsed. A maximum of zero runs the
+// simulation to completion. Returns the simulation report and an error item
+// which will be set to 'nil' on successful completion.
+//
+func Simulate(topology smiMemTemplates.ArbitrationTreeTopology, config ModelConfig,
+	transactions []Transaction, maxCycles uint64) (*Report, error) {
+
+	model, err := newTreeModel(topology, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Queue the transactions for each client in request order.
+	sorted := make([]Transaction, len(transactions))
+	copy(sorted, transactions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Cycle < sorted[j].Cycle
+	})
+	for _, transaction := range sorted {
+		if transaction.Client >= uint(len(model.clients)) {
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid SMI client (%d) for transaction", transaction.Client))
+		}
+		if (transaction.Length == 0) || (transaction.Length > smiProtocol.MaxBurstLength) {
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid transaction length (%d bytes)", transaction.Length))
+		}
+		client := model.clients[transaction.Client]
+		client.pending = append(client.pending, &treeFrame{
+			client:  transaction.Client,
+			write:   transaction.Write,
+			length:  transaction.Length,
+			created: transaction.Cycle})
+		client.stats.request(transaction)
+	}
+
+	// Run the model until all transactions have completed.
+	var now uint64
+	lastProgress := uint64(0)
+	remaining := func() bool {
+		for _, client := range model.clients {
+			if len(client.pending) != 0 || client.outstanding != 0 {
+				return true
+			}
+		}
+		return false
+	}
+	for now = 0; (maxCycles == 0) || (now < maxCycles); now++ {
+		if !remaining() {
+			break
+		}
+		if model.step(now) {
+			lastProgress = now
+		}
+		if now-lastProgress > stallCycleLimit {
+			for _, client := range model.clients {
+				if client.outstanding != 0 {
+					return nil, errors.New(fmt.Sprintf(
+						"Arbitration tree model stalled at cycle %d", now))
+				}
+			}
+			lastProgress = now
+		}
+	}
+
+	clientStats := make([]*clientStats, len(model.clients))
+	for i, client := range model.clients {
+		clientStats[i] = client.stats
+	}
+	return makeReport(topology, now, clientStats), nil
+}
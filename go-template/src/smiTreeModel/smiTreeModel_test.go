@@ -0,0 +1,170 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiTreeModel
+
+import (
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemTemplates"
+	"math"
+	"testing"
+)
+
+//
+// Lists the arbitration tree sizes used for testing.
+//
+var testNumClients = []uint{1, 2, 3, 4, 8, 17, 64}
+
+//
+// Determines the number of cycles taken by a single 8 byte read on an
+// unscaled tree which are not included in the analytical round trip latency.
+// The two flit request is transferred over the client link and the server
+// side link of each arbiter, while the two flit response is transferred over
+// the server link and the steering and client port links of each arbiter.
+// Each idle arbiter also takes a single cycle to select the request.
+//
+func readTransferCycles(arbiterStages uint) uint64 {
+	reqFlits := uint64(2 * (arbiterStages + 1))
+	respFlits := uint64(2 * (2*arbiterStages + 1))
+	return reqFlits + respFlits + uint64(arbiterStages)
+}
+
+//
+// Tests that the latency of a single transaction on an otherwise idle tree
+// matches the analytical client path latency, including the latency of any
+// link pipeline stages.
+//
+func TestSingleClientLatency(t *testing.T) {
+	pipelined := smiMemTemplates.DefaultArbitrationTreeOptions()
+	pipelined.PipelineLayers = []uint{0}
+	pipelined.PipelineClients = []uint{0}
+	config := DefaultModelConfig()
+	for _, numClients := range testNumClients {
+		for _, options := range []smiMemTemplates.ArbitrationTreeOptions{
+			smiMemTemplates.DefaultArbitrationTreeOptions(), pipelined} {
+			topology, err := smiMemTemplates.DescribeArbitrationTreeWithOptions(
+				"tree", numClients, 1, options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for client, path := range topology.ClientPaths() {
+				report, err := Simulate(topology, config,
+					[]Transaction{{Cycle: 10, Client: uint(client), Length: 8}}, 0)
+				if err != nil {
+					t.Fatal(err)
+				}
+				expected := uint64(path.RoundTripLatency) +
+					uint64(config.ServerLatency) + readTransferCycles(path.ArbiterStages)
+				latency := report.Clients[client].Latency
+				if latency.Count != 1 || latency.Max != expected {
+					t.Errorf("X%d client %d (%d pipelines): latency %d, expected %d",
+						numClients, client, path.PipelineStages, latency.Max, expected)
+				}
+			}
+		}
+	}
+}
+
+//
+// Tests that under saturation each client receives the share of the server
+// bandwidth given by round robin arbitration at each arbiter, which is 1/N
+// for balanced trees.
+//
+func TestRoundRobinFairness(t *testing.T) {
+	const cycles = 20000
+	for _, numClients := range testNumClients[1:] {
+		topology, err := smiMemTemplates.DescribeArbitrationTree("tree", numClients, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		transactions := make([]Transaction, 0)
+		for client := uint(0); client < numClients; client++ {
+			for i := 0; i < cycles/int(numClients); i++ {
+				transactions = append(transactions,
+					Transaction{0, client, (i % 2) == 0, 32})
+			}
+		}
+		report, err := Simulate(topology, DefaultModelConfig(), transactions, cycles)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.Incomplete == 0 {
+			t.Fatalf("X%d: tree not saturated", numClients)
+		}
+		totalBytes := uint64(0)
+		for _, client := range report.Clients {
+			totalBytes += client.CompletedBytes
+		}
+		balanced := (numClients & (numClients - 1)) == 0
+		for i, path := range topology.ClientPaths() {
+			share := float64(report.Clients[i].CompletedBytes) / float64(totalBytes)
+			if math.Abs(share-path.BandwidthShare) > 0.02*path.BandwidthShare {
+				t.Errorf("X%d client %d: bandwidth share %f, expected %f",
+					numClients, i, share, path.BandwidthShare)
+			}
+			if balanced && (path.BandwidthShare != 1/float64(numClients)) {
+				t.Errorf("X%d client %d: analytical share %f, expected 1/%d",
+					numClients, i, path.BandwidthShare, numClients)
+			}
+		}
+	}
+}
+
+//
+// Tests that the arbiter FIFO depths limit the number of transactions which
+// can be buffered in front of a slow memory server, so that clients wait
+// longer to issue transactions when the FIFOs are shallower.
+//
+func TestFifoBackPressure(t *testing.T) {
+	config := DefaultModelConfig()
+	config.ServerLatency = 200
+	config.ServerQueueDepth = 1
+	transactions := make([]Transaction, 20)
+	for i := range transactions {
+		transactions[i] = Transaction{0, 0, true, 8}
+	}
+	fifoDepths := []struct{ flits, frames uint }{{4, 1}, {32, 4}, {128, 8}}
+	var lastWait float64
+	var lastCycles uint64
+	for i, depth := range fifoDepths {
+		options := smiMemTemplates.DefaultArbitrationTreeOptions()
+		options.FifoFlitDepth = depth.flits
+		options.FifoFrames = depth.frames
+		topology, err := smiMemTemplates.DescribeArbitrationTreeWithOptions(
+			"tree", 2, 1, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		report, err := Simulate(topology, config, transactions, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if report.Incomplete != 0 {
+			t.Fatalf("FIFO depth %d: %d transactions incomplete",
+				depth.flits, report.Incomplete)
+		}
+		wait := report.Clients[0].MeanIssueWait
+		if (i != 0) && (wait >= lastWait) {
+			t.Errorf("FIFO depth %d: mean issue wait %.1f, expected less than %.1f",
+				depth.flits, wait, lastWait)
+		}
+		if (i != 0) && (report.Cycles != lastCycles) {
+			t.Errorf("FIFO depth %d: completed after %d cycles, expected %d",
+				depth.flits, report.Cycles, lastCycles)
+		}
+		lastWait = wait
+		lastCycles = report.Cycles
+	}
+}
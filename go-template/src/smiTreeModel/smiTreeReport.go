@@ -0,0 +1,218 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiTreeModel
+
+import (
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiMemTemplates"
+	"io"
+	"sort"
+)
+
+//
+// LatencyStats specifies the distribution of transaction latencies, measured
+// in clock cycles from the cycle on which a transaction is requested by the
+// client to the cycle on which the complete response is received.
+//
+type LatencyStats struct {
+	Count uint    // Number of completed transactions.
+	Min   uint64  // Minimum latency.
+	Max   uint64  // Maximum latency.
+	Mean  float64 // Mean latency.
+	P50   uint64  // Median latency.
+	P90   uint64  // 90th percentile latency.
+	P99   uint64  // 99th percentile latency.
+}
+
+//
+// ClientReport specifies the simulation results for a single SMI client.
+// Throughput is measured over the active period of the client, from its
+// first transaction request to its last transaction completion.
+//
+type ClientReport struct {
+	Client         uint         // Index of the SMI client.
+	Name           string       // Name of the client request connection.
+	Requested      uint         // Number of transactions requested.
+	Completed      uint         // Number of transactions completed.
+	RequestedBytes uint64       // Number of payload bytes requested.
+	CompletedBytes uint64       // Number of payload bytes transferred.
+	Throughput     float64      // Payload bytes per clock cycle.
+	MeanIssueWait  float64      // Mean cycles between request and issue.
+	Latency        LatencyStats // Distribution of transaction latencies.
+}
+
+//
+// Report specifies the results of an arbitration tree simulation. Fairness
+// is given by Jain's fairness index over the per-client throughput of all
+// active clients, which is 1.0 when all clients receive equal throughput and
+// falls towards 1/N as throughput is concentrated on fewer clients.
+//
+type Report struct {
+	ModuleName string         // Name of the arbitration tree module.
+	Cycles     uint64         // Number of clock cycles simulated.
+	Clients    []ClientReport // Per-client results.
+	Throughput float64        // Aggregate payload bytes per clock cycle.
+	Latency    LatencyStats   // Latency distribution over all clients.
+	Fairness   float64        // Jain's fairness index.
+	Incomplete uint           // Number of transactions not completed.
+}
+
+//
+// Accumulates the transaction statistics for a single SMI client.
+//
+type clientStats struct {
+	client         uint
+	requested      uint
+	completed      uint
+	requestedBytes uint64
+	completedBytes uint64
+	firstRequest   uint64
+	lastCompletion uint64
+	totalWait      uint64
+	latencies      []uint64
+}
+
+func (stats *clientStats) request(transaction Transaction) {
+	if stats.requested == 0 || transaction.Cycle < stats.firstRequest {
+		stats.firstRequest = transaction.Cycle
+	}
+	stats.requested++
+	stats.requestedBytes += uint64(transaction.Length)
+}
+
+func (stats *clientStats) complete(frame *treeFrame, now uint64) {
+	stats.completed++
+	stats.completedBytes += uint64(frame.length)
+	stats.lastCompletion = now
+	stats.totalWait += frame.issued - frame.created
+	stats.latencies = append(stats.latencies, now-frame.created)
+}
+
+//
+// Derives the latency distribution from a list of latency values.
+//
+func makeLatencyStats(latencies []uint64) LatencyStats {
+	stats := LatencyStats{Count: uint(len(latencies))}
+	if len(latencies) == 0 {
+		return stats
+	}
+	sorted := make([]uint64, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	total := uint64(0)
+	for _, latency := range sorted {
+		total += latency
+	}
+	percentile := func(p int) uint64 {
+		return sorted[(len(sorted)-1)*p/100]
+	}
+	stats.Min = sorted[0]
+	stats.Max = sorted[len(sorted)-1]
+	stats.Mean = float64(total) / float64(len(sorted))
+	stats.P50 = percentile(50)
+	stats.P90 = percentile(90)
+	stats.P99 = percentile(99)
+	return stats
+}
+
+//
+// Builds the simulation report from the accumulated client statistics.
+//
+func makeReport(topology smiMemTemplates.ArbitrationTreeTopology, cycles uint64,
+	stats []*clientStats) *Report {
+
+	report := &Report{ModuleName: topology.ModuleName, Cycles: cycles}
+	allLatencies := make([]uint64, 0)
+	totalBytes := uint64(0)
+	sumThroughput := 0.0
+	sumSquares := 0.0
+	numActive := 0
+	for i, clientStats := range stats {
+		client := ClientReport{
+			Client:         clientStats.client,
+			Name:           topology.ClientConns[i].Name,
+			Requested:      clientStats.requested,
+			Completed:      clientStats.completed,
+			RequestedBytes: clientStats.requestedBytes,
+			CompletedBytes: clientStats.completedBytes,
+			Latency:        makeLatencyStats(clientStats.latencies)}
+		if clientStats.completed != 0 {
+			activeCycles := clientStats.lastCompletion - clientStats.firstRequest + 1
+			client.Throughput = float64(clientStats.completedBytes) / float64(activeCycles)
+			client.MeanIssueWait = float64(clientStats.totalWait) / float64(clientStats.completed)
+			sumThroughput += client.Throughput
+			sumSquares += client.Throughput * client.Throughput
+			numActive++
+		}
+		report.Incomplete += clientStats.requested - clientStats.completed
+		totalBytes += clientStats.completedBytes
+		allLatencies = append(allLatencies, clientStats.latencies...)
+		report.Clients = append(report.Clients, client)
+	}
+	if cycles != 0 {
+		report.Throughput = float64(totalBytes) / float64(cycles)
+	}
+	report.Latency = makeLatencyStats(allLatencies)
+	if sumSquares != 0 {
+		report.Fairness = (sumThroughput * sumThroughput) / (float64(numActive) * sumSquares)
+	}
+	return report
+}
+
+//
+// Write outputs the simulation report in a human readable tabular format.
+// Returns an error item which will be set to 'nil' on successful completion.
+//
+func (report *Report) Write(output io.Writer) error {
+	_, err := fmt.Fprintf(output,
+		"Arbitration tree %s: %d clients, %d cycles\n"+
+			"Aggregate throughput %.3f bytes/cycle, fairness index %.3f, %d incomplete\n\n",
+		report.ModuleName, len(report.Clients), report.Cycles,
+		report.Throughput, report.Fairness, report.Incomplete)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(output, "%-20s %8s %10s %10s %9s %9s %7s %7s %7s %7s\n",
+		"client", "done", "bytes", "bytes/cyc", "wait", "mean", "p50", "p90", "p99", "max")
+	if err != nil {
+		return err
+	}
+	line := func(name string, completed uint, bytes uint64, throughput float64,
+		wait float64, latency LatencyStats) error {
+		_, err := fmt.Fprintf(output,
+			"%-20s %8d %10d %10.3f %9.1f %9.1f %7d %7d %7d %7d\n",
+			name, completed, bytes, throughput, wait, latency.Mean,
+			latency.P50, latency.P90, latency.P99, latency.Max)
+		return err
+	}
+	totalWait := 0.0
+	totalBytes := uint64(0)
+	for _, client := range report.Clients {
+		err = line(client.Name, client.Completed, client.CompletedBytes,
+			client.Throughput, client.MeanIssueWait, client.Latency)
+		if err != nil {
+			return err
+		}
+		totalWait += client.MeanIssueWait * float64(client.Completed)
+		totalBytes += client.CompletedBytes
+	}
+	if report.Latency.Count != 0 {
+		totalWait /= float64(report.Latency.Count)
+	}
+	return line("total", report.Latency.Count, totalBytes, report.Throughput,
+		totalWait, report.Latency)
+}
@@ -0,0 +1,138 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiTreeModel
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+//
+// Transaction specifies a single memory transaction requested by an SMI
+// client.
+//
+type Transaction struct {
+	Cycle  uint64 // Clock cycle at which the client requests the transaction.
+	Client uint   // Index of the requesting SMI client.
+	Write  bool   // Transaction is a write rather than a read.
+	Length uint   // Number of payload bytes to transfer.
+}
+
+//
+// SyntheticTraffic specifies the parameters for generating random client
+// traffic. Each client independently requests a new transaction on each
+// clock cycle with the specified probability.
+//
+type SyntheticTraffic struct {
+	NumClients    uint    // Number of SMI clients.
+	Cycles        uint64  // Number of clock cycles over which to generate traffic.
+	InjectionRate float64 // Probability of a client requesting a transaction per cycle.
+	WriteRatio    float64 // Proportion of transactions which are writes.
+	MinLength     uint    // Minimum transaction length in bytes.
+	MaxLength     uint    // Maximum transaction length in bytes.
+	Seed          int64   // Random number generator seed.
+}
+
+//
+// Generate creates the list of transactions for the synthetic traffic
+// parameters, in request order. The same seed value always generates the
+// same list of transactions.
+//
+func (traffic SyntheticTraffic) Generate() []Transaction {
+	random := rand.New(rand.NewSource(traffic.Seed))
+	transactions := make([]Transaction, 0)
+	minLength := traffic.MinLength
+	if minLength == 0 {
+		minLength = 1
+	}
+	lengthRange := 1
+	if traffic.MaxLength > minLength {
+		lengthRange = int(traffic.MaxLength-minLength) + 1
+	}
+	for cycle := uint64(0); cycle < traffic.Cycles; cycle++ {
+		for client := uint(0); client < traffic.NumClients; client++ {
+			if random.Float64() >= traffic.InjectionRate {
+				continue
+			}
+			transactions = append(transactions, Transaction{
+				Cycle:  cycle,
+				Client: client,
+				Write:  random.Float64() < traffic.WriteRatio,
+				Length: minLength + uint(random.Intn(lengthRange))})
+		}
+	}
+	return transactions
+}
+
+//
+// ReadTrafficTrace reads a list of recorded client transactions from a text
+// trace. Each line of the trace specifies a single transaction using the
+// format '<cycle> <client> <R|W> <length>'. Blank lines and lines starting
+// with '#' are ignored. Returns the list of transactions and an error item
+// which will be set to 'nil' on successful completion.
+//
+func ReadTrafficTrace(input io.Reader) ([]Transaction, error) {
+	transactions := make([]Transaction, 0)
+	scanner := bufio.NewScanner(input)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid traffic trace entry at line %d", lineNum))
+		}
+		cycle, err := strconv.ParseUint(fields[0], 10, 64)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid transaction cycle (%s) at line %d", fields[0], lineNum))
+		}
+		client, err := strconv.ParseUint(fields[1], 10, 32)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid transaction client (%s) at line %d", fields[1], lineNum))
+		}
+		var write bool
+		switch strings.ToUpper(fields[2]) {
+		case "R":
+			write = false
+		case "W":
+			write = true
+		default:
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid transaction type (%s) at line %d", fields[2], lineNum))
+		}
+		length, err := strconv.ParseUint(fields[3], 10, 32)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf(
+				"Invalid transaction length (%s) at line %d", fields[3], lineNum))
+		}
+		transactions = append(transactions,
+			Transaction{cycle, uint(client), write, uint(length)})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
@@ -0,0 +1,315 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiVcd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ReconfigureIO/smi/go-template/src/smiProtocol"
+	"io"
+	"sort"
+	"strings"
+)
+
+//
+// SmiLinkEvent specifies an event detected on a monitored SMI link. Each event
+// is either a decoded frame or a protocol violation, in which case the frame
+// will be 'nil' and the violation message will be set.
+//
+type SmiLinkEvent struct {
+	Time      uint64            // Simulation time of the clock edge.
+	Link      string            // Name of the SMI link.
+	Frame     smiProtocol.Frame // Decoded frame.
+	Violation string            // Protocol violation message.
+}
+
+//
+// SmiLinkSignals specifies the full hierarchical VCD names of the signals
+// which make up a single SMI link.
+//
+type SmiLinkSignals struct {
+	Ready string // Name of the flit ready signal.
+	Eofc  string // Name of the end of frame control signal.
+	Data  string // Name of the flit data signal.
+	Stop  string // Name of the flow control stop signal.
+}
+
+//
+// NewSmiLinkSignals derives the SMI link signal names from a common prefix,
+// using the standard 'Ready', 'Eofc', 'Data' and 'Stop' suffixes.
+//
+func NewSmiLinkSignals(prefix string) SmiLinkSignals {
+	return SmiLinkSignals{prefix + "Ready", prefix + "Eofc", prefix + "Data", prefix + "Stop"}
+}
+
+//
+// Holds the current monitoring state for a single SMI link.
+//
+type smiLinkMonitor struct {
+	name      string
+	ids       [4]string // Ready, Eofc, Data and Stop identifier codes.
+	flitWidth int
+	flits     []smiProtocol.Flit
+	stalled   bool   // Flit was held by stop on the previous clock edge.
+	heldEofc  string // End of frame control for the held flit.
+	heldData  string // Data for the held flit.
+	startTime uint64 // Time of the first flit in the current frame.
+}
+
+//
+// SmiAnalyzer follows a set of SMI links in a VCD file, reassembling and
+// decoding the SMI frames transferred over each link and checking for
+// protocol violations. All signals are sampled on the rising edge of the
+// specified clock signal.
+//
+type SmiAnalyzer struct {
+	clockId  string
+	monitors []*smiLinkMonitor
+	values   map[string]string
+}
+
+//
+// Looks up the VCD identifier code for a named signal.
+//
+func lookupVcdId(reader *VcdReader, name string) (string, int, error) {
+	variable, ok := reader.Variables[name]
+	if !ok {
+		return "", 0, errors.New(fmt.Sprintf(
+			"Signal (%s) not found in VCD file", name))
+	}
+	return variable.Id, variable.Width, nil
+}
+
+//
+// NewSmiAnalyzer creates an SMI analyzer for the named clock signal and SMI
+// links, which must all be declared in the VCD file header. Returns the
+// analyzer and an error item which will be set to 'nil' on successful
+// completion. Events detected on the same clock edge are reported in link
+// name order.
+//
+func NewSmiAnalyzer(reader *VcdReader, clockName string,
+	links map[string]SmiLinkSignals) (*SmiAnalyzer, error) {
+
+	analyzer := &SmiAnalyzer{values: make(map[string]string)}
+	clockId, _, err := lookupVcdId(reader, clockName)
+	if err != nil {
+		return nil, err
+	}
+	analyzer.clockId = clockId
+
+	linkNames := make([]string, 0, len(links))
+	for linkName := range links {
+		linkNames = append(linkNames, linkName)
+	}
+	sort.Strings(linkNames)
+	for _, linkName := range linkNames {
+		signals := links[linkName]
+		monitor := &smiLinkMonitor{name: linkName}
+		names := []string{signals.Ready, signals.Eofc, signals.Data, signals.Stop}
+		for i, name := range names {
+			id, width, err := lookupVcdId(reader, name)
+			if err != nil {
+				return nil, err
+			}
+			monitor.ids[i] = id
+			if i == 2 {
+				if (width % 8) != 0 {
+					return nil, errors.New(fmt.Sprintf(
+						"SMI data signal (%s) width (%d) is not a whole number of bytes",
+						name, width))
+				}
+				monitor.flitWidth = width / 8
+				if err := smiProtocol.CheckFlitWidth(uint(monitor.flitWidth)); err != nil {
+					return nil, err
+				}
+			}
+		}
+		analyzer.monitors = append(analyzer.monitors, monitor)
+	}
+	return analyzer, nil
+}
+
+//
+// Converts a binary value string to a little endian byte slice of the
+// specified size. Returns 'false' if the value contains unknown bits.
+//
+func binaryToBytes(value string, size int) ([]byte, bool) {
+	data := make([]byte, size)
+	for i := 0; i < len(value); i++ {
+		bit := len(value) - 1 - i
+		switch value[i] {
+		case '1':
+			if bit < size*8 {
+				data[bit/8] |= 1 << uint(bit%8)
+			}
+		case '0':
+		default:
+			return nil, false
+		}
+	}
+	return data, true
+}
+
+//
+// Samples a single SMI link on a rising clock edge, returning any detected
+// events.
+//
+func (monitor *smiLinkMonitor) sample(time uint64, values map[string]string) []SmiLinkEvent {
+	events := make([]SmiLinkEvent, 0)
+	violation := func(format string, args ...interface{}) {
+		events = append(events, SmiLinkEvent{time, monitor.name, nil,
+			fmt.Sprintf(format, args...)})
+	}
+	ready := values[monitor.ids[0]]
+	eofc := values[monitor.ids[1]]
+	data := values[monitor.ids[2]]
+	stop := values[monitor.ids[3]]
+
+	if ready != "0" && ready != "1" {
+		violation("ready signal has unknown value (%s)", ready)
+		monitor.stalled = false
+		return events
+	}
+	if ready == "1" && stop != "0" && stop != "1" {
+		violation("stop signal has unknown value (%s) while ready", stop)
+		monitor.stalled = false
+		return events
+	}
+
+	// Check that a flit held by stop on the previous clock edge is still
+	// being presented unchanged.
+	if monitor.stalled {
+		if ready != "1" {
+			violation("ready deasserted while stop asserted")
+		} else if eofc != monitor.heldEofc {
+			violation("eofc changed while stop asserted")
+		} else if data != monitor.heldData {
+			violation("data changed while stop asserted")
+		}
+	}
+	monitor.stalled = (ready == "1") && (stop == "1")
+	monitor.heldEofc = eofc
+	monitor.heldData = data
+	if ready != "1" || stop != "0" {
+		return events
+	}
+
+	// Process the flit transfer.
+	eofcBytes, eofcOk := binaryToBytes(eofc, 1)
+	flitData, dataOk := binaryToBytes(data, monitor.flitWidth)
+	if !eofcOk || !dataOk {
+		violation("flit transferred with unknown eofc or data bits")
+		monitor.flits = monitor.flits[:0]
+		return events
+	}
+	if len(monitor.flits) == 0 {
+		monitor.startTime = time
+	}
+	monitor.flits = append(monitor.flits, smiProtocol.Flit{Eofc: eofcBytes[0], Data: flitData})
+	if eofcBytes[0] == 0 {
+		return events
+	}
+	if int(eofcBytes[0]) > monitor.flitWidth {
+		violation("eofc value (%d) exceeds flit width (%d)", eofcBytes[0], monitor.flitWidth)
+		monitor.flits = monitor.flits[:0]
+		return events
+	}
+	frame, err := smiProtocol.DecodeFlits(monitor.flits)
+	monitor.flits = monitor.flits[:0]
+	if err != nil {
+		violation("invalid frame started at %d: %v", monitor.startTime, err)
+	} else {
+		events = append(events, SmiLinkEvent{time, monitor.name, frame, ""})
+	}
+	return events
+}
+
+//
+// Run processes the value changes from the VCD reader until the end of the
+// file, passing each detected event to the supplied report function in time
+// order. Frames which are incomplete at the end of the file are reported as
+// violations. Returns an error item which will be set to 'nil' on successful
+// completion.
+//
+func (analyzer *SmiAnalyzer) Run(reader *VcdReader, report func(SmiLinkEvent)) error {
+	for {
+		time, changes, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		// Sample using the values from before the clock edge.
+		prevClock := analyzer.values[analyzer.clockId]
+		for _, change := range changes {
+			if change.Id == analyzer.clockId && prevClock == "0" && change.Value == "1" {
+				for _, monitor := range analyzer.monitors {
+					for _, event := range monitor.sample(time, analyzer.values) {
+						report(event)
+					}
+				}
+				break
+			}
+		}
+		for _, change := range changes {
+			analyzer.values[change.Id] = change.Value
+		}
+	}
+	for _, monitor := range analyzer.monitors {
+		if len(monitor.flits) != 0 {
+			report(SmiLinkEvent{monitor.startTime, monitor.name, nil, fmt.Sprintf(
+				"incomplete frame at end of dump (%d flits)", len(monitor.flits))})
+		}
+	}
+	return nil
+}
+
+//
+// FormatFrame creates a single line text description of a decoded frame. At
+// most 'maxData' data bytes are included, with longer data being truncated.
+//
+func FormatFrame(frame smiProtocol.Frame, maxData int) string {
+	formatData := func(data []byte) string {
+		text := make([]string, 0, len(data))
+		for i, b := range data {
+			if i == maxData {
+				text = append(text, "...")
+				break
+			}
+			text = append(text, fmt.Sprintf("%02X", b))
+		}
+		return strings.Join(text, " ")
+	}
+	switch f := frame.(type) {
+	case smiProtocol.ReadRequest:
+		return fmt.Sprintf("READ_REQ   tag=0x%04X opts=0x%02X addr=0x%016X len=%d",
+			f.Tag, f.Options, f.Address, f.Length)
+	case smiProtocol.WriteRequest:
+		return fmt.Sprintf("WRITE_REQ  tag=0x%04X opts=0x%02X addr=0x%016X len=%d data=[%s]",
+			f.Tag, f.Options, f.Address, len(f.Data), formatData(f.Data))
+	case smiProtocol.ReadResponse:
+		return fmt.Sprintf("READ_RESP  tag=0x%04X status=%d ok=%v len=%d data=[%s]",
+			f.Tag, f.Status, f.Ok(), len(f.Data), formatData(f.Data))
+	case smiProtocol.WriteResponse:
+		return fmt.Sprintf("WRITE_RESP tag=0x%04X status=%d ok=%v",
+			f.Tag, f.Status, f.Ok())
+	default:
+		return fmt.Sprintf("%+v", frame)
+	}
+}
@@ -0,0 +1,189 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+package smiVcd
+
+import (
+	"github.com/ReconfigureIO/smi/go-template/src/smiProtocol"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+//
+// Specifies the VCD fixture, which contains a request link and a response
+// link with 64-bit flits. The request link includes one valid stall and one
+// stall during which the flit data is changed.
+//
+const testVcdFileName = "testdata/smiLinks.vcd"
+
+//
+// Opens the VCD fixture and parses the header.
+//
+func openTestVcd(t *testing.T) (*VcdReader, func()) {
+	file, err := os.Open(testVcdFileName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := NewVcdReader(file)
+	if err != nil {
+		file.Close()
+		t.Fatal(err)
+	}
+	return reader, func() { file.Close() }
+}
+
+//
+// Tests that the VCD header declarations and value changes are parsed.
+//
+func TestVcdReader(t *testing.T) {
+	reader, done := openTestVcd(t)
+	defer done()
+
+	if reader.Timescale != "1ns" {
+		t.Errorf("timescale %q, expected \"1ns\"", reader.Timescale)
+	}
+	expected := map[string]int{
+		"TOP.clk": 1, "TOP.dut.smiReqReady": 1, "TOP.dut.smiReqEofc": 8,
+		"TOP.dut.smiReqData": 64, "TOP.dut.smiReqStop": 1,
+		"TOP.dut.smiRespReady": 1, "TOP.dut.smiRespEofc": 8,
+		"TOP.dut.smiRespData": 64, "TOP.dut.smiRespStop": 1}
+	if len(reader.Variables) != len(expected) {
+		t.Errorf("%d variables declared, expected %d",
+			len(reader.Variables), len(expected))
+	}
+	for name, width := range expected {
+		variable, ok := reader.Variables[name]
+		if !ok {
+			t.Errorf("variable %s not declared", name)
+		} else if variable.Width != width {
+			t.Errorf("variable %s width %d, expected %d", name, variable.Width, width)
+		}
+	}
+
+	// Check the initial values, including extension of unknown vectors.
+	time, changes, err := reader.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time != 0 || len(changes) != 9 {
+		t.Fatalf("%d initial changes at time %d, expected 9 at time 0",
+			len(changes), time)
+	}
+	dataId := reader.Variables["TOP.dut.smiReqData"].Id
+	for _, change := range changes {
+		if change.Id == dataId && change.Value != strings.Repeat("x", 64) {
+			t.Errorf("initial data value %q, expected all unknown", change.Value)
+		}
+	}
+
+	// Check the remaining timestamps and the end of file indication.
+	lastTime := time
+	numSteps := 1
+	for {
+		time, _, err = reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if time <= lastTime {
+			t.Errorf("timestamp %d follows timestamp %d", time, lastTime)
+		}
+		lastTime = time
+		numSteps++
+	}
+	if lastTime != 110 || numSteps != 23 {
+		t.Errorf("%d timestamps ending at %d, expected 23 ending at 110",
+			numSteps, lastTime)
+	}
+}
+
+//
+// Tests that the frames on the monitored links are decoded and that changing
+// the flit data while stop is asserted is reported as a violation.
+//
+func TestSmiAnalyzer(t *testing.T) {
+	reader, done := openTestVcd(t)
+	defer done()
+
+	links := map[string]SmiLinkSignals{
+		"smiReq":  NewSmiLinkSignals("TOP.dut.smiReq"),
+		"smiResp": NewSmiLinkSignals("TOP.dut.smiResp")}
+	analyzer, err := NewSmiAnalyzer(reader, "TOP.clk", links)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events := make([]SmiLinkEvent, 0)
+	err = analyzer.Run(reader, func(event SmiLinkEvent) {
+		events = append(events, event)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readData := make([]byte, 16)
+	for i := range readData {
+		readData[i] = byte(i)
+	}
+	expected := []SmiLinkEvent{
+		{35, "smiReq", smiProtocol.ReadRequest{
+			Options: smiProtocol.ReadOptDefault, Tag: 0x1234,
+			Address: 0x0FF8, Length: 16}, ""},
+		{65, "smiReq", smiProtocol.WriteRequest{
+			Options: smiProtocol.WriteOptDefault, Tag: 0x0042,
+			Address: 0x1000, Data: []byte{0xDE, 0xAD, 0xBE, 0xEF}}, ""},
+		{65, "smiResp", smiProtocol.ReadResponse{
+			Status: smiProtocol.StatusOkay, Tag: 0x1234, Data: readData}, ""},
+		{75, "smiResp", smiProtocol.WriteResponse{
+			Status: smiProtocol.StatusSlvErr, Tag: 0x0042}, ""},
+		{95, "smiReq", nil, "data changed while stop asserted"},
+		{105, "smiReq", smiProtocol.ReadRequest{
+			Options: smiProtocol.ReadOptDefault, Tag: 0x0008,
+			Address: 0x2000, Length: 8}, ""}}
+	if len(events) != len(expected) {
+		t.Errorf("%d events detected, expected %d", len(events), len(expected))
+	}
+	for i := 0; i < len(events) && i < len(expected); i++ {
+		if !reflect.DeepEqual(events[i], expected[i]) {
+			t.Errorf("event %d: got %+v, expected %+v", i, events[i], expected[i])
+		}
+	}
+}
+
+//
+// Tests that missing signals and unsupported flit widths are rejected.
+//
+func TestSmiAnalyzerInvalidLinks(t *testing.T) {
+	reader, done := openTestVcd(t)
+	defer done()
+
+	invalidLinks := []map[string]SmiLinkSignals{
+		{"missing": NewSmiLinkSignals("TOP.dut.smiMissing")},
+		{"narrow": {"TOP.dut.smiReqReady", "TOP.dut.smiReqEofc",
+			"TOP.dut.smiReqEofc", "TOP.dut.smiReqStop"}}}
+	for i, links := range invalidLinks {
+		if _, err := NewSmiAnalyzer(reader, "TOP.clk", links); err == nil {
+			t.Errorf("invalid link set %d not rejected", i)
+		}
+	}
+	if _, err := NewSmiAnalyzer(reader, "TOP.missingClk", nil); err == nil {
+		t.Errorf("missing clock signal not rejected")
+	}
+}
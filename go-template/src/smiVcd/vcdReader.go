@@ -0,0 +1,232 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+//
+// Package smiVcd provides support for analysing SMI transactions captured in
+// VCD waveform dumps, as generated by Verilator and most other Verilog
+// simulators.
+//
+package smiVcd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+//
+// VcdVariable specifies a single variable declared in the VCD file header.
+// The name is the full hierarchical name, with scope names separated by dots.
+//
+type VcdVariable struct {
+	Name  string // Full hierarchical variable name.
+	Id    string // VCD identifier code.
+	Width int    // Width of the variable in bits.
+}
+
+//
+// VcdChange specifies a single value change. Values are stored as binary
+// strings with the most significant bit first, and may include 'x' and 'z'
+// bits.
+//
+type VcdChange struct {
+	Id    string // VCD identifier code.
+	Value string // New value.
+}
+
+//
+// VcdReader implements streaming access to the contents of a VCD file. The
+// header is parsed on construction and value changes are then read one
+// timestamp at a time.
+//
+type VcdReader struct {
+	Timescale string                  // Timescale declared in the header.
+	Variables map[string]*VcdVariable // Declared variables, indexed by name.
+	widths    map[string]int          // Variable widths, indexed by identifier.
+	scanner   *bufio.Scanner
+	pending   string // Lookahead token.
+	time      uint64 // Current simulation time.
+}
+
+//
+// NewVcdReader creates a new VCD reader for the supplied input stream and
+// parses the VCD file header. Returns the reader and an error item which will
+// be set to 'nil' on successful completion.
+//
+func NewVcdReader(input io.Reader) (*VcdReader, error) {
+	reader := &VcdReader{
+		Variables: make(map[string]*VcdVariable),
+		widths:    make(map[string]int)}
+	reader.scanner = bufio.NewScanner(input)
+	reader.scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	reader.scanner.Split(bufio.ScanWords)
+	return reader, reader.parseHeader()
+}
+
+//
+// Reads the next whitespace delimited token, returning an empty string at the
+// end of the input.
+//
+func (reader *VcdReader) nextToken() string {
+	if reader.pending != "" {
+		token := reader.pending
+		reader.pending = ""
+		return token
+	}
+	if reader.scanner.Scan() {
+		return reader.scanner.Text()
+	}
+	return ""
+}
+
+//
+// Reads the tokens up to the next '$end' keyword.
+//
+func (reader *VcdReader) readToEnd() ([]string, error) {
+	tokens := make([]string, 0)
+	for {
+		token := reader.nextToken()
+		switch token {
+		case "":
+			return tokens, errors.New("Unexpected end of VCD file in header")
+		case "$end":
+			return tokens, nil
+		default:
+			tokens = append(tokens, token)
+		}
+	}
+}
+
+//
+// Parses the VCD header, up to and including the '$enddefinitions' command.
+//
+func (reader *VcdReader) parseHeader() error {
+	scopes := make([]string, 0)
+	for {
+		command := reader.nextToken()
+		if command == "" {
+			return errors.New("VCD file does not contain '$enddefinitions'")
+		}
+		args, err := reader.readToEnd()
+		if err != nil {
+			return err
+		}
+		switch command {
+		case "$timescale":
+			reader.Timescale = strings.Join(args, "")
+		case "$scope":
+			if len(args) < 2 {
+				return errors.New("Invalid VCD '$scope' declaration")
+			}
+			scopes = append(scopes, args[1])
+		case "$upscope":
+			if len(scopes) != 0 {
+				scopes = scopes[:len(scopes)-1]
+			}
+		case "$var":
+			if len(args) < 4 {
+				return errors.New("Invalid VCD '$var' declaration")
+			}
+			width, err := strconv.Atoi(args[1])
+			if err != nil || width < 1 {
+				return errors.New(fmt.Sprintf(
+					"Invalid VCD variable width (%s)", args[1]))
+			}
+			name := strings.Join(append(append([]string{}, scopes...), args[3]), ".")
+			reader.Variables[name] = &VcdVariable{name, args[2], width}
+			reader.widths[args[2]] = width
+		case "$enddefinitions":
+			return nil
+		}
+	}
+}
+
+//
+// Extends a vector value to the full variable width, using the VCD left
+// extension rules.
+//
+func (reader *VcdReader) extendValue(id string, value string) string {
+	width, ok := reader.widths[id]
+	if !ok || len(value) >= width {
+		return value
+	}
+	fill := "0"
+	if value[0] == 'x' || value[0] == 'X' || value[0] == 'z' || value[0] == 'Z' {
+		fill = strings.ToLower(value[:1])
+	}
+	return strings.Repeat(fill, width-len(value)) + value
+}
+
+//
+// Next reads all the value changes for the next simulation timestamp. Returns
+// the timestamp, the list of value changes and an error item which will be set
+// to 'nil' on successful completion. The end of the file is indicated by
+// returning io.EOF.
+//
+func (reader *VcdReader) Next() (uint64, []VcdChange, error) {
+	changes := make([]VcdChange, 0)
+	started := false
+	for {
+		token := reader.nextToken()
+		if token == "" {
+			if !started && len(changes) == 0 {
+				return reader.time, changes, io.EOF
+			}
+			return reader.time, changes, nil
+		}
+		switch token[0] {
+		case '#':
+			time, err := strconv.ParseUint(token[1:], 10, 64)
+			if err != nil {
+				return reader.time, changes, errors.New(fmt.Sprintf(
+					"Invalid VCD timestamp (%s)", token))
+			}
+			if started || len(changes) != 0 {
+				reader.pending = token
+				return reader.time, changes, nil
+			}
+			reader.time = time
+			started = true
+		case '$':
+			// Skip dump control keywords and ignore comments.
+			if token == "$comment" {
+				if _, err := reader.readToEnd(); err != nil {
+					return reader.time, changes, err
+				}
+			}
+		case '0', '1', 'x', 'X', 'z', 'Z':
+			changes = append(changes,
+				VcdChange{token[1:], strings.ToLower(token[:1])})
+		case 'b', 'B', 'r', 'R':
+			id := reader.nextToken()
+			if id == "" {
+				return reader.time, changes, errors.New(
+					"Unexpected end of VCD file in vector value change")
+			}
+			value := strings.ToLower(token[1:])
+			if token[0] == 'b' || token[0] == 'B' {
+				value = reader.extendValue(id, value)
+			}
+			changes = append(changes, VcdChange{id, value})
+		default:
+			return reader.time, changes, errors.New(fmt.Sprintf(
+				"Invalid VCD value change (%s)", token))
+		}
+	}
+}
@@ -0,0 +1,239 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Implements the Verilator testbench for the SMI/AXI memory bus adaptor error
+// handling test (test/verilog/smiAxiAdaptorErrorTest64.v). Burst writes and
+// reads are issued to normal memory and to the error regions configured on
+// the AXI slave model, checking that the smiMemLib done status reports the
+// AXI error responses. The AXI slave model must be started with the matching
+// error regions - see the accompanying Makefile.
+//
+
+#include <cstdio>
+#include <cstdlib>
+#include <stdexcept>
+#include <vector>
+
+#include <unistd.h>
+
+#include "VsmiAxiAdaptorErrorTest64.h"
+#include "verilated.h"
+#include "smiAxiSlaveBridge.h"
+
+//
+// Specifies a single burst write and read back test.
+//
+struct TestCase {
+  uint64_t addr;
+  uint16_t length;
+  bool expectOk;
+  const char* name;
+};
+
+static const TestCase testCases[] = {
+  { 0x00001000, 256, true, "normal memory" },
+  { 0x00100000, 128, false, "SLVERR region" },
+  { 0x00001800, 512, true, "normal memory after SLVERR" },
+  { 0x00200000, 64, false, "DECERR region" },
+  { 0x00002000, 8, true, "normal memory after DECERR" }
+};
+
+static const uint64_t MAX_PHASE_CYCLES = 100000;
+
+//
+// Generates the test data word at a given address.
+//
+static uint64_t testData(uint64_t addr) {
+  return (addr * 0x9E3779B97F4A7C15ULL) ^ 0xA5A5A5A5A5A5A5A5ULL;
+}
+
+//
+// Implements the simulation clocking and AXI bridge connection.
+//
+class Testbench {
+ public:
+  Testbench(VsmiAxiAdaptorErrorTest64* top, SmiAxiSlaveBridge* bridge) :
+      top(top), bridge(bridge), cycle(0) {
+    slave = bridge->reset();
+  }
+
+  // Drives the AXI slave inputs and evaluates the model with the clock low,
+  // so that the testbench can sample the model outputs before the rising
+  // clock edge.
+  void drive() {
+    SMI_AXI_DRIVE_SLAVE(top, axi, slave);
+    top->clk = 0;
+    top->eval();
+  }
+
+  // Evaluates the rising clock edge and clocks the AXI slave model.
+  void clock() {
+    SmiAxiMasterSignals master;
+    SMI_AXI_SAMPLE_MASTER(top, axi, master);
+    top->clk = 1;
+    top->eval();
+    slave = bridge->clock(master);
+    cycle++;
+  }
+
+  VsmiAxiAdaptorErrorTest64* top;
+  SmiAxiSlaveBridge* bridge;
+  SmiAxiSlaveSignals slave;
+  uint64_t cycle;
+};
+
+//
+// Runs a burst write followed by a burst read for a single test case.
+// Returns the number of errors detected.
+//
+static int runTestCase(Testbench& tb, const TestCase& test) {
+  VsmiAxiAdaptorErrorTest64* top = tb.top;
+  int errors = 0;
+  unsigned numWords = (test.length + 7) / 8;
+
+  // Issue the burst write and wait for the done status.
+  bool paramsSent = false;
+  unsigned wordsSent = 0;
+  bool done = false;
+  bool statusOk = false;
+  for (uint64_t i = 0; !done; i++) {
+    if (i == MAX_PHASE_CYCLES) {
+      printf("FAIL: %s write timed out\n", test.name);
+      return errors + 1;
+    }
+    top->wrParamsValid = !paramsSent;
+    top->wrParamBurstAddr = test.addr;
+    top->wrParamBurstLen = test.length;
+    top->writeValid = (wordsSent < numWords);
+    top->writeData = testData(test.addr + 8 * wordsSent);
+    top->wrDoneStop = 0;
+    tb.drive();
+    if (top->wrParamsValid && !top->wrParamsStop)
+      paramsSent = true;
+    if (top->writeValid && !top->writeStop)
+      wordsSent++;
+    if (top->wrDoneValid) {
+      done = true;
+      statusOk = top->wrDoneStatusOk;
+    }
+    tb.clock();
+  }
+  if (statusOk != test.expectOk) {
+    printf("FAIL: %s write status %s, expected %s\n", test.name,
+        statusOk ? "ok" : "error", test.expectOk ? "ok" : "error");
+    errors++;
+  }
+
+  // Issue the burst read and wait for the done status, checking the read
+  // data for successful transfers.
+  paramsSent = false;
+  unsigned wordsRead = 0;
+  done = false;
+  for (uint64_t i = 0; !done; i++) {
+    if (i == MAX_PHASE_CYCLES) {
+      printf("FAIL: %s read timed out\n", test.name);
+      return errors + 1;
+    }
+    top->rdParamsValid = !paramsSent;
+    top->rdParamBurstAddr = test.addr;
+    top->rdParamBurstLen = test.length;
+    top->readStop = 0;
+    top->rdDoneStop = 0;
+    tb.drive();
+    if (top->rdParamsValid && !top->rdParamsStop)
+      paramsSent = true;
+    if (top->readValid) {
+      uint64_t expected = testData(test.addr + 8 * wordsRead);
+      if (test.expectOk && top->readData != expected) {
+        printf("FAIL: %s read data mismatch at word %u (0x%016llX, expected 0x%016llX)\n",
+            test.name, wordsRead, (unsigned long long) top->readData,
+            (unsigned long long) expected);
+        errors++;
+      }
+      wordsRead++;
+    }
+    if (top->rdDoneValid) {
+      done = true;
+      statusOk = top->rdDoneStatusOk;
+    }
+    tb.clock();
+  }
+  if (statusOk != test.expectOk) {
+    printf("FAIL: %s read status %s, expected %s\n", test.name,
+        statusOk ? "ok" : "error", test.expectOk ? "ok" : "error");
+    errors++;
+  }
+  if (test.expectOk && wordsRead != numWords) {
+    printf("FAIL: %s read %u words, expected %u\n", test.name, wordsRead, numWords);
+    errors++;
+  }
+  if (errors == 0)
+    printf("PASS: %s\n", test.name);
+  return errors;
+}
+
+//
+// Connects to the AXI slave model, retrying while the model starts up.
+//
+static SmiAxiSlaveBridge* connectBridge(const char* socketPath) {
+  for (int retry = 0; ; retry++) {
+    try {
+      return new SmiAxiSlaveBridge(socketPath, 8);
+    } catch (const std::runtime_error& err) {
+      if (retry == 50)
+        throw;
+      usleep(100000);
+    }
+  }
+}
+
+int main(int argc, char** argv) {
+  Verilated::commandArgs(argc, argv);
+  if (argc < 2) {
+    fprintf(stderr, "Usage: %s <axi slave socket>\n", argv[0]);
+    return 2;
+  }
+  VsmiAxiAdaptorErrorTest64* top = new VsmiAxiAdaptorErrorTest64;
+  SmiAxiSlaveBridge* bridge = connectBridge(argv[1]);
+  Testbench tb(top, bridge);
+
+  // Apply the synchronous reset with all testbench inputs idle.
+  top->wrParamsValid = 0;
+  top->writeValid = 0;
+  top->wrDoneStop = 0;
+  top->rdParamsValid = 0;
+  top->readStop = 0;
+  top->rdDoneStop = 0;
+  top->srst = 1;
+  for (int i = 0; i < 16; i++) {
+    tb.drive();
+    tb.clock();
+  }
+  top->srst = 0;
+
+  int errors = 0;
+  for (size_t i = 0; i < sizeof(testCases) / sizeof(testCases[0]); i++)
+    errors += runTestCase(tb, testCases[i]);
+
+  bridge->finish();
+  delete bridge;
+  top->final();
+  delete top;
+  printf("%s: %d errors after %llu cycles\n", (errors == 0) ? "PASSED" : "FAILED",
+      errors, (unsigned long long) tb.cycle);
+  return (errors == 0) ? 0 : 1;
+}
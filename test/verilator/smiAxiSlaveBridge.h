@@ -0,0 +1,307 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Implements the testbench side of the AXI slave model bridge, allowing the
+// AXI master interface of a Verilator simulation model to be connected to the
+// Go AXI slave model (cmd/smiAxiSlave). The bridge exchanges one record in
+// each direction per clock cycle and may run over a Unix domain socket or over
+// a pair of pipes to a child process. The record format is described in
+// go-template/src/smiAxiModel/smiAxiBridge.go.
+//
+
+#ifndef SMI_AXI_SLAVE_BRIDGE_H
+#define SMI_AXI_SLAVE_BRIDGE_H
+
+#include <algorithm>
+#include <cstdint>
+#include <cstring>
+#include <stdexcept>
+#include <string>
+#include <vector>
+
+#include <sys/socket.h>
+#include <sys/un.h>
+#include <unistd.h>
+
+//
+// Specifies the bridge record types and fixed record sizes.
+//
+enum SmiAxiBridgeMsgType {
+  SMI_AXI_BRIDGE_MSG_CYCLE = 0x11,
+  SMI_AXI_BRIDGE_MSG_CLOSE = 0x13,
+  SMI_AXI_BRIDGE_MSG_SLAVE = 0x91
+};
+
+static const size_t SMI_AXI_BRIDGE_MASTER_SIZE = 40;
+static const size_t SMI_AXI_BRIDGE_SLAVE_SIZE = 8;
+
+//
+// Specifies the AXI master outputs sampled on a rising clock edge. Data uses
+// little endian byte lane ordering.
+//
+struct SmiAxiMasterSignals {
+  bool arValid;
+  uint16_t arId;
+  uint64_t arAddr;
+  uint8_t arLen;
+  uint8_t arSize;
+  bool rReady;
+  bool awValid;
+  uint16_t awId;
+  uint64_t awAddr;
+  uint8_t awLen;
+  uint8_t awSize;
+  bool wValid;
+  std::vector<uint8_t> wData;
+  uint64_t wStrb;
+  bool wLast;
+  bool bReady;
+};
+
+//
+// Specifies the AXI slave outputs to be driven during a clock cycle.
+//
+struct SmiAxiSlaveSignals {
+  bool arReady;
+  bool rValid;
+  uint16_t rId;
+  std::vector<uint8_t> rData;
+  uint8_t rResp;
+  bool rLast;
+  bool awReady;
+  bool wReady;
+  bool bValid;
+  uint16_t bId;
+  uint8_t bResp;
+};
+
+//
+// Implements the cycle level bridge connection to the AXI slave model. The
+// data width is specified in bytes and must match the slave model setting.
+//
+class SmiAxiSlaveBridge {
+ public:
+
+  // Connects to an AXI slave model listening on the specified Unix socket.
+  SmiAxiSlaveBridge(const std::string& socketPath, size_t dataWidth) :
+      dataWidth(dataWidth) {
+    struct sockaddr_un addr;
+    if (socketPath.size() >= sizeof(addr.sun_path))
+      throw std::runtime_error("AXI bridge socket path too long");
+    int fd = socket(AF_UNIX, SOCK_STREAM, 0);
+    if (fd < 0)
+      throw std::runtime_error("Failed to create AXI bridge socket");
+    memset(&addr, 0, sizeof(addr));
+    addr.sun_family = AF_UNIX;
+    strncpy(addr.sun_path, socketPath.c_str(), sizeof(addr.sun_path) - 1);
+    if (connect(fd, (struct sockaddr*) &addr, sizeof(addr)) != 0) {
+      close(fd);
+      throw std::runtime_error("Failed to connect to AXI bridge socket " + socketPath);
+    }
+    readFd = fd;
+    writeFd = fd;
+  }
+
+  // Uses existing file descriptors, such as pipes to a child process.
+  SmiAxiSlaveBridge(int readFd, int writeFd, size_t dataWidth) :
+      readFd(readFd), writeFd(writeFd), dataWidth(dataWidth) {}
+
+  ~SmiAxiSlaveBridge() {
+    if (readFd >= 0) {
+      finish();
+    }
+  }
+
+  // Returns the slave outputs which should be driven after reset.
+  SmiAxiSlaveSignals reset() const {
+    SmiAxiSlaveSignals slave = SmiAxiSlaveSignals();
+    slave.rData.assign(dataWidth, 0);
+    return slave;
+  }
+
+  // Passes the master outputs sampled on the rising clock edge to the slave
+  // model and returns the slave outputs for the next clock cycle.
+  SmiAxiSlaveSignals clock(const SmiAxiMasterSignals& master) {
+    std::vector<uint8_t> record(SMI_AXI_BRIDGE_MASTER_SIZE + dataWidth, 0);
+    record[0] = SMI_AXI_BRIDGE_MSG_CYCLE;
+    record[1] = (master.arValid ? 0x01 : 0) | (master.rReady ? 0x02 : 0) |
+        (master.awValid ? 0x04 : 0) | (master.wValid ? 0x08 : 0) |
+        (master.wLast ? 0x10 : 0) | (master.bReady ? 0x20 : 0);
+    record[2] = master.arLen;
+    record[3] = master.arSize;
+    record[4] = master.awLen;
+    record[5] = master.awSize;
+    putLe(&record[8], master.arId, 2);
+    putLe(&record[10], master.awId, 2);
+    putLe(&record[16], master.arAddr, 8);
+    putLe(&record[24], master.awAddr, 8);
+    putLe(&record[32], master.wStrb, 8);
+    std::copy(master.wData.begin(),
+        master.wData.begin() + std::min(master.wData.size(), dataWidth),
+        record.begin() + SMI_AXI_BRIDGE_MASTER_SIZE);
+    writeBytes(record.data(), record.size());
+
+    std::vector<uint8_t> reply(SMI_AXI_BRIDGE_SLAVE_SIZE + dataWidth);
+    readBytes(reply.data(), reply.size());
+    if (reply[0] != SMI_AXI_BRIDGE_MSG_SLAVE)
+      throw std::runtime_error("Invalid AXI bridge record type");
+    SmiAxiSlaveSignals slave;
+    slave.arReady = (reply[1] & 0x01) != 0;
+    slave.rValid = (reply[1] & 0x02) != 0;
+    slave.rLast = (reply[1] & 0x04) != 0;
+    slave.awReady = (reply[1] & 0x08) != 0;
+    slave.wReady = (reply[1] & 0x10) != 0;
+    slave.bValid = (reply[1] & 0x20) != 0;
+    slave.rResp = reply[2];
+    slave.bResp = reply[3];
+    slave.rId = (uint16_t) getLe(&reply[4], 2);
+    slave.bId = (uint16_t) getLe(&reply[6], 2);
+    slave.rData.assign(reply.begin() + SMI_AXI_BRIDGE_SLAVE_SIZE, reply.end());
+    return slave;
+  }
+
+  // Signals the end of the simulation and closes the connection.
+  void finish() {
+    uint8_t record = SMI_AXI_BRIDGE_MSG_CLOSE;
+    writeBytes(&record, 1);
+    close(readFd);
+    if (writeFd != readFd)
+      close(writeFd);
+    readFd = -1;
+    writeFd = -1;
+  }
+
+ private:
+  int readFd;
+  int writeFd;
+  size_t dataWidth;
+
+  static uint64_t getLe(const uint8_t* bytes, int count) {
+    uint64_t value = 0;
+    for (int i = count - 1; i >= 0; i--)
+      value = (value << 8) | bytes[i];
+    return value;
+  }
+
+  static void putLe(uint8_t* bytes, uint64_t value, int count) {
+    for (int i = 0; i < count; i++) {
+      bytes[i] = (uint8_t) value;
+      value >>= 8;
+    }
+  }
+
+  void readBytes(uint8_t* bytes, size_t count) {
+    while (count != 0) {
+      ssize_t n = read(readFd, bytes, count);
+      if (n <= 0)
+        throw std::runtime_error("AXI bridge connection closed by slave model");
+      bytes += n;
+      count -= n;
+    }
+  }
+
+  void writeBytes(const uint8_t* bytes, size_t count) {
+    while (count != 0) {
+      ssize_t n = write(writeFd, bytes, count);
+      if (n <= 0)
+        throw std::runtime_error("AXI bridge connection closed by slave model");
+      bytes += n;
+      count -= n;
+    }
+  }
+};
+
+//
+// Converts between 64-bit Verilator data signals and byte lane vectors.
+//
+inline std::vector<uint8_t> smiAxiDataBytes(uint64_t data) {
+  std::vector<uint8_t> bytes(8);
+  for (int i = 0; i < 8; i++)
+    bytes[i] = (uint8_t) (data >> (8 * i));
+  return bytes;
+}
+
+inline uint64_t smiAxiDataWord(const std::vector<uint8_t>& bytes) {
+  uint64_t data = 0;
+  for (int i = std::min<int>(8, bytes.size()) - 1; i >= 0; i--)
+    data = (data << 8) | bytes[i];
+  return data;
+}
+
+//
+// Converts between wide Verilator data signals, which are represented as
+// arrays of 32-bit words, and byte lane vectors.
+//
+inline std::vector<uint8_t> smiAxiDataBytes(const uint32_t* words, size_t numWords) {
+  std::vector<uint8_t> bytes(numWords * 4);
+  for (size_t i = 0; i < bytes.size(); i++)
+    bytes[i] = (uint8_t) (words[i / 4] >> (8 * (i % 4)));
+  return bytes;
+}
+
+inline void smiAxiDataWords(const std::vector<uint8_t>& bytes, uint32_t* words,
+    size_t numWords) {
+  for (size_t i = 0; i < numWords; i++) {
+    words[i] = 0;
+    for (size_t j = 0; j < 4 && 4 * i + j < bytes.size(); j++)
+      words[i] |= ((uint32_t) bytes[4 * i + j]) << (8 * j);
+  }
+}
+
+//
+// Samples the AXI master outputs of a Verilator model with up to 64-bit data,
+// where the signal names are formed from a common prefix followed by the
+// standard AXI signal names (for example 'axiARValid').
+//
+#define SMI_AXI_SAMPLE_MASTER(top, prefix, master) do { \
+    (master).arValid = (top)->prefix##ARValid;          \
+    (master).arId = (top)->prefix##ARId;                \
+    (master).arAddr = (top)->prefix##ARAddr;            \
+    (master).arLen = (top)->prefix##ARLen;              \
+    (master).arSize = (top)->prefix##ARSize;            \
+    (master).rReady = (top)->prefix##RReady;            \
+    (master).awValid = (top)->prefix##AWValid;          \
+    (master).awId = (top)->prefix##AWId;                \
+    (master).awAddr = (top)->prefix##AWAddr;            \
+    (master).awLen = (top)->prefix##AWLen;              \
+    (master).awSize = (top)->prefix##AWSize;            \
+    (master).wValid = (top)->prefix##WValid;            \
+    (master).wData = smiAxiDataBytes((top)->prefix##WData); \
+    (master).wStrb = (top)->prefix##WStrb;              \
+    (master).wLast = (top)->prefix##WLast;              \
+    (master).bReady = (top)->prefix##BReady;            \
+  } while (0)
+
+//
+// Drives the AXI slave inputs of a Verilator model with up to 64-bit data,
+// using the same signal naming convention.
+//
+#define SMI_AXI_DRIVE_SLAVE(top, prefix, slave) do {   \
+    (top)->prefix##ARReady = (slave).arReady;          \
+    (top)->prefix##RValid = (slave).rValid;            \
+    (top)->prefix##RId = (slave).rId;                  \
+    (top)->prefix##RData = smiAxiDataWord((slave).rData); \
+    (top)->prefix##RResp = (slave).rResp;              \
+    (top)->prefix##RLast = (slave).rLast;              \
+    (top)->prefix##AWReady = (slave).awReady;          \
+    (top)->prefix##WReady = (slave).wReady;            \
+    (top)->prefix##BValid = (slave).bValid;            \
+    (top)->prefix##BId = (slave).bId;                  \
+    (top)->prefix##BResp = (slave).bResp;              \
+  } while (0)
+
+#endif
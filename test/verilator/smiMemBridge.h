@@ -0,0 +1,265 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Implements the testbench side of the SMI memory server bridge, allowing the
+// 64-bit SMI memory ports of a Verilator simulation model to be connected to
+// the Go memory server (cmd/smiMemServer). The bridge may run over a Unix
+// domain socket or over a pair of pipes to a child server process. The
+// message format is described in go-template/src/smiMemServer/smiMemBridge.go.
+//
+
+#ifndef SMI_MEM_BRIDGE_H
+#define SMI_MEM_BRIDGE_H
+
+#include <algorithm>
+#include <cstdint>
+#include <cstring>
+#include <deque>
+#include <stdexcept>
+#include <string>
+#include <vector>
+
+#include <sys/socket.h>
+#include <sys/un.h>
+#include <unistd.h>
+
+//
+// Specifies the bridge message types and header size.
+//
+enum SmiBridgeMsgType {
+  SMI_BRIDGE_MSG_REQUEST  = 0x01,
+  SMI_BRIDGE_MSG_TICK     = 0x02,
+  SMI_BRIDGE_MSG_CLOSE    = 0x03,
+  SMI_BRIDGE_MSG_RESPONSE = 0x81,
+  SMI_BRIDGE_MSG_TICK_ACK = 0x82
+};
+
+static const size_t SMI_BRIDGE_HEADER_SIZE = 16;
+
+//
+// Specifies a single response frame received from the memory server.
+//
+struct SmiBridgeResponse {
+  uint8_t port;
+  uint64_t cycle;
+  std::vector<uint8_t> frame;
+};
+
+//
+// Implements the message level bridge connection to the memory server.
+//
+class SmiMemBridge {
+ public:
+
+  // Connects to a memory server listening on the specified Unix socket.
+  explicit SmiMemBridge(const std::string& socketPath) {
+    struct sockaddr_un addr;
+    if (socketPath.size() >= sizeof(addr.sun_path))
+      throw std::runtime_error("SMI bridge socket path too long");
+    int fd = socket(AF_UNIX, SOCK_STREAM, 0);
+    if (fd < 0)
+      throw std::runtime_error("Failed to create SMI bridge socket");
+    memset(&addr, 0, sizeof(addr));
+    addr.sun_family = AF_UNIX;
+    strncpy(addr.sun_path, socketPath.c_str(), sizeof(addr.sun_path) - 1);
+    if (connect(fd, (struct sockaddr*) &addr, sizeof(addr)) != 0) {
+      close(fd);
+      throw std::runtime_error("Failed to connect to SMI bridge socket " + socketPath);
+    }
+    readFd = fd;
+    writeFd = fd;
+  }
+
+  // Uses existing file descriptors, such as pipes to a child process.
+  SmiMemBridge(int readFd, int writeFd) : readFd(readFd), writeFd(writeFd) {}
+
+  ~SmiMemBridge() {
+    if (readFd >= 0) {
+      finish();
+    }
+  }
+
+  // Passes a complete request frame received on an SMI port to the server.
+  void request(uint8_t port, uint64_t cycle, const std::vector<uint8_t>& frame) {
+    writeMessage(SMI_BRIDGE_MSG_REQUEST, port, cycle, frame);
+  }
+
+  // Collects all responses which become available on or before the specified
+  // clock cycle.
+  void tick(uint64_t cycle, std::vector<SmiBridgeResponse>& responses) {
+    writeMessage(SMI_BRIDGE_MSG_TICK, 0, cycle, std::vector<uint8_t>());
+    while (true) {
+      uint8_t header[SMI_BRIDGE_HEADER_SIZE];
+      readBytes(header, SMI_BRIDGE_HEADER_SIZE);
+      uint32_t length = getLe(header + 4, 4);
+      SmiBridgeResponse response;
+      response.port = header[1];
+      response.cycle = getLe(header + 8, 8);
+      response.frame.resize(length);
+      if (length != 0)
+        readBytes(response.frame.data(), length);
+      if (header[0] == SMI_BRIDGE_MSG_TICK_ACK)
+        break;
+      if (header[0] != SMI_BRIDGE_MSG_RESPONSE)
+        throw std::runtime_error("Invalid SMI bridge message type");
+      responses.push_back(response);
+    }
+  }
+
+  // Signals the end of the simulation and closes the connection.
+  void finish() {
+    writeMessage(SMI_BRIDGE_MSG_CLOSE, 0, 0, std::vector<uint8_t>());
+    close(readFd);
+    if (writeFd != readFd)
+      close(writeFd);
+    readFd = -1;
+    writeFd = -1;
+  }
+
+ private:
+  int readFd;
+  int writeFd;
+
+  static uint64_t getLe(const uint8_t* bytes, int count) {
+    uint64_t value = 0;
+    for (int i = count - 1; i >= 0; i--)
+      value = (value << 8) | bytes[i];
+    return value;
+  }
+
+  static void putLe(uint8_t* bytes, uint64_t value, int count) {
+    for (int i = 0; i < count; i++) {
+      bytes[i] = (uint8_t) value;
+      value >>= 8;
+    }
+  }
+
+  void readBytes(uint8_t* bytes, size_t count) {
+    while (count != 0) {
+      ssize_t n = read(readFd, bytes, count);
+      if (n <= 0)
+        throw std::runtime_error("SMI bridge connection closed by server");
+      bytes += n;
+      count -= n;
+    }
+  }
+
+  void writeMessage(uint8_t type, uint8_t port, uint64_t cycle,
+      const std::vector<uint8_t>& payload) {
+    std::vector<uint8_t> message(SMI_BRIDGE_HEADER_SIZE + payload.size(), 0);
+    message[0] = type;
+    message[1] = port;
+    putLe(&message[4], payload.size(), 4);
+    putLe(&message[8], cycle, 8);
+    std::copy(payload.begin(), payload.end(), message.begin() + SMI_BRIDGE_HEADER_SIZE);
+    const uint8_t* bytes = message.data();
+    size_t count = message.size();
+    while (count != 0) {
+      ssize_t n = write(writeFd, bytes, count);
+      if (n <= 0)
+        throw std::runtime_error("SMI bridge connection closed by server");
+      bytes += n;
+      count -= n;
+    }
+  }
+};
+
+//
+// Implements the flit level interface for a single 64-bit SMI memory port,
+// acting as the server end of the port's request and response links. The
+// 'drive' method should be called to set the model inputs before each rising
+// clock edge and the 'sample' method should be called with the model outputs
+// immediately before evaluating the rising clock edge.
+//
+class SmiMemPort {
+ public:
+  SmiMemPort(uint8_t port) : port(port) {}
+
+  // Sets the response link outputs and request link flow control.
+  void drive(uint8_t& reqStop, uint8_t& respReady, uint8_t& respEofc,
+      uint64_t& respData) {
+    reqStop = 0;
+    if (respFlits.empty()) {
+      respReady = 0;
+      respEofc = 0;
+      respData = 0;
+    } else {
+      respReady = 1;
+      respEofc = respFlits.front().eofc;
+      respData = respFlits.front().data;
+    }
+  }
+
+  // Samples the request link inputs and response link flow control on the
+  // rising clock edge. Complete request frames are forwarded to the bridge.
+  void sample(SmiMemBridge& bridge, uint64_t cycle, uint8_t reqReady,
+      uint8_t reqEofc, uint64_t reqData, uint8_t respReady, uint8_t respStop) {
+    if (reqReady) {
+      int count = (reqEofc == 0) ? 8 : reqEofc;
+      for (int i = 0; i < count; i++)
+        reqFrame.push_back((uint8_t) (reqData >> (8 * i)));
+      if (reqEofc != 0) {
+        bridge.request(port, cycle, reqFrame);
+        reqFrame.clear();
+      }
+    }
+    if (respReady && !respStop && !respFlits.empty())
+      respFlits.pop_front();
+  }
+
+  // Queues a response frame for transfer over the response link.
+  void respond(const std::vector<uint8_t>& frame) {
+    for (size_t i = 0; i < frame.size(); i += 8) {
+      Flit flit = { 0, 0 };
+      size_t count = std::min<size_t>(8, frame.size() - i);
+      for (size_t j = 0; j < count; j++)
+        flit.data |= ((uint64_t) frame[i + j]) << (8 * j);
+      if (i + count == frame.size())
+        flit.eofc = (uint8_t) count;
+      respFlits.push_back(flit);
+    }
+  }
+
+  // Indicates whether the port has any partially transferred frames.
+  bool idle() const {
+    return reqFrame.empty() && respFlits.empty();
+  }
+
+ private:
+  struct Flit {
+    uint8_t eofc;
+    uint64_t data;
+  };
+  uint8_t port;
+  std::vector<uint8_t> reqFrame;
+  std::deque<Flit> respFlits;
+};
+
+//
+// Distributes the responses collected from the bridge to the SMI ports.
+//
+inline void smiMemBridgeDispatch(std::vector<SmiBridgeResponse>& responses,
+    std::vector<SmiMemPort>& ports) {
+  for (size_t i = 0; i < responses.size(); i++) {
+    if (responses[i].port >= ports.size())
+      throw std::runtime_error("SMI bridge response for invalid port");
+    ports[responses[i].port].respond(responses[i].frame);
+  }
+  responses.clear();
+}
+
+#endif
@@ -0,0 +1,176 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Provides a Verilator test harness for the 64-bit SMI/AXI memory bus adaptor.
+// A single burst read and a single burst write library component share the
+// adaptor via a two-way transaction arbiter. The AXI master interface is
+// connected to the Go AXI slave model (cmd/smiAxiSlave) by the testbench,
+// allowing error responses from the AXI slave to be checked against the
+// done status outputs of the library components.
+//
+
+`timescale 1ns/1ps
+
+module smiAxiAdaptorErrorTest64
+  (wrParamsValid, wrParamBurstAddr, wrParamBurstLen, wrParamsStop, writeValid,
+  writeData, writeStop, wrDoneValid, wrDoneStatusOk, wrDoneStop, rdParamsValid,
+  rdParamBurstAddr, rdParamBurstLen, rdParamsStop, readValid, readData,
+  readStop, rdDoneValid, rdDoneStatusOk, rdDoneStop, axiARValid, axiARReady,
+  axiARId, axiARAddr, axiARLen, axiARSize, axiRValid, axiRReady, axiRId,
+  axiRData, axiRResp, axiRLast, axiAWValid, axiAWReady, axiAWId, axiAWAddr,
+  axiAWLen, axiAWSize, axiWValid, axiWReady, axiWData, axiWStrb, axiWLast,
+  axiBValid, axiBReady, axiBId, axiBResp, clk, srst);
+
+// Specifies the width of the AXI ID signals.
+parameter AxiIdWidth = 2;
+
+// Specify write burst parameter inputs.
+input        wrParamsValid;
+input [63:0] wrParamBurstAddr;
+input [15:0] wrParamBurstLen;
+output       wrParamsStop;
+
+// Specify write data inputs.
+input        writeValid;
+input [63:0] writeData;
+output       writeStop;
+
+// Specify write done outputs.
+output wrDoneValid;
+output wrDoneStatusOk;
+input  wrDoneStop;
+
+// Specify read burst parameter inputs.
+input        rdParamsValid;
+input [63:0] rdParamBurstAddr;
+input [15:0] rdParamBurstLen;
+output       rdParamsStop;
+
+// Specify read data outputs.
+output        readValid;
+output [63:0] readData;
+input         readStop;
+
+// Specify read done outputs.
+output rdDoneValid;
+output rdDoneStatusOk;
+input  rdDoneStop;
+
+// Specifies the AXI read address and data signals.
+output                  axiARValid;
+input                   axiARReady;
+output [AxiIdWidth-1:0] axiARId;
+output [63:0]           axiARAddr;
+output [7:0]            axiARLen;
+output [2:0]            axiARSize;
+
+input                  axiRValid;
+output                 axiRReady;
+input [AxiIdWidth-1:0] axiRId;
+input [63:0]           axiRData;
+input [1:0]            axiRResp;
+input                  axiRLast;
+
+// Specifies the AXI write address, data and response signals.
+output                  axiAWValid;
+input                   axiAWReady;
+output [AxiIdWidth-1:0] axiAWId;
+output [63:0]           axiAWAddr;
+output [7:0]            axiAWLen;
+output [2:0]            axiAWSize;
+
+output        axiWValid;
+input         axiWReady;
+output [63:0] axiWData;
+output [7:0]  axiWStrb;
+output        axiWLast;
+
+input                  axiBValid;
+output                 axiBReady;
+input [AxiIdWidth-1:0] axiBId;
+input [1:0]            axiBResp;
+
+// System level signals.
+input clk;
+input srst;
+
+// Specifies internal SMI memory bus signals.
+wire        smiWrReqReady;
+wire [7:0]  smiWrReqEofc;
+wire [63:0] smiWrReqData;
+wire        smiWrReqStop;
+wire        smiWrRespReady;
+wire [7:0]  smiWrRespEofc;
+wire [63:0] smiWrRespData;
+wire        smiWrRespStop;
+
+wire        smiRdReqReady;
+wire [7:0]  smiRdReqEofc;
+wire [63:0] smiRdReqData;
+wire        smiRdReqStop;
+wire        smiRdRespReady;
+wire [7:0]  smiRdRespEofc;
+wire [63:0] smiRdRespData;
+wire        smiRdRespStop;
+
+wire        smiReqReady;
+wire [7:0]  smiReqEofc;
+wire [63:0] smiReqData;
+wire        smiReqStop;
+wire        smiRespReady;
+wire [7:0]  smiRespEofc;
+wire [63:0] smiRespData;
+wire        smiRespStop;
+
+// Unused AXI signals.
+wire [3:0]            axiARCache;
+wire [3:0]            axiAWCache;
+wire [AxiIdWidth-1:0] axiWId;
+
+// Instantiate the burst write library component.
+smiMemLibWriteBurstSingle64 burstWriter
+  (wrParamsValid, wrParamBurstAddr, wrParamBurstLen, 8'h00, wrParamsStop,
+  writeValid, writeData, writeStop, wrDoneValid, wrDoneStatusOk, wrDoneStop,
+  smiWrReqReady, smiWrReqEofc, smiWrReqData, smiWrReqStop, smiWrRespReady,
+  smiWrRespEofc, smiWrRespData, smiWrRespStop, clk, srst);
+
+// Instantiate the burst read library component.
+smiMemLibReadBurstSingle64 burstReader
+  (rdParamsValid, rdParamBurstAddr, rdParamBurstLen, 8'h00, rdParamsStop,
+  readValid, readData, readStop, rdDoneValid, rdDoneStatusOk, rdDoneStop,
+  smiRdReqReady, smiRdReqEofc, smiRdReqData, smiRdReqStop, smiRdRespReady,
+  smiRdRespEofc, smiRdRespData, smiRdRespStop, clk, srst);
+
+// Instantiate two-way SMI transaction arbiter.
+smiTransactionArbiterX2 #(8, 2, 64, 4) transactionArbiter
+  (smiWrReqReady, smiWrReqEofc, smiWrReqData, smiWrReqStop,
+  smiWrRespReady, smiWrRespEofc, smiWrRespData, smiWrRespStop,
+  smiRdReqReady, smiRdReqEofc, smiRdReqData, smiRdReqStop,
+  smiRdRespReady, smiRdRespEofc, smiRdRespData, smiRdRespStop,
+  smiReqReady, smiReqEofc, smiReqData, smiReqStop, smiRespReady, smiRespEofc,
+  smiRespData, smiRespStop, clk, srst);
+
+// Instantiate the SMI/AXI bus adapter.
+smiAxiMemBusAdaptor #(3, AxiIdWidth) smiAxiMemBusAdaptor
+  (smiReqReady, smiReqEofc, smiReqData, smiReqStop, smiRespReady, smiRespEofc,
+  smiRespData, smiRespStop, axiARValid, axiARReady, axiARId, axiARAddr,
+  axiARLen, axiARSize, axiARCache, axiRValid, axiRReady, axiRId, axiRData,
+  axiRResp, axiRLast, axiAWValid, axiAWReady, axiAWId, axiAWAddr, axiAWLen,
+  axiAWSize, axiAWCache, axiWValid, axiWReady, axiWId, axiWData, axiWStrb,
+  axiWLast, axiBValid, axiBReady, axiBId, axiBResp, srst, clk, srst);
+
+endmodule
@@ -0,0 +1,429 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+//
+// Implementation of the scalable memory interface (SMI) to Intel Avalon-MM
+// bus adaptor. This reuses the standard SMI to AXI adaptor and then converts
+// the resulting AXI transactions to pipelined Avalon-MM bursts. Avalon-MM
+// addresses are byte addresses and burst counts are expressed in data words.
+// Since Avalon-MM read data can not be stalled, read commands are only issued
+// when there is sufficient space in the read data FIFO to accept the entire
+// burst. Read responses are always returned in order, so the AXI read IDs are
+// tracked using a simple ID FIFO. The optional Avalon-MM response status is
+// forwarded with the read data and, when write responses are enabled, with
+// the write response for each burst. Avalon-MM response codes use the same
+// encoding as AXI, so they are passed through unchanged. If the Avalon-MM
+// slave does not provide a response signal it should be tied to 2'b00.
+//
+
+`timescale 1ns/1ps
+
+module smiAvmMemBusAdaptor
+  (smiReqReady, smiReqEofc, smiReqData, smiReqStop, smiRespReady, smiRespEofc,
+  smiRespData, smiRespStop, avmAddress, avmBurstCount, avmRead, avmWrite,
+  avmWriteData, avmByteEnable, avmWaitRequest, avmReadData, avmReadDataValid,
+  avmResponse, avmWriteResponseValid, avmReset, clk, srst);
+
+// Specifies the number of bits required to address individual bytes within the
+// Avalon-MM data signal. This also determines the width of the data signal.
+// Valid range is from 3 to 6 for data widths of 64 to 512 inclusive.
+parameter DataIndexSize = 3;
+
+// Specifies the width of the internal AXI ID signal. This determines the
+// number of read transactions which may be 'in flight' through the adaptor at
+// any given time.
+parameter AxiIdWidth = 4;
+
+// Specifies the internal FIFO depths (between 3 and 128 entries).
+parameter FifoSize = 16;
+
+// Specifies whether the Avalon-MM slave generates write responses. When set,
+// the AXI write response for each burst is only generated once the Avalon-MM
+// write response has been received. Otherwise writes are treated as posted
+// and always complete with an 'OKAY' status.
+parameter WriteResponseEnable = 0;
+
+// Derives the flit width of the data input and output ports. Minimum 8 bytes.
+parameter FlitWidth = (1 << DataIndexSize);
+
+// Specifies the size of the read data FIFO. This must be able to hold at least
+// one maximum length (256 word) burst.
+parameter ReadFifoSize = 512;
+
+// Specifies the read data FIFO index size, which should be capable of holding
+// the binary representation of ReadFifoSize-1.
+parameter ReadFifoIndexSize = 9;
+
+// Specifies the clock and active high synchronous reset signals.
+input clk;
+input srst;
+input avmReset;
+
+// Specifies the 'upstream' combined read and write ports.
+input                   smiReqReady;
+input [7:0]             smiReqEofc;
+input [FlitWidth*8-1:0] smiReqData;
+output                  smiReqStop;
+
+output                   smiRespReady;
+output [7:0]             smiRespEofc;
+output [FlitWidth*8-1:0] smiRespData;
+input                    smiRespStop;
+
+// Specifies the 'downstream' Avalon-MM command ports.
+output [63:0]            avmAddress;
+output [8:0]             avmBurstCount;
+output                   avmRead;
+output                   avmWrite;
+output [FlitWidth*8-1:0] avmWriteData;
+output [FlitWidth-1:0]   avmByteEnable;
+input                    avmWaitRequest;
+
+// Specifies the 'downstream' Avalon-MM read and write response ports.
+input [FlitWidth*8-1:0] avmReadData;
+input                   avmReadDataValid;
+input [1:0]             avmResponse;
+input                   avmWriteResponseValid;
+
+// Specifies the internal AXI bus signals.
+wire                   axiARValid;
+wire                   axiARReady;
+wire [AxiIdWidth-1:0]  axiARId;
+wire [63:0]            axiARAddr;
+wire [7:0]             axiARLen;
+wire [2:0]             axiARSize;
+wire [3:0]             axiARCache;
+
+wire                   axiRValid;
+wire                   axiRReady;
+wire [AxiIdWidth-1:0]  axiRId;
+wire [FlitWidth*8-1:0] axiRData;
+wire [1:0]             axiRResp;
+wire                   axiRLast;
+
+wire                   axiAWValid;
+wire                   axiAWReady;
+wire [AxiIdWidth-1:0]  axiAWId;
+wire [63:0]            axiAWAddr;
+wire [7:0]             axiAWLen;
+wire [2:0]             axiAWSize;
+wire [3:0]             axiAWCache;
+
+wire                   axiWValid;
+wire                   axiWReady;
+wire [AxiIdWidth-1:0]  axiWId;
+wire [FlitWidth*8-1:0] axiWData;
+wire [FlitWidth-1:0]   axiWStrb;
+wire                   axiWLast;
+
+wire                   axiBValid;
+wire                   axiBReady;
+wire [AxiIdWidth-1:0]  axiBId;
+wire [1:0]             axiBResp;
+
+// Specifies the command state machine signals.
+parameter [1:0]
+  CommandReset = 0,
+  CommandIdle  = 1,
+  CommandRead  = 2,
+  CommandWrite = 3;
+
+reg [1:0]             commandState_d;
+reg                   avmRead_d;
+reg                   avmWrite_d;
+reg [63:0]            avmAddress_d;
+reg [8:0]             avmBurstCount_d;
+reg [FlitWidth*8-1:0] avmWriteData_d;
+reg [FlitWidth-1:0]   avmByteEnable_d;
+reg                   writeDataDone_d;
+reg [AxiIdWidth-1:0]  writeId_d;
+reg                   writeRespWait_d;
+reg                   writeRespValid_d;
+reg [1:0]             writeResp_d;
+
+reg [1:0]             commandState_q;
+reg                   avmRead_q;
+reg                   avmWrite_q;
+reg [63:0]            avmAddress_q;
+reg [8:0]             avmBurstCount_q;
+reg [FlitWidth*8-1:0] avmWriteData_q;
+reg [FlitWidth-1:0]   avmByteEnable_q;
+reg                   writeDataDone_q;
+reg [AxiIdWidth-1:0]  writeId_q;
+reg                   writeRespWait_q;
+reg                   writeRespValid_q;
+reg [1:0]             writeResp_q;
+
+// Specifies the read data FIFO credit counter signals.
+reg [ReadFifoIndexSize:0] readCredit_d;
+reg [ReadFifoIndexSize:0] readCredit_q;
+
+// Specifies the read ID FIFO signals.
+wire                  readIdInValid;
+wire                  readIdInStop;
+wire                  readIdOutValid;
+wire [AxiIdWidth-1:0] readIdOutId;
+wire [7:0]            readIdOutLen;
+wire                  readIdOutStop;
+
+// Specifies the read data FIFO signals.
+wire                   readDataOutValid;
+wire [FlitWidth*8-1:0] readDataOutData;
+wire [1:0]             readDataOutResp;
+wire                   readDataOutStop;
+wire                   readDataInStop;
+
+// Specifies the read response beat counter.
+reg [7:0] readBeatCount_q;
+
+// Miscellaneous signals.
+wire axiARAccept;
+wire axiAWAccept;
+wire axiWAccept;
+wire readDataPop;
+
+// Instantiate the standard SMI/AXI memory adaptor.
+smiAxiMemBusAdaptor #(DataIndexSize, AxiIdWidth, FifoSize) axiBusAdaptor
+  (smiReqReady, smiReqEofc, smiReqData, smiReqStop, smiRespReady, smiRespEofc,
+  smiRespData, smiRespStop, axiARValid, axiARReady, axiARId, axiARAddr,
+  axiARLen, axiARSize, axiARCache, axiRValid, axiRReady, axiRId, axiRData,
+  axiRResp, axiRLast, axiAWValid, axiAWReady, axiAWId, axiAWAddr, axiAWLen,
+  axiAWSize, axiAWCache, axiWValid, axiWReady, axiWId, axiWData, axiWStrb,
+  axiWLast, axiBValid, axiBReady, axiBId, axiBResp, avmReset, clk, srst);
+
+// Derive the AXI handshake signals. Write requests are given priority over
+// read requests and reads are only accepted when there is a free slot in the
+// read ID FIFO and sufficient read data FIFO credit for the full burst. Only
+// one write burst may be awaiting a write response at any given time.
+assign axiAWReady = (commandState_q == CommandIdle) &
+  ~writeRespWait_q & ~writeRespValid_q;
+assign axiARReady = (commandState_q == CommandIdle) &
+  ~(axiAWValid & ~writeRespWait_q & ~writeRespValid_q) & ~readIdInStop &
+  (readCredit_q >= ({{(ReadFifoIndexSize-8){1'b0}}, axiARLen} + 1));
+assign axiWReady = (commandState_q == CommandWrite) & ~writeDataDone_q &
+  ~(avmWrite_q & avmWaitRequest);
+
+assign axiARAccept = axiARValid & axiARReady;
+assign axiAWAccept = axiAWValid & axiAWReady;
+assign axiWAccept = axiWValid & axiWReady;
+
+// Implement combinatorial logic for the command state machine.
+always @(commandState_q, avmRead_q, avmWrite_q, avmAddress_q, avmBurstCount_q,
+  avmWriteData_q, avmByteEnable_q, writeDataDone_q, writeId_q,
+  writeRespWait_q, writeRespValid_q, writeResp_q, avmWaitRequest,
+  avmResponse, avmWriteResponseValid, axiARAccept, axiARAddr, axiARLen,
+  axiAWAccept, axiAWAddr, axiAWLen, axiAWId, axiWAccept, axiWData, axiWStrb,
+  axiWLast, axiBReady)
+begin
+
+  // Hold current state by default.
+  commandState_d = commandState_q;
+  avmRead_d = avmRead_q;
+  avmWrite_d = avmWrite_q;
+  avmAddress_d = avmAddress_q;
+  avmBurstCount_d = avmBurstCount_q;
+  avmWriteData_d = avmWriteData_q;
+  avmByteEnable_d = avmByteEnable_q;
+  writeDataDone_d = writeDataDone_q;
+  writeId_d = writeId_q;
+  writeRespWait_d = writeRespWait_q;
+  writeRespValid_d = writeRespValid_q;
+  writeResp_d = writeResp_q;
+
+  // Clear the write response once it has been accepted.
+  if (axiBReady)
+    writeRespValid_d = 1'b0;
+
+  // Capture the Avalon-MM write response status when it becomes available.
+  if (writeRespWait_q & avmWriteResponseValid)
+  begin
+    writeRespWait_d = 1'b0;
+    writeRespValid_d = 1'b1;
+    writeResp_d = avmResponse;
+  end
+
+  // Implement state machine.
+  case (commandState_q)
+
+    // Issue a single read command for the full burst length.
+    CommandRead :
+    begin
+      if (~avmWaitRequest)
+      begin
+        avmRead_d = 1'b0;
+        commandState_d = CommandIdle;
+      end
+    end
+
+    // Issue write data words for the full burst length. The address and
+    // burst count are held for the duration of the burst.
+    CommandWrite :
+    begin
+      if (avmWrite_q & ~avmWaitRequest)
+        avmWrite_d = 1'b0;
+      if (axiWAccept)
+      begin
+        avmWrite_d = 1'b1;
+        avmWriteData_d = axiWData;
+        avmByteEnable_d = axiWStrb;
+        writeDataDone_d = axiWLast;
+      end
+      else if (writeDataDone_q & avmWrite_q & ~avmWaitRequest)
+      begin
+        if (WriteResponseEnable != 0)
+        begin
+          writeRespWait_d = 1'b1;
+        end
+        else
+        begin
+          writeRespValid_d = 1'b1;
+          writeResp_d = 2'b00;
+        end
+        commandState_d = CommandIdle;
+      end
+    end
+
+    // From the idle state, accept the next AXI write or read request.
+    CommandIdle :
+    begin
+      if (axiAWAccept)
+      begin
+        avmAddress_d = axiAWAddr;
+        avmBurstCount_d = {1'b0, axiAWLen} + 9'd1;
+        writeDataDone_d = 1'b0;
+        writeId_d = axiAWId;
+        commandState_d = CommandWrite;
+      end
+      else if (axiARAccept)
+      begin
+        avmRead_d = 1'b1;
+        avmAddress_d = axiARAddr;
+        avmBurstCount_d = {1'b0, axiARLen} + 9'd1;
+        commandState_d = CommandRead;
+      end
+    end
+
+    // From the reset state, transition to the idle state.
+    default :
+    begin
+      commandState_d = CommandIdle;
+    end
+  endcase
+end
+
+// Implement sequential logic for resettable command state machine signals.
+always @(posedge clk)
+begin
+  if (avmReset)
+  begin
+    commandState_q <= CommandReset;
+    avmRead_q <= 1'b0;
+    avmWrite_q <= 1'b0;
+    writeRespWait_q <= 1'b0;
+    writeRespValid_q <= 1'b0;
+  end
+  else
+  begin
+    commandState_q <= commandState_d;
+    avmRead_q <= avmRead_d;
+    avmWrite_q <= avmWrite_d;
+    writeRespWait_q <= writeRespWait_d;
+    writeRespValid_q <= writeRespValid_d;
+  end
+end
+
+// Implement sequential logic for non-resettable command datapath signals.
+always @(posedge clk)
+begin
+  avmAddress_q <= avmAddress_d;
+  avmBurstCount_q <= avmBurstCount_d;
+  avmWriteData_q <= avmWriteData_d;
+  avmByteEnable_q <= avmByteEnable_d;
+  writeDataDone_q <= writeDataDone_d;
+  writeId_q <= writeId_d;
+  writeResp_q <= writeResp_d;
+end
+
+// Implement the read data FIFO credit counter. Credit is consumed for the full
+// burst length when a read request is accepted and is returned as each read
+// data word is forwarded on the AXI read data bus.
+always @(readCredit_q, axiARAccept, axiARLen, readDataPop)
+begin
+  readCredit_d = readCredit_q;
+  if (axiARAccept)
+    readCredit_d = readCredit_d -
+      ({{(ReadFifoIndexSize-8){1'b0}}, axiARLen} + 1);
+  if (readDataPop)
+    readCredit_d = readCredit_d + 1;
+end
+
+always @(posedge clk)
+begin
+  if (avmReset)
+    readCredit_q <= ReadFifoSize [ReadFifoIndexSize:0];
+  else
+    readCredit_q <= readCredit_d;
+end
+
+// Track the IDs and lengths of outstanding read requests.
+assign readIdInValid = axiARAccept;
+
+smiSelfLinkBufferFifoS #(AxiIdWidth+8, 16, 4) readIdFifo
+  (readIdInValid, {axiARId, axiARLen}, readIdInStop, readIdOutValid,
+  {readIdOutId, readIdOutLen}, readIdOutStop, clk, avmReset);
+
+// Buffer the incoming Avalon-MM read data and response status.
+smiSelfLinkBufferFifoL #(FlitWidth*8+2, ReadFifoSize, ReadFifoIndexSize) readDataFifo
+  (avmReadDataValid, {avmResponse, avmReadData}, readDataInStop,
+  readDataOutValid, {readDataOutResp, readDataOutData}, readDataOutStop, clk,
+  avmReset);
+
+// Count the read data beats in the current burst.
+always @(posedge clk)
+begin
+  if (avmReset)
+    readBeatCount_q <= 8'd0;
+  else if (readDataPop)
+    readBeatCount_q <= axiRLast ? 8'd0 : readBeatCount_q + 8'd1;
+end
+
+// Derive the AXI read data signals.
+assign axiRValid = readDataOutValid & readIdOutValid;
+assign axiRId = readIdOutId;
+assign axiRData = readDataOutData;
+assign axiRResp = readDataOutResp;
+assign axiRLast = (readBeatCount_q == readIdOutLen) ? 1'b1 : 1'b0;
+
+assign readDataPop = axiRValid & axiRReady;
+assign readDataOutStop = ~(readIdOutValid & axiRReady);
+assign readIdOutStop = ~(readDataPop & axiRLast);
+
+// Derive the AXI write response signals. If write responses are disabled the
+// Avalon-MM writes are posted, so the write response is generated once the
+// final write word has been accepted.
+assign axiBValid = writeRespValid_q;
+assign axiBId = writeId_q;
+assign axiBResp = writeResp_q;
+
+// Derive the Avalon-MM command signals.
+assign avmAddress = avmAddress_q;
+assign avmBurstCount = avmBurstCount_q;
+assign avmRead = avmRead_q;
+assign avmWrite = avmWrite_q;
+assign avmWriteData = avmWriteData_q;
+assign avmByteEnable = avmByteEnable_q;
+
+endmodule
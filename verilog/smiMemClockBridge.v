@@ -0,0 +1,90 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+//
+// Provides an SMI memory bus connection between two asynchronous clock
+// domains. Requests are passed from the client clock domain to the server
+// clock domain and responses are passed from the server clock domain to the
+// client clock domain, using asynchronous FIFOs in each direction.
+//
+
+`timescale 1ns/1ps
+
+module smiMemClockBridge
+  (smiReqInReady, smiReqInEofc, smiReqInData, smiReqInStop, smiRespOutReady,
+  smiRespOutEofc, smiRespOutData, smiRespOutStop, smiReqOutReady, smiReqOutEofc,
+  smiReqOutData, smiReqOutStop, smiRespInReady, smiRespInEofc, smiRespInData,
+  smiRespInStop, clientClk, clientSrst, serverClk, serverSrst);
+
+// Specifies the width of the flit data ports as an integer power of two
+// number of bytes.
+parameter FlitWidth = 8;
+
+// Specifies the asynchronous FIFO index size, giving FIFOs with
+// 2^FifoIndexSize entries.
+parameter FifoIndexSize = 4;
+
+// Specifies the client side clock and active high synchronous reset signals.
+input clientClk;
+input clientSrst;
+
+// Specifies the server side clock and active high synchronous reset signals.
+input serverClk;
+input serverSrst;
+
+// Specifies the client side SMI request and response signals.
+input                   smiReqInReady;
+input [7:0]             smiReqInEofc;
+input [FlitWidth*8-1:0] smiReqInData;
+output                  smiReqInStop;
+
+output                   smiRespOutReady;
+output [7:0]             smiRespOutEofc;
+output [FlitWidth*8-1:0] smiRespOutData;
+input                    smiRespOutStop;
+
+// Specifies the server side SMI request and response signals.
+output                   smiReqOutReady;
+output [7:0]             smiReqOutEofc;
+output [FlitWidth*8-1:0] smiReqOutData;
+input                    smiReqOutStop;
+
+input                   smiRespInReady;
+input [7:0]             smiRespInEofc;
+input [FlitWidth*8-1:0] smiRespInData;
+output                  smiRespInStop;
+
+// Specifies the concatenated flit vectors.
+wire [FlitWidth*8+7:0] smiReqOutVec;
+wire [FlitWidth*8+7:0] smiRespOutVec;
+
+// Instantiate the request FIFO.
+smiSelfLinkAsyncFifo #(FlitWidth*8+8, FifoIndexSize) reqFifo
+  (smiReqInReady, {smiReqInEofc, smiReqInData}, smiReqInStop, smiReqOutReady,
+  smiReqOutVec, smiReqOutStop, clientClk, clientSrst, serverClk, serverSrst);
+
+assign smiReqOutEofc = smiReqOutVec [FlitWidth*8+7:FlitWidth*8];
+assign smiReqOutData = smiReqOutVec [FlitWidth*8-1:0];
+
+// Instantiate the response FIFO.
+smiSelfLinkAsyncFifo #(FlitWidth*8+8, FifoIndexSize) respFifo
+  (smiRespInReady, {smiRespInEofc, smiRespInData}, smiRespInStop, smiRespOutReady,
+  smiRespOutVec, smiRespOutStop, serverClk, serverSrst, clientClk, clientSrst);
+
+assign smiRespOutEofc = smiRespOutVec [FlitWidth*8+7:FlitWidth*8];
+assign smiRespOutData = smiRespOutVec [FlitWidth*8-1:0];
+
+endmodule
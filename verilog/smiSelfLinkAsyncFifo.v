@@ -0,0 +1,166 @@
+//
+// Copyright 2018 ReconfigureIO
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.//
+//
+
+//
+// Implementation of a SELF link FIFO which transfers data between two
+// asynchronous clock domains. The FIFO read and write pointers are passed
+// between the clock domains using Gray code synchronisers. The input and
+// output side resets are synchronous to their respective clocks and should
+// be asserted together for several cycles of the slower clock, so that both
+// sides of the FIFO are reset to the empty state.
+//
+
+`timescale 1ns/1ps
+
+module smiSelfLinkAsyncFifo
+  (dataInValid, dataIn, dataInStop, dataOutValid, dataOut, dataOutStop,
+  clkIn, srstIn, clkOut, srstOut);
+
+// Specifes the width of the data channel.
+parameter DataWidth = 8;
+
+// Specifies the FIFO index size. The FIFO size is given by 2^FifoIndexSize
+// and the minimum index size is 2.
+parameter FifoIndexSize = 4;
+
+// Specifies the FIFO size.
+parameter FifoSize = (1 << FifoIndexSize);
+
+// Specifies the 'upstream' data input ports.
+input [DataWidth-1:0] dataIn;
+input                 dataInValid;
+output                dataInStop;
+
+// Specifies the 'downstream' data output ports.
+output [DataWidth-1:0] dataOut;
+output                 dataOutValid;
+input                  dataOutStop;
+
+// Specify the clock and reset signals for each clock domain.
+input clkIn;
+input srstIn;
+input clkOut;
+input srstOut;
+
+// Specifies the FIFO storage array.
+reg [DataWidth-1:0] fifoArray [FifoSize-1:0];
+
+// Specify the input side state. Pointers include an additional wrap bit.
+reg [FifoIndexSize:0] writePtrBin_q;
+reg [FifoIndexSize:0] writePtrGray_q;
+reg                   writeFull_q;
+(* ASYNC_REG = "TRUE" *) reg [FifoIndexSize:0] readPtrGraySync1_q;
+(* ASYNC_REG = "TRUE" *) reg [FifoIndexSize:0] readPtrGraySync2_q;
+
+// Specify the output side state.
+reg [FifoIndexSize:0] readPtrBin_q;
+reg [FifoIndexSize:0] readPtrGray_q;
+reg [DataWidth-1:0]   dataOut_q;
+reg                   dataOutValid_q;
+(* ASYNC_REG = "TRUE" *) reg [FifoIndexSize:0] writePtrGraySync1_q;
+(* ASYNC_REG = "TRUE" *) reg [FifoIndexSize:0] writePtrGraySync2_q;
+
+// Combinatorial pointer update signals.
+wire                   writePush;
+wire [FifoIndexSize:0] writePtrBin_d;
+wire [FifoIndexSize:0] writePtrGray_d;
+wire                   writeFull_d;
+wire                   readEmpty;
+wire                   readPop;
+wire [FifoIndexSize:0] readPtrBin_d;
+wire [FifoIndexSize:0] readPtrGray_d;
+
+// Derive the next input side pointer values. The FIFO is full when the next
+// write pointer matches the synchronised read pointer with the two most
+// significant Gray code bits inverted.
+assign writePush = dataInValid & ~writeFull_q;
+assign writePtrBin_d = writePtrBin_q + {{FifoIndexSize{1'b0}}, writePush};
+assign writePtrGray_d = (writePtrBin_d >> 1) ^ writePtrBin_d;
+assign writeFull_d = (writePtrGray_d == {~readPtrGraySync2_q [FifoIndexSize:FifoIndexSize-1],
+  readPtrGraySync2_q [FifoIndexSize-2:0]});
+
+// Implement sequential logic for the input side.
+always @(posedge clkIn)
+begin
+  if (srstIn)
+  begin
+    writePtrBin_q <= {(FifoIndexSize+1){1'b0}};
+    writePtrGray_q <= {(FifoIndexSize+1){1'b0}};
+    writeFull_q <= 1'b1;
+    readPtrGraySync1_q <= {(FifoIndexSize+1){1'b0}};
+    readPtrGraySync2_q <= {(FifoIndexSize+1){1'b0}};
+  end
+  else
+  begin
+    writePtrBin_q <= writePtrBin_d;
+    writePtrGray_q <= writePtrGray_d;
+    writeFull_q <= writeFull_d;
+    readPtrGraySync1_q <= readPtrGray_q;
+    readPtrGraySync2_q <= readPtrGraySync1_q;
+  end
+end
+
+// Implement the FIFO storage writes.
+always @(posedge clkIn)
+begin
+  if (writePush)
+    fifoArray [writePtrBin_q [FifoIndexSize-1:0]] <= dataIn;
+end
+
+// Derive the next output side pointer values. The FIFO is empty when the
+// read pointer matches the synchronised write pointer. Entries are popped
+// into the output register whenever it is empty or being emptied.
+assign readEmpty = (readPtrGray_q == writePtrGraySync2_q);
+assign readPop = ~readEmpty & (~dataOutValid_q | ~dataOutStop);
+assign readPtrBin_d = readPtrBin_q + {{FifoIndexSize{1'b0}}, readPop};
+assign readPtrGray_d = (readPtrBin_d >> 1) ^ readPtrBin_d;
+
+// Implement sequential logic for the output side.
+always @(posedge clkOut)
+begin
+  if (srstOut)
+  begin
+    readPtrBin_q <= {(FifoIndexSize+1){1'b0}};
+    readPtrGray_q <= {(FifoIndexSize+1){1'b0}};
+    dataOutValid_q <= 1'b0;
+    writePtrGraySync1_q <= {(FifoIndexSize+1){1'b0}};
+    writePtrGraySync2_q <= {(FifoIndexSize+1){1'b0}};
+  end
+  else
+  begin
+    readPtrBin_q <= readPtrBin_d;
+    readPtrGray_q <= readPtrGray_d;
+    writePtrGraySync1_q <= writePtrGray_q;
+    writePtrGraySync2_q <= writePtrGraySync1_q;
+    if (readPop)
+      dataOutValid_q <= 1'b1;
+    else if (~dataOutStop)
+      dataOutValid_q <= 1'b0;
+  end
+end
+
+// Implement the output data register.
+always @(posedge clkOut)
+begin
+  if (readPop)
+    dataOut_q <= fifoArray [readPtrBin_q [FifoIndexSize-1:0]];
+end
+
+assign dataInStop = writeFull_q;
+assign dataOut = dataOut_q;
+assign dataOutValid = dataOutValid_q;
+
+endmodule